@@ -94,7 +94,7 @@ func generateAPIs(cmd *cobra.Command, args []string) error {
 	}
 	ctx, cancel := sdk.ContextWithSigterm(context.Background())
 	defer cancel()
-	sdkDirPath, err := sdk.EnsureRepo(ctx, optCacheDir, optRefreshCache, optAWSSDKGoVersion, optOutputPath)
+	sdkDirPath, err := ensureSDKModels(ctx, svcAlias)
 	if err != nil {
 		return err
 	}
@@ -107,7 +107,11 @@ func generateAPIs(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	ts, err := ackgenerate.APIs(m, optTemplateDirs)
+	tplDirs, err := templateDirs(optOutputPath)
+	if err != nil {
+		return err
+	}
+	ts, err := ackgenerate.APIs(m, tplDirs)
 	if err != nil {
 		return err
 	}
@@ -14,7 +14,9 @@
 package command
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"sort"
 	"strings"
 
@@ -45,12 +47,12 @@ func loadModel(svcAlias string, apiVersion string, apiGroup string, defaultCfg a
 		return nil, err
 	}
 
-	modelName := strings.ToLower(cfg.SDKNames.Model)
-	if modelName == "" {
-		modelName = svcAlias
-	}
+	modelName := sdkModelName(svcAlias, cfg)
 
 	sdkHelper := acksdk.NewHelper(sdkDir, cfg)
+	if modelDir := acksdk.LocalModelDir(cfg.ModelSource, optModelPath, optOutputPath); modelDir != "" {
+		sdkHelper.WithModelDir(modelDir)
+	}
 	sdkAPI, err := sdkHelper.API(modelName)
 	if err != nil {
 		retryModelName, err := FallBackFindServiceID(sdkDir, svcAlias)
@@ -82,6 +84,52 @@ func loadModel(svcAlias string, apiVersion string, apiGroup string, defaultCfg a
 	return m, nil
 }
 
+// sdkModelName returns the name of the service's API model directory, which is
+// the configured SDK model name if any, or the service alias.
+func sdkModelName(svcAlias string, cfg ackgenconfig.Config) string {
+	modelName := strings.ToLower(cfg.SDKNames.Model)
+	if modelName == "" {
+		modelName = svcAlias
+	}
+	return modelName
+}
+
+// ensureSDKModels returns the path of the directory containing the AWS API
+// models used for generation. If the API model is read from a local model
+// directory, it is verified to exist and an empty path is returned. If the
+// service controller's repository contains API models vendored with
+// `ack-generate fetch-model`, their path is returned once the API model has
+// been verified against the pinned checksum. Otherwise, the aws-sdk-go
+// repository is cloned or updated in the cache directory, and the version
+// passed with --aws-sdk-go-version, or else the git ref of the model source,
+// is checked out.
+func ensureSDKModels(ctx context.Context, svcAlias string) (string, error) {
+	cfg, err := ackgenconfig.New(optGeneratorConfigPath, ackgenerate.DefaultConfig)
+	if err != nil {
+		return "", err
+	}
+	if modelDir := acksdk.LocalModelDir(cfg.ModelSource, optModelPath, optOutputPath); modelDir != "" {
+		return "", acksdk.VerifyLocalModel(modelDir)
+	}
+	modelsPath := acksdk.VendoredModelsPath(optOutputPath)
+	if fi, err := os.Stat(modelsPath); err != nil || !fi.IsDir() {
+		sdkVersion := optAWSSDKGoVersion
+		if sdkVersion == "" && cfg.ModelSource != nil {
+			sdkVersion = cfg.ModelSource.GitRef
+		}
+		return acksdk.EnsureRepo(ctx, optCacheDir, optRefreshCache, sdkVersion, optOutputPath)
+	}
+	if err = acksdk.VerifyVendoredModel(
+		cfg.ModelSource, sdkModelName(svcAlias, cfg), modelsPath,
+	); err != nil {
+		return "", fmt.Errorf(
+			"vendored API model in %s is out of date, run `%s fetch-model %s`: %v",
+			modelsPath, appName, svcAlias, err,
+		)
+	}
+	return modelsPath, nil
+}
+
 // getLatestAPIVersion looks in the controller metadata file to determine what
 // the latest Kubernetes API version for CRDs exposed by the generated service
 // controller.
@@ -34,6 +34,7 @@ var (
 	cmdControllerPath string
 	pkgResourcePath   string
 	latestAPIVersion  string
+	optVerifyLock     bool
 )
 
 var controllerCmd = &cobra.Command{
@@ -43,6 +44,9 @@ var controllerCmd = &cobra.Command{
 }
 
 func init() {
+	controllerCmd.PersistentFlags().BoolVar(
+		&optVerifyLock, "verify-lock", false, "If true, does not write any files and instead verifies that the existing controller outputs and generation.lock match the current generator, SDK model, config and templates",
+	)
 	rootCmd.AddCommand(controllerCmd)
 }
 
@@ -58,7 +62,7 @@ func generateController(cmd *cobra.Command, args []string) error {
 
 	ctx, cancel := sdk.ContextWithSigterm(context.Background())
 	defer cancel()
-	sdkDirPath, err := sdk.EnsureRepo(ctx, optCacheDir, optRefreshCache, optAWSSDKGoVersion, optOutputPath)
+	sdkDirPath, err := ensureSDKModels(ctx, svcAlias)
 	if err != nil {
 		return err
 	}
@@ -75,7 +79,11 @@ func generateController(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	ts, err := ackgenerate.Controller(m, optTemplateDirs, serviceAccountName)
+	tplDirs, err := templateDirs(optOutputPath)
+	if err != nil {
+		return err
+	}
+	ts, err := ackgenerate.Controller(m, tplDirs, serviceAccountName)
 	if err != nil {
 		return err
 	}
@@ -84,6 +92,21 @@ func generateController(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	outputs := map[string][]byte{}
+	for path, contents := range ts.Executed() {
+		outputs[path] = contents.Bytes()
+	}
+	lock, err := ackmetadata.NewGenerationLock(
+		optAWSSDKGoVersion, m.SDKAPI.ModelPath, optGeneratorConfigPath,
+		tplDirs, outputs, ts.Sources(),
+	)
+	if err != nil {
+		return err
+	}
+	if optVerifyLock {
+		return verifyGenerationLock(lock, optOutputPath)
+	}
+
 	for path, contents := range ts.Executed() {
 		if optDryRun {
 			fmt.Printf("============================= %s ======================================\n", path)
@@ -99,6 +122,33 @@ func generateController(cmd *cobra.Command, args []string) error {
 			return err
 		}
 	}
+	if optDryRun {
+		return nil
+	}
+	return lock.Write(optOutputPath)
+}
+
+// verifyGenerationLock compares the generation.lock found in the output
+// directory with the supplied lock, computed from the current inputs, and
+// returns an error describing any mismatch
+func verifyGenerationLock(current *ackmetadata.GenerationLock, outputPath string) error {
+	locked, err := ackmetadata.LoadGenerationLock(outputPath)
+	if err != nil {
+		return err
+	}
+	mismatches := locked.Verify(current)
+	outputMismatches, err := locked.VerifyOutputs(outputPath)
+	if err != nil {
+		return err
+	}
+	mismatches = append(mismatches, outputMismatches...)
+	if len(mismatches) > 0 {
+		return fmt.Errorf(
+			"generated outputs do not match %s:\n  %s",
+			ackmetadata.GenerationLockFileName, strings.Join(mismatches, "\n  "),
+		)
+	}
+	fmt.Printf("outputs match %s\n", ackmetadata.GenerationLockFileName)
 	return nil
 }
 
@@ -0,0 +1,79 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	ackgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	"github.com/aws-controllers-k8s/code-generator/pkg/sdk"
+)
+
+// fetchModelCmd is the command that downloads the pinned API model of a
+// service into the service controller's repository
+var fetchModelCmd = &cobra.Command{
+	Use:   "fetch-model <service>",
+	Short: "Download the API model pinned in the generator config into the service controller repository",
+	RunE:  fetchModel,
+}
+
+func init() {
+	rootCmd.AddCommand(fetchModelCmd)
+}
+
+// fetchModel downloads the API model files of the service from the source
+// pinned in the generator config's `model_source` and stores them in the
+// service controller's repository, where subsequent generation picks them up
+// instead of the aws-sdk-go repository.
+func fetchModel(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("please specify the service alias for the AWS service API to fetch")
+	}
+	svcAlias := strings.ToLower(args[0])
+	if optOutputPath == "" {
+		optOutputPath = filepath.Join(optServicesDir, svcAlias)
+	}
+	cfg, err := ackgenconfig.New(optGeneratorConfigPath, ackgenerate.DefaultConfig)
+	if err != nil {
+		return err
+	}
+	if cfg.ModelSource == nil {
+		return fmt.Errorf(
+			"generator config %q does not specify a model_source", optGeneratorConfigPath,
+		)
+	}
+
+	ctx, cancel := sdk.ContextWithSigterm(context.Background())
+	defer cancel()
+	modelsPath := sdk.VendoredModelsPath(optOutputPath)
+	sum, err := sdk.FetchModel(ctx, cfg.ModelSource, sdkModelName(svcAlias, cfg), modelsPath)
+	if err != nil {
+		return fmt.Errorf("cannot fetch API model: %v", err)
+	}
+	if cfg.ModelSource.Checksum == "" {
+		fmt.Printf(
+			"API model stored in %s. Pin it by adding `checksum: %s` to model_source\n",
+			modelsPath, sum,
+		)
+		return nil
+	}
+	fmt.Printf("API model stored in %s\n", modelsPath)
+	return nil
+}
@@ -86,7 +86,7 @@ func generateOLMAssets(cmd *cobra.Command, args []string) error {
 	// get the generator inputs
 	ctx, cancel := sdk.ContextWithSigterm(context.Background())
 	defer cancel()
-	sdkDirPath, err := sdk.EnsureRepo(ctx, optCacheDir, optRefreshCache, optAWSSDKGoVersion, optOutputPath)
+	sdkDirPath, err := ensureSDKModels(ctx, svcAlias)
 	if err != nil {
 		return err
 	}
@@ -130,7 +130,11 @@ func generateOLMAssets(cmd *cobra.Command, args []string) error {
 	}
 
 	// generate templates
-	ts, err := olmgenerate.BundleAssets(m, commonMeta, svcConf, version, optImageRepository, optTemplateDirs)
+	tplDirs, err := templateDirs(optOutputPath)
+	if err != nil {
+		return err
+	}
+	ts, err := olmgenerate.BundleAssets(m, commonMeta, svcConf, version, optImageRepository, tplDirs)
 	if err != nil {
 		return err
 	}
@@ -61,7 +61,7 @@ func generateRelease(cmd *cobra.Command, args []string) error {
 
 	ctx, cancel := sdk.ContextWithSigterm(context.Background())
 	defer cancel()
-	sdkDirPath, err := sdk.EnsureRepo(ctx, optCacheDir, optRefreshCache, optAWSSDKGoVersion, optOutputPath)
+	sdkDirPath, err := ensureSDKModels(ctx, svcAlias)
 	if err != nil {
 		return err
 	}
@@ -76,8 +76,12 @@ func generateRelease(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	tplDirs, err := templateDirs(optReleaseOutputPath)
+	if err != nil {
+		return err
+	}
 	ts, err := ackgenerate.Release(
-		m, metadata, optTemplateDirs,
+		m, metadata, tplDirs,
 		releaseVersion, optImageRepository, optServiceAccountName,
 	)
 	if err != nil {
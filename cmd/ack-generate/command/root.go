@@ -34,11 +34,13 @@ var (
 	optCacheDir                string
 	optRefreshCache            bool
 	optAWSSDKGoVersion         string
+	optModelPath               string
 	defaultTemplateDirs        []string
 	optTemplateDirs            []string
 	defaultServicesDir         string
 	optServicesDir             string
 	optDryRun                  bool
+	optVendoredTemplates       bool
 	sdkDir                     string
 	optGeneratorConfigPath     string
 	optMetadataConfigPath      string
@@ -100,6 +102,9 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(
 		&optDryRun, "dry-run", false, "If true, outputs all files to stdout",
 	)
+	rootCmd.PersistentFlags().BoolVar(
+		&optVendoredTemplates, "vendored-templates", false, "If true, generates from the snapshot of templates stored in the service controller repository by `ack-generate vendor-templates` instead of --template-dirs",
+	)
 	rootCmd.PersistentFlags().StringSliceVar(
 		&optTemplateDirs, "template-dirs", defaultTemplateDirs, "Paths to directories with templates to use in code generation. Note that the order in which directories is specified will be used to provide override functionality.",
 	)
@@ -127,6 +132,9 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(
 		&optAWSSDKGoVersion, "aws-sdk-go-version", "", "Version of github.com/aws/aws-sdk-go used to generate apis and controllers files",
 	)
+	rootCmd.PersistentFlags().StringVar(
+		&optModelPath, "model-path", "", "Path to a local directory containing the service's api-2.json (and optional docs-2.json) model files to generate from, overriding the model source of the generator config",
+	)
 	rootCmd.PersistentFlags().StringVar(
 		&optServiceAccountName, "service-account-name", "", "The name of the ServiceAccount used for ACK service controller",
 	)
@@ -0,0 +1,101 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package command
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
+)
+
+// vendorTemplatesCmd is the command that copies the templates used for code
+// generation into the service controller's repository
+var vendorTemplatesCmd = &cobra.Command{
+	Use:   "vendor-templates <service>",
+	Short: "Copy the templates used for code generation into the service controller repository",
+	RunE:  vendorTemplates,
+}
+
+func init() {
+	rootCmd.AddCommand(vendorTemplatesCmd)
+}
+
+// vendorTemplates replaces the snapshot of templates stored in the service
+// controller's repository with a copy of the template directories, except the
+// repository's own `templates` directory holding its hook templates.
+// Generating with `--vendored-templates` then uses that snapshot instead of
+// those template directories, so that the templates can be patched locally
+// and upgraded by running this command again.
+func vendorTemplates(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("please specify the service alias for the AWS service API to vendor templates for")
+	}
+	svcAlias := strings.ToLower(args[0])
+	if optOutputPath == "" {
+		optOutputPath = filepath.Join(optServicesDir, svcAlias)
+	}
+	if optVendoredTemplates {
+		return fmt.Errorf("cannot vendor templates from the vendored templates")
+	}
+	_, baseDirs, err := templateset.SplitControllerTemplateDirs(
+		optOutputPath, optTemplateDirs,
+	)
+	if err != nil {
+		return err
+	}
+	vendorPath := templateset.VendoredTemplatesPath(optOutputPath)
+	if optDryRun {
+		fmt.Printf("would copy %s into %s\n", strings.Join(baseDirs, ", "), vendorPath)
+		return nil
+	}
+	manifest, err := templateset.Vendor(baseDirs, vendorPath)
+	if err != nil {
+		return fmt.Errorf("cannot vendor templates: %v", err)
+	}
+	fmt.Printf(
+		"templates of %s %s stored in %s\n",
+		appName, manifest.ACKGenerateVersion, vendorPath,
+	)
+	return nil
+}
+
+// templateDirs returns the template directories to generate from: the
+// `--template-dirs` that are the service controller repository's own
+// templates followed by the snapshot of templates stored in that repository
+// when generating with `--vendored-templates`, the `--template-dirs`
+// otherwise.
+func templateDirs(controllerRepoPath string) ([]string, error) {
+	if !optVendoredTemplates {
+		return optTemplateDirs, nil
+	}
+	controllerDirs, _, err := templateset.SplitControllerTemplateDirs(
+		controllerRepoPath, optTemplateDirs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	vendorPath := templateset.VendoredTemplatesPath(controllerRepoPath)
+	dirs, err := templateset.VendoredSearchPaths(vendorPath)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"no vendored templates in %s, run `%s vendor-templates`: %v",
+			vendorPath, appName, err,
+		)
+	}
+	return append(controllerDirs, dirs...), nil
+}
@@ -0,0 +1,69 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
+)
+
+func TestTemplateDirs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	repoPath := t.TempDir()
+	hooksDir := filepath.Join(repoPath, "templates")
+	baseDir := t.TempDir()
+	require.NoError(os.MkdirAll(hooksDir, 0755))
+	require.NoError(os.WriteFile(filepath.Join(baseDir, "boilerplate.txt"), []byte("boilerplate"), 0644))
+
+	defer func(dirs []string, vendored bool, outputPath string, dryRun bool) {
+		optTemplateDirs = dirs
+		optVendoredTemplates = vendored
+		optOutputPath = outputPath
+		optDryRun = dryRun
+	}(optTemplateDirs, optVendoredTemplates, optOutputPath, optDryRun)
+	optTemplateDirs = []string{hooksDir, baseDir}
+	optOutputPath = repoPath
+	optDryRun = false
+
+	optVendoredTemplates = false
+	dirs, err := templateDirs(repoPath)
+	require.NoError(err)
+	assert.Equal([]string{hooksDir, baseDir}, dirs)
+
+	// Without a snapshot, generating from vendored templates fails
+	optVendoredTemplates = true
+	_, err = templateDirs(repoPath)
+	assert.Error(err)
+
+	// The service controller's own templates are not vendored, and are still
+	// searched first when generating from the snapshot
+	optVendoredTemplates = false
+	require.NoError(vendorTemplates(vendorTemplatesCmd, []string{"ecr"}))
+	vendorPath := templateset.VendoredTemplatesPath(repoPath)
+	assert.FileExists(filepath.Join(vendorPath, "0", "boilerplate.txt"))
+	assert.NoDirExists(filepath.Join(vendorPath, "1"))
+
+	optVendoredTemplates = true
+	dirs, err = templateDirs(repoPath)
+	require.NoError(err)
+	assert.Equal([]string{hooksDir, filepath.Join(vendorPath, "0")}, dirs)
+}
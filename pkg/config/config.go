@@ -52,6 +52,176 @@ type Config struct {
 	// documentdb.
 	// This will also change the helm chart and image names.
 	ControllerName string `json:"controller_name,omitempty"`
+	// ModelSource pins the exact source of the service's API model, which
+	// `ack-generate fetch-model` downloads and stores in the service
+	// controller's repository so that generation does not depend on a
+	// checkout of aws-sdk-go.
+	ModelSource *ModelSourceConfig `json:"model_source,omitempty"`
+	// CustomShapes contains new structure shapes, keyed by shape name, that
+	// do not exist in the service's API model. Custom fields refer to them
+	// with `custom_field.struct_of`, `custom_field.list_of` or
+	// `custom_field.map_of` to model controller-only concepts.
+	CustomShapes map[string]*CustomShapeConfig `json:"custom_shapes,omitempty"`
+	// HealthCheck instructs the code generator to generate a readiness check
+	// of the controller that calls an operation of the service's API, so
+	// that invalid credentials or an unreachable endpoint make the
+	// controller's pods unready instead of failing each reconcile silently.
+	HealthCheck *HealthCheckConfig `json:"health_check,omitempty"`
+	// Endpoint instructs the code generator to construct the service's API
+	// clients with endpoint options, e.g. to use FIPS or dual-stack
+	// endpoints or a VPC endpoint, instead of relying on the runtime's
+	// defaults only.
+	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+	// TypedEnums instructs the code generator to type the top-level Spec and
+	// Status fields holding enum strings with the Go string alias generated
+	// for the enum, e.g. `*EncryptionType` instead of `*string`, and to
+	// validate the values of the Spec fields with a
+	// `+kubebuilder:validation:Enum` marker. Status fields are not validated
+	// since the AWS API may return values added after the controller was
+	// generated. A `{Enum}_Values()` function listing the valid values of each
+	// enum is also generated.
+	TypedEnums bool `json:"typed_enums,omitempty"`
+	// InferNestedRequiredFields instructs the code generator to mark the
+	// members of the generated struct types listed as required by their
+	// shape in the AWS API model with a `+kubebuilder:validation:Required`
+	// marker, like it already does for top-level Spec fields required by the
+	// Create input shape. The `is_required` config of a nested field path,
+	// e.g. `ResourcesVpcConfig.SubnetIds`, overrides the inferred value.
+	InferNestedRequiredFields bool `json:"infer_nested_required_fields,omitempty"`
+	// TypeNames maps the names of structure shapes of the service's API
+	// model to the names of the Go types generated for them, overriding the
+	// Camel-cased shape names. Structure shapes whose Camel-cased names
+	// collide, e.g. `VpcConfig` and `VPCConfig`, are otherwise disambiguated
+	// by suffixing the name of all but the first of them, in shape name
+	// order, with a number, e.g. `VPCConfig2`.
+	TypeNames map[string]string `json:"type_names,omitempty"`
+	// DefaultTags are the tags, keyed by tag key, added to the tags of the
+	// AWS resources of the service in addition to the controller's default
+	// tags configured with its `--resource-tags` flag, e.g. to enforce a
+	// tagging policy. The values are expanded like the values of the
+	// controller's default tags, e.g. `%K8S_NAMESPACE%` and
+	// `%K8S_RESOURCE_NAME%`, and `%K8S_CLUSTER_NAME%` is expanded to the
+	// value of the controller's ACK_CLUSTER_NAME environment variable, set
+	// from the `clusterName` value of its helm chart. Resources with a tag
+	// referring to `%K8S_CLUSTER_NAME%` get a terminal error while the
+	// variable is empty. Tags of the resource and the controller's default
+	// tags take precedence.
+	DefaultTags map[string]string `json:"default_tags,omitempty"`
+}
+
+// HealthCheckConfig describes the AWS API call made by the controller's
+// readiness check. The operation should be a cheap, read-only call that needs
+// no input, typically a List or Describe operation.
+//
+// Example:
+//
+//	health_check:
+//	  operation: DescribeRepositories
+//	  timeout_seconds: 5
+//	  interval_seconds: 60
+type HealthCheckConfig struct {
+	// Operation is the name of the API operation called by the check. Its
+	// input shape must not have required members.
+	Operation string `json:"operation"`
+	// TimeoutSeconds is the number of seconds after which the call is
+	// considered failed. Defaults to 5.
+	TimeoutSeconds int `json:"timeout_seconds,omitempty"`
+	// IntervalSeconds is the number of seconds the result of the call is
+	// reused for by subsequent checks, limiting the rate of API calls made by
+	// the probes. Defaults to 60.
+	IntervalSeconds int `json:"interval_seconds,omitempty"`
+}
+
+// EndpointConfig describes the endpoint options the service's API clients,
+// used by the resource managers and the readiness check, are constructed
+// with.
+//
+// Example:
+//
+//	endpoint:
+//	  use_fips: true
+//	  use_dual_stack: true
+//	  url_env_var: ECR_ENDPOINT_URL
+type EndpointConfig struct {
+	// UseFIPS makes the clients send their requests to the FIPS endpoints of
+	// the service.
+	UseFIPS bool `json:"use_fips,omitempty"`
+	// UseDualStack makes the clients send their requests to the dual-stack
+	// (IPv4 and IPv6) endpoints of the service.
+	UseDualStack bool `json:"use_dual_stack,omitempty"`
+	// URLEnvVar is the name of an environment variable of the controller
+	// which, when set, holds the URL of the endpoint the clients send their
+	// requests to, e.g. the DNS name of a VPC endpoint of the service. It
+	// takes precedence over the controller's --endpoint-url flag.
+	URLEnvVar string `json:"url_env_var,omitempty"`
+}
+
+// CustomShapeConfig describes a new structure shape added to the service's
+// API model by the code generator.
+//
+// Example:
+//
+//	custom_shapes:
+//	  SimpleCode:
+//	    documentation: The location of a function's deployment package
+//	    members:
+//	      S3Bucket: string
+//	      S3Key: string
+//	      S3ObjectVersion: string
+//	      Tags: map[string]*string
+//	resources:
+//	  Function:
+//	    fields:
+//	      SimpleCode:
+//	        custom_field:
+//	          struct_of: SimpleCode
+type CustomShapeConfig struct {
+	// Documentation is the documentation of the generated Go type
+	Documentation string `json:"documentation,omitempty"`
+	// Members maps the names of the structure's members to their types:
+	// either the name of a shape in the API model or another custom shape,
+	// or a Go type ("string", "bool", "int64", "float64", "time.Time" or
+	// "bytes", optionally in a slice or a map with string keys, like
+	// "[]*string" or "map[string]*string").
+	Members map[string]string `json:"members"`
+}
+
+// ModelSourceConfig identifies where the service's API model JSON files are
+// downloaded from, either a git ref of aws-sdk-go or a URL, along with the
+// checksum the API model file must match. The model files may instead be
+// read from a local directory, e.g. to generate a controller against
+// unreleased API changes.
+//
+// Example:
+//
+//	model_source:
+//	  git_ref: v1.44.93
+//	  api_version: 2015-09-21
+//	  checksum: sha256:<hex digest of api-2.json>
+type ModelSourceConfig struct {
+	// GitRef is the aws-sdk-go tag or commit the model files are taken from.
+	// When the API model is not vendored, it also pins the version of the
+	// aws-sdk-go checkout, unless the --aws-sdk-go-version flag is passed.
+	GitRef string `json:"git_ref,omitempty"`
+	// URL is the URL of the API model (api-2.json) file. It is used instead
+	// of GitRef, for instance for models not published in aws-sdk-go.
+	URL string `json:"url,omitempty"`
+	// DocsURL is the URL of the documentation (docs-2.json) file. Only used
+	// along with URL. When empty, no documentation file is fetched.
+	DocsURL string `json:"docs_url,omitempty"`
+	// APIVersion is the version of the service API, e.g. "2015-09-21", under
+	// which the model files are stored.
+	APIVersion string `json:"api_version"`
+	// Checksum is the expected checksum of the API model file, in the
+	// "sha256:<hex digest>" format. When empty, the checksum is not verified.
+	Checksum string `json:"checksum,omitempty"`
+	// LocalPath is the path of a local directory holding the API model
+	// (api-2.json) and, optionally, documentation (docs-2.json) files.
+	// Relative paths are relative to the service controller's repository.
+	// When set, the model files are read from this directory, and neither
+	// the vendored API model nor aws-sdk-go is used. The --model-path flag
+	// overrides it.
+	LocalPath string `json:"local_path,omitempty"`
 }
 
 // SDKNames contains information on the SDK Client package. More precisely
@@ -94,6 +264,11 @@ type IgnoreSpec struct {
 	// Set of field paths to ignore. The name here should be the original name of
 	// the field as it appears in AWS SDK objects. You can refer to a field by
 	// giving its "<shape_name>.<field_name>". For example, "CreateApiInput.Name".
+	//
+	// Field paths may contain wildcards, matched against the API model's
+	// shapes and members, e.g. "*.KmsKeyId" ignores the KmsKeyId member of
+	// every shape and "Instance.Rules[*].Internal*" ignores the members
+	// starting with "Internal" of the elements of Instance's Rules list.
 	FieldPaths []string `json:"field_paths"`
 }
 
@@ -15,6 +15,7 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 )
 
@@ -101,6 +102,53 @@ import (
 //	      from:
 //	        operation: GetFunction
 //	        path: Code.RegisteredImageUri
+//
+// The operation may also be the resource's ReadMany (List) Operation, in which
+// case the first element of the path names the repeated member of its Output
+// shape. For resources read with a ReadOne or GetAttributes Operation, the
+// code generator then calls the ReadMany Operation and copies the field's
+// value from the element whose list operation match fields (or identifier
+// field) have the resource's values, reading the pages of results until the
+// element is found. The field is cleared when no element matches:
+//
+//	Function:
+//	  fields:
+//	    ListedCodeSize:
+//	      is_read_only: true
+//	      from:
+//	        operation: ListFunctions
+//	        path: Functions.CodeSize
+//
+// `from` may also be an ordered list of sources. The field's Go type is
+// determined by the first source whose Operation exists in the API model, and
+// the code generator sets a Status field from the first source in the list
+// with a value in the Output shape of the API call being handled, falling
+// back to the next source otherwise:
+//
+//	Function:
+//	  fields:
+//	    CodeLocation:
+//	      is_read_only: true
+//	      from:
+//	        - operation: GetFunction
+//	          path: Code.Location
+//	        - operation: GetFunction
+//	          path: Code.ImageUri
+//
+// A Status field may also be sourced from a key of the attributes map returned
+// by the resource's GetAttributes Operation, naming the key with `attribute`.
+// The attribute's string value is parsed according to the field's
+// `attribute_type`. The code generator calls the GetAttributes Operation after
+// the ReadOne Operation for resources read with their ReadOne Operation:
+//
+//	Queue:
+//	  fields:
+//	    ApproximateNumberOfMessages:
+//	      is_read_only: true
+//	      attribute_type: int
+//	      from:
+//	        operation: GetQueueAttributes
+//	        attribute: ApproximateNumberOfMessages
 type SourceFieldConfig struct {
 	// Operation refers to the ID of the API Operation where we will
 	// determine the field's Go type.
@@ -109,6 +157,70 @@ type SourceFieldConfig struct {
 	// shape in the Operation identified by OperationID that we will take as
 	// our additional spec/status field's value.
 	Path string `json:"path"`
+	// Attribute is the key, in the attributes map of the Output shape of the
+	// GetAttributes Operation identified by OperationID, of the attribute we
+	// will take as our additional status field's value. Path then refers to
+	// the attributes map member and defaults to "Attributes".
+	Attribute string `json:"attribute,omitempty"`
+	// Fallbacks contains, in order of preference, the sources to take the
+	// field's value from when the source identified by Operation and Path
+	// has none. It is populated when `from` is a list, with every element of
+	// the list but the first one.
+	Fallbacks []SourceFieldConfig `json:"-"`
+}
+
+// Sources returns the field's sources in order of preference.
+func (c *SourceFieldConfig) Sources() []SourceFieldConfig {
+	if c == nil {
+		return nil
+	}
+	first := *c
+	first.Fallbacks = nil
+	return append([]SourceFieldConfig{first}, c.Fallbacks...)
+}
+
+// IsAttribute returns true if the field is sourced from a key of an
+// attributes map, i.e. `from` has an `attribute`.
+func (c *SourceFieldConfig) IsAttribute() bool {
+	return c != nil && c.Attribute != ""
+}
+
+// AttributesPath returns the field path of the attributes map member the
+// field's attribute is sourced from.
+func (c *SourceFieldConfig) AttributesPath() string {
+	if c.Path == "" {
+		return "Attributes"
+	}
+	return c.Path
+}
+
+// HasFallbacks returns true if `from` is a list of more than one source.
+func (c *SourceFieldConfig) HasFallbacks() bool {
+	return c != nil && len(c.Fallbacks) > 0
+}
+
+// UnmarshalJSON unmarshals a SourceFieldConfig from either a single source
+// object or an ordered list of source objects.
+func (c *SourceFieldConfig) UnmarshalJSON(b []byte) error {
+	// sourceFieldConfig has no UnmarshalJSON method, avoiding recursion
+	type sourceFieldConfig SourceFieldConfig
+	var sources []sourceFieldConfig
+	if err := json.Unmarshal(b, &sources); err != nil {
+		var source sourceFieldConfig
+		if err := json.Unmarshal(b, &source); err != nil {
+			return err
+		}
+		*c = SourceFieldConfig(source)
+		return nil
+	}
+	if len(sources) == 0 {
+		return fmt.Errorf("from must contain at least one source")
+	}
+	*c = SourceFieldConfig(sources[0])
+	for _, source := range sources[1:] {
+		c.Fallbacks = append(c.Fallbacks, SourceFieldConfig(source))
+	}
+	return nil
 }
 
 // SetFieldConfig instructs the code generator how to handle setting the value
@@ -270,6 +382,33 @@ type CompareFieldConfig struct {
 	// NilEqualsZeroValue indicates a nil pointer and zero-value pointed-to
 	// value should be considered equal for the purposes of comparison
 	NilEqualsZeroValue bool `json:"nil_equals_zero_value"`
+	// IsSet indicates a list field has set semantics: the order of its
+	// elements is ignored when comparing a resource. Useful for lists that
+	// the AWS service returns in arbitrary order.
+	IsSet bool `json:"is_set"`
+	// NilEqualsEmpty indicates a nil and an empty slice or map should be
+	// considered equal for the purposes of comparison, including within the
+	// elements of a list of structs or the values of a map of structs. Useful
+	// for collections the AWS service returns empty when they were not set.
+	NilEqualsEmpty bool `json:"nil_equals_empty"`
+	// CaseInsensitive indicates a string field's values should be compared
+	// regardless of case. Useful for fields whose case is normalized by the
+	// AWS service, e.g. lowercased names or uppercased engine names.
+	CaseInsensitive bool `json:"case_insensitive"`
+	// IsJSON indicates a string field holds a JSON document, such as a
+	// policy document, whose values should be compared semantically, ignoring
+	// whitespace and the order of object keys. Useful for documents the AWS
+	// service reformats when storing them.
+	IsJSON bool `json:"is_json"`
+	// CustomMethodName is the name of a hand-written function, in the
+	// resource's package, that the generated delta code calls to compare the
+	// field instead of generating the comparison itself. The function must
+	// have the signature:
+	//
+	//	func(delta *ackcompare.Delta, a, b <field Go type>)
+	//
+	// and is responsible for calling `delta.Add()` when the values differ.
+	CustomMethodName string `json:"custom_method_name,omitempty"`
 }
 
 // PrintFieldConfig instructs the code generator how to handle kubebuilder:printcolumn
@@ -290,34 +429,286 @@ type PrintFieldConfig struct {
 	// The field with the smallest index will be right next to the first column (NAME).
 	// The field with the biggest index will be positioned right before the last column (AGE).
 	Index int `json:"index"`
+	// Type overrides the OpenAPI type of the column, which is otherwise
+	// inferred from the field's Go type. One of "string", "boolean",
+	// "integer", "number" or "date". Required when JSONPath points to a
+	// value whose type differs from the field's type.
+	Type string `json:"type,omitempty"`
+	// Format is the OpenAPI format of the column, e.g. "date-time" or
+	// "int32", used by kubectl to render the column's values.
+	Format string `json:"format,omitempty"`
+	// JSONPath overrides the JSONPath of the column, which is otherwise the
+	// path of the field in the CR. Useful for columns pointing into nested
+	// structs or list elements of the field, e.g.
+	// `.status.vpcConfig.subnetIDs[0]`.
+	JSONPath string `json:"json_path,omitempty"`
 }
 
-// CustomField instructs the code generator to create a new list or map field
-// type using a shape that exists in the SDK.
+// CustomField instructs the code generator to create a new struct, list or
+// map field type using a shape that exists in the SDK or a custom shape
+// defined in the top-level `custom_shapes` config.
 type CustomFieldConfig struct {
-	// ListOf provides the name of the SDK shape which will become the
-	// member of a custom slice field.
+	// StructOf provides the name of the custom shape, or SDK structure shape,
+	// which will become the type of a custom struct field.
+	StructOf string `json:"struct_of,omitempty"`
+	// ListOf provides the name of the SDK shape or custom shape which will
+	// become the member of a custom slice field. Custom shapes include the
+	// shapes of other custom list and map fields, e.g. `RuleOverrideList` for
+	// a field with `list_of: RuleOverride`, and list or map types of shapes,
+	// e.g. `[]RuleOverride`.
 	ListOf string `json:"list_of,omitempty"`
-	// MapOf provides the name of the SDK shape which will become the value
-	// shape for a custom map field. All maps will have `string` as their key
-	// type.
+	// MapOf provides the name of the SDK shape or custom shape which will
+	// become the value shape for a custom map field, like ListOf. All maps
+	// will have `string` as their key type.
 	MapOf string `json:"map_of,omitempty"`
 }
 
 // LateInitializeConfig contains instructions for how to handle the
 // retrieval and setting of server-side defaulted fields.
-// NOTE: Currently the members of this have no effect on late initialization of fields.
-// Currently the late initialization is requeued with static delay of 5 second.
-// TODO: (vijat@) Add support of retry/backoff for late initialization.
+//
+// When the fields are not all late initialized after reading the resource,
+// late initialization is requeued with an exponential backoff, starting at
+// MinBackoffSeconds and capped at MaxBackoffSeconds. As the resource is late
+// initialized as a whole, the smallest MinBackoffSeconds, the largest
+// MaxBackoffSeconds and the largest MaxAttempts of its fields are used.
 type LateInitializeConfig struct {
 	// MinBackoffSeconds provides the minimum backoff to attempt late initialization again after an unsuccessful
 	// attempt to late initialized fields from ReadOne output
 	// For every attempt, the reconciler will calculate the delay between MinBackoffSeconds and MaxBackoffSeconds
-	// using exponential backoff and retry strategy
+	// using exponential backoff and retry strategy. Defaults to 5 seconds.
 	MinBackoffSeconds int `json:"min_backoff_seconds,omitempty"`
 	// MaxBackoffSeconds provide the maximum allowed backoff when retrying late initialization after an
-	// unsuccessful attempt.
+	// unsuccessful attempt. Defaults to MinBackoffSeconds.
 	MaxBackoffSeconds int `json:"max_backoff_seconds"`
+	// MaxAttempts is the number of unsuccessful late initialization attempts
+	// after which late initialization is no longer requeued and the
+	// LateInitialized condition is left False. When zero, late initialization
+	// is retried until it completes.
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// SkipFields is the list of member fields of a struct field that are not
+	// late initialized. When set, instead of late initializing the struct
+	// field as a whole, each of its other member fields is late initialized
+	// individually, creating the struct if needed. Late initialization does
+	// not wait for these member fields to be defaulted by the AWS service.
+	//
+	// resources:
+	//   Repository:
+	//     fields:
+	//       EncryptionConfiguration:
+	//         late_initialize:
+	//           skip_fields:
+	//           - KmsKey
+	SkipFields []string `json:"skip_fields,omitempty"`
+}
+
+// FieldMigrationConfig instructs the code generator how to read the values
+// stored in existing custom resources for a top-level Spec field whose Go type
+// or name changed between two versions of the controller, so that the
+// upgraded controller can still decode and reconcile those resources.
+// Example:
+// ```
+// Function:
+//
+//	fields:
+//	  Architectures:
+//	    migration:
+//	      previous_type: "*string"
+//	  Runtime:
+//	    migration:
+//	      previous_name: RuntimeName
+//
+// ```
+// The above configuration makes the generated Spec accept a single string,
+// wrapped into a one-element list, as the value of Architectures. It also
+// keeps a deprecated RuntimeName field in the Spec, so that the Kubernetes
+// API server does not prune the values stored under that name, and moves its
+// value into Runtime when Runtime is not set.
+//
+// Supported type changes are between a type and a slice of that type, and
+// between the *string, *int64, *float64 and *bool scalar types.
+type FieldMigrationConfig struct {
+	// PreviousType is the Go type the field had, e.g. "*string"
+	PreviousType string `json:"previous_type,omitempty"`
+	// PreviousName is the name the field had
+	PreviousName string `json:"previous_name,omitempty"`
+}
+
+// TransformFieldConfig instructs the code generator to convert the value of an
+// integer field between the unit used by the AWS API and a friendlier unit
+// exposed by the CRD. The CRD value is converted into the API's unit when
+// setting the SDK input shapes, and the API value into the CRD's unit when
+// setting the resource from the SDK output shapes.
+//
+// Either `multiply` or `unit` must be set:
+//
+//	fields:
+//	  AllocatedStorage:
+//	    transform:
+//	      unit:
+//	        api: GiB
+//	        crd: MiB
+//
+// The CRD's unit must divide the API's unit, e.g. MiB for GiB or seconds for
+// minutes, so that every value returned by the API has an exact CRD value.
+// Values of the CRD that are not a whole number of the API's unit are
+// rejected with a terminal error.
+type TransformFieldConfig struct {
+	// Multiply is the factor the API value is multiplied by to obtain the
+	// CRD value.
+	Multiply int64 `json:"multiply,omitempty"`
+	// Unit converts the value between two units of the same dimension.
+	Unit *UnitTransformConfig `json:"unit,omitempty"`
+}
+
+// UnitTransformConfig contains the units of a field's value in the AWS API
+// and in the CRD. Supported units are the byte units B, KB, MB, GB, TB, KiB,
+// MiB, GiB and TiB, and the duration units ms, s, min, h and d.
+type UnitTransformConfig struct {
+	// API is the unit of the value in the AWS API
+	API string `json:"api"`
+	// CRD is the unit of the value in the CRD
+	CRD string `json:"crd"`
+}
+
+// TimestampFieldConfig instructs the code generator that a string field of
+// the AWS API holds a timestamp. The field is a *metav1.Time in the CRD and
+// its value is parsed from, and formatted into, the API's string.
+// Example:
+// ```
+// Certificate:
+//
+//	fields:
+//	  NotAfter:
+//	    timestamp:
+//	      layout: "2006-01-02T15:04:05.000Z07:00"
+//
+// ```
+//
+// Values returned by the AWS API that cannot be parsed are returned as
+// errors.
+type TimestampFieldConfig struct {
+	// Layout is the Go reference time layout of the API's string, see the
+	// `time` package. Defaults to RFC 3339, the ISO-8601 profile used by
+	// most AWS APIs.
+	Layout string `json:"layout,omitempty"`
+}
+
+// QuantityFieldConfig instructs the code generator that an integer field of
+// the AWS API holds a size, such as a storage or memory size. The field is a
+// *resource.Quantity in the CRD, accepting familiar Kubernetes quantities like
+// `10Gi`, and its value is converted from and into the API's unit.
+// Example:
+// ```
+// DBInstance:
+//
+//	fields:
+//	  AllocatedStorage:
+//	    quantity:
+//	      unit: GiB
+//
+// ```
+//
+// Quantities that are not a whole number of the API's unit are rejected with
+// a terminal error.
+type QuantityFieldConfig struct {
+	// Unit is the unit of the value in the AWS API, one of B, KB, MB, GB, TB,
+	// KiB, MiB, GiB or TiB.
+	Unit string `json:"unit"`
+}
+
+// ServerSideApplyConfig instructs the code generator how server-side apply
+// merges a list or map field, by emitting the `+listType`, `+listMapKey` and
+// `+mapType` markers on the field. Without them, lists are atomic and every
+// manager applying the field, for instance a GitOps tool and the controller
+// itself, conflicts with the others.
+// Example:
+// ```
+// Repository:
+//
+//	fields:
+//	  Tags:
+//	    server_side_apply:
+//	      list_type: map
+//	      list_map_keys:
+//	        - Key
+//
+// ```
+//
+// The members named in ListMapKeys are marked required in the type of the
+// list elements, as Kubernetes requires of list map keys. Since that type is
+// shared by every field of the same shape, all those fields must configure
+// the same list map keys. Likewise, the markers of a nested field apply to
+// every field of the type containing it, which must all configure them.
+type ServerSideApplyConfig struct {
+	// ListType is the topology of a list field, one of "atomic", "set" or
+	// "map".
+	ListType string `json:"list_type,omitempty"`
+	// ListMapKeys are the names of the members of the list elements
+	// identifying an element of a list field with a "map" ListType.
+	ListMapKeys []string `json:"list_map_keys,omitempty"`
+	// MapType is the topology of a map field, one of "atomic" or "granular".
+	MapType string `json:"map_type,omitempty"`
+}
+
+// ListLimitConfig instructs the code generator to cap the number of elements
+// stored in a list Status field populated from the AWS API. Some read
+// operations return very large lists (for instance hundreds of endpoints)
+// that would otherwise push the CR past the etcd object size limit.
+// Example:
+// ```
+// Cluster:
+//
+//	fields:
+//	  Endpoints:
+//	    is_read_only: true
+//	    list_limit:
+//	      max_items: 100
+//	      sort_by: Address
+//	      truncated_field_name: EndpointsTruncated
+//
+// ```
+// The above configuration sorts the Status.Endpoints elements by their
+// Address before keeping the first 100 of them, and adds a
+// Status.EndpointsTruncated boolean field recording whether elements were
+// dropped.
+type ListLimitConfig struct {
+	// MaxItems is the maximum number of elements kept in the list field. When
+	// zero, the list is not truncated.
+	MaxItems int `json:"max_items,omitempty"`
+	// SortBy is the name of the member field the elements of a list of
+	// structs are sorted by before the list is truncated, so that the same
+	// elements are kept on every read. Lists of scalars are sorted by value
+	// and ignore this setting. When empty, lists of structs keep the order
+	// returned by the AWS service.
+	SortBy string `json:"sort_by,omitempty"`
+	// TruncatedFieldName is the name of a boolean Status field added to the
+	// resource, set to true when elements were dropped from the list field
+	// and false otherwise.
+	TruncatedFieldName string `json:"truncated_field_name,omitempty"`
+}
+
+// FromARNConfig instructs the code generator that the value of a field can be
+// derived from the ARN of the resource. This allows adopting by ARN resources
+// whose ReadOne or Delete operations require an identifier, often only
+// present in the Status, that is embedded in the ARN.
+//
+// Example:
+// ```
+// Repository:
+//
+//	fields:
+//	  RegistryId:
+//	    from_arn:
+//	      pattern: "^arn:[^:]+:ecr:[^:]*:([0-9]+):repository/"
+//
+// ```
+// The above configuration will result in the 'RegistryID' field being set to
+// the account ID parsed from the ARN supplied in the identifiers of an adopted
+// 'Repository' resource.
+type FromARNConfig struct {
+	// Pattern is a regular expression, matched against the ARN of the
+	// resource, with a single capturing group for the value of the field.
+	Pattern string `json:"pattern"`
 }
 
 // ReferencesConfig contains the instructions for how to add the referenced resource
@@ -359,6 +750,67 @@ type ReferencesConfig struct {
 	Path string `json:"path"`
 }
 
+// KMSKeyConfig instructs the code generator that a field contains the
+// identifier of the AWS KMS Key used to encrypt the resource at rest.
+// Example:
+// ```
+// DBInstance:
+//
+//	fields:
+//	  KmsKeyId:
+//	    kms_key:
+//	      enabled_by: StorageEncrypted
+//	      default_key_id: alias/aws/rds
+//
+// ```
+// The above configuration will result in generation of a new field
+// 'KMSKeyRef' of type 'AWSResourceReference' that refers to a 'Key' resource
+// managed by the kms-controller. When 'StorageEncrypted' is true and neither
+// 'KMSKeyID' nor 'KMSKeyRef' is supplied, the controller falls back to the
+// 'alias/aws/rds' key.
+type KMSKeyConfig struct {
+	// EnabledBy is the path of a boolean Spec field that indicates whether
+	// encryption at rest is enabled for the resource. When the field is true,
+	// either the KMS Key identifier or its reference must be supplied, unless
+	// DefaultKeyID is set.
+	EnabledBy *string `json:"enabled_by,omitempty"`
+	// DefaultKeyID is the KMS Key identifier (ID, ARN or alias) to use when
+	// neither the KMS Key identifier nor its reference is supplied.
+	DefaultKeyID *string `json:"default_key_id,omitempty"`
+}
+
+// ReferencesConfig returns the ReferencesConfig used to generate the
+// companion reference field for a KMS Key identifier field.
+func (c *KMSKeyConfig) ReferencesConfig() *ReferencesConfig {
+	return &ReferencesConfig{
+		ServiceName: "kms",
+		Resource:    "Key",
+		Path:        "Status.KeyID",
+	}
+}
+
+const (
+	// AttributeTypeBool coerces an attribute field's value to a *bool
+	AttributeTypeBool = "bool"
+	// AttributeTypeInt coerces an attribute field's value to an *int64
+	AttributeTypeInt = "int"
+	// AttributeTypeDuration coerces an attribute field's value, a number of
+	// seconds, to a *metav1.Duration
+	AttributeTypeDuration = "duration"
+	// AttributeTypeJSON keeps an attribute field's value, a JSON document, as
+	// a *string that is compacted and compared semantically
+	AttributeTypeJSON = "json"
+)
+
+const (
+	// AttributeUpdateAlways sends an attribute field in every SetAttributes
+	// call
+	AttributeUpdateAlways = "always"
+	// AttributeUpdateNever never sends an attribute field in SetAttributes
+	// calls
+	AttributeUpdateNever = "never"
+)
+
 // FieldConfig contains instructions to the code generator about how
 // to interpret the value of an Attribute and how to map it to a CRD's Spec or
 // Status field
@@ -371,17 +823,58 @@ type FieldConfig struct {
 	// the primary resource, and that those fields should be "unpacked" from
 	// the raw map and into CRD's Spec and Status struct fields.
 	IsAttribute bool `json:"is_attribute"`
+	// AttributeType is the type the string value of an attribute field is
+	// coerced to in the CR's Spec or Status. One of "bool", "int",
+	// "duration" (a number of seconds, exposed as a metav1.Duration) or
+	// "json" (a JSON document, compared semantically). When empty, the
+	// attribute field is a string.
+	//
+	// resources:
+	//   Queue:
+	//     fields:
+	//       VisibilityTimeout:
+	//         is_attribute: true
+	//         attribute_type: duration
+	AttributeType string `json:"attribute_type,omitempty"`
+	// AttributeUpdate overrides when the attribute field is sent in the
+	// resource's SetAttributes calls. One of "always", sending it in every
+	// call, even when it did not change, for APIs requiring it alongside
+	// other attributes, or "never", never sending it, for attributes that
+	// cannot be changed after creation. By default, the attribute is sent in
+	// every call, or only when it changed if the resource is configured with
+	// `set_changed_attributes_only`.
+	AttributeUpdate string `json:"attribute_update,omitempty"`
 	// IsReadOnly indicates the field's value can not be set by a Kubernetes
 	// user; in other words, the field should go in the CR's Status struct
 	IsReadOnly bool `json:"is_read_only"`
 	// Required indicates whether this field is a required member or not.
 	// This field is used to configure '+kubebuilder:validation:Required' on API object's members.
+	// For a nested field, it applies to the type containing the field, so
+	// every field of that type must require the member the same way.
 	IsRequired *bool `json:"is_required,omitempty"`
 	// IsPrimaryKey indicates the field represents the primary name/string
 	// identifier field for the resource.  This allows the generator config to
 	// override the default behaviour of considering a field called "Name" or
 	// "{Resource}Name" or "{Resource}Id" as the "name field" for the resource.
 	IsPrimaryKey bool `json:"is_primary_key"`
+	// PrimaryKeyOrder is the position of the field in the composite
+	// identifier of a resource whose identity is a tuple of fields, i.e.
+	// when several fields are marked with `is_primary_key`. Each of those
+	// fields must have a distinct order.
+	//
+	// Example:
+	//
+	// resources:
+	//
+	//	Repository:
+	//	  fields:
+	//	    RegistryId:
+	//	      is_primary_key: true
+	//	      primary_key_order: 0
+	//	    RepositoryName:
+	//	      is_primary_key: true
+	//	      primary_key_order: 1
+	PrimaryKeyOrder int `json:"primary_key_order,omitempty"`
 	// IsOwnerAccountID indicates the field contains the AWS Account ID
 	// that owns the resource. This is a special field that we direct to
 	// storage in the common `Status.ACKResourceMetadata.OwnerAccountID` field.
@@ -391,12 +884,54 @@ type FieldConfig struct {
 	// default behaviour of considering a field called "Arn" or
 	// "{Resource}Arn" (case in-sensitive) as the "ARN field" for the resource.
 	IsARN bool `json:"is_arn"`
+	// FromARN instructs the code generator that the value of the field can
+	// be parsed from the ARN of the resource
+	FromARN *FromARNConfig `json:"from_arn,omitempty"`
 	// IsSecret instructs the code generator that this field should be a
 	// SecretKeyReference.
 	IsSecret bool `json:"is_secret"`
+	// IsConfigMap instructs the code generator that this field should be a
+	// ConfigMapKeyReference, the value of the field being read from the
+	// referenced key of a ConfigMap when the resource manager calls the AWS
+	// service API. This is useful for large values, such as policy documents
+	// or user data, shared by several resources.
+	IsConfigMap bool `json:"is_configmap"`
+	// IsSensitive instructs the code generator to redact the value of this
+	// field from the differences recorded in resource deltas, which the
+	// runtime dumps into controller debug logs.
+	IsSensitive bool `json:"is_sensitive"`
+	// WriteToSecret instructs the code generator that this output-only field
+	// contains a value generated by the AWS service (for instance a password
+	// or a private key) that must be written by the controller into a
+	// Kubernetes Secret instead of being stored in the resource's Status.
+	//
+	// A `{FieldName}SecretRef` field of type SecretKeyReference is added to
+	// the resource's Spec, identifying the Secret and key the value is written
+	// to, and the Status field records that SecretKeyReference once the value
+	// has been written. The Secret must already exist.
+	WriteToSecret bool `json:"write_to_secret"`
 	// IsImmutable instructs the code generator to add advisory conditions
 	// if user modifies the spec field after resource was created.
 	IsImmutable bool `json:"is_immutable"`
+	// IsPassthrough instructs the code generator that the Spec field is
+	// intentionally not mapped to any member of the API operations' input or
+	// output shapes, even when a member has the same name. The field is never
+	// set from or into an SDK shape and is only interpreted by hook code, e.g.
+	// a `DeletionPolicy` or `SkipFinalSnapshot` knob consumed by a delete hook.
+	// Fields not sourced from an API shape must declare their `type`.
+	//
+	// resources:
+	//   DBInstance:
+	//     fields:
+	//       SkipFinalSnapshot:
+	//         is_passthrough: true
+	//         type: bool
+	IsPassthrough bool `json:"is_passthrough"`
+	// UpdateAfter is a list of names of Spec fields that must be updated
+	// before this field. When this field and any of those fields have changed,
+	// the update of this field is deferred to a later reconcile, once the
+	// fields it depends on have been updated.
+	UpdateAfter []string `json:"update_after,omitempty"`
 	// From instructs the code generator that the value of the field should
 	// be retrieved from the specified operation and member path
 	From *SourceFieldConfig `json:"from,omitempty"`
@@ -414,12 +949,56 @@ type FieldConfig struct {
 	// influence hows field are printed in `kubectl get` response. If this field
 	// is not nil, it will be added to the columns of `kubectl get`.
 	Print *PrintFieldConfig `json:"print,omitempty"`
+	// ListLimit instructs the code generator to cap, and deterministically
+	// order, the elements of a list Status field populated from the AWS API.
+	ListLimit *ListLimitConfig `json:"list_limit,omitempty"`
+	// Transform instructs the code generator to convert the value of the
+	// field between the unit of the AWS API and the unit of the CRD.
+	Transform *TransformFieldConfig `json:"transform,omitempty"`
+	// Timestamp instructs the code generator that the string value of the
+	// field in the AWS API is a timestamp, exposed as a metav1.Time in the
+	// CRD instead of an opaque string.
+	Timestamp *TimestampFieldConfig `json:"timestamp,omitempty"`
+	// Quantity instructs the code generator that the integer value of the
+	// field in the AWS API is a size, exposed as a resource.Quantity in the
+	// CRD.
+	Quantity *QuantityFieldConfig `json:"quantity,omitempty"`
+	// ServerSideApply instructs the code generator how server-side apply
+	// merges the list or map field.
+	ServerSideApply *ServerSideApplyConfig `json:"server_side_apply,omitempty"`
+	// Markers are additional kubebuilder markers emitted on the field's
+	// declaration, e.g. `+kubebuilder:validation:MinLength=3`, for the markers
+	// the code generator does not otherwise support.
+	Markers []string `json:"markers,omitempty"`
+	// Migration instructs the code generator to accept the values of the
+	// field stored in existing custom resources before the field's Go type or
+	// name changed, for instance after an SDK model update.
+	Migration *FieldMigrationConfig `json:"migration,omitempty"`
 	// Late Initialize instructs the code generator how to handle the late initialization
 	// of the field.
 	LateInitialize *LateInitializeConfig `json:"late_initialize,omitempty"`
+	// Documentation overrides, prepends to or appends to the field's doc
+	// comment, and hence its description in the CRD. It takes precedence over
+	// the field's entry in the documentation config file, if any. Useful when
+	// the AWS SDK documentation is wrong, missing or lacks Kubernetes-specific
+	// guidance.
+	//
+	// resources:
+	//   Repository:
+	//     fields:
+	//       ImageTagMutability:
+	//         documentation:
+	//           append: |
+	//             Changing this field does not affect images already pushed.
+	Documentation *FieldDocsConfig `json:"documentation,omitempty"`
 	// References instructs the code generator how to refer this field from
 	// other custom resource
 	References *ReferencesConfig `json:"references,omitempty"`
+	// KMSKey instructs the code generator that this field contains a KMS Key
+	// identifier used for encryption at rest. A companion reference field to
+	// a kms-controller Key resource is generated for the field unless
+	// References is explicitly configured.
+	KMSKey *KMSKeyConfig `json:"kms_key,omitempty"`
 	// Type *overrides* the inferred Go type of the field. This is required for
 	// custom fields that are not inferred either as a Create Input/Output
 	// shape or via the SourceFieldConfig attribute.
@@ -28,15 +28,114 @@ type StringArray []string
 // OperationConfig represents instructions to the ACK code generator to
 // specify the overriding values for API operation parameters and its custom implementation.
 type OperationConfig struct {
-	CustomImplementation                   string            `json:"custom_implementation,omitempty"`
-	CustomCheckRequiredFieldsMissingMethod string            `json:"custom_check_required_fields_missing_method,omitempty"`
-	OverrideValues                         map[string]string `json:"override_values"`
+	CustomImplementation                   string `json:"custom_implementation,omitempty"`
+	CustomCheckRequiredFieldsMissingMethod string `json:"custom_check_required_fields_missing_method,omitempty"`
+	// OverrideValues is a map, keyed by member name, of the constant values
+	// always set on scalar members of the operation's Input shape by the
+	// generated code building the operation's request, in place of the
+	// values of the resource's fields, if any. Values must be valid values
+	// of the members' types, e.g. one of the values of an enum or a finite
+	// number.
+	//
+	// Example:
+	// ```
+	// operations:
+	//
+	//	ListWebACLs:
+	//	  override_values:
+	//	    Scope: REGIONAL
+	//	    Limit: 100
+	//
+	// ```
+	OverrideValues map[string]string `json:"override_values"`
+	// InputFields is a map, keyed by member name, of the paths of the
+	// resource's fields that scalar members of the operation's Input shape
+	// are set from, for members whose value is held by a field of another
+	// name, typically a Status field such as a change token or revision ID.
+	// Paths start with Spec or Status and may refer to nested fields, whose
+	// Go type must be the member's, except for typed enums, which may set
+	// string members. They apply to the requests of the operation whichever
+	// of the resource's operations it is, e.g. its ReadMany or GetAttributes
+	// operation.
+	//
+	// Example:
+	// ```
+	// operations:
+	//
+	//	DeleteDistribution:
+	//	  input_fields:
+	//	    IfMatch: Status.ETag
+	//
+	// ```
+	InputFields map[string]string `json:"input_fields,omitempty"`
+	// GenerateIdempotencyToken instructs the code generator to set the
+	// idempotency token member of the operation's Input shape, e.g.
+	// ClientToken, to a token derived from the resource's UID and generation,
+	// so that a call retried for the same generation of the resource, e.g.
+	// after a timeout, does not create a duplicate resource. The token of a
+	// Create operation also includes the number of times the resource was
+	// created, kept in its `<api group>/create-count` annotation, so that a
+	// resource deleted outside of the controller is created anew instead of
+	// reusing the token of the deleted one. The member is not a field of the
+	// resource.
+	GenerateIdempotencyToken bool `json:"generate_idempotency_token,omitempty"`
+	// IdempotencyTokenMember is the name of the Input shape's member holding
+	// the generated idempotency token. Defaults to the member marked as an
+	// idempotency token in the API model.
+	IdempotencyTokenMember string `json:"idempotency_token_member,omitempty"`
+	// SkipGeneration replaces the generated body of the sdkCreate, sdkFind,
+	// sdkUpdate or sdkDelete method calling the operation entirely by a call
+	// to the operation's `custom_implementation` method, which has the
+	// signature of the replaced method, except for Delete operations whose
+	// method only returns an error. By default, the custom method is called
+	// before the generated code, which runs unless the method returns an
+	// error or, for Create and Update operations, a resource.
+	//
+	// Example:
+	// ```
+	// operations:
+	//
+	//	CreateApi:
+	//	  custom_implementation: customCreateApi
+	//	  skip_generation: true
+	//
+	// ```
+	SkipGeneration bool `json:"skip_generation,omitempty"`
+	// TimeoutSeconds is the number of seconds after which a call to the
+	// operation made by the generated resource manager code is cancelled, so
+	// that a slow API call cannot stall the reconciler's worker. This covers
+	// the calls managing attachments, collections, auxiliary resources and
+	// pre-delete steps as well as the CRUD operations; waiters are bounded by
+	// their own `timeout_seconds` instead. Calls are only bounded by the
+	// reconciler's context by default.
+	TimeoutSeconds int `json:"timeout_seconds,omitempty"`
+	// Retry instructs the code generator to retry the calls to the operation
+	// made by the generated resource manager code that fail with one of the
+	// configured error codes, on top of the retries of the aws-sdk-go client.
+	Retry *RetryConfig `json:"retry,omitempty"`
 	// SetOutputCustomMethodName provides the name of the custom method on the
 	// `resourceManager` struct that will set fields on a `resource` struct
 	// depending on the output of the operation.
 	SetOutputCustomMethodName string `json:"set_output_custom_method_name,omitempty"`
+	// ProcessOutputCustomMethodName provides the name of the custom method on
+	// the `resourceManager` struct that is called with the resource and the
+	// Output shape of the operation as soon as a call to the operation
+	// succeeds, before the resource's fields are set from the Output shape,
+	// so that the response can be normalized, e.g. by decoding payloads or
+	// stripping default values. It is not supported for a resource's Delete
+	// operation or its `update_operations`, whose Output shapes are not read.
+	// The method has the signature:
+	//
+	//	func (rm *resourceManager) normalizeGetFooOutput(
+	//		ctx context.Context,
+	//		r *resource,
+	//		resp *svcsdk.GetFooOutput,
+	//	) error
+	ProcessOutputCustomMethodName string `json:"process_output_custom_method_name,omitempty"`
 	// OutputWrapperFieldPath provides the JSON-Path like to the struct field containing
-	// information that will be merged into a `resource` object.
+	// information that will be merged into a `resource` object. An element of
+	// a list can be selected with an index, e.g. `Reservations[0].Instances[0]`,
+	// in which case bounds-checked accessors are generated.
 	OutputWrapperFieldPath string `json:"output_wrapper_field_path,omitempty"`
 	// Override for resource name in case of heuristic failure
 	// An example of this is correcting stutter when the resource logic doesn't properly determine the resource name
@@ -45,6 +144,89 @@ type OperationConfig struct {
 	// An example of this is `Put...` or `Register...` API operations not being correctly classified as `Create` op type
 	// OperationType []string `json:"operation_type"`
 	OperationType StringArray `json:"operation_type"`
+	// RequiredFields overrides the fields of the resource that must be set
+	// for the operation to be called. By default, the fields corresponding to
+	// the required members of the operation's Input shape must all be set.
+	RequiredFields *RequiredFieldsConfig `json:"required_fields,omitempty"`
+	// InputShape is the name of a structure shape of the API model used in
+	// place of the operation's Input shape when the Spec fields of the
+	// resource created by the operation are determined. The generated code
+	// still sends the operation's own Input shape, setting the members
+	// matching a field of the resource.
+	InputShape string `json:"input_shape,omitempty"`
+	// OutputShape is the name of a structure shape of the API model used in
+	// place of the operation's Output shape when the Status fields of the
+	// resource created by the operation are determined. The output wrapper
+	// field path, if any, applies to this shape. The generated code still
+	// reads the operation's own Output shape, setting the fields matching
+	// one of its members. As elsewhere in this config, the Input and Output
+	// shapes of operations go by their SDK names, e.g. "GetFooOutput".
+	//
+	// Example, for an API whose Create Output shape lacks members returned
+	// by its Describe Output shape:
+	// ```
+	// operations:
+	//
+	//	CreateFoo:
+	//	  output_shape: FooDescription
+	//
+	// ```
+	OutputShape string `json:"output_shape,omitempty"`
+}
+
+// RequiredFieldsConfig lists the fields of a resource that must be set for an
+// operation reading the resource to be called. Fields are referred to by
+// their path, e.g. "Spec.Name", "Status.ID" or
+// "Status.ACKResourceMetadata.ARN". At least one of AllOf and AnyOf must be
+// set.
+//
+// Example:
+// ```
+// operations:
+//
+//	GetWorkGroup:
+//	  required_fields:
+//	    any_of:
+//	      - Spec.Name
+//	      - Status.ID
+//
+// ```
+// The above configuration will result in the resource being considered as
+// not created yet only when neither its 'Spec.Name' nor its 'Status.ID'
+// fields are set.
+type RequiredFieldsConfig struct {
+	// AllOf lists the paths of the fields that must all be set
+	AllOf []string `json:"all_of,omitempty"`
+	// AnyOf lists the paths of the fields of which at least one must be set
+	AnyOf []string `json:"any_of,omitempty"`
+}
+
+// RetryConfig describes the retries of the calls to an operation failing with
+// one of the supplied error codes, with an exponential backoff.
+//
+// Example:
+// ```
+// operations:
+//
+//	CreateCacheCluster:
+//	  retry:
+//	    max_attempts: 5
+//	    backoff_milliseconds: 1000
+//	    error_codes:
+//	      - InvalidParameterCombination
+//	      - ThrottlingException
+//
+// ```
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of calls made, including the first
+	// one. Defaults to 3.
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// BackoffMilliseconds is the number of milliseconds waited for before the
+	// first retry, doubled before each subsequent retry. Defaults to 500.
+	BackoffMilliseconds int `json:"backoff_milliseconds,omitempty"`
+	// ErrorCodes lists the codes of the AWS API errors the calls are retried
+	// on.
+	ErrorCodes []string `json:"error_codes"`
 }
 
 // OperationIsIgnored returns true if Operation Name is configured to be ignored
@@ -99,6 +281,22 @@ func (c *Config) GetOutputWrapperFieldPath(
 	return &opConfig.OutputWrapperFieldPath
 }
 
+// GetShapeOverrides returns the names of the shapes, if any, used in place of
+// the supplied operation's Input and Output shapes when the fields of a
+// resource are determined.
+func (c *Config) GetShapeOverrides(
+	op *awssdkmodel.Operation,
+) (inputShape string, outputShape string) {
+	if op == nil || c == nil {
+		return "", ""
+	}
+	opConfig, found := c.Operations[op.ExportedName]
+	if !found {
+		return "", ""
+	}
+	return opConfig.InputShape, opConfig.OutputShape
+}
+
 // GetSetOutputCustomMethodName returns custom set output operation as *string for
 // given operation on custom resource, if specified in generator config
 func (c *Config) GetSetOutputCustomMethodName(
@@ -122,6 +320,22 @@ func (c *Config) GetSetOutputCustomMethodName(
 	return &opConfig.SetOutputCustomMethodName
 }
 
+// GetProcessOutputCustomMethodName returns the name of the custom method
+// processing the Output shape of the supplied operation, or an empty string
+// if none is configured.
+func (c *Config) GetProcessOutputCustomMethodName(
+	op *awssdkmodel.Operation,
+) string {
+	if op == nil || c == nil {
+		return ""
+	}
+	opConfig, found := c.Operations[op.ExportedName]
+	if !found {
+		return ""
+	}
+	return opConfig.ProcessOutputCustomMethodName
+}
+
 // GetCustomImplementation returns custom implementation method name for the
 // supplied operation as specified in generator config
 func (c *Config) GetCustomImplementation(
@@ -140,6 +354,45 @@ func (c *Config) GetCustomImplementation(
 	return operationConfig.CustomImplementation
 }
 
+// GetSkipGeneration returns true if the generated code calling the supplied
+// operation is replaced entirely by its custom implementation.
+func (c *Config) GetSkipGeneration(op *awssdkmodel.Operation) bool {
+	if op == nil || c == nil {
+		return false
+	}
+	operationConfig, found := c.Operations[op.ExportedName]
+	if !found {
+		return false
+	}
+	return operationConfig.SkipGeneration
+}
+
+// GetOperationTimeoutSeconds returns the number of seconds after which a call
+// to the supplied operation is cancelled, or 0 if calls are not bounded.
+func (c *Config) GetOperationTimeoutSeconds(op *awssdkmodel.Operation) int {
+	if op == nil || c == nil {
+		return 0
+	}
+	operationConfig, found := c.Operations[op.ExportedName]
+	if !found {
+		return 0
+	}
+	return operationConfig.TimeoutSeconds
+}
+
+// GetOperationRetry returns the retry policy of the calls to the supplied
+// operation, or nil if calls are not retried.
+func (c *Config) GetOperationRetry(op *awssdkmodel.Operation) *RetryConfig {
+	if op == nil || c == nil {
+		return nil
+	}
+	operationConfig, found := c.Operations[op.ExportedName]
+	if !found {
+		return nil
+	}
+	return operationConfig.Retry
+}
+
 // GetCustomCheckRequiredFieldsMissingMethod returns custom check required fields missing method
 // as string for custom resource, if specified in generator config
 func (c *Config) GetCustomCheckRequiredFieldsMissingMethod(
@@ -158,6 +411,52 @@ func (c *Config) GetCustomCheckRequiredFieldsMissingMethod(
 	return operationConfig.CustomCheckRequiredFieldsMissingMethod
 }
 
+// GetRequiredFieldsConfig returns the fields of the resource that must be set
+// for the supplied operation to be called, or nil if they are inferred from
+// the operation's Input shape.
+func (c *Config) GetRequiredFieldsConfig(
+	op *awssdkmodel.Operation,
+) *RequiredFieldsConfig {
+	if op == nil || c == nil {
+		return nil
+	}
+	operationConfig, found := c.Operations[op.ExportedName]
+	if !found {
+		return nil
+	}
+	return operationConfig.RequiredFields
+}
+
+// GetInputFields returns the map, keyed by member name, of the paths of the
+// resource fields that members of the supplied operation's Input shape are
+// set from.
+func (c *Config) GetInputFields(op *awssdkmodel.Operation) map[string]string {
+	if op == nil || c == nil {
+		return nil
+	}
+	operationConfig, found := c.Operations[op.ExportedName]
+	if !found {
+		return nil
+	}
+	return operationConfig.InputFields
+}
+
+// GetIdempotencyTokenConfig returns whether an idempotency token is generated
+// for the calls to the supplied operation and the configured name of the
+// member holding it, if any.
+func (c *Config) GetIdempotencyTokenConfig(
+	op *awssdkmodel.Operation,
+) (bool, string) {
+	if op == nil || c == nil {
+		return false, ""
+	}
+	operationConfig, found := c.Operations[op.ExportedName]
+	if !found {
+		return false, ""
+	}
+	return operationConfig.GenerateIdempotencyToken, operationConfig.IdempotencyTokenMember
+}
+
 // OverrideValues returns a list of member values to override for a given operation
 func (c *Config) GetOverrideValues(operationName string) (map[string]string, bool) {
 	if c == nil {
@@ -40,6 +40,15 @@ type ResourceConfig struct {
 	// Synced contains instructions for the code generator to generate Go code
 	// that verifies whether a resource is synced or not.
 	Synced *SyncedConfig `json:"synced"`
+	// TerminalStates contains instructions for the code generator to
+	// generate Go code that sets the Terminal condition of a resource whose
+	// state field has a value the resource cannot recover from.
+	TerminalStates *TerminalStatesConfig `json:"terminal_states,omitempty"`
+	// Waiters contains instructions for the code generator to generate Go
+	// code that waits, with the aws-sdk-go waiters of the API, for the
+	// resource to reach a stable state after it is created, updated or
+	// deleted.
+	Waiters *WaitersConfig `json:"waiters,omitempty"`
 	// Renames identifies fields in Operations that should be renamed.
 	Renames *RenamesConfig `json:"renames,omitempty"`
 	// ListOperation contains instructions for the code generator to generate
@@ -63,6 +72,49 @@ type ResourceConfig struct {
 	// very little consistency to the APIs that we can use to instruct the code
 	// generator :(
 	UpdateOperation *UpdateOperationConfig `json:"update_operation,omitempty"`
+	// UpdateOperations is the list of the resource's additional update
+	// operations and of the Spec fields each of them updates, for resources
+	// whose fields are updated by different API calls, e.g. EKS's
+	// UpdateClusterVersion and UpdateClusterConfig. sdkUpdate calls, in
+	// order, the first operation whose fields changed, and requeues the
+	// resource to call the next ones in the following reconciliations. The
+	// resource's Update operation, if any, is only called once no field of
+	// these operations differs anymore.
+	//
+	// Example:
+	//
+	// resources:
+	//
+	//	Cluster:
+	//	  update_operations:
+	//	    - operation: UpdateClusterVersion
+	//	      fields:
+	//	        - Version
+	//	    - operation: UpdateClusterConfig
+	//	      fields:
+	//	        - Logging
+	//	        - ResourcesVPCConfig
+	UpdateOperations []*FieldsUpdateOperationConfig `json:"update_operations,omitempty"`
+	// ReadOneOperations lists, in order of precedence, additional operations
+	// reading a single resource, for resources that can be read with
+	// different identifiers, e.g. an ID once it is known and a name
+	// otherwise. When the fields required by the resource's ReadOne operation
+	// are missing, sdkFind calls the first of these operations whose required
+	// fields, see the operation's `required_fields`, are set. The resource it
+	// reads goes through the same post-processing, including the
+	// `sdk_read_one_*` hooks, as one read with the ReadOne operation; in
+	// those hooks `resp` holds the Output of the operation actually called.
+	// The Spec fields updated by different update operations are configured
+	// with `update_operations` instead.
+	//
+	// Example:
+	//
+	// resources:
+	//
+	//	Workgroup:
+	//	  read_one_operations:
+	//	    - GetWorkgroupByName
+	ReadOneOperations []string `json:"read_one_operations,omitempty"`
 	// ReadOperation contains instructions for the code generator to generate
 	// Go code for the read operation for the resource. For some resources,
 	// there is no describe/find/list apis. However, it is possible to write
@@ -97,6 +149,14 @@ type ResourceConfig struct {
 	// All ShortNames must be distinct from any other ShortNames installed into the cluster,
 	// otherwise the CRD will fail to install.
 	ShortNames []string `json:"shortNames,omitempty"`
+	// Categories are the groups of resources the CRD belongs to, e.g. `aws`,
+	// so that `kubectl get aws` lists the custom resources of every CRD in the
+	// category.
+	Categories []string `json:"categories,omitempty"`
+	// Markers are additional kubebuilder markers emitted on the CRD's root
+	// type, e.g. `+kubebuilder:deprecatedversion`, for the markers the code
+	// generator does not otherwise support.
+	Markers []string `json:"markers,omitempty"`
 	// APIVersions represents the API versions defined for the generated CRD.
 	// Default version to be used is the one specified via the "--version"
 	// command-line argument, if none is specified here.
@@ -113,9 +173,908 @@ type ResourceConfig struct {
 	// IsARNPrimaryKey determines whether the CRD uses the ARN as the primary
 	// identifier in the ReadOne operations.
 	IsARNPrimaryKey bool `json:"is_arn_primary_key"`
+	// ARNLess marks the resource as never exposing an ARN and designates its
+	// canonical identifier instead.
+	ARNLess *ARNLessConfig `json:"arn_less,omitempty"`
+	// PrimaryKeySeparator separates the values of the primary key fields in
+	// the composite identifier of a resource whose identity is a tuple of
+	// fields, i.e. with several fields marked with `is_primary_key`. Defaults
+	// to ":".
+	PrimaryKeySeparator string `json:"primary_key_separator,omitempty"`
 	// TagConfig contains instructions for the code generator to generate
 	// custom code for ensuring tags
 	TagConfig *TagConfig `json:"tags,omitempty"`
+	// AuxiliaryResources contains instructions for the code generator to
+	// generate Go code that cleans up the auxiliary AWS resources provisioned
+	// alongside the resource when the resource is deleted.
+	AuxiliaryResources []*AuxiliaryResourceConfig `json:"auxiliary_resources,omitempty"`
+	// PreDelete contains instructions for the code generator to disable or
+	// stop the resource, and wait for it to reach a deletable state, before
+	// calling its Delete operation.
+	PreDelete *PreDeleteConfig `json:"pre_delete,omitempty"`
+	// ForceDelete contains instructions for the code generator to let users
+	// opt into destructive deletes of the resource, with a Spec field or an
+	// annotation, via a boolean member of the Delete operation's Input shape.
+	ForceDelete *ForceDeleteConfig `json:"force_delete,omitempty"`
+	// Attachments contains instructions for the code generator to fold
+	// single-valued sub-APIs of the resource, like a policy read and written
+	// with their own Get/Put/Delete operations, into Spec fields of the
+	// resource.
+	Attachments []*AttachmentConfig `json:"attachments,omitempty"`
+	// Collections contains instructions for the code generator to sync
+	// list Spec fields whose members are added and removed with their own
+	// operations, like the managed policies attached to an IAM role.
+	Collections []*CollectionConfig `json:"collections,omitempty"`
+	// Middleware contains instructions for the code generator to route the
+	// resource manager's ReadOne, Create, Update and Delete calls through a
+	// chain of middleware.
+	Middleware []*MiddlewareConfig `json:"middleware,omitempty"`
+	// Conditions contains instructions for the code generator to generate
+	// Go code that keeps the resource's Status.Conditions deduplicated,
+	// deterministically ordered and bounded across reconciles.
+	Conditions *ConditionsConfig `json:"conditions,omitempty"`
+	// AdoptionRetry contains instructions for the code generator to generate
+	// Go code that retries, with exponential backoff, the ReadOne operation
+	// of a resource being adopted when it fails with a transient error.
+	AdoptionRetry *AdoptionRetryConfig `json:"adoption_retry,omitempty"`
+	// CreateGracePeriod contains instructions for the code generator to
+	// generate Go code that requeues, instead of recreating, a resource that
+	// is not found shortly after it was created.
+	CreateGracePeriod *CreateGracePeriodConfig `json:"create_grace_period,omitempty"`
+	// Ownership contains instructions for the code generator to generate Go
+	// code that tags the AWS resource with the custom resource owning it and
+	// detects when another custom resource, e.g. from another ACK
+	// installation, manages the same AWS resource.
+	Ownership *OwnershipConfig `json:"ownership,omitempty"`
+	// Logging contains instructions for the code generator to generate Go
+	// code that logs, at debug level, the AWS API request and response
+	// payloads of the resource manager with secret and sensitive fields
+	// redacted.
+	Logging *LoggingConfig `json:"logging,omitempty"`
+	// Singleton marks the resource as an account- or region-wide settings
+	// resource that is put and read, but never created or deleted.
+	Singleton *SingletonConfig `json:"singleton,omitempty"`
+	// ReferenceWait contains instructions for the code generator to generate
+	// Go code that reports which referenced resource is not synced yet when
+	// the resource's references cannot be resolved, and requeues the
+	// resource after a configurable delay.
+	ReferenceWait *ReferenceWaitConfig `json:"reference_wait,omitempty"`
+	// Scope is the scope of the generated CRD, either "Namespaced" (the
+	// default) or "Cluster". Resources that are inherently global to an AWS
+	// account, like IAM roles or Route53 hosted zones, can be made
+	// cluster-scoped so that a single custom resource manages them cluster
+	// wide. Cluster-scoped resources require the controller to be installed
+	// with a cluster install scope. Their references to namespaced resources
+	// must set the namespace of the referenced resource, and they are not
+	// owned by a namespaced parent resource.
+	Scope string `json:"scope,omitempty"`
+	// Parent makes the resource a child resource of another resource of the
+	// API: a repeated member of the parent, carved out of the parent into its
+	// own CRD. The custom resources of a child resource are owned by the
+	// custom resource of their parent.
+	Parent *ParentConfig `json:"parent,omitempty"`
+	// ObserveOnly makes the resource an observe-only resource: its custom
+	// resources identify AWS resources the controller reads into their Status
+	// but never creates, updates or deletes, e.g. default VPCs or clusters
+	// managed outside of Kubernetes. Resources without a Create operation in
+	// the API can be observe-only resources, their Spec being the Input shape
+	// of their ReadOne operation (or GetAttributes or ReadMany operation when
+	// they have no ReadOne operation). Their Spec, identifying the AWS
+	// resource, is only ever set by the user, and their tags are ignored.
+	ObserveOnly bool `json:"observe_only,omitempty"`
+	// AdoptionOnly makes the resource an adoption-only resource: the AWS
+	// resources of its custom resources can only be adopted, never created
+	// by the controller, e.g. service-linked roles or quota-limited resources.
+	// Creating the custom resource of an AWS resource that does not exist
+	// sets a terminal condition. Adopted resources are updated and deleted
+	// like those of any other resource.
+	AdoptionOnly bool `json:"adoption_only,omitempty"`
+	// ReadAfterCreate instructs the code generator to read the resource with
+	// its find operation, e.g. ReadOne, right after its creation and to merge
+	// the Status fields read into the created resource. This is useful when
+	// the Output shape of the Create operation only returns the identifier of
+	// the resource. When the resource cannot be read yet, its Status fields
+	// are left to the read following its creation in the reconciliation.
+	ReadAfterCreate bool `json:"read_after_create,omitempty"`
+	// UpsertOperation is the ID of a Put-style operation of the API with
+	// upsert semantics, e.g. S3's PutBucketPolicy, creating the resource when
+	// it does not exist and replacing it otherwise. The operation is used as
+	// both the Create and Update operation of the resource. Since it does not
+	// fail for an existing resource, no "resource already exists" handling is
+	// needed, and update requests always carry the whole desired state.
+	UpsertOperation string `json:"upsert_operation,omitempty"`
+	// DeletionPolicy is the default deletion policy of the resource, either
+	// "delete" or "retain". Deleting a custom resource whose deletion policy
+	// is "retain" removes its finalizer without deleting the AWS resource,
+	// unless the custom resource has the `services.k8s.aws/deletion-policy`
+	// annotation set to "delete", or has none and its namespace has the
+	// `{service}.services.k8s.aws/deletion-policy` annotation set to
+	// "delete". Defaults to "delete", the controller's deletion policy then
+	// applying.
+	DeletionPolicy string `json:"deletion_policy,omitempty"`
+	// Scale contains instructions for the code generator to enable the scale
+	// subresource of the CRD, so that `kubectl scale` and the
+	// HorizontalPodAutoscaler can scale capacity-style resources, e.g. the
+	// desired capacity of an auto scaling group.
+	Scale *ScaleConfig `json:"scale,omitempty"`
+}
+
+// ARNLessConfig instructs the code generator to handle a resource that never
+// exposes an ARN, e.g. an EC2 DHCP options set or an API Gateway route. No
+// field of the resource is mapped onto `Status.ACKResourceMetadata.ARN`, even
+// when named like an ARN, no ARN printer column is generated, and the
+// resource is adopted with the `nameOrID` of its adoption identifiers, set
+// into its identifier field, never with an ARN.
+//
+// Example:
+//
+// resources:
+//
+//	DhcpOptions:
+//	  arn_less:
+//	    identifier_field: DHCPOptionsID
+type ARNLessConfig struct {
+	// IdentifierField is the name of the Spec or Status field holding the
+	// canonical identifier of the resource. It is the resource's primary key
+	// unless fields are marked with `is_primary_key`.
+	IdentifierField string `json:"identifier_field"`
+}
+
+// ScaleConfig instructs the code generator to emit the
+// `+kubebuilder:subresource:scale` marker on the CRD's root type, mapping the
+// replicas of the scale subresource onto a Spec field holding the desired
+// count and a Status field holding the observed count. Both fields must be
+// integers and may be nested, e.g. `ScalingConfig.DesiredSize`.
+//
+// Example:
+//
+// resources:
+//
+//	Cluster:
+//	  scale:
+//	    spec_field: NumShards
+//	    status_field: NumberOfShards
+type ScaleConfig struct {
+	// SpecField is the path of the Spec field holding the desired count
+	SpecField string `json:"spec_field"`
+	// StatusField is the path of the Status field holding the observed count
+	StatusField string `json:"status_field"`
+	// SelectorField is the path of the string Status field holding the
+	// serialized label selector of the scaled pods, if any. The
+	// HorizontalPodAutoscaler requires it to scale on pod metrics.
+	SelectorField string `json:"selector_field,omitempty"`
+}
+
+const (
+	// DeletionPolicyDelete deletes the AWS resource when the custom resource
+	// is deleted
+	DeletionPolicyDelete = "delete"
+	// DeletionPolicyRetain leaves the AWS resource in place when the custom
+	// resource is deleted
+	DeletionPolicyRetain = "retain"
+)
+
+const (
+	// ResourceScopeNamespaced is the scope of namespaced CRDs
+	ResourceScopeNamespaced = "Namespaced"
+	// ResourceScopeCluster is the scope of cluster-scoped CRDs
+	ResourceScopeCluster = "Cluster"
+)
+
+// ParentConfig instructs the code generator to generate a resource as a child
+// resource of another resource, its parent. The child resource's lifecycle
+// maps onto the parent's add, modify and remove operations, which are
+// associated with the child resource like those of any other resource, with
+// the `operations` config when their names do not match the child
+// resource's name. The child resource must have a reference field to its
+// parent, with `references`, and the custom resource referenced by it becomes
+// the owner of the child custom resource, so that deleting the parent custom
+// resource garbage collects its children.
+//
+// Example:
+//
+// resources:
+//
+//	Route:
+//	  parent:
+//	    resource: RouteTable
+//	    field: Routes
+//	    reference_field: RouteTableID
+//	  fields:
+//	    RouteTableID:
+//	      references:
+//	        resource: RouteTable
+//	        path: Status.RouteTableID
+type ParentConfig struct {
+	// Resource is the name of the parent resource
+	Resource string `json:"resource"`
+	// Field is the name of the parent's field carved out into the child
+	// resource, if any. The field is removed from the parent's Spec and
+	// Status so that the parent does not manage the child resources.
+	Field string `json:"field,omitempty"`
+	// ReferenceField is the name of the child resource's Spec field holding
+	// the identifier of its parent. It must reference the parent resource.
+	ReferenceField string `json:"reference_field"`
+}
+
+// ReferenceWaitConfig instructs the code generator to detect that a resource
+// referenced by one of the resource's reference fields exists but is not
+// synced yet. While waiting for it, the resource carries an
+// `ACK.ReferenceNotSynced` condition naming the kind, namespace and name of
+// the blocking resource, so that users can tell which resource stalls a
+// rollout of several dependent resources, and the resource is requeued after
+// `requeue_after_seconds`. The condition is removed once the references are
+// resolved.
+//
+// Example:
+//
+// resources:
+//
+//	Integration:
+//	  reference_wait:
+//	    requeue_after_seconds: 10
+type ReferenceWaitConfig struct {
+	// RequeueAfterSeconds is the number of seconds to wait before resolving
+	// the references again. Defaults to 30.
+	RequeueAfterSeconds int `json:"requeue_after_seconds,omitempty"`
+}
+
+// SingletonConfig instructs the code generator to handle the resource as a
+// singleton: a settings resource, like a registry's scanning configuration or
+// an account's configuration, of which each AWS account has exactly one per
+// region. The operation putting the settings, mapped to the Create operation
+// type with an `operations` config, is also used as the resource's Update
+// operation when it has none.
+//
+// When the API has no Delete operation for the resource, deleting the custom
+// resource either leaves the settings as they are (`on_delete: noop`, the
+// default) or calls the Create operation with the members in `reset_values`
+// set to their default values (`on_delete: reset`).
+//
+// The code generator also generates a validating webhook rejecting the
+// creation of a second custom resource of the same kind for the same
+// namespace and region. The webhook only runs when the controller is started
+// with `--enable-webhook-server` and the cluster has a
+// ValidatingWebhookConfiguration, with its serving certificate, pointing at
+// the controller. The configuration is not part of the generated release
+// artifacts: generate it from the webhook's kubebuilder marker with
+// `controller-gen webhook`. The check lists the existing custom resources
+// before admitting a new one, so two creations racing each other may both be
+// admitted.
+//
+// Example:
+//
+// operations:
+//
+//	PutRegistryScanningConfiguration:
+//	  operation_type:
+//	    - Create
+//	  resource_name: RegistryScanningConfiguration
+//	GetRegistryScanningConfiguration:
+//	  operation_type:
+//	    - ReadOne
+//	  resource_name: RegistryScanningConfiguration
+//
+// resources:
+//
+//	RegistryScanningConfiguration:
+//	  singleton:
+//	    on_delete: reset
+//	    reset_values:
+//	      ScanType: BASIC
+type SingletonConfig struct {
+	// OnDelete is what deleting the custom resource does when the API has no
+	// Delete operation for the resource, either "noop" or "reset".
+	OnDelete string `json:"on_delete,omitempty"`
+	// ResetValues maps the names of members of the Create operation's Input
+	// shape to the values they are reset to when `on_delete` is "reset".
+	ResetValues map[string]string `json:"reset_values,omitempty"`
+}
+
+const (
+	// SingletonOnDeleteNoop leaves the settings as they are when the custom
+	// resource is deleted
+	SingletonOnDeleteNoop = "noop"
+	// SingletonOnDeleteReset resets the settings to their default values when
+	// the custom resource is deleted
+	SingletonOnDeleteReset = "reset"
+)
+
+// LoggingConfig instructs the code generator to log the input and output
+// payloads of the resource's Create, ReadOne, Update and Delete AWS API calls
+// in the resource logger at debug level. The values of the members
+// corresponding to fields configured with `is_secret` or `is_sensitive` are
+// replaced with "<redacted>" wherever they appear in a payload.
+//
+// Example:
+//
+// resources:
+//
+//	DBInstance:
+//	  logging:
+//	    log_sdk_payloads: true
+//	    redact_all: false
+type LoggingConfig struct {
+	// LogSDKPayloads enables the logging of the AWS API request and response
+	// payloads.
+	LogSDKPayloads bool `json:"log_sdk_payloads"`
+	// RedactAll replaces every logged payload as a whole with "<redacted>",
+	// only logging which AWS API operations are called. Intended for
+	// regulated environments where no resource data may reach the logs.
+	RedactAll bool `json:"redact_all,omitempty"`
+}
+
+// OwnershipConfig instructs the code generator to stamp an ownership tag,
+// holding the UID of the custom resource, on the AWS resource each time the
+// controller ensures its tags. When the AWS resource read from the service
+// carries the ownership tag of another custom resource, the controller stops
+// reconciling it, sets an `ACK.OwnershipConflict` condition and does not
+// overwrite the resource. A custom resource annotated with
+// `{api_group}/claim-ownership: "true"` takes ownership of the AWS resource
+// regardless, for instance after adopting it.
+//
+// Ownership conflicts can only be detected for resources whose ReadOne
+// operation returns the resource's tags.
+//
+// Example:
+//
+// resources:
+//
+//	Repository:
+//	  ownership:
+//	    tag_key: example.com/owner
+type OwnershipConfig struct {
+	// TagKey is the key of the ownership tag. Defaults to
+	// "services.k8s.aws/owner-uid".
+	TagKey string `json:"tag_key,omitempty"`
+}
+
+// AdoptionRetryConfig instructs the code generator to retry the ReadOne
+// operation of a resource being adopted when the AWS service does not find
+// the resource yet (eventual consistency) or throttles the request, instead
+// of failing the adoption immediately. Each retry requeues the adoption and,
+// while retrying, the resource carries an `ACK.Adopting` condition.
+//
+// Example:
+//
+// resources:
+//
+//	Repository:
+//	  adoption_retry:
+//	    max_attempts: 5
+//	    initial_backoff_seconds: 1
+//	    max_backoff_seconds: 30
+//	    retryable_error_codes:
+//	      - ThrottlingException
+type AdoptionRetryConfig struct {
+	// MaxAttempts is the maximum number of ReadOne attempts. Defaults to 5.
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// InitialBackoffSeconds is the number of seconds to wait before the
+	// first retry. The wait doubles with each retry. Defaults to 1.
+	InitialBackoffSeconds int `json:"initial_backoff_seconds,omitempty"`
+	// MaxBackoffSeconds is the maximum number of seconds to wait between two
+	// attempts. Defaults to 30.
+	MaxBackoffSeconds int `json:"max_backoff_seconds,omitempty"`
+	// RetryableErrorCodes is the list of AWS error codes, in addition to the
+	// resource not being found, that are retried. Defaults to the common
+	// throttling error codes.
+	RetryableErrorCodes []string `json:"retryable_error_codes,omitempty"`
+}
+
+// CreateGracePeriodConfig instructs the code generator to tolerate the
+// eventual consistency of AWS APIs that do not find a resource for a short
+// while after it was successfully created (e.g. IAM or S3). The time of
+// creation is recorded in an annotation of the resource and, when the
+// resource is not found within the grace period, the ReadOne operation
+// requeues the resource instead of letting the reconciler create it again.
+//
+// Example:
+//
+// resources:
+//
+//	Role:
+//	  create_grace_period:
+//	    seconds: 60
+//	    requeue_after_seconds: 5
+type CreateGracePeriodConfig struct {
+	// Seconds is the number of seconds after the creation of the resource
+	// during which the resource not being found is tolerated. Defaults to
+	// 30.
+	Seconds int `json:"seconds,omitempty"`
+	// RequeueAfterSeconds is the number of seconds after which a resource
+	// not found within the grace period is requeued. Defaults to 5.
+	RequeueAfterSeconds int `json:"requeue_after_seconds,omitempty"`
+}
+
+// ConditionsConfig instructs the code generator to manage the resource's
+// Status.Conditions each time the resource manager updates them. Conditions
+// are deduplicated by type, keeping the last one, and ordered with the ACK
+// condition types first followed by the custom condition types sorted by
+// name.
+//
+// Example:
+//
+// resources:
+//
+//	Repository:
+//	  conditions:
+//	    max_count: 10
+//	    prune_stale_after_seconds: 86400
+//
+// Only custom conditions, i.e. conditions whose type is not one of the ACK
+// condition types, are ever pruned.
+type ConditionsConfig struct {
+	// MaxCount is the maximum number of conditions kept in the resource's
+	// Status. When exceeded, the custom conditions with the oldest last
+	// transition time are pruned first. Zero means no maximum.
+	MaxCount int `json:"max_count,omitempty"`
+	// PruneStaleAfterSeconds is the number of seconds after which a custom
+	// condition whose status is not True, and that has not transitioned
+	// since, is considered stale and pruned. Zero means stale conditions are
+	// never pruned.
+	PruneStaleAfterSeconds int `json:"prune_stale_after_seconds,omitempty"`
+}
+
+// MiddlewareConfig instructs the code generator to wrap the resource
+// manager's calls to sdkFind, sdkCreate, sdkUpdate and sdkDelete with a
+// middleware. A middleware is a function with the following signature:
+//
+//	func(opName string, next sdkOperation) sdkOperation
+//
+// where opName is one of "find", "create", "update" or "delete" and
+// sdkOperation is:
+//
+//	func(ctx context.Context, r *resource) (*resource, error)
+//
+// Example:
+//
+// resources:
+//
+//	Repository:
+//	  middleware:
+//	    - builtin: logging
+//	    - builtin: metrics
+//	    - builtin: rate_limiting
+//	      requests_per_second: 5
+//	      burst: 10
+//	    - builtin: caching
+//	      ttl_seconds: 30
+//	    - custom_method_name: auditMiddleware
+//
+// Middleware is applied in order, the first entry being the outermost.
+// Cross-cutting behaviors not provided by the code generator are implemented
+// as `resourceManager` methods in the controller's custom code and referenced
+// with `custom_method_name`.
+type MiddlewareConfig struct {
+	// Builtin is the name of a middleware provided by the code generator, one
+	// of:
+	//
+	//   - "logging" traces the entry into and exit from each operation in the
+	//     resource logger.
+	//   - "metrics" observes the duration of each operation, by operation and
+	//     outcome, in the `ack_resource_manager_operation_duration_seconds`
+	//     Prometheus histogram.
+	//   - "caching" reuses, for TTLSeconds, the resource returned by a
+	//     successful find for the same generation of the custom resource. The
+	//     cached resource is discarded by any create, update or delete.
+	//   - "rate_limiting" limits the operations of all the resources of the
+	//     kind to RequestsPerSecond, allowing bursts of Burst operations.
+	Builtin string `json:"builtin,omitempty"`
+	// CustomMethodName is the name of a custom method on the
+	// `resourceManager` struct with the middleware signature.
+	CustomMethodName string `json:"custom_method_name,omitempty"`
+	// TTLSeconds is the number of seconds for which the "caching" builtin
+	// reuses the result of a find. Defaults to 10.
+	TTLSeconds int `json:"ttl_seconds,omitempty"`
+	// RequestsPerSecond is the number of operations per second the
+	// "rate_limiting" builtin allows. Defaults to 1.
+	RequestsPerSecond float64 `json:"requests_per_second,omitempty"`
+	// Burst is the number of operations the "rate_limiting" builtin allows
+	// at once. Defaults to 1.
+	Burst int `json:"burst,omitempty"`
+}
+
+// GetTTLSeconds returns the number of seconds for which the "caching"
+// builtin middleware reuses the result of a find.
+func (c *MiddlewareConfig) GetTTLSeconds() int {
+	if c.TTLSeconds > 0 {
+		return c.TTLSeconds
+	}
+	return 10
+}
+
+// GetRequestsPerSecond returns the number of operations per second the
+// "rate_limiting" builtin middleware allows.
+func (c *MiddlewareConfig) GetRequestsPerSecond() float64 {
+	if c.RequestsPerSecond > 0 {
+		return c.RequestsPerSecond
+	}
+	return 1
+}
+
+// GetBurst returns the number of operations the "rate_limiting" builtin
+// middleware allows at once.
+func (c *MiddlewareConfig) GetBurst() int {
+	if c.Burst > 0 {
+		return c.Burst
+	}
+	return 1
+}
+
+// AuxiliaryResourceConfig instructs the code generator how to clean up an
+// auxiliary AWS resource (for instance a configuration or a log destination)
+// that is implicitly provisioned by the resource's Create operation and that
+// would otherwise be orphaned when the resource is deleted.
+//
+// Example:
+//
+// resources:
+//
+//	Repository:
+//	  auxiliary_resources:
+//	    - name: LifecyclePolicy
+//	      delete_operation: DeleteLifecyclePolicy
+//	      input_fields:
+//	        RepositoryName: Spec.RepositoryName
+//	      not_found_codes:
+//	        - LifecyclePolicyNotFoundException
+//
+// The auxiliary resources are cleaned up, in order, before the resource is
+// deleted, so that a failed clean up is retried on the next reconcile rather
+// than orphaning the auxiliary resources once the resource is gone. As the
+// clean up runs again whenever the deletion of the resource is retried, the
+// DeleteOperation should list the codes it returns for an auxiliary resource
+// that is already gone in NotFoundCodes.
+type AuxiliaryResourceConfig struct {
+	// Name identifies the auxiliary resource in the generated code
+	Name string `json:"name"`
+	// DeleteOperation is the ID of the API Operation that deletes the
+	// auxiliary resource
+	DeleteOperation string `json:"delete_operation,omitempty"`
+	// InputFields is a map, keyed by the DeleteOperation's Input shape member
+	// name, of the field path (e.g. "Status.ID") of the resource field whose
+	// value is used for that member. The DeleteOperation is only called when
+	// all of those fields are set.
+	InputFields map[string]string `json:"input_fields,omitempty"`
+	// CustomMethodName is the name of a custom method on the
+	// `resourceManager` struct that deletes the auxiliary resource. It is
+	// used instead of DeleteOperation, for instance when the auxiliary
+	// resource belongs to another AWS service API.
+	CustomMethodName string `json:"custom_method_name,omitempty"`
+	// NotFoundCodes is the list of AWS error codes returned by the
+	// DeleteOperation indicating that the auxiliary resource no longer exists
+	NotFoundCodes []string `json:"not_found_codes,omitempty"`
+	// Retain instructs the code generator to leave the auxiliary resource in
+	// place when the resource is deleted.
+	Retain bool `json:"retain,omitempty"`
+}
+
+// PreDeleteConfig instructs the code generator how to prepare a resource for
+// its deletion, for resources that must be disabled or stopped before they
+// can be deleted, e.g. a resource with deletion protection.
+//
+// Example:
+//
+// resources:
+//
+//	DBInstance:
+//	  pre_delete:
+//	    operations:
+//	      - operation: ModifyDBInstance
+//	        input_fields:
+//	          DBInstanceIdentifier: Spec.DBInstanceIdentifier
+//	        input_values:
+//	          ApplyImmediately: "true"
+//	          DeletionProtection: "false"
+//	    wait_for:
+//	      path: Status.DBInstanceStatus
+//	      in:
+//	        - available
+//	        - stopped
+//
+// The operations are called, in order, every time the resource is about to be
+// deleted, and the collections listed in `empty` are then emptied. The
+// resource is then read again, and the Delete operation is only called once
+// the value of the `wait_for` field read after the operations is one of the
+// `in` values, the deletion being requeued otherwise.
+type PreDeleteConfig struct {
+	// Operations is the list of API Operations called before the resource's
+	// Delete operation
+	Operations []*PreDeleteOperationConfig `json:"operations,omitempty"`
+	// Empty is the list of collections, e.g. the images of an ECR
+	// repository, that must be emptied before the resource's Delete
+	// operation is called
+	Empty []*PreDeleteEmptyConfig `json:"empty,omitempty"`
+	// WaitFor is the condition, on a string field of the latest observed
+	// resource, that must be satisfied before the Delete operation is called
+	WaitFor *SyncedCondition `json:"wait_for,omitempty"`
+}
+
+// FieldsUpdateOperationConfig instructs the code generator which Spec fields
+// of a resource an additional update operation updates, see
+// `update_operations`
+type FieldsUpdateOperationConfig struct {
+	// Operation is the ID of the API Operation
+	Operation string `json:"operation"`
+	// Fields is the list of the names of the top-level Spec fields updated by
+	// the Operation
+	Fields []string `json:"fields"`
+}
+
+// PreDeleteOperationConfig instructs the code generator how to call an API
+// Operation before deleting a resource
+type PreDeleteOperationConfig struct {
+	// Operation is the ID of the API Operation
+	Operation string `json:"operation"`
+	// InputFields is a map, keyed by the Operation's Input shape member
+	// name, of the field path (e.g. "Status.ID") of the resource field whose
+	// value is used for that member. The Operation is only called when all
+	// of those fields are set.
+	InputFields map[string]string `json:"input_fields,omitempty"`
+	// InputValues is a map, keyed by the Operation's Input shape member name,
+	// of the constant value used for that scalar member, which must be a valid
+	// value of the member's type
+	InputValues map[string]string `json:"input_values,omitempty"`
+	// NotFoundCodes is the list of AWS error codes returned by the Operation
+	// indicating that the resource no longer exists
+	NotFoundCodes []string `json:"not_found_codes,omitempty"`
+}
+
+// PreDeleteEmptyConfig instructs the code generator how to empty a
+// collection of a resource, e.g. the images of an ECR repository, before the
+// resource is deleted.
+//
+// Example:
+//
+// resources:
+//
+//	Repository:
+//	  pre_delete:
+//	    empty:
+//	      - name: Images
+//	        list_operation: ListImages
+//	        list_input_fields:
+//	          RepositoryName: Spec.RepositoryName
+//	        list_path: ImageIds
+//	        delete_operation: BatchDeleteImage
+//	        delete_input_fields:
+//	          RepositoryName: Spec.RepositoryName
+//	        delete_items_member: ImageIds
+//	        only_when_forced: true
+//
+// Every page returned by the list operation is deleted either with a single
+// call to the delete operation, its `delete_items_member` list member being
+// set to the page's elements, or with one call per element, the
+// `delete_item_members` of the delete operation's Input shape being set to
+// the element's members. The collection is then listed again from its first
+// page, until no element is left. Enumeration code that cannot be expressed this way
+// is provided with `custom_method_name` instead.
+type PreDeleteEmptyConfig struct {
+	// Name identifies the collection in the generated code
+	Name string `json:"name"`
+	// ListOperation is the ID of the API Operation that lists the elements
+	// of the collection
+	ListOperation string `json:"list_operation,omitempty"`
+	// ListInputFields is a map, keyed by the ListOperation's Input shape
+	// member name, of the field path (e.g. "Spec.Name") of the resource field
+	// whose value is used for that member. The collection is only emptied
+	// when all of those fields are set.
+	ListInputFields map[string]string `json:"list_input_fields,omitempty"`
+	// ListPath is the name of the list member of the ListOperation's Output
+	// shape holding the elements of the collection
+	ListPath string `json:"list_path,omitempty"`
+	// DeleteOperation is the ID of the API Operation that deletes the
+	// elements of the collection
+	DeleteOperation string `json:"delete_operation,omitempty"`
+	// DeleteInputFields is a map, keyed by the DeleteOperation's Input shape
+	// member name, of the field path of the resource field whose value is
+	// used for that member
+	DeleteInputFields map[string]string `json:"delete_input_fields,omitempty"`
+	// DeleteItemsMember is the name of the list member of the
+	// DeleteOperation's Input shape set to all the elements of a page, for
+	// batch delete operations
+	DeleteItemsMember string `json:"delete_items_member,omitempty"`
+	// DeleteItemMembers is a map, keyed by the DeleteOperation's Input shape
+	// member name, of the name of the element's member whose value is used
+	// for that member, for delete operations called once per element
+	DeleteItemMembers map[string]string `json:"delete_item_members,omitempty"`
+	// DeleteFailuresMember is the name of the list member of the
+	// DeleteOperation's Output shape holding the elements that could not be
+	// deleted, for batch delete operations reporting per-element failures.
+	// Defaults to Failures when the Output shape has such a member.
+	DeleteFailuresMember string `json:"delete_failures_member,omitempty"`
+	// CustomMethodName is the name of a custom method on the
+	// `resourceManager` struct, with the `func(context.Context, *resource)
+	// error` signature, that empties the collection. It is used instead of
+	// the list and delete operations.
+	CustomMethodName string `json:"custom_method_name,omitempty"`
+	// OnlyWhenForced instructs the code generator to only empty the
+	// collection when the deletion of the resource is forced, as configured
+	// in the resource's `force_delete` generator config
+	OnlyWhenForced bool `json:"only_when_forced,omitempty"`
+}
+
+// ForceDeleteConfig instructs the code generator how to force the deletion of
+// a resource, for Delete operations with a `Force` or `SkipFinalSnapshot`
+// style boolean member that users must opt into.
+//
+// Example:
+//
+// resources:
+//
+//	Repository:
+//	  fields:
+//	    ForceDelete:
+//	      type: "*bool"
+//	  force_delete:
+//	    member: Force
+//	    field: Spec.ForceDelete
+//	    annotation: true
+//
+// The member is set to true when the field is true or, with `annotation`,
+// when the resource has the `<API group>/force-delete` annotation set to
+// "true". It is left unset otherwise.
+type ForceDeleteConfig struct {
+	// Member is the name of the boolean member of the Delete operation's
+	// Input shape that forces the deletion
+	Member string `json:"member"`
+	// Field is the path (e.g. "Spec.ForceDelete") of the boolean resource
+	// field that forces the deletion
+	Field string `json:"field,omitempty"`
+	// Annotation instructs the code generator to force the deletion when the
+	// resource has the `<API group>/force-delete` annotation set to "true"
+	Annotation bool `json:"annotation,omitempty"`
+}
+
+// AttachmentConfig instructs the code generator how to read and write a
+// Spec field whose value is managed by its own operations of the AWS API
+// rather than the resource's Create, ReadOne and Update operations, e.g. the
+// lifecycle policy of an ECR repository. The Spec field itself is typically
+// added with `from`.
+//
+// Example:
+//
+// resources:
+//
+//	Repository:
+//	  fields:
+//	    LifecyclePolicyText:
+//	      from:
+//	        operation: PutLifecyclePolicy
+//	        path: LifecyclePolicyText
+//	  attachments:
+//	    - field: LifecyclePolicyText
+//	      get_operation: GetLifecyclePolicy
+//	      put_operation: PutLifecyclePolicy
+//	      delete_operation: DeleteLifecyclePolicy
+//	      input_fields:
+//	        RepositoryName: Spec.RepositoryName
+//	      not_found_codes:
+//	        - LifecyclePolicyNotFoundException
+//
+// The field is read with the GetOperation after the resource is read, put
+// with the PutOperation after the resource is created and whenever the field
+// changes, and deleted with the DeleteOperation when the field is unset.
+// Differences in attachment fields only do not call the resource's Update
+// operation.
+type AttachmentConfig struct {
+	// Field is the name of the Spec field holding the attachment's value. It
+	// must be a scalar field.
+	Field string `json:"field"`
+	// Member is the name of the member holding the attachment's value in the
+	// GetOperation's Output shape and the PutOperation's Input shape.
+	// Defaults to Field.
+	Member string `json:"member,omitempty"`
+	// GetOperation is the ID of the API Operation that reads the attachment
+	GetOperation string `json:"get_operation"`
+	// PutOperation is the ID of the API Operation that writes the attachment
+	PutOperation string `json:"put_operation"`
+	// DeleteOperation is the ID of the API Operation that deletes the
+	// attachment when the field is unset. The attachment is left in place
+	// when the field is unset if no DeleteOperation is configured.
+	DeleteOperation string `json:"delete_operation,omitempty"`
+	// InputFields is a map, keyed by the Input shape member name of the
+	// attachment's operations, of the field path (e.g. "Spec.Name") of the
+	// resource field whose value is used for that member. The operations are
+	// only called when all of those fields are set.
+	InputFields map[string]string `json:"input_fields,omitempty"`
+	// NotFoundCodes is the list of AWS error codes returned by the
+	// GetOperation and DeleteOperation indicating that the attachment does
+	// not exist
+	NotFoundCodes []string `json:"not_found_codes,omitempty"`
+}
+
+// GetMember returns the name of the member holding the attachment's value
+func (c *AttachmentConfig) GetMember() string {
+	if c.Member != "" {
+		return c.Member
+	}
+	return c.Field
+}
+
+// CollectionConfig instructs the code generator how to read and sync a list
+// Spec field whose members are added and removed with their own operations
+// of the AWS API, one member at a time, rather than with the resource's
+// Create and Update operations, e.g. the managed policies attached to an IAM
+// role.
+//
+// Example:
+//
+// resources:
+//
+//	Role:
+//	  fields:
+//	    Policies:
+//	      type: "[]*string"
+//	      compare:
+//	        is_set: true
+//	  collections:
+//	    - field: Policies
+//	      member: PolicyArn
+//	      list_operation: ListAttachedRolePolicies
+//	      list_path: AttachedPolicies.PolicyArn
+//	      add_operation: AttachRolePolicy
+//	      remove_operation: DetachRolePolicy
+//	      input_fields:
+//	        RoleName: Spec.Name
+//	      not_found_codes:
+//	        - NoSuchEntity
+//
+// The field is read with the ListOperation after the resource is read. After
+// the resource is created, and whenever the field changes, the members
+// missing from the latest observed field are added with the AddOperation and
+// the members missing from the desired field are removed with the
+// RemoveOperation. Differences in collection fields only do not call the
+// resource's Update operation. Only lists of scalars are supported, and they
+// are typically compared with `is_set` since the ListOperation returns the
+// members in arbitrary order.
+type CollectionConfig struct {
+	// Field is the name of the Spec field holding the collection. It must be
+	// a list of scalars.
+	Field string `json:"field"`
+	// Member is the name of the member holding a single member of the
+	// collection in the Input shapes of the AddOperation and RemoveOperation.
+	// Defaults to Field.
+	Member string `json:"member,omitempty"`
+	// ListOperation is the ID of the API Operation that reads the collection
+	ListOperation string `json:"list_operation"`
+	// ListPath is the dotted path, in the ListOperation's Output shape, to
+	// the members of the collection, e.g. "AttachedPolicies.PolicyArn" for
+	// the PolicyArn member of each element of the AttachedPolicies list.
+	// Defaults to Member.
+	ListPath string `json:"list_path,omitempty"`
+	// AddOperation is the ID of the API Operation that adds a member to the
+	// collection
+	AddOperation string `json:"add_operation"`
+	// RemoveOperation is the ID of the API Operation that removes a member
+	// from the collection
+	RemoveOperation string `json:"remove_operation"`
+	// InputFields is a map, keyed by the Input shape member name of the
+	// collection's operations, of the field path (e.g. "Spec.Name") of the
+	// resource field whose value is used for that member. The operations are
+	// only called when all of those fields are set.
+	InputFields map[string]string `json:"input_fields,omitempty"`
+	// NotFoundCodes is the list of AWS error codes returned by the
+	// RemoveOperation indicating that the member was already removed
+	NotFoundCodes []string `json:"not_found_codes,omitempty"`
+}
+
+// GetMember returns the name of the member holding a single member of the
+// collection in the Input shapes of the add and remove operations
+func (c *CollectionConfig) GetMember() string {
+	if c.Member != "" {
+		return c.Member
+	}
+	return c.Field
+}
+
+// GetListPath returns the dotted path to the members of the collection in the
+// Output shape of the list operation
+func (c *CollectionConfig) GetListPath() string {
+	if c.ListPath != "" {
+		return c.ListPath
+	}
+	return c.GetMember()
 }
 
 // TagConfig instructs the code  generator on how to generate functions that
@@ -135,23 +1094,225 @@ type TagConfig struct {
 	// tag struct. This is only used for tag fields with shape as list of struct,
 	// where the struct represents a single tag.
 	ValueMemberName *string `json:"value_name,omitempty"`
+	// Representation is the representation of the tags in the tag field of
+	// the resource when the API represents them the other way, either `map`,
+	// a map of tag values keyed by tag key, or `list`, a list of the tag
+	// structs named by StructName. The code generator generates the helpers
+	// converting the tags between the representations. Defaults to the
+	// representation of the API.
+	Representation string `json:"representation,omitempty"`
+	// StructName is the name of the structure shape of a tag, with key and
+	// value members, used by the `list` representation. APIs representing
+	// their tags as a map usually have no such shape, it is then defined in
+	// `custom_shapes`, e.g.:
+	//
+	//	custom_shapes:
+	//	  FunctionTag:
+	//	    members:
+	//	      Key: string
+	//	      Value: string
+	//	resources:
+	//	  Function:
+	//	    tags:
+	//	      representation: list
+	//	      struct_name: FunctionTag
+	//
+	// Defaults to Tag.
+	StructName string `json:"struct_name,omitempty"`
+	// DefaultTags are the tags, keyed by tag key, added to the tags of the
+	// resource in addition to the service's `default_tags`, whose values they
+	// override.
+	DefaultTags map[string]string `json:"default_tags,omitempty"`
+	// IgnoreKeyPrefixes are the prefixes of the keys of tags managed by other
+	// services, e.g. `elasticbeanstalk:`, that cannot be set or removed by
+	// the user. These tags, and the tags managed by AWS whose keys have the
+	// `aws:` prefix, are ignored when comparing and updating the tags of the
+	// resource.
+	IgnoreKeyPrefixes []string `json:"ignore_key_prefixes,omitempty"`
+	// Sync instructs the code generator to generate the code updating the
+	// tags of the AWS resource with the tagging operations of the service
+	// when the tag field of the resource changes.
+	Sync *TagSyncConfig `json:"sync,omitempty"`
+}
+
+// TagSyncConfig describes the tagging operations of a service, e.g. the
+// following resource is tagged with AddTagsToResource and untagged with
+// RemoveTagsFromResource instead of TagResource and UntagResource:
+//
+//	tags:
+//	  sync:
+//	    tag_operation: AddTagsToResource
+//	    untag_operation: RemoveTagsFromResource
+//	    resource_member: ResourceName
+//
+// Resources whose Create operation does not honour the tags set in its Input
+// shape are tagged in a second call after their creation with `after_create:
+// true`.
+//
+// The tags are updated by comparing the tags of the desired resource with the
+// tags read from the AWS resource. Resources whose ReadOne, or ReadMany,
+// operation does not return their tags, like RDS' DBSubnetGroup, read them
+// with a `list_operation`:
+//
+//	tags:
+//	  sync:
+//	    tag_operation: AddTagsToResource
+//	    untag_operation: RemoveTagsFromResource
+//	    list_operation: ListTagsForResource
+//	    list_tags_member: TagList
+//
+// Services adding and removing tags in a single call, like Route53's
+// ChangeTagsForResource, use the same tag and untag operation. The other
+// members of the tagging operations' Input shapes are set with the
+// operations' `override_values`.
+type TagSyncConfig struct {
+	// TagOperation is the name of the operation adding tags to the AWS
+	// resource or overwriting their values. Defaults to TagResource.
+	TagOperation string `json:"tag_operation,omitempty"`
+	// UntagOperation is the name of the operation removing tags from the AWS
+	// resource. Defaults to UntagResource.
+	UntagOperation string `json:"untag_operation,omitempty"`
+	// ResourceMember is the name of the member of the tagging operations'
+	// Input shapes identifying the AWS resource. Defaults to the only other
+	// required member of the tag operation's Input shape.
+	ResourceMember string `json:"resource_member,omitempty"`
+	// ResourceField is the path of the field of the resource, starting with
+	// Spec or Status, holding the value of ResourceMember. Defaults to
+	// Status.ACKResourceMetadata.ARN.
+	ResourceField string `json:"resource_field,omitempty"`
+	// TagsMember is the name of the member of the tag operation's Input
+	// shape holding the tags to add. Defaults to Tags.
+	TagsMember string `json:"tags_member,omitempty"`
+	// TagKeysMember is the name of the member of the untag operation's Input
+	// shape holding the keys of the tags to remove. Defaults to TagKeys.
+	TagKeysMember string `json:"tag_keys_member,omitempty"`
+	// ListOperation is the name of the operation reading the tags of the AWS
+	// resource, e.g. ListTagsForResource, called when the resource is read.
+	// Its Input shape identifies the AWS resource with ResourceMember.
+	// Required unless the Output shape of the operation reading the resource
+	// has a tag member.
+	ListOperation string `json:"list_operation,omitempty"`
+	// ListTagsMember is the name of the member of the list operation's Output
+	// shape holding the tags of the AWS resource. Defaults to Tags.
+	ListTagsMember string `json:"list_tags_member,omitempty"`
+	// AfterCreate instructs the code generator to leave the tags out of the
+	// Create operation's Input shape and to add them with the tag operation
+	// once the resource is created. Some APIs silently drop the tags passed
+	// to their Create operation or do not accept tags on creation at all.
+	AfterCreate bool `json:"after_create,omitempty"`
 }
 
 // SyncedConfig instructs the code generator on how to generate functions that checks
 // whether a resource was synced or not.
+//
+// Conditions can be combined with `all_of`, `any_of` and `not` to express
+// more complex readiness semantics, e.g. the following resource is synced
+// when its status is "available" and it has no pending modifications:
+//
+// resources:
+//
+//	DBInstance:
+//	  synced:
+//	    when:
+//	      - all_of:
+//	          - path: Status.DBInstanceStatus
+//	            in:
+//	              - available
+//	          - path: Status.PendingModifiedValues
+//	            empty: true
 type SyncedConfig struct {
 	// When is a list of conditions that should be satisfied in order to tell whether a
 	// a resource was synced or not.
 	When []SyncedCondition `json:"when"`
 }
 
+// TerminalStatesConfig instructs the code generator to treat some values of
+// a resource's state field as terminal. When the resource is read in such a
+// state, its Terminal condition is set and the resource is no longer
+// requeued, instead of being reconciled over and over while it is
+// permanently broken. Resources being deleted are not affected.
+//
+// Example:
+//
+// resources:
+//
+//	Stack:
+//	  terminal_states:
+//	    path: Status.StackStatus
+//	    in:
+//	      - CREATE_FAILED
+//	      - ROLLBACK_COMPLETE
+type TerminalStatesConfig struct {
+	// Path is the field path of the resource's state field, e.g.
+	// Status.StackStatus. The field must be a string field.
+	Path string `json:"path"`
+	// In is the list of terminal values of the state field
+	In []string `json:"in"`
+}
+
+// WaitersConfig instructs the code generator to call the aws-sdk-go waiters
+// of the API after the operations mutating a resource, so that a resource
+// reaching a stable state shortly after is not requeued. The waiters, named
+// as in the waiters-2.json file of the API model, must poll the resource's
+// ReadOne operation and are called with its Input shape. Not reaching the
+// expected state before the waiter's timeout is not an error: the synced
+// condition of the resource carries the rest of the wait, and a deletion
+// under way is left to complete.
+//
+// Example:
+//
+// resources:
+//
+//	Table:
+//	  waiters:
+//	    create:
+//	      name: TableExists
+//	      timeout_seconds: 600
+//	    delete:
+//	      name: TableNotExists
+type WaitersConfig struct {
+	// Create is the waiter called after the resource is created
+	Create *WaiterConfig `json:"create,omitempty"`
+	// Update is the waiter called after the resource is updated
+	Update *WaiterConfig `json:"update,omitempty"`
+	// Delete is the waiter called after the resource is deleted
+	Delete *WaiterConfig `json:"delete,omitempty"`
+}
+
+// WaiterConfig describes a call to an aws-sdk-go waiter
+type WaiterConfig struct {
+	// Name is the name of the waiter, e.g. TableExists for the
+	// WaitUntilTableExists waiter
+	Name string `json:"name"`
+	// TimeoutSeconds is the maximum number of seconds to wait for, during
+	// which the reconciliation of the resource is blocked. Defaults to 30.
+	TimeoutSeconds int `json:"timeout_seconds,omitempty"`
+}
+
 // SyncedCondition represent one of the unique condition that should be fulfilled in
 // order to assert whether a resource is synced.
+//
+// A condition either matches the value of the field at `Path`, using exactly
+// one of `In`, `NotIn` or `Empty`, or combines other conditions using exactly
+// one of `AllOf`, `AnyOf` or `Not`.
 type SyncedCondition struct {
 	// Path of the field. e.g Status.Processing
 	Path *string `json:"path"`
 	// In contains a list of possible values `Path` should be equal to.
 	In []string `json:"in"`
+	// NotIn contains a list of values `Path` should not be equal to. A field
+	// that is not set is not equal to any of those values.
+	NotIn []string `json:"not_in,omitempty"`
+	// Empty indicates whether the field at `Path` should be empty, i.e. not
+	// set or, for list and map fields, without any element.
+	Empty *bool `json:"empty,omitempty"`
+	// AllOf contains a list of conditions that should all be satisfied.
+	AllOf []SyncedCondition `json:"all_of,omitempty"`
+	// AnyOf contains a list of conditions of which at least one should be
+	// satisfied.
+	AnyOf []SyncedCondition `json:"any_of,omitempty"`
+	// Not contains a condition that should not be satisfied.
+	Not *SyncedCondition `json:"not,omitempty"`
 }
 
 // HooksConfig instructs the code generator how to inject custom callback hooks
@@ -189,9 +1350,51 @@ type HooksConfig struct {
 
 // CompareConfig informs instruct the code generator on how to compare two different
 // two objects of the same type
+//
+// Example:
+//
+// resources:
+//
+//	Function:
+//	  compare:
+//	    ignore:
+//	      - Spec.Description
+//	    ignore_prefixes:
+//	      - Spec.Code
+//	    ignore_when:
+//	      - path: Spec.Handler
+//	        when:
+//	          path: Spec.PackageType
+//	          in:
+//	            - Image
+//
+// Differences in ignored fields, or in fields at or below one of the ignored
+// prefixes, are never compared. Prefixes match whole path segments:
+// "Spec.Code" matches "Spec.Code" and "Spec.Code.S3Bucket" but not
+// "Spec.CodeSigningConfigARN". Differences at or below the
+// `ignore_when` paths are discarded when the condition is satisfied by the
+// desired resource.
 type CompareConfig struct {
 	// Ignore is a list of field paths to ignore when comparing two objects
 	Ignore []string `json:"ignore"`
+	// IgnorePrefixes is a list of field paths, e.g. "Spec.Code", of the
+	// fields to ignore, along with the fields below them, when comparing two
+	// objects
+	IgnorePrefixes []string `json:"ignore_prefixes,omitempty"`
+	// IgnoreWhen is a list of field paths to ignore when comparing two
+	// objects, each only when its condition is satisfied
+	IgnoreWhen []CompareIgnoreWhenConfig `json:"ignore_when,omitempty"`
+}
+
+// CompareIgnoreWhenConfig instructs the code generator to ignore the
+// differences in a field when a condition, e.g. on the value of another
+// field, is satisfied by the desired resource.
+type CompareIgnoreWhenConfig struct {
+	// Path of the field whose differences are ignored, e.g. Spec.Handler
+	Path string `json:"path"`
+	// When is the condition, using the same syntax as the `synced` resource
+	// conditions, that must be satisfied for the differences to be ignored
+	When SyncedCondition `json:"when"`
 }
 
 // UnpackAttributesMapConfig informs the code generator that the API follows a
@@ -236,6 +1439,14 @@ type UnpackAttributesMapConfig struct {
 	// SetPlatformApplicationAttributes API call which accepts multiple
 	// attributes and replaces the supplied attributes map key/values...
 	SetAttributesSingleAttribute bool `json:"set_attributes_single_attribute"`
+	// SetChangedAttributesOnly instructs the code generator to only send, in
+	// the SetAttributes calls of sdkUpdate, the attributes whose fields
+	// changed, instead of every attribute. No call is made when no attribute
+	// changed. When combined with SetAttributesSingleAttribute, sdkUpdate is
+	// generated too, calling SetAttributes once for each changed attribute,
+	// instead of requiring a custom update implementation. Which attributes
+	// are sent can be refined per field with `attribute_update`.
+	SetChangedAttributesOnly bool `json:"set_changed_attributes_only,omitempty"`
 	// GetAttributesInput instructs the code generator how to handle the
 	// GetAttributes input shape
 	GetAttributesInput *GetAttributesInputConfig `json:"get_attributes_input,omitempty"`
@@ -273,6 +1484,78 @@ type ExceptionsConfig struct {
 	Errors map[int]ErrorConfig `json:"errors"`
 	// Set of aws exception codes that are terminal exceptions for this resource
 	TerminalCodes []string `json:"terminal_codes"`
+	// TerminalMessages is a list of matchers on the code and message of the
+	// aws exceptions that are terminal exceptions for this resource. Useful
+	// for APIs returning the same exception code, e.g. ValidationException,
+	// for both terminal and retryable errors.
+	TerminalMessages []TerminalMessageConfig `json:"terminal_messages,omitempty"`
+	// ErrorConditions is a map, keyed by aws exception code, of the reason
+	// and message of the Terminal or Recoverable condition set when the
+	// resource manager fails with that exception, so users see actionable
+	// errors rather than raw API errors.
+	ErrorConditions map[string]ErrorConditionConfig `json:"error_conditions,omitempty"`
+	// DeleteNotFoundCodes is the list of aws exception codes returned by the
+	// Delete operation indicating that the resource is already gone. The
+	// deletion then succeeds.
+	DeleteNotFoundCodes []string `json:"delete_not_found_codes,omitempty"`
+	// DeleteInProgressCodes is the list of aws exception codes returned by
+	// the Delete operation indicating that the resource is already being
+	// deleted. The deletion is then requeued, without error, until the
+	// resource is gone.
+	DeleteInProgressCodes []string `json:"delete_in_progress_codes,omitempty"`
+}
+
+// ErrorConditionConfig contains the reason and message of the condition set
+// for an aws exception.
+//
+// Example:
+//
+// resources:
+//
+//	Repository:
+//	  exceptions:
+//	    error_conditions:
+//	      LimitExceededException:
+//	        reason: RepositoryQuotaExceeded
+//	        message: "The repository quota of the account is reached, request a quota increase"
+//
+// The condition's message is the configured message followed by the
+// exception's own message.
+type ErrorConditionConfig struct {
+	// Reason is the reason of the condition, in CamelCase
+	Reason string `json:"reason"`
+	// Message is the human-friendly message of the condition. Defaults to the
+	// exception's message only.
+	Message string `json:"message,omitempty"`
+}
+
+// TerminalMessageConfig matches terminal exceptions on their message, in
+// addition to their code. Exactly one of MessageContains and MessageRegex
+// must be set.
+//
+// Example:
+//
+// resources:
+//
+//	Model:
+//	  exceptions:
+//	    terminal_messages:
+//	      - code: ValidationException
+//	        message_contains: "is not authorized to perform"
+//	      - code: ValidationException
+//	        message_regex: "^Could not access model data at .*"
+type TerminalMessageConfig struct {
+	// Code is the aws exception code to match. Exceptions with any code are
+	// matched when it is empty.
+	// In Go SDK terms - awsErr.Code()
+	Code string `json:"code,omitempty"`
+	// MessageContains is a substring of the exception message to match.
+	// In Go SDK terms - awsErr.Message()
+	MessageContains *string `json:"message_contains,omitempty"`
+	// MessageRegex is a regular expression, in the syntax of Go's regexp
+	// package, the exception message must match.
+	// In Go SDK terms - awsErr.Message()
+	MessageRegex *string `json:"message_regex,omitempty"`
 }
 
 // ErrorConfig contains instructions to the code generator about the exception
@@ -321,10 +1604,104 @@ type OperationRenamesConfig struct {
 // ListOperationConfig contains instructions for the code generator to handle
 // List operations for service APIs that have no built-in filtering ability and
 // whose List Operation always returns all objects.
+//
+// When multiple match fields or any predicates are configured, an element of
+// the List Operation's Output is only considered to describe the resource if
+// all of its match fields equal the ones of the resource and all the
+// predicates hold. These conditions are checked before any value of the
+// element is copied to the resource, allowing resources identified by a tuple
+// of fields to be located reliably.
+//
+// Example:
+// ```
+// Record:
+//
+//	list_operation:
+//	  match_fields:
+//	    - Name
+//	    - Type
+//	  predicates:
+//	    - path: Status
+//	      not_in:
+//	        - DELETING
+//
+// ```
 type ListOperationConfig struct {
 	// MatchFields lists the names of fields in the Shape of the
 	// list element in the List Operation's Output shape.
 	MatchFields []string `json:"match_fields"`
+	// Predicates lists conditions on the string members of the list element
+	// that must all hold for the element to describe the resource.
+	Predicates []ListPredicateConfig `json:"predicates,omitempty"`
+	// PageSize overrides the maximum number of results returned by each call
+	// to the List Operation. It is set on the page size member of the Input
+	// shape, e.g. MaxResults or MaxRecords.
+	PageSize int `json:"page_size,omitempty"`
+	// Filters maps fields of the resource to the names of the filters of
+	// List Operations accepting a `Filters` list in their Input shape, so the
+	// results are filtered by the AWS service.
+	//
+	// Example:
+	// ```
+	// SecurityGroup:
+	//
+	//	list_operation:
+	//	  filters:
+	//	    - name: group-name
+	//	      field: Name
+	//	    - name: vpc-id
+	//	      field: VpcId
+	//
+	// ```
+	Filters []ListFilterConfig `json:"filters,omitempty"`
+	// MatchTag instructs the code generator to locate the resource in the
+	// List Operation's Output by an identity tag, for resources with
+	// server-generated names and no identifier usable by a ReadOne
+	// Operation. The controller stamps the identity tag, holding the
+	// namespace and name of the custom resource, on the AWS resource each
+	// time it ensures its tags, and the resource is read with the List
+	// Operation, even if it has a ReadOne Operation.
+	//
+	// Example:
+	// ```
+	// Rule:
+	//
+	//	list_operation:
+	//	  match_tag:
+	//	    key: example.com/namespaced-name
+	//
+	// ```
+	MatchTag *ListMatchTagConfig `json:"match_tag,omitempty"`
+}
+
+// ListMatchTagConfig describes the identity tag used to locate a resource in
+// the List Operation's Output.
+type ListMatchTagConfig struct {
+	// Key is the key of the identity tag. Defaults to
+	// "services.k8s.aws/namespaced-name".
+	Key string `json:"key,omitempty"`
+}
+
+// ListFilterConfig maps a field of the resource to the name of a filter of
+// the List Operation. The filter is only added when the field is set.
+type ListFilterConfig struct {
+	// Name is the name of the filter, e.g. "vpc-id"
+	Name string `json:"name"`
+	// Field is the name of the top-level Spec or Status field, of type
+	// string or list of strings, holding the values of the filter
+	Field string `json:"field"`
+}
+
+// ListPredicateConfig is a condition on the value of a string member of the
+// list element in the List Operation's Output shape. Exactly one of In and
+// NotIn must be set.
+type ListPredicateConfig struct {
+	// Path is the name of the member of the list element
+	Path string `json:"path"`
+	// In lists the values the member must have
+	In []string `json:"in,omitempty"`
+	// NotIn lists the values the member must not have
+	NotIn []string `json:"not_in,omitempty"`
 }
 
 // UpdateOperationConfig contains instructions for the code generator to handle
@@ -369,6 +1746,8 @@ type AdditionalColumnConfig struct {
 	// Type is the OpenAPI type of the output.
 	// c.f., https://github.com/OAI/OpenAPI-Specification/blob/master/versions/2.0.md#data-types
 	Type string `json:"type"`
+	// Format is the OpenAPI format of the output, e.g. "date-time".
+	Format string `json:"format,omitempty"`
 	// Priority of the column in the resource's output.
 	Priority int `json:"priority,omitempty"`
 	// Index is the zero-based index of the position at which to display the column in output.
@@ -384,7 +1763,9 @@ type PrintConfig struct {
 	//
 	// NOTE: this is the Kubernetes resource Age (creation time at the api-server/etcd)
 	// and not the AWS resource Age.
-	AddAgeColumn bool `json:"add_age_column"`
+	//
+	// Defaults to the value of AddDefaultColumns.
+	AddAgeColumn *bool `json:"add_age_column"`
 	// AddSyncedColumn is used to append a kubebuilder marker comment to show the status of a
 	// resource in `kubectl get` response.
 	//
@@ -392,6 +1773,31 @@ type PrintConfig struct {
 	AddSyncedColumn *bool `json:"add_synced_column"`
 	// OrderBy is the field used to sort the list of PrinterColumn options.
 	OrderBy string `json:"order_by"`
+	// AddDefaultColumns informs the code generator to include the standard
+	// columns of an ACK resource in `kubectl get` response, without listing
+	// each of them: the resource ARN (in wide view), its state, its Synced
+	// condition and its Age. Each of these columns can be suppressed with its
+	// own toggle, e.g. `add_arn_column: false`.
+	AddDefaultColumns bool `json:"add_default_columns,omitempty"`
+	// AddARNColumn is used to append a kubebuilder marker comment to show the
+	// resource ARN, from '.status.ackResourceMetadata.arn', in the wide view
+	// of `kubectl get` response.
+	//
+	// Defaults to the value of AddDefaultColumns.
+	AddARNColumn *bool `json:"add_arn_column,omitempty"`
+	// AddStateColumn is used to append a kubebuilder marker comment to show
+	// the state of the resource, as reported by the AWS service, in
+	// `kubectl get` response. The state is read from the Status field named
+	// StateFieldName. When StateFieldName is empty and the resource has no
+	// state field, the column is skipped.
+	//
+	// Defaults to the value of AddDefaultColumns.
+	AddStateColumn *bool `json:"add_state_column,omitempty"`
+	// StateFieldName is the name of the Status field holding the state of the
+	// resource. When empty, the first of the "Status", "State",
+	// "{Resource}Status" and "{Resource}State" string Status fields found is
+	// used.
+	StateFieldName string `json:"state_field_name,omitempty"`
 
 	// AdditionalColumns can be used to add arbitrary extra columns to a Resource's output
 	// if present, should be a list of objects, each containing: name, json_path, and type
@@ -411,6 +1817,47 @@ type ReconcileConfig struct {
 	// causing ACK controllers to refresh the status views of all watched resources, but this
 	// behaviour is expensive and may be turned off in future ACK runtime options.
 	RequeueOnSuccessSeconds int `json:"requeue_on_success_seconds,omitempty"`
+	// RequeueOnSuccessByState configures different requeue delays depending
+	// on the value of the resource's state field returned by its Create or
+	// Update operation, e.g. a short delay while the resource is being
+	// created or updated. Resources in a state without a configured delay,
+	// and resources that were neither created nor updated, are requeued
+	// according to RequeueOnSuccessSeconds.
+	RequeueOnSuccessByState *RequeueOnSuccessByStateConfig `json:"requeue_on_success_by_state,omitempty"`
+	// ResyncSeconds is the default number of seconds after which the
+	// controller resyncs the desired state of the resource. It is used
+	// unless the controller is started with a
+	// `--reconcile-resource-resync-seconds` flag for the resource.
+	ResyncSeconds int `json:"resync_seconds,omitempty"`
+	// MaxConcurrentSyncs is the default maximum number of resources of this
+	// kind the controller reconciles concurrently, allowing heavyweight
+	// resources to be reconciled more conservatively than light ones. It is
+	// used unless the controller is started with a
+	// `--reconcile-resource-max-concurrent-syncs` flag for the resource.
+	MaxConcurrentSyncs int `json:"max_concurrent_syncs,omitempty"`
+}
+
+// RequeueOnSuccessByStateConfig describes the requeue delays of a resource
+// keyed by the value of its state field.
+//
+// Example:
+//
+// resources:
+//
+//	Endpoint:
+//	  reconcile:
+//	    requeue_on_success_by_state:
+//	      path: Status.EndpointStatus
+//	      seconds:
+//	        Creating: 15
+//	        Updating: 30
+type RequeueOnSuccessByStateConfig struct {
+	// Path is the field path of the resource's state field, e.g.
+	// Status.EndpointStatus. The field must be a string field.
+	Path string `json:"path"`
+	// Seconds is a map, keyed by value of the state field, of the number of
+	// seconds after which to requeue the resource
+	Seconds map[string]int `json:"seconds"`
 }
 
 // ResourceIsIgnored returns true if resource name is configured to be ignored
@@ -474,14 +1921,17 @@ func (c *Config) ResourceDisplaysAgeColumn(resourceName string) bool {
 		return false
 	}
 	if rConfig.Print != nil {
-		return rConfig.Print.AddAgeColumn
+		if rConfig.Print.AddAgeColumn != nil {
+			return *rConfig.Print.AddAgeColumn
+		}
+		return rConfig.Print.AddDefaultColumns
 	}
 	return false
 }
 
-// ResourceDisplaysSyncedColumn returns true if the resource is
-// configured to display the synced status.
-func (c *Config) ResourceDisplaysSyncedColumn(resourceName string) bool {
+// ResourceDisplaysARNColumn returns true if the resource is configured to
+// display its ARN.
+func (c *Config) ResourceDisplaysARNColumn(resourceName string) bool {
 	if c == nil {
 		return false
 	}
@@ -490,29 +1940,80 @@ func (c *Config) ResourceDisplaysSyncedColumn(resourceName string) bool {
 		return false
 	}
 	if rConfig.Print != nil {
-		// default value should be true.
-		return rConfig.Print.AddSyncedColumn == nil || *rConfig.Print.AddSyncedColumn
+		if rConfig.Print.AddARNColumn != nil {
+			return *rConfig.Print.AddARNColumn
+		}
+		return rConfig.Print.AddDefaultColumns
 	}
 	return false
 }
 
-// ResourceSetsSingleAttribute returns true if the supplied resource name has
-// a SetAttributes operation that only actually changes a single attribute at a
-// time. See: SNS SetTopicAttributes API call, which is entirely different from
-// the SNS SetPlatformApplicationAttributes API call, which sets multiple
-// attributes at once. :shrug:
-func (c *Config) ResourceSetsSingleAttribute(resourceName string) bool {
+// ResourceDisplaysStateColumn returns true if the resource is configured to
+// display its state.
+func (c *Config) ResourceDisplaysStateColumn(resourceName string) bool {
 	if c == nil {
 		return false
 	}
-	resGenConfig, found := c.Resources[resourceName]
-	if !found || resGenConfig.UnpackAttributesMapConfig == nil {
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
 		return false
 	}
-	return resGenConfig.UnpackAttributesMapConfig.SetAttributesSingleAttribute
-}
-
-// GetResourceConfig returns the ResourceConfig for a given resource name,
+	if rConfig.Print != nil {
+		if rConfig.Print.AddStateColumn != nil {
+			return *rConfig.Print.AddStateColumn
+		}
+		return rConfig.Print.AddDefaultColumns
+	}
+	return false
+}
+
+// ResourceDisplaysSyncedColumn returns true if the resource is
+// configured to display the synced status.
+func (c *Config) ResourceDisplaysSyncedColumn(resourceName string) bool {
+	if c == nil {
+		return false
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return false
+	}
+	if rConfig.Print != nil {
+		// default value should be true.
+		return rConfig.Print.AddSyncedColumn == nil || *rConfig.Print.AddSyncedColumn
+	}
+	return false
+}
+
+// ResourceSetsSingleAttribute returns true if the supplied resource name has
+// a SetAttributes operation that only actually changes a single attribute at a
+// time. See: SNS SetTopicAttributes API call, which is entirely different from
+// the SNS SetPlatformApplicationAttributes API call, which sets multiple
+// attributes at once. :shrug:
+func (c *Config) ResourceSetsSingleAttribute(resourceName string) bool {
+	if c == nil {
+		return false
+	}
+	resGenConfig, found := c.Resources[resourceName]
+	if !found || resGenConfig.UnpackAttributesMapConfig == nil {
+		return false
+	}
+	return resGenConfig.UnpackAttributesMapConfig.SetAttributesSingleAttribute
+}
+
+// ResourceSetsChangedAttributesOnly returns true if the supplied resource name
+// only sends the attributes that changed in its SetAttributes calls.
+func (c *Config) ResourceSetsChangedAttributesOnly(resourceName string) bool {
+	if c == nil {
+		return false
+	}
+	resGenConfig, found := c.Resources[resourceName]
+	if !found || resGenConfig.UnpackAttributesMapConfig == nil {
+		return false
+	}
+	return resGenConfig.UnpackAttributesMapConfig.SetChangedAttributesOnly
+}
+
+// GetResourceConfig returns the ResourceConfig for a given resource name,
 // searching case-insensitively.
 func (c *Config) GetResourceConfig(resourceName string) *ResourceConfig {
 	if c == nil {
@@ -543,6 +2044,32 @@ func (c *Config) GetCompareIgnoredFieldPaths(resourceName string) []string {
 	return rConfig.Compare.Ignore
 }
 
+// GetCompareIgnoredFieldPathPrefixes returns the list of field path prefixes
+// of the fields to ignore when comparing two different objects
+func (c *Config) GetCompareIgnoredFieldPathPrefixes(resourceName string) []string {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok || rConfig.Compare == nil {
+		return nil
+	}
+	return rConfig.Compare.IgnorePrefixes
+}
+
+// GetCompareIgnoreWhen returns the list of field paths to ignore, each when
+// its condition is satisfied, when comparing two different objects
+func (c *Config) GetCompareIgnoreWhen(resourceName string) []CompareIgnoreWhenConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok || rConfig.Compare == nil {
+		return nil
+	}
+	return rConfig.Compare.IgnoreWhen
+}
+
 // GetResourceFieldName returns a resource field name
 // after applying rename overrides, if configured
 func (c *Config) GetResourceFieldName(
@@ -622,6 +2149,31 @@ func (c *Config) GetResourceShortNames(resourceName string) []string {
 	return rConfig.ShortNames
 }
 
+// GetResourceCategories returns the CRD list of categories
+func (c *Config) GetResourceCategories(resourceName string) []string {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return nil
+	}
+	return rConfig.Categories
+}
+
+// GetResourceMarkers returns the additional kubebuilder markers of the CRD's
+// root type
+func (c *Config) GetResourceMarkers(resourceName string) []string {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return nil
+	}
+	return rConfig.Markers
+}
+
 // GetResourcePrintOrderByName returns the Printer Column order-by field name
 func (c *Config) GetResourcePrintOrderByName(resourceName string) string {
 	if c == nil {
@@ -668,6 +2220,46 @@ func (c *Config) GetReconcileRequeueOnSuccessSeconds(resourceName string) int {
 	return 0
 }
 
+// GetReconcileResyncSeconds returns the default resync period, in seconds,
+// configured for the supplied resource name, or 0 if none is configured.
+func (c *Config) GetReconcileResyncSeconds(resourceName string) int {
+	if c == nil {
+		return 0
+	}
+	resGenConfig, found := c.Resources[resourceName]
+	if !found || resGenConfig.Reconcile == nil {
+		return 0
+	}
+	return resGenConfig.Reconcile.ResyncSeconds
+}
+
+// GetReconcileMaxConcurrentSyncs returns the default maximum number of
+// concurrent reconciles configured for the supplied resource name, or 0 if
+// none is configured.
+func (c *Config) GetReconcileMaxConcurrentSyncs(resourceName string) int {
+	if c == nil {
+		return 0
+	}
+	resGenConfig, found := c.Resources[resourceName]
+	if !found || resGenConfig.Reconcile == nil {
+		return 0
+	}
+	return resGenConfig.Reconcile.MaxConcurrentSyncs
+}
+
+// GetReconcileRequeueOnSuccessByState returns the requeue delays keyed by
+// state configured for the supplied resource name, if any.
+func (c *Config) GetReconcileRequeueOnSuccessByState(resourceName string) *RequeueOnSuccessByStateConfig {
+	if c == nil {
+		return nil
+	}
+	resGenConfig, found := c.Resources[resourceName]
+	if !found || resGenConfig.Reconcile == nil {
+		return nil
+	}
+	return resGenConfig.Reconcile.RequeueOnSuccessByState
+}
+
 // GetCustomUpdateMethodName returns the name of the custom resourceManager method
 // for updating the resource state, if any has been specified in the generator
 // config
@@ -758,6 +2350,60 @@ func (c *Config) GetTerminalExceptionCodes(resourceName string) []string {
 	return nil
 }
 
+// GetTerminalExceptionMessages returns the matchers of the terminal exception
+// messages for custom resource, if specified in generator config
+func (c *Config) GetTerminalExceptionMessages(resourceName string) []TerminalMessageConfig {
+	if c == nil {
+		return nil
+	}
+	resGenConfig, found := c.Resources[resourceName]
+	if found && resGenConfig.Exceptions != nil {
+		return resGenConfig.Exceptions.TerminalMessages
+	}
+	return nil
+}
+
+// GetErrorConditions returns the condition reasons and messages, keyed by aws
+// exception code, for custom resource, if specified in generator config
+func (c *Config) GetErrorConditions(resourceName string) map[string]ErrorConditionConfig {
+	if c == nil {
+		return nil
+	}
+	resGenConfig, found := c.Resources[resourceName]
+	if found && resGenConfig.Exceptions != nil {
+		return resGenConfig.Exceptions.ErrorConditions
+	}
+	return nil
+}
+
+// GetDeleteNotFoundCodes returns the exception codes returned by the Delete
+// operation of custom resource when it is already gone, if specified in
+// generator config
+func (c *Config) GetDeleteNotFoundCodes(resourceName string) []string {
+	if c == nil {
+		return nil
+	}
+	resGenConfig, found := c.Resources[resourceName]
+	if found && resGenConfig.Exceptions != nil {
+		return resGenConfig.Exceptions.DeleteNotFoundCodes
+	}
+	return nil
+}
+
+// GetDeleteInProgressCodes returns the exception codes returned by the Delete
+// operation of custom resource when it is already being deleted, if specified
+// in generator config
+func (c *Config) GetDeleteInProgressCodes(resourceName string) []string {
+	if c == nil {
+		return nil
+	}
+	resGenConfig, found := c.Resources[resourceName]
+	if found && resGenConfig.Exceptions != nil {
+		return resGenConfig.Exceptions.DeleteInProgressCodes
+	}
+	return nil
+}
+
 // GetListOpMatchFieldNames returns a slice of strings representing the field
 // names in the List operation's Output shape's element Shape that we should
 // check a corresponding value in the target Spec exists.
@@ -778,6 +2424,471 @@ func (c *Config) GetListOpMatchFieldNames(
 	return rConfig.ListOperation.MatchFields
 }
 
+// GetListOpPageSize returns the maximum number of results returned by each
+// call to the List operation, or 0 if the service default applies.
+func (c *Config) GetListOpPageSize(resName string) int {
+	if c == nil {
+		return 0
+	}
+	rConfig, found := c.Resources[resName]
+	if !found || rConfig.ListOperation == nil {
+		return 0
+	}
+	return rConfig.ListOperation.PageSize
+}
+
+// GetListOpFilters returns the mappings of the resource's fields to the
+// names of the filters of the List operation.
+func (c *Config) GetListOpFilters(resName string) []ListFilterConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resName]
+	if !found || rConfig.ListOperation == nil {
+		return nil
+	}
+	return rConfig.ListOperation.Filters
+}
+
+// GetListOpPredicates returns the conditions on the List operation's Output
+// shape's element Shape that must hold for an element to describe the
+// resource.
+func (c *Config) GetListOpPredicates(resName string) []ListPredicateConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resName]
+	if !found || rConfig.ListOperation == nil {
+		return nil
+	}
+	return rConfig.ListOperation.Predicates
+}
+
+// GetListOpMatchTag returns the identity tag used to locate the resource in
+// the List operation's Output shape, if any.
+func (c *Config) GetListOpMatchTag(resName string) *ListMatchTagConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resName]
+	if !found || rConfig.ListOperation == nil {
+		return nil
+	}
+	return rConfig.ListOperation.MatchTag
+}
+
+// GetPreDeleteConfig returns the pre-delete configuration for the supplied
+// resource name, if any.
+func (c *Config) GetPreDeleteConfig(resourceName string) *PreDeleteConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.PreDelete
+}
+
+// GetForceDeleteConfig returns the force-delete configuration for the
+// supplied resource name, if any.
+func (c *Config) GetForceDeleteConfig(resourceName string) *ForceDeleteConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.ForceDelete
+}
+
+// GetAuxiliaryResources returns the auxiliary resources configured for the
+// supplied resource name, if any.
+func (c *Config) GetAuxiliaryResources(resourceName string) []*AuxiliaryResourceConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.AuxiliaryResources
+}
+
+// GetAttachments returns the attachments configured for the supplied
+// resource name, if any.
+func (c *Config) GetAttachments(resourceName string) []*AttachmentConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.Attachments
+}
+
+// GetCollections returns the collections configured for the supplied
+// resource name, if any.
+func (c *Config) GetCollections(resourceName string) []*CollectionConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.Collections
+}
+
+// GetMiddleware returns the middleware configured for the supplied resource
+// name, if any.
+func (c *Config) GetMiddleware(resourceName string) []*MiddlewareConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.Middleware
+}
+
+// GetConditionsConfig returns the conditions management configured for the
+// supplied resource name, if any.
+func (c *Config) GetConditionsConfig(resourceName string) *ConditionsConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.Conditions
+}
+
+// GetAdoptionRetryConfig returns the adoption retry behavior configured for
+// the supplied resource name, if any.
+func (c *Config) GetAdoptionRetryConfig(resourceName string) *AdoptionRetryConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.AdoptionRetry
+}
+
+// GetPrimaryKeySeparator returns the separator of the values of the primary
+// key fields in the composite identifier of the supplied resource name, or
+// an empty string if none is configured.
+func (c *Config) GetPrimaryKeySeparator(resourceName string) string {
+	if c == nil {
+		return ""
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return ""
+	}
+	return rConfig.PrimaryKeySeparator
+}
+
+// GetTerminalStatesConfig returns the terminal states configured for the
+// supplied resource name, if any.
+func (c *Config) GetTerminalStatesConfig(resourceName string) *TerminalStatesConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.TerminalStates
+}
+
+// GetWaitersConfig returns the waiters configured for the supplied resource
+// name, if any.
+func (c *Config) GetWaitersConfig(resourceName string) *WaitersConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.Waiters
+}
+
+// GetCreateGracePeriodConfig returns the post-create grace period configured
+// for the supplied resource name, if any.
+func (c *Config) GetCreateGracePeriodConfig(resourceName string) *CreateGracePeriodConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.CreateGracePeriod
+}
+
+// GetOwnershipConfig returns the ownership tagging behavior configured for
+// the supplied resource name, if any.
+func (c *Config) GetOwnershipConfig(resourceName string) *OwnershipConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.Ownership
+}
+
+// GetReferenceWaitConfig returns the behavior configured for the supplied
+// resource name when a referenced resource is not synced yet, if any.
+func (c *Config) GetReferenceWaitConfig(resourceName string) *ReferenceWaitConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.ReferenceWait
+}
+
+// GetLoggingConfig returns the SDK payload logging behavior configured for
+// the supplied resource name, if any.
+func (c *Config) GetLoggingConfig(resourceName string) *LoggingConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.Logging
+}
+
+// GetSingletonConfig returns the singleton behavior configured for the
+// supplied resource name, if any.
+func (c *Config) GetSingletonConfig(resourceName string) *SingletonConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.Singleton
+}
+
+// GetARNLessConfig returns the ARN-less configuration of the supplied
+// resource name, if any.
+func (c *Config) GetARNLessConfig(resourceName string) *ARNLessConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.ARNLess
+}
+
+// GetScaleConfig returns the scale subresource configured for the supplied
+// resource name, if any.
+func (c *Config) GetScaleConfig(resourceName string) *ScaleConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, found := c.Resources[resourceName]
+	if !found {
+		return nil
+	}
+	return rConfig.Scale
+}
+
+// ResourceIsSingleton returns true if the supplied resource name is
+// configured as a singleton settings resource.
+func (c *Config) ResourceIsSingleton(resourceName string) bool {
+	return c.GetSingletonConfig(resourceName) != nil
+}
+
+// ResourceIsObserveOnly returns true if the supplied resource name is
+// configured as an observe-only resource.
+func (c *Config) ResourceIsObserveOnly(resourceName string) bool {
+	if c == nil {
+		return false
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return false
+	}
+	return rConfig.ObserveOnly
+}
+
+// ResourceIsAdoptionOnly returns true if the supplied resource name is
+// configured as an adoption-only resource.
+func (c *Config) ResourceIsAdoptionOnly(resourceName string) bool {
+	if c == nil {
+		return false
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return false
+	}
+	return rConfig.AdoptionOnly
+}
+
+// ResourceReadsAfterCreate returns true if the supplied resource name is
+// configured to be read right after its creation.
+func (c *Config) ResourceReadsAfterCreate(resourceName string) bool {
+	if c == nil {
+		return false
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return false
+	}
+	return rConfig.ReadAfterCreate
+}
+
+// GetDeletionPolicy returns the default deletion policy of the supplied
+// resource name, or an empty string if none is configured.
+func (c *Config) GetDeletionPolicy(resourceName string) string {
+	if c == nil {
+		return ""
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return ""
+	}
+	return rConfig.DeletionPolicy
+}
+
+// HasRetainedResources returns true if the deletion policy of any resource is
+// "retain" by default, see `deletion_policy`.
+func (c *Config) HasRetainedResources() bool {
+	if c == nil {
+		return false
+	}
+	for _, rConfig := range c.Resources {
+		if rConfig.DeletionPolicy == DeletionPolicyRetain {
+			return true
+		}
+	}
+	return false
+}
+
+// HasConfigMapFields returns true if any field of any resource is a
+// ConfigMapKeyReference, the ConfigMapKeyReference type then being generated
+// into the apis package.
+func (c *Config) HasConfigMapFields() bool {
+	if c == nil {
+		return false
+	}
+	for _, rConfig := range c.Resources {
+		for _, fConfig := range rConfig.Fields {
+			if fConfig != nil && fConfig.IsConfigMap {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// UsesAPIReader returns true if the resource managers read Kubernetes
+// objects, namespaces or ConfigMaps, with the controller manager's API
+// reader.
+func (c *Config) UsesAPIReader() bool {
+	return c.HasRetainedResources() || c.HasConfigMapFields()
+}
+
+// GetUpsertOperation returns the ID of the upsert operation of the supplied
+// resource name, or an empty string if none is configured.
+func (c *Config) GetUpsertOperation(resourceName string) string {
+	if c == nil {
+		return ""
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return ""
+	}
+	return rConfig.UpsertOperation
+}
+
+// GetUpdateOperations returns the additional update operations, in order, of
+// the supplied resource name and the Spec fields each of them updates.
+func (c *Config) GetUpdateOperations(resourceName string) []*FieldsUpdateOperationConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return nil
+	}
+	return rConfig.UpdateOperations
+}
+
+// GetReadOneOperations returns the IDs, in order of precedence, of the
+// additional ReadOne operations of the supplied resource name.
+func (c *Config) GetReadOneOperations(resourceName string) []string {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return nil
+	}
+	return rConfig.ReadOneOperations
+}
+
+// GetResourceScope returns the configured scope of the CRD of the supplied
+// resource name, or an empty string if none is configured.
+func (c *Config) GetResourceScope(resourceName string) string {
+	if c == nil {
+		return ""
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return ""
+	}
+	return rConfig.Scope
+}
+
+// GetParentConfig returns the ParentConfig of the supplied resource name, if
+// it is a child resource.
+func (c *Config) GetParentConfig(resourceName string) *ParentConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return nil
+	}
+	return rConfig.Parent
+}
+
+// IsChildResourceField returns true if the supplied field of the supplied
+// resource name is carved out into a child resource.
+func (c *Config) IsChildResourceField(resourceName string, fieldName string) bool {
+	if c == nil {
+		return false
+	}
+	for _, rConfig := range c.Resources {
+		if rConfig.Parent != nil &&
+			rConfig.Parent.Resource == resourceName &&
+			rConfig.Parent.Field == fieldName {
+			return true
+		}
+	}
+	return false
+}
+
 // TagsAreIgnored returns whether ensuring controller tags should be ignored
 // for a resource or not.
 func (c *Config) TagsAreIgnored(resName string) bool {
@@ -789,6 +2900,89 @@ func (c *Config) TagsAreIgnored(resName string) bool {
 	return false
 }
 
+// GetTagRepresentation returns the configured representation of the tags in
+// the tag field of the supplied resource, see `tags.representation`, or an
+// empty string if the tag field has the representation of the API.
+func (c *Config) GetTagRepresentation(resName string) string {
+	if c == nil {
+		return ""
+	}
+	if rConfig, found := c.Resources[resName]; found {
+		if tagConfig := rConfig.TagConfig; tagConfig != nil {
+			return tagConfig.Representation
+		}
+	}
+	return ""
+}
+
+// GetTagStructName returns the name of the structure shape of a tag used by
+// the `list` representation of the tags of the supplied resource, see
+// `tags.struct_name`. Defaults to Tag.
+func (c *Config) GetTagStructName(resName string) string {
+	if c != nil {
+		if rConfig, found := c.Resources[resName]; found {
+			if tagConfig := rConfig.TagConfig; tagConfig != nil && tagConfig.StructName != "" {
+				return tagConfig.StructName
+			}
+		}
+	}
+	return "Tag"
+}
+
+// GetDefaultTags returns the tags, keyed by tag key, added to the tags of the
+// supplied resource, see `default_tags`, or nil if there are none or the
+// tags of the resource are ignored.
+func (c *Config) GetDefaultTags(resName string) map[string]string {
+	if c == nil || c.TagsAreIgnored(resName) {
+		return nil
+	}
+	var resourceDefaultTags map[string]string
+	if rConfig, found := c.Resources[resName]; found && rConfig.TagConfig != nil {
+		resourceDefaultTags = rConfig.TagConfig.DefaultTags
+	}
+	if len(c.DefaultTags) == 0 && len(resourceDefaultTags) == 0 {
+		return nil
+	}
+	res := make(map[string]string, len(c.DefaultTags)+len(resourceDefaultTags))
+	for k, v := range c.DefaultTags {
+		res[k] = v
+	}
+	for k, v := range resourceDefaultTags {
+		res[k] = v
+	}
+	return res
+}
+
+// GetTagIgnoreKeyPrefixes returns the prefixes of the keys of the tags
+// ignored when comparing and updating the tags of the supplied resource, see
+// `tags.ignore_key_prefixes`.
+func (c *Config) GetTagIgnoreKeyPrefixes(resName string) []string {
+	if c == nil {
+		return nil
+	}
+	if rConfig, found := c.Resources[resName]; found {
+		if tagConfig := rConfig.TagConfig; tagConfig != nil && !tagConfig.Ignore {
+			return tagConfig.IgnoreKeyPrefixes
+		}
+	}
+	return nil
+}
+
+// GetTagSyncConfig returns the configuration of the code updating the tags of
+// the supplied resource with the tagging operations of the service, or nil
+// if the generated code does not update its tags.
+func (c *Config) GetTagSyncConfig(resName string) *TagSyncConfig {
+	if c == nil {
+		return nil
+	}
+	if rConfig, found := c.Resources[resName]; found {
+		if tagConfig := rConfig.TagConfig; tagConfig != nil && !tagConfig.Ignore {
+			return tagConfig.Sync
+		}
+	}
+	return nil
+}
+
 // GetFieldConfig accepts a string name of a field and returns the FieldConfig
 // object inside the ResourceConfig matching (case-insensitively) the supplied
 // field name, or nil if that field has no FieldConfig.
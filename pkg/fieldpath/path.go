@@ -15,6 +15,8 @@ package fieldpath
 
 import (
 	"encoding/json"
+	"regexp"
+	"strconv"
 	"strings"
 
 	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
@@ -308,3 +310,33 @@ func (p *Path) HasPrefixFold(subject string) bool {
 func FromString(dotted string) *Path {
 	return &Path{strings.Split(dotted, ".")}
 }
+
+// indexedPartRegex matches a path part selecting an element of a list, e.g.
+// "Reservations[0]"
+var indexedPartRegex = regexp.MustCompile(`^(.+)\[(\d+)\]$`)
+
+// SplitIndex returns the name of the supplied path part and the index of the
+// list element it selects, e.g. "Reservations" and 0 for "Reservations[0]".
+// The index is -1 if the part does not select a list element.
+func SplitIndex(part string) (string, int) {
+	matches := indexedPartRegex.FindStringSubmatch(part)
+	if matches == nil {
+		return part, -1
+	}
+	index, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return part, -1
+	}
+	return matches[1], index
+}
+
+// StripIndices returns the supplied dotted-notation path without the list
+// element indices of its parts, e.g. "Reservations.Instances" for
+// "Reservations[0].Instances[0]".
+func StripIndices(dotted string) string {
+	parts := strings.Split(dotted, ".")
+	for i, part := range parts {
+		parts[i], _ = SplitIndex(part)
+	}
+	return strings.Join(parts, ".")
+}
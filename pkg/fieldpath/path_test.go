@@ -234,3 +234,26 @@ func TestShapeRef(t *testing.T) {
 	require.Equal("WeirdlycasEdType", ref.ShapeName)
 	require.Equal("string", ref.Shape.Type)
 }
+
+func TestIndices(t *testing.T) {
+	require := require.New(t)
+
+	name, index := fieldpath.SplitIndex("Reservations[0]")
+	require.Equal("Reservations", name)
+	require.Equal(0, index)
+
+	name, index = fieldpath.SplitIndex("Instances[12]")
+	require.Equal("Instances", name)
+	require.Equal(12, index)
+
+	name, index = fieldpath.SplitIndex("Reservations")
+	require.Equal("Reservations", name)
+	require.Equal(-1, index)
+
+	name, index = fieldpath.SplitIndex("Reservations[first]")
+	require.Equal("Reservations[first]", name)
+	require.Equal(-1, index)
+
+	require.Equal("Reservations.Instances", fieldpath.StripIndices("Reservations[0].Instances[0]"))
+	require.Equal("Repository", fieldpath.StripIndices("Repository"))
+}
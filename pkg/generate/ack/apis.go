@@ -18,6 +18,7 @@ import (
 	"strings"
 	ttpl "text/template"
 
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
 	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/iancoleman/strcase"
@@ -38,6 +39,9 @@ var (
 	apisCopyPaths = []string{}
 	apisFuncMap   = ttpl.FuncMap{
 		"Join": strings.Join,
+		"GoCodeMigrateSpecFields": func(r *ackmodel.CRD, targetVarName string, sourceVarName string, indentLevel int) string {
+			return code.MigrateSpecFields(r.Config(), r, targetVarName, sourceVarName, indentLevel)
+		},
 	}
 )
 
@@ -72,6 +76,8 @@ func APIs(
 		metaVars,
 		enumDefs,
 		typeDefs,
+		m.GetConfig() != nil && m.GetConfig().TypedEnums,
+		m.GetConfig().HasConfigMapFields(),
 	}
 	for _, path := range apisTemplatePaths {
 		outPath := strings.TrimSuffix(filepath.Base(path), ".tpl")
@@ -100,6 +106,12 @@ type templateAPIVars struct {
 	templateset.MetaVars
 	EnumDefs []*ackmodel.EnumDef
 	TypeDefs []*ackmodel.TypeDef
+	// TypedEnums is true when fields are typed with the Go string alias of
+	// their enum, in which case the valid values of each enum are listed
+	TypedEnums bool
+	// HasConfigMapFields is true when any field is a ConfigMapKeyReference,
+	// the type then being defined in the apis package
+	HasConfigMapFields bool
 }
 
 // templateCRDVars contains template variables for the template that outputs Go
@@ -0,0 +1,92 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ack_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestAPIs_EKS_DefaultPrinterColumns(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-default-columns.yaml",
+	})
+
+	ts, err := ack.APIs(g, []string{"../../../templates"})
+	require.NoError(err)
+	require.NoError(ts.Execute())
+	buf, found := ts.Executed()["fargate_profile.go"]
+	require.True(found)
+
+	columns := []string{}
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if strings.HasPrefix(line, "// +kubebuilder:printcolumn:") {
+			columns = append(columns, line)
+		}
+	}
+	// The ARN and Synced columns are suppressed and the Age column is always
+	// the last one
+	require.Len(columns, 2)
+	assert.Contains(columns[0], `name="Status"`)
+	assert.Contains(columns[1], `name="Age"`)
+}
+
+func TestAPIs_SageMaker_TypedEnums(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sagemaker", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-typed-enums.yaml",
+	})
+
+	ts, err := ack.APIs(g, []string{"../../../templates"})
+	require.NoError(err)
+	require.NoError(ts.Execute())
+	buf, found := ts.Executed()["model_package.go"]
+	require.True(found)
+
+	// Only the Spec fields are validated against the values of their enum
+	got := buf.String()
+	assert.Contains(got, "\n// +kubebuilder:validation:Enum=\"Approved\";\"Rejected\";\"PendingManualApproval\"\nModelApprovalStatus *ModelApprovalStatus `json:\"modelApprovalStatus,omitempty\"`")
+	assert.Contains(got, "\t// +kubebuilder:validation:Optional\n\tModelPackageStatus *ModelPackageStatus_SDK `json:\"modelPackageStatus,omitempty\"`")
+	assert.Equal(1, strings.Count(got, "+kubebuilder:validation:Enum="))
+}
+
+func TestAPIs_IAM_ConfigMapKeyReference(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-configmap.yaml",
+	})
+
+	ts, err := ack.APIs(g, []string{"../../../templates"})
+	require.NoError(err)
+	require.NoError(ts.Execute())
+	types, found := ts.Executed()["types.go"]
+	require.True(found)
+	assert.Contains(types.String(), "type ConfigMapKeyReference struct {")
+	role, found := ts.Executed()["role.go"]
+	require.True(found)
+	assert.Contains(role.String(), "AssumeRolePolicyDocument *ConfigMapKeyReference `json:\"assumeRolePolicyDocument\"`")
+}
@@ -0,0 +1,162 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ack_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+// conditionsStubAPI is the minimal Repository API type the generated
+// conditions.go compiles against
+const conditionsStubAPI = `package v1alpha1
+
+import ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+
+type RepositoryStatus struct {
+	Conditions []*ackv1alpha1.Condition
+}
+
+type Repository struct {
+	Status RepositoryStatus
+}
+`
+
+// conditionsBehaviorTest exercises the generated normalizeConditions,
+// sortConditions and pruneOldestConditions functions, configured with a
+// max_count of 10 and a prune_stale_after_seconds of 86400
+const conditionsBehaviorTest = `package repository
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/ecr-controller/apis/v1alpha1"
+)
+
+func condition(
+	conditionType ackv1alpha1.ConditionType,
+	status corev1.ConditionStatus,
+	age time.Duration,
+) *ackv1alpha1.Condition {
+	transitioned := metav1.NewTime(time.Now().Add(-age))
+	return &ackv1alpha1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		LastTransitionTime: &transitioned,
+	}
+}
+
+func conditionTypes(conditions []*ackv1alpha1.Condition) string {
+	types := []string{}
+	for _, c := range conditions {
+		types = append(types, string(c.Type))
+	}
+	return strings.Join(types, ",")
+}
+
+func TestSortConditions(t *testing.T) {
+	conditions := []*ackv1alpha1.Condition{
+		condition("Custom.B", corev1.ConditionTrue, 0),
+		condition(ackv1alpha1.ConditionTypeResourceSynced, corev1.ConditionTrue, 0),
+		condition("Custom.A", corev1.ConditionTrue, 0),
+		condition(ackv1alpha1.ConditionTypeTerminal, corev1.ConditionTrue, 0),
+		condition(ackv1alpha1.ConditionTypeAdopted, corev1.ConditionTrue, 0),
+	}
+	sortConditions(conditions)
+	want := "ACK.Adopted,ACK.ResourceSynced,ACK.Terminal,Custom.A,Custom.B"
+	if got := conditionTypes(conditions); got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestNormalizeConditions(t *testing.T) {
+	ko := &svcapitypes.Repository{}
+	ko.Status.Conditions = []*ackv1alpha1.Condition{
+		condition("Custom.A", corev1.ConditionFalse, 0),
+		condition(ackv1alpha1.ConditionTypeResourceSynced, corev1.ConditionFalse, 48*time.Hour),
+		condition("Custom.Stale", corev1.ConditionFalse, 48*time.Hour),
+		condition("Custom.StaleTrue", corev1.ConditionTrue, 48*time.Hour),
+		nil,
+		condition("Custom.A", corev1.ConditionTrue, 0),
+		condition(ackv1alpha1.ConditionTypeAdopted, corev1.ConditionTrue, 48*time.Hour),
+	}
+	if !normalizeConditions(ko) {
+		t.Fatal("expected the conditions to be changed")
+	}
+	// Duplicates keep the last condition, stale custom conditions that are
+	// not True are pruned and ACK conditions are never pruned
+	want := "ACK.Adopted,ACK.ResourceSynced,Custom.A,Custom.StaleTrue"
+	if got := conditionTypes(ko.Status.Conditions); got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if ko.Status.Conditions[2].Status != corev1.ConditionTrue {
+		t.Fatal("expected the last Custom.A condition to be kept")
+	}
+	if normalizeConditions(ko) {
+		t.Fatal("expected normalized conditions to be left unchanged")
+	}
+}
+
+func TestPruneOldestConditions(t *testing.T) {
+	conditions := []*ackv1alpha1.Condition{
+		condition(ackv1alpha1.ConditionTypeResourceSynced, corev1.ConditionTrue, 10*time.Hour),
+		condition("Custom.A", corev1.ConditionTrue, time.Hour),
+		condition("Custom.B", corev1.ConditionTrue, 3*time.Hour),
+		condition("Custom.C", corev1.ConditionTrue, 2*time.Hour),
+		{Type: "Custom.D", Status: corev1.ConditionTrue},
+	}
+	// Custom.D has no last transition time and Custom.B is the oldest of the
+	// other custom conditions
+	kept := pruneOldestConditions(conditions, 3)
+	want := "ACK.ResourceSynced,Custom.A,Custom.C"
+	if got := conditionTypes(kept); got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got := len(pruneOldestConditions(conditions, maxConditions)); got != len(conditions) {
+		t.Fatalf("got %d conditions, want %d", got, len(conditions))
+	}
+}
+`
+
+func TestController_ECR_Repository_Conditions(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-conditions.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	conditions := renderResourceFile(t, g, crd, "conditions.go")
+	assert.Contains(conditions, "\tackv1alpha1.ConditionTypeAdopted,\n\tackv1alpha1.ConditionTypeResourceSynced,\n")
+	assert.Contains(conditions, "const maxConditions = 10")
+	assert.Contains(conditions, "const staleConditionAge = 86400 * time.Second")
+
+	testGeneratedCode(t, "ecr", "pkg/resource/repository", map[string]string{
+		"apis/v1alpha1/repository.go":                conditionsStubAPI,
+		"pkg/resource/repository/conditions.go":      conditions,
+		"pkg/resource/repository/conditions_test.go": conditionsBehaviorTest,
+	})
+}
@@ -56,6 +56,9 @@ var (
 		"pkg/resource/sdk_update_custom.go.tpl",
 		"pkg/resource/sdk_update_set_attributes.go.tpl",
 		"pkg/resource/sdk_update_not_implemented.go.tpl",
+		"pkg/resource/sdk_update_observe_only.go.tpl",
+		"pkg/resource/sdk_update_operations.go.tpl",
+		"pkg/resource/sdk_update_tags.go.tpl",
 	}
 	controllerCopyPaths = []string{}
 	controllerFuncMap   = ttpl.FuncMap{
@@ -63,6 +66,9 @@ var (
 		"TrimPrefix": func(s string, prefix string) string {
 			return strings.TrimPrefix(s, prefix)
 		},
+		"Replace": func(s string, old string, new string) string {
+			return strings.ReplaceAll(s, old, new)
+		},
 		"Dereference": func(s *string) string {
 			return *s
 		},
@@ -85,6 +91,12 @@ var (
 		"GoCodeSetExceptionMessageCheck": func(r *ackmodel.CRD, httpStatusCode int) string {
 			return code.CheckExceptionMessage(r.Config(), r, httpStatusCode)
 		},
+		"GoCodeCheckTerminalExceptionMessages": func(r *ackmodel.CRD, indentLevel int) string {
+			return code.CheckTerminalExceptionMessages(r.Config(), r, indentLevel)
+		},
+		"GoCodeTerminalExceptionMessageRegexes": func(r *ackmodel.CRD, indentLevel int) string {
+			return code.TerminalExceptionMessageRegexes(r.Config(), r, indentLevel)
+		},
 		"GoCodeSetReadOneOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.SetResource(r.Config(), r, ackmodel.OpTypeGet, sourceVarName, targetVarName, indentLevel)
 		},
@@ -94,21 +106,48 @@ var (
 		"GoCodeSetReadManyOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.SetResource(r.Config(), r, ackmodel.OpTypeList, sourceVarName, targetVarName, indentLevel)
 		},
+		"GoCodeSetReadManySourcedFields": func(r *ackmodel.CRD, inputVarName string, targetVarName string, indentLevel int) string {
+			return code.SetResourceReadManySourcedFields(r.Config(), r, inputVarName, targetVarName, indentLevel)
+		},
+		"GoCodeSetSingletonResetInput": func(r *ackmodel.CRD, targetVarName string, indentLevel int) string {
+			return code.SetSDKSingletonReset(r.Config(), r, targetVarName, indentLevel)
+		},
 		"GoCodeSetReadManyInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.SetSDK(r.Config(), r, ackmodel.OpTypeList, sourceVarName, targetVarName, indentLevel)
 		},
+		"GoCodeReadManyPagination": func(r *ackmodel.CRD, inputVarName string, outputVarName string, indentLevel int) string {
+			return code.ReadManyPagination(r.Config(), r, inputVarName, outputVarName, indentLevel)
+		},
+		"GoCodeSetReadManyFilters": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+			return code.SetReadManyFilters(r.Config(), r, sourceVarName, targetVarName, indentLevel)
+		},
+		"GoCodeReadManyPageSize": func(r *ackmodel.CRD, inputVarName string, indentLevel int) string {
+			return code.ReadManyPageSize(r.Config(), r, inputVarName, indentLevel)
+		},
 		"GoCodeGetAttributesSetInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.SetSDKGetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
 		},
 		"GoCodeSetAttributesSetInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.SetSDKSetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
 		},
+		"GoCodeSetChangedAttributes": func(r *ackmodel.CRD, sourceVarName string, deltaVarName string, targetVarName string, indentLevel int) string {
+			return code.SetChangedAttributes(r.Config(), r, sourceVarName, deltaVarName, targetVarName, indentLevel)
+		},
 		"GoCodeGetAttributesSetOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.SetResourceGetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
 		},
+		"GoCodeSetAttributeSourcedFields": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+			return code.SetResourceAttributeSourcedFields(r.Config(), r, sourceVarName, targetVarName, indentLevel)
+		},
 		"GoCodeSetCreateOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.SetResource(r.Config(), r, ackmodel.OpTypeCreate, sourceVarName, targetVarName, indentLevel)
 		},
+		"GoCodeDeferDependentUpdates": func(r *ackmodel.CRD, deltaVarName string, latestVarName string, targetVarName string, deferredVarName string, indentLevel int) string {
+			return code.DeferDependentUpdates(r.Config(), r, deltaVarName, latestVarName, targetVarName, deferredVarName, indentLevel)
+		},
+		"GoCodeWriteSecretOutputs": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+			return code.WriteSecretOutputs(r.Config(), r, r.Ops.Create, sourceVarName, targetVarName, indentLevel)
+		},
 		"GoCodeSetCreateInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.SetSDK(r.Config(), r, ackmodel.OpTypeCreate, sourceVarName, targetVarName, indentLevel)
 		},
@@ -118,9 +157,30 @@ var (
 		"GoCodeSetUpdateInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.SetSDK(r.Config(), r, ackmodel.OpTypeUpdate, sourceVarName, targetVarName, indentLevel)
 		},
+		"GoCodeSetUpdateOperationInput": func(r *ackmodel.CRD, op *awssdkmodel.Operation, sourceVarName string, targetVarName string, indentLevel int) string {
+			return code.SetSDKForOperation(r.Config(), r, op, sourceVarName, targetVarName, indentLevel)
+		},
+		"GoCodeSetReadOneOperationInput": func(r *ackmodel.CRD, op *awssdkmodel.Operation, sourceVarName string, targetVarName string, indentLevel int) string {
+			return code.SetSDKForReadOneOperation(r.Config(), r, op, sourceVarName, targetVarName, indentLevel)
+		},
+		"GoCodeSetReadOneOperationOutput": func(r *ackmodel.CRD, op *awssdkmodel.Operation, sourceVarName string, targetVarName string, indentLevel int) string {
+			return code.SetResourceForReadOneOperation(r.Config(), r, op, sourceVarName, targetVarName, indentLevel)
+		},
+		"GoCodeUpdateWithOperations": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
+			return code.UpdateWithOperations(r.Config(), r, resVarName, indentLevel)
+		},
+		"GoCodeSyncTags": func(r *ackmodel.CRD, desiredVarName string, latestVarName string, indentLevel int) string {
+			return code.SyncTags(r.Config(), r, desiredVarName, latestVarName, indentLevel)
+		},
+		"GoCodeReadTags": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
+			return code.ReadTags(r.Config(), r, koVarName, indentLevel)
+		},
 		"GoCodeSetDeleteInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.SetSDK(r.Config(), r, ackmodel.OpTypeDelete, sourceVarName, targetVarName, indentLevel)
 		},
+		"GoCodeSetForceDeleteInput": func(r *ackmodel.CRD, resVarName string, targetVarName string, indentLevel int) string {
+			return code.SetSDKForceDelete(r.Config(), r, resVarName, targetVarName, indentLevel)
+		},
 		"GoCodeSetSDKForStruct": func(r *ackmodel.CRD, targetFieldName string, targetVarName string, targetShapeRef *awssdkmodel.ShapeRef, sourceFieldPath string, sourceVarName string, indentLevel int) string {
 			return code.SetSDKForStruct(r.Config(), r, targetFieldName, targetVarName, targetShapeRef, sourceFieldPath, sourceVarName, model.OpTypeList, indentLevel)
 		},
@@ -143,9 +203,48 @@ var (
 		"GoCodeCompare": func(r *ackmodel.CRD, deltaVarName string, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.CompareResource(r.Config(), r, deltaVarName, sourceVarName, targetVarName, indentLevel)
 		},
+		"GoCodeCompareIgnoreWhen": func(r *ackmodel.CRD, deltaVarName string, desiredVarName string, indentLevel int) string {
+			return code.CompareIgnoreWhen(r.Config(), r, deltaVarName, desiredVarName, indentLevel)
+		},
 		"GoCodeIsSynced": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
 			return code.ResourceIsSynced(r.Config(), r, resVarName, indentLevel)
 		},
+		"GoCodeCompositeIdentifier": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
+			return code.CompositeIdentifier(r.Config(), r, koVarName, indentLevel)
+		},
+		"GoCodeSDKCall": func(r *ackmodel.CRD, op *awssdkmodel.Operation, lhs string, inputVarName string, indentLevel int) string {
+			return code.SDKCall(r.Config(), r, op, lhs, inputVarName, indentLevel)
+		},
+		"GoCodeWaitUntil": func(r *ackmodel.CRD, opType string, resVarName string, indentLevel int) string {
+			return code.WaitUntil(r.Config(), r, ackmodel.OpTypeFromString(opType), resVarName, indentLevel)
+		},
+		"GoCodeTerminalState": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
+			return code.ResourceTerminalState(r.Config(), r, resVarName, indentLevel)
+		},
+		"GoCodeRequeueOnSuccessByState": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
+			return code.RequeueOnSuccessByState(r.Config(), r, resVarName, indentLevel)
+		},
+		"GoCodeDeleteAuxiliaryResources": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
+			return code.DeleteAuxiliaryResources(r.Config(), r, resVarName, indentLevel)
+		},
+		"GoCodePreDelete": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
+			return code.PreDelete(r.Config(), r, resVarName, indentLevel)
+		},
+		"GoCodeFindAttachments": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
+			return code.FindAttachments(r.Config(), r, resVarName, indentLevel)
+		},
+		"GoCodePutAttachments": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
+			return code.PutAttachments(r.Config(), r, resVarName, indentLevel)
+		},
+		"GoCodeFindCollections": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
+			return code.FindCollections(r.Config(), r, resVarName, indentLevel)
+		},
+		"GoCodeSyncCollections": func(r *ackmodel.CRD, resVarName string, latestVarName string, indentLevel int) string {
+			return code.SyncCollections(r.Config(), r, resVarName, latestVarName, indentLevel)
+		},
+		"GoCodeMiddlewareChain": func(r *ackmodel.CRD, indentLevel int) string {
+			return code.MiddlewareChain(r.Config(), r, indentLevel)
+		},
 		"GoCodeCompareStruct": func(r *ackmodel.CRD, shape *awssdkmodel.Shape, deltaVarName string, sourceVarName string, targetVarName string, fieldPath string, indentLevel int) string {
 			return code.CompareStruct(r.Config(), r, nil, shape, deltaVarName, sourceVarName, targetVarName, fieldPath, indentLevel)
 		},
@@ -155,6 +254,9 @@ var (
 		"GoCodeRequiredFieldsMissingFromReadOneInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
 			return code.CheckRequiredFieldsMissingFromShape(r, ackmodel.OpTypeGet, koVarName, indentLevel)
 		},
+		"GoCodeRequiredFieldsMissingFromReadOneOperationInput": func(r *ackmodel.CRD, op *awssdkmodel.Operation, koVarName string, indentLevel int) string {
+			return code.CheckRequiredFieldsMissingFromOperationInput(r, op, koVarName, indentLevel)
+		},
 		"GoCodeRequiredFieldsMissingFromReadManyInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
 			return code.CheckRequiredFieldsMissingFromShape(r, ackmodel.OpTypeList, koVarName, indentLevel)
 		},
@@ -167,6 +269,9 @@ var (
 		"GoCodeSetResourceIdentifiers": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
 			return code.SetResourceIdentifiers(r.Config(), r, sourceVarName, targetVarName, indentLevel)
 		},
+		"GoCodeSetResourceIdentifiersFromARN": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+			return code.SetResourceIdentifiersFromARN(r.Config(), r, sourceVarName, targetVarName, indentLevel)
+		},
 		"GoCodeFindLateInitializedFieldNames": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
 			return code.FindLateInitializedFieldNames(r.Config(), r, resVarName, indentLevel)
 		},
@@ -179,6 +284,12 @@ var (
 		"GoCodeReferencesValidation": func(f *ackmodel.Field, sourceVarName string, indentLevel int) string {
 			return code.ReferenceFieldsValidation(f, sourceVarName, indentLevel)
 		},
+		"GoCodeKMSKeyValidation": func(f *ackmodel.Field, sourceVarName string, indentLevel int) string {
+			return code.KMSKeyFieldValidation(f, sourceVarName, indentLevel)
+		},
+		"GoCodeSetKMSKeyDefault": func(f *ackmodel.Field, targetVarName string, indentLevel int) string {
+			return code.SetKMSKeyDefault(f, targetVarName, indentLevel)
+		},
 		"CheckNilFieldPath": func(f *ackmodel.Field, sourceVarName string) string {
 			return code.CheckNilFieldPath(f, sourceVarName)
 		},
@@ -243,14 +354,18 @@ func Controller(
 
 	// First add all the CRD pkg/resource templates
 	targets := []string{
+		"attributes.go.tpl",
+		"conditions.go.tpl",
 		"delta.go.tpl",
 		"descriptor.go.tpl",
 		"identifiers.go.tpl",
+		"logging.go.tpl",
 		"manager.go.tpl",
 		"manager_factory.go.tpl",
 		"references.go.tpl",
 		"resource.go.tpl",
 		"sdk.go.tpl",
+		"singleton_webhook.go.tpl",
 		"tags.go.tpl",
 	}
 	for _, crd := range crds {
@@ -259,6 +374,26 @@ func Controller(
 			if target == "tags.go.tpl" && crd.Config().TagsAreIgnored(crd.Names.Original) {
 				continue
 			}
+			// skip adding "attributes.go.tpl" file if a crd has no
+			// attribute fields with a coerced type
+			if target == "attributes.go.tpl" && !crd.HasTypedAttributes() {
+				continue
+			}
+			// skip adding "conditions.go.tpl" file if conditions are not
+			// managed for a crd
+			if target == "conditions.go.tpl" && crd.ConditionsConfig() == nil {
+				continue
+			}
+			// skip adding "logging.go.tpl" file if the AWS API payloads of
+			// a crd are not logged
+			if target == "logging.go.tpl" && !crd.LogsSDKPayloads() {
+				continue
+			}
+			// skip adding "singleton_webhook.go.tpl" file if a crd is not a
+			// singleton settings resource
+			if target == "singleton_webhook.go.tpl" && !crd.IsSingleton() {
+				continue
+			}
 			outPath := filepath.Join("pkg/resource", crd.Names.Snake, strings.TrimSuffix(target, ".tpl"))
 			tplPath := filepath.Join("pkg/resource", target)
 			crdVars := &templateCRDVars{
@@ -286,12 +421,20 @@ func Controller(
 		return nil, err
 	}
 
-	// Next add the template for the main.go file
+	// Next add the templates for the main.go and import.go files
 	snakeCasedCRDNames := make([]string, 0)
 	// using Map to implement the Set
 	referencedServiceNamesMap := make(map[string]struct{})
+	resourceResyncSeconds := map[string]int{}
+	resourceMaxConcurrentSyncs := map[string]int{}
 	for _, crd := range crds {
 		snakeCasedCRDNames = append(snakeCasedCRDNames, crd.Names.Snake)
+		if seconds := crd.ReconcileResyncSeconds(); seconds > 0 {
+			resourceResyncSeconds[crd.Kind] = seconds
+		}
+		if syncs := crd.ReconcileMaxConcurrentSyncs(); syncs > 0 {
+			resourceMaxConcurrentSyncs[crd.Kind] = syncs
+		}
 		for _, serviceName := range crd.ReferencedServiceNames() {
 			referencedServiceNamesMap[serviceName] = struct{}{}
 		}
@@ -301,14 +444,35 @@ func Controller(
 		referencedServiceNames = append(referencedServiceNames, serviceName)
 	}
 	sort.Strings(referencedServiceNames)
+	healthCheck, err := m.HealthCheck()
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := m.Endpoint()
+	if err != nil {
+		return nil, err
+	}
 	cmdVars := &templateCmdVars{
 		metaVars,
 		snakeCasedCRDNames,
 		referencedServiceNames,
+		healthCheck,
+		endpoint,
+		resourceResyncSeconds,
+		resourceMaxConcurrentSyncs,
+		m.GetConfig().UsesAPIReader(),
 	}
 	if err = ts.Add("cmd/controller/main.go", "cmd/controller/main.go.tpl", cmdVars); err != nil {
 		return nil, err
 	}
+	if err = ts.Add("cmd/controller/import.go", "cmd/controller/import.go.tpl", cmdVars); err != nil {
+		return nil, err
+	}
+	if healthCheck != nil {
+		if err = ts.Add("cmd/controller/health.go", "cmd/controller/health.go.tpl", cmdVars); err != nil {
+			return nil, err
+		}
+	}
 
 	// Finally, add the configuration YAML file templates
 	for _, path := range controllerConfigTemplatePaths {
@@ -329,6 +493,23 @@ type templateCmdVars struct {
 	// resources are referenced inside the CRDs.
 	// Service name is go package name of AWS service in aws-sdk-go.
 	ReferencedServiceNames []string
+	// HealthCheck contains the AWS API call made by the controller's
+	// readiness check, if any.
+	HealthCheck *ackgenconfig.HealthCheckConfig
+	// Endpoint contains the endpoint options the service's API clients are
+	// constructed with, if any.
+	Endpoint *ackgenconfig.EndpointConfig
+	// ResourceResyncSeconds contains the default resync period, in seconds,
+	// of the resources that configure one, keyed by resource kind.
+	ResourceResyncSeconds map[string]int
+	// ResourceMaxConcurrentSyncs contains the default maximum number of
+	// concurrent reconciles of the resources that configure one, keyed by
+	// resource kind.
+	ResourceMaxConcurrentSyncs map[string]int
+	// UsesAPIReader is true if the resource managers read the deletion policy
+	// annotations of the namespaces, or the ConfigMaps their fields refer to,
+	// with the controller manager's API reader.
+	UsesAPIReader bool
 }
 
 // templateConfigVars contains template variables for the templates that require
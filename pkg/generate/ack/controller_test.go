@@ -0,0 +1,608 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ack_test
+
+import (
+	"go/format"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+// renderResourceFile returns the gofmt'd Go code of the supplied file, e.g.
+// "sdk.go", generated for the supplied resource of the controller, failing
+// the test if the generated code cannot be parsed.
+func renderResourceFile(
+	t *testing.T,
+	m *ackmodel.Model,
+	crd *ackmodel.CRD,
+	fileName string,
+) string {
+	t.Helper()
+	return renderFile(t, m, filepath.Join("pkg", "resource", crd.Names.Snake, fileName))
+}
+
+// renderFile returns the gofmt'd Go code of the supplied path, e.g.
+// "pkg/resource/registry.go", of the controller, failing the test if the
+// generated code cannot be parsed.
+func renderFile(
+	t *testing.T,
+	m *ackmodel.Model,
+	path string,
+) string {
+	t.Helper()
+	ts, err := ack.Controller(m, []string{"../../../templates"}, "ack-controller")
+	require.NoError(t, err)
+	require.NoError(t, ts.Execute())
+	buf, found := ts.Executed()[path]
+	require.True(t, found, "%s was not generated", path)
+	formatted, err := format.Source(buf.Bytes())
+	require.NoError(t, err, "%s is not valid Go code:\n%s", path, buf.String())
+	return string(formatted)
+}
+
+// testGeneratedCode runs the Go tests of the supplied package of a module made
+// of the supplied generated files, and stubs, of the controller of the
+// supplied service. The module is built out of the code generator's own
+// dependencies, which include the ACK runtime. The test is skipped in short
+// mode.
+func testGeneratedCode(
+	t *testing.T,
+	serviceAlias string,
+	pkg string,
+	files map[string]string,
+) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("skipping compiling the generated code in short mode")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not found")
+	}
+
+	dir := t.TempDir()
+	goMod, err := os.ReadFile("../../../go.mod")
+	require.NoError(t, err)
+	goMod = regexp.MustCompile(`(?m)^module .*$`).ReplaceAll(
+		goMod, []byte("module github.com/aws-controllers-k8s/"+serviceAlias+"-controller"),
+	)
+	goSum, err := os.ReadFile("../../../go.sum")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), goMod, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.sum"), goSum, 0o644))
+	for path, content := range files {
+		path = filepath.Join(dir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	cmd := exec.Command(goBin, "test", "./"+pkg+"/")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestController_ECR_Repository_CustomDeleteAuxiliaryResources(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-auxiliary-resources.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// The auxiliary resources are cleaned up before the resource is deleted,
+	// so that a failed clean up is retried while the resource still exists
+	sdk := renderResourceFile(t, g, crd, "sdk.go")
+	assert.Contains(sdk, `	if err = rm.deleteAuxiliaryResources(ctx, r); err != nil {
+		return nil, err
+	}
+	input, err := rm.newDeleteRequestPayload(r)
+`)
+
+	// The auxiliary resources are cleaned up before a custom delete as well
+	resCfg := crd.Config().Resources["Repository"]
+	resCfg.DeleteOperation = &ackgenconfig.DeleteOperationsConfig{
+		CustomMethodName: "customDeleteRepository",
+	}
+	crd.Config().Resources["Repository"] = resCfg
+
+	sdk = renderResourceFile(t, g, crd, "sdk.go")
+	assert.Contains(sdk, `	if err = rm.deleteAuxiliaryResources(ctx, r); err != nil {
+		return nil, err
+	}
+	return rm.customDeleteRepository(ctx, r)
+`)
+}
+
+func TestController_ECR_Repository_AdoptionRetry(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-adoption-retry.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	manager := renderResourceFile(t, g, crd, "manager.go")
+	assert.Contains(manager, "func (rm *resourceManager) withAdoptionRetries(")
+	assert.Contains(manager, `const conditionTypeAdopting ackv1alpha1.ConditionType = "ACK.Adopting"`)
+	// Retries requeue the adoption instead of blocking the worker, and the
+	// attempts are counted across reconciles
+	assert.NotContains(manager, "time.After(")
+	assert.Contains(manager, `		adoptionAttemptsMu.Lock()
+		adoptionAttempts[key]++
+		attempt := adoptionAttempts[key]
+		adoptionAttemptsMu.Unlock()
+`)
+	assert.Contains(manager, `		setAdoptingCondition(observed, corev1.ConditionTrue, msg)
+		return observed, ackrequeue.NeededAfter(err, backoff)
+`)
+	assert.Contains(manager, `			setAdoptingCondition(observed, corev1.ConditionFalse, msg)
+`)
+
+	// The Adopting condition is one of the ACK conditions, never pruned
+	conditions := renderResourceFile(t, g, crd, "conditions.go")
+	assert.Contains(conditions, `	ackv1alpha1.ConditionTypeAdopted,
+	conditionTypeAdopting,
+	ackv1alpha1.ConditionTypeResourceSynced,
+`)
+}
+
+func TestController_ECR_Repository_BuiltinMiddleware(t *testing.T) {
+	assert := assert.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-middleware.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(t, crd)
+
+	manager := renderResourceFile(t, g, crd, "manager.go")
+	assert.Contains(manager, `func (rm *resourceManager) loggingMiddleware(`)
+	assert.Contains(manager, `"ecr", "Repository", opName, result,`)
+	assert.Contains(manager, `var rateLimiter = rate.NewLimiter(rate.Limit(5), 10)`)
+	assert.Contains(manager, `const findCacheTTL = 30 * time.Second`)
+	assert.Contains(manager, `observed, err := rm.withMiddleware("find", rm.sdkFind)(ctx, r)`)
+	assert.NotContains(manager, `func (rm *resourceManager) auditMiddleware(`)
+}
+
+func TestController_ECR_ImportCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator.yaml",
+	})
+
+	// The resource managers of the import command are given a Reconciler
+	// that fails instead of a nil one, and the default name of the custom
+	// resource is validated as a DNS-1123 subdomain
+	importCmd := renderFile(t, g, "cmd/controller/import.go")
+	assert.Contains(importCmd, "importReconciler{}, sess,")
+	assert.Contains(importCmd, "func (importReconciler) SecretValueFromReference(")
+	assert.Contains(importCmd, "name = importName(identifier)")
+	assert.Contains(importCmd, "if errs := k8svalidation.IsDNS1123Subdomain(name); len(errs) > 0 {")
+	assert.NotContains(importCmd, "strings.ToLower(identifier)")
+}
+
+func TestController_ECR_ImageTagMutabilitySetting_SingletonWebhook(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-singleton.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "ImageTagMutabilitySetting")
+	require.NotNil(crd)
+
+	webhook := renderResourceFile(t, g, crd, "singleton_webhook.go")
+	// The webhook is registered with its API version, kind and type, in the
+	// order expected by the runtime
+	assert.Contains(webhook, `	webhook := ackrtwebhook.New(
+		"v1alpha1",
+		"ImageTagMutabilitySetting",
+		"validation",
+`)
+}
+
+func TestController_EC2_Route_ClusterScopedParent(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ec2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-child-resources.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Route")
+	require.NotNil(crd)
+
+	// A cluster-scoped RouteTable is read without a namespace
+	resCfg := crd.Config().Resources["RouteTable"]
+	resCfg.Scope = ackgenconfig.ResourceScopeCluster
+	crd.Config().Resources["RouteTable"] = resCfg
+
+	references := renderResourceFile(t, g, crd, "references.go")
+	assert.Contains(references, `	parent := &svcapitypes.RouteTable{}
+	// RouteTable is cluster-scoped
+	namespacedName := types.NamespacedName{
+		Name: *arr.Name,
+	}
+`)
+	assert.Contains(references, `		namespace := ""
+		obj := &svcapitypes.RouteTable{}
+`)
+
+	// A cluster-scoped Route cannot be owned by a namespaced RouteTable, and
+	// must set the namespace of its reference
+	resCfg.Scope = ""
+	crd.Config().Resources["RouteTable"] = resCfg
+	resCfg = crd.Config().Resources["Route"]
+	resCfg.Scope = ackgenconfig.ResourceScopeCluster
+	crd.Config().Resources["Route"] = resCfg
+
+	references = renderResourceFile(t, g, crd, "references.go")
+	assert.NotContains(references, "setParentOwnerReference")
+	assert.Contains(references, `		namespace := *arr.Namespace
+`)
+}
+
+func TestController_IAM_Role_ConfigMap(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-configmap.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+
+	manager := renderResourceFile(t, g, crd, "manager.go")
+	assert.Contains(manager, "func (rm *resourceManager) configMapValueFromReference(")
+	assert.Contains(manager, "return svcresource.ConfigMapValue(ctx, namespace, ref.Name, ref.Key)")
+
+	registry := renderFile(t, g, "pkg/resource/registry.go")
+	assert.Contains(registry, "func SetAPIReader(r ctrlrtclient.Reader) {")
+	assert.Contains(registry, "func ConfigMapValue(")
+	assert.NotContains(registry, "func NamespaceDeletionPolicy(")
+
+	main := renderFile(t, g, "cmd/controller/main.go")
+	assert.Contains(main, "svcresource.SetAPIReader(mgr.GetAPIReader())")
+}
+
+func TestController_IAM_Role_AdoptionOnly(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-adoption-only.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+
+	// Creating the resource fails with a terminal error, without calling the
+	// Create operation
+	sdk := renderResourceFile(t, g, crd, "sdk.go")
+	assert.Contains(sdk, `	// Role can only be adopted and is never created in the
+	// backend AWS service API
+	return nil, ackerr.NewTerminalError(errors.New(
+		"resource can only be adopted, use an AdoptedResource to adopt the " +
+			"existing AWS resource",
+	))
+}
+`)
+	assert.NotContains(sdk, "CreateRole")
+	assert.NotContains(sdk, "newCreateRequestPayload")
+	// The adopted resource is still deleted
+	assert.Contains(sdk, "rm.sdkapi.DeleteRoleWithContext(ctx, input)")
+}
+
+func TestController_DynamoDB_Table_RetainedByDefault(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "dynamodb", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-deletion-policy.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Table")
+	require.NotNil(crd)
+
+	// The deletion policy annotation of the namespace is honoured when the
+	// custom resource has none
+	manager := renderResourceFile(t, g, crd, "manager.go")
+	assert.Contains(manager, `	policy, found := r.ko.GetAnnotations()[ackv1alpha1.AnnotationDeletionPolicy]
+	if !found {
+		var err error
+		if policy, err = svcresource.NamespaceDeletionPolicy(ctx, r.ko.Namespace); err != nil {
+			return rm.onError(r, err)
+		}
+	}
+	if policy != string(ackv1alpha1.DeletionPolicyDelete) {
+`)
+	registry := renderFile(t, g, "pkg/resource/registry.go")
+	assert.Contains(registry, `	return ns.GetAnnotations()["dynamodb."+ackv1alpha1.AnnotationDeletionPolicy], nil`)
+	main := renderFile(t, g, "cmd/controller/main.go")
+	assert.Contains(main, "svcresource.SetAPIReader(mgr.GetAPIReader())")
+
+	// Controllers without retained resources do not read the namespaces
+	g = testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-auxiliary-resources.yaml",
+	})
+	assert.NotContains(renderFile(t, g, "pkg/resource/registry.go"), "NamespaceDeletionPolicy")
+	assert.NotContains(renderFile(t, g, "cmd/controller/main.go"), "SetAPIReader")
+}
+
+func TestController_DynamoDB_Table_PendingDeferredUpdates(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "dynamodb", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-update-after.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Table")
+	require.NotNil(crd)
+
+	sdk := renderResourceFile(t, g, crd, "sdk.go")
+	assert.Contains(sdk, `const pendingUpdatesAnnotation = "dynamodb.services.k8s.aws/pending-updates"`)
+	// Deferred updates recorded as pending for the current generation are not
+	// requested again, e.g. after a restart of the controller
+	assert.Contains(sdk, `	requested, deferredFields := rm.deferDependentUpdates(desired, latest, delta)
+	if len(deferredFields) > 0 &&
+		strings.Join(pendingUpdates(desired), ",") == strings.Join(deferredFields, ",") {
+`)
+	assert.Contains(sdk, `	setPendingUpdates(ko, deferredFields)
+	if len(deferredFields) > 0 {
+`)
+	// Records of a previous generation are ignored
+	assert.Contains(sdk, `	if !found || generation != fmt.Sprint(r.ko.GetGeneration()) {
+		return nil
+	}
+`)
+	assert.Contains(sdk, `	annotations[pendingUpdatesAnnotation] = fmt.Sprintf(
+		"%d:%s", ko.GetGeneration(), strings.Join(deferred, ","),
+	)
+`)
+
+	// Resources without deferred updates do not record pending updates
+	g = testutil.NewModelForServiceWithOptions(t, "dynamodb", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-deletion-policy.yaml",
+	})
+	crd = testutil.GetCRDByName(t, g, "Table")
+	require.NotNil(crd)
+	assert.NotContains(renderResourceFile(t, g, crd, "sdk.go"), "pendingUpdates")
+}
+
+func TestController_EKS_Cluster_RequeueOnSuccessByState(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-requeue-by-state.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Cluster")
+	require.NotNil(crd)
+
+	// The created resource is requeued after the delay of its state
+	sdk := renderResourceFile(t, g, crd, "sdk.go")
+	assert.Contains(sdk, "	return created, requeueOnSuccessByState(created)\n}\n")
+	assert.Contains(sdk, `			return ackrequeue.NeededAfter(nil, 15*time.Second)`)
+	manager := renderResourceFile(t, g, crd, "manager.go")
+	assert.NotContains(manager, "requeueOnSuccessByState")
+}
+
+func TestController_EKS_Cluster_ReadAfterCreate(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-read-after-create.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Cluster")
+	require.NotNil(crd)
+
+	// A created resource that cannot be read yet is returned without error so
+	// that the runtime records its creation
+	sdk := renderResourceFile(t, g, crd, "sdk.go")
+	assert.Contains(sdk, `	observed, err := rm.sdkFind(ctx, created)
+	if err == nil {
+		created.SetStatus(observed)
+	} else if err != ackerr.NotFound {
+		return created, err
+	}
+`)
+	assert.Contains(sdk, "\treturn created, nil\n}\n")
+	assert.NotContains(sdk, "ackrequeue.NeededAfter")
+
+	crd = testutil.GetCRDByName(t, g, "FargateProfile")
+	require.NotNil(crd)
+	sdk = renderResourceFile(t, g, crd, "sdk.go")
+	assert.NotContains(sdk, "rm.sdkFind(ctx, created)")
+}
+
+func TestController_Lambda_Function_ReadOneOperations(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-read-one-operations.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+
+	// The resource read with a fallback ReadOne operation goes through the
+	// same post-processing, hooks included, as the one read with the ReadOne
+	// operation
+	sdk := renderResourceFile(t, g, crd, "sdk.go")
+	start := strings.Index(sdk, "func (rm *resourceManager) findWithGetFunctionConfiguration(")
+	require.True(start >= 0)
+	findWith := sdk[start:]
+	findWith = findWith[:strings.Index(findWith, "\n}\n")]
+	assert.Contains(findWith, `	rm.setStatusDefaults(ko)
+	rm.customSetOutput(ko)
+	return &resource{ko}, nil`)
+}
+
+func TestController_ECR_Repository_ProcessOutputCustomMethodName(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-process-output.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// The custom methods process the Output shapes before the resource's
+	// fields are set from them
+	sdk := renderResourceFile(t, g, crd, "sdk.go")
+	assert.Contains(sdk, `	if err = rm.normalizeCreateRepositoryOutput(ctx, desired, resp); err != nil {
+		return nil, err
+	}
+	// Merge in the information we read from the API call above to the copy of
+	// the original Kubernetes object we passed to the function
+	ko := desired.ko.DeepCopy()
+`)
+	assert.Contains(sdk, `	if err = rm.normalizeDescribeRepositoriesOutput(ctx, r, resp); err != nil {
+		return nil, err
+	}
+	// Merge in the information we read from the API call above to the copy of
+	// the original Kubernetes object we passed to the function
+	ko := r.ko.DeepCopy()
+`)
+}
+
+func TestController_EC2_Instance_CreateCount(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ec2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-idempotency-tokens.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Instance")
+	require.NotNil(crd)
+
+	// A successful creation is counted in the resource's annotations, which
+	// are part of the idempotency token of the next creation
+	sdk := renderResourceFile(t, g, crd, "sdk.go")
+	assert.Contains(sdk, `	ko := desired.ko.DeepCopy()
+	incrementCreateCount(ko)
+`)
+	assert.Contains(sdk, `const createCountAnnotation = "ec2.services.k8s.aws/create-count"`)
+	assert.Contains(sdk, `	count, _ := strconv.Atoi(annotations[createCountAnnotation])
+	annotations[createCountAnnotation] = strconv.Itoa(count + 1)
+	ko.SetAnnotations(annotations)
+`)
+
+	crd = testutil.GetCRDByName(t, g, "Vpc")
+	require.NotNil(crd)
+	sdk = renderResourceFile(t, g, crd, "sdk.go")
+	assert.NotContains(sdk, "createCountAnnotation")
+}
+
+func TestController_ECR_Repository_DefaultTags(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-default-tags.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// The configured default tags are merged with the controller's default
+	// tags, the resource's own tags taking precedence over both
+	manager := renderResourceFile(t, g, crd, "manager.go")
+	assert.Contains(manager, `var configuredDefaultTags = map[string]string{
+	"cluster":   "%K8S_CLUSTER_NAME%",
+	"namespace": "%K8S_NAMESPACE%",
+	"team":      "registry",
+}
+`)
+	assert.Contains(manager, `	defaultTags := ackrt.GetDefaultTags(&rm.cfg, r.ko, md)
+	configuredTags, err := getDefaultTags(r, md)
+	if err != nil {
+		return err
+	}
+	defaultTags = acktags.Merge(defaultTags, configuredTags)
+`)
+	assert.Contains(manager, "\ttags := acktags.Merge(resourceTags, defaultTags)\n")
+	// A tag referring to the cluster name is not expanded to an empty value
+	assert.Contains(manager, `		if clusterName == "" && strings.Contains(v, clusterNameTagFormat) {
+			return nil, ackerr.NewTerminalError(fmt.Errorf(
+				"default tag %q refers to %s but ACK_CLUSTER_NAME is not set", k, clusterNameTagFormat,
+			))
+		}
+`)
+}
+
+func TestController_ECR_Repository_SensitiveDifferences(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-sensitive-fields.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// Redacted differences keep their nil values, so that DifferencesFromDelta
+	// still reports sensitive fields as added or removed
+	delta := renderResourceFile(t, g, crd, "delta.go")
+	assert.Contains(delta, "\tredactSensitiveDifferences(delta)\n\treturn delta\n")
+	assert.Contains(delta, "var sensitiveFieldPaths = []string{\n\t\"Spec.ImageScanningConfiguration\",\n}")
+	assert.Contains(delta, `			if !isNilValue(diff.A) {
+				diff.A = redactedValue
+			}
+			if !isNilValue(diff.B) {
+				diff.B = redactedValue
+			}
+`)
+
+	// The resource manager logs the fields that differ before updating
+	manager := renderResourceFile(t, g, crd, "manager.go")
+	assert.Contains(manager, "\tfor _, diff := range DifferencesFromDelta(delta) {\n")
+}
+
+func TestController_ECR_Repository_IgnoredTagKeyPrefixes(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-ignored-tag-key-prefixes.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// The tags managed by AWS are always ignored, in addition to those with
+	// the configured prefixes
+	tags := renderResourceFile(t, g, crd, "tags.go")
+	assert.Contains(tags, `var ignoredTagKeyPrefixes = []string{
+	"aws:",
+	"ecr:",
+}
+`)
+}
@@ -0,0 +1,135 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ack_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+// compositeIdentifierStubAPI is the minimal Repository API type, identified
+// by its RegistryID and RepositoryName, the generated resource.go and
+// identifiers.go compile against
+const compositeIdentifierStubAPI = `package v1alpha1
+
+import (
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type RepositorySpec struct {
+	RepositoryName *string
+}
+
+type RepositoryStatus struct {
+	ACKResourceMetadata *ackv1alpha1.ResourceMetadata
+	Conditions          []*ackv1alpha1.Condition
+	RegistryID          *string
+}
+
+type Repository struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec   RepositorySpec
+	Status RepositoryStatus
+}
+
+func (in *Repository) DeepCopy() *Repository {
+	out := *in
+	return &out
+}
+
+func (in *Repository) DeepCopyObject() runtime.Object {
+	return in.DeepCopy()
+}
+`
+
+// compositeIdentifierBehaviorTest exercises the generated CompositeIdentifier
+// and SetIdentifiers methods of a Repository with a "|" primary key separator
+const compositeIdentifierBehaviorTest = `package repository
+
+import (
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+
+	svcapitypes "github.com/aws-controllers-k8s/ecr-controller/apis/v1alpha1"
+)
+
+func TestCompositeIdentifierRoundTrip(t *testing.T) {
+	registryID := "111122223333"
+	repositoryName := "my-repo"
+	r := &resource{&svcapitypes.Repository{}}
+	if got := r.CompositeIdentifier(); got != "" {
+		t.Fatalf("got %q for a resource without primary key fields", got)
+	}
+	r.ko.Status.RegistryID = &registryID
+	r.ko.Spec.RepositoryName = &repositoryName
+	identifier := r.CompositeIdentifier()
+	if identifier != "111122223333|my-repo" {
+		t.Fatalf("got identifier %q", identifier)
+	}
+
+	adopted := &resource{&svcapitypes.Repository{}}
+	if err := adopted.SetIdentifiers(&ackv1alpha1.AWSIdentifiers{
+		NameOrID: identifier,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if *adopted.ko.Status.RegistryID != registryID ||
+		*adopted.ko.Spec.RepositoryName != repositoryName {
+		t.Fatalf("identifier %q was not set back", identifier)
+	}
+	if got := adopted.CompositeIdentifier(); got != identifier {
+		t.Fatalf("got %q after SetIdentifiers, want %q", got, identifier)
+	}
+
+	if err := adopted.SetIdentifiers(&ackv1alpha1.AWSIdentifiers{
+		NameOrID: "my-repo",
+	}); err == nil {
+		t.Fatal("expected an error for an identifier missing a primary key field")
+	}
+}
+`
+
+func TestController_ECR_Repository_CompositeIdentifier(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-composite-primary-key.yaml",
+	})
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	res := renderResourceFile(t, g, crd, "resource.go")
+	assert.Contains(res, "func (r *resource) CompositeIdentifier() string {")
+	// The import command names the custom resource after the composite
+	// identifier read from the resource
+	importCmd := renderFile(t, g, "cmd/controller/import.go")
+	assert.Contains(importCmd, `	if ci, ok := latest.(compositeIdentifier); ok && ci.CompositeIdentifier() != "" {
+`)
+
+	testGeneratedCode(t, "ecr", "pkg/resource/repository", map[string]string{
+		"apis/v1alpha1/repository.go":              compositeIdentifierStubAPI,
+		"pkg/resource/repository/resource.go":      res,
+		"pkg/resource/repository/identifiers.go":   renderResourceFile(t, g, crd, "identifiers.go"),
+		"pkg/resource/repository/resource_test.go": compositeIdentifierBehaviorTest,
+	})
+}
@@ -0,0 +1,293 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// FindAttachments returns the Go code that reads the attachments of a
+// resource, as configured in the resource's `attachments` generator config,
+// into their Spec fields. An attachment that does not exist leaves its Spec
+// field unset.
+//
+// Sample output:
+//
+//	// Read attachment LifecyclePolicyText
+//	if r.ko.Spec.RepositoryName != nil {
+//		input := &svcsdk.GetLifecyclePolicyInput{}
+//		input.RepositoryName = r.ko.Spec.RepositoryName
+//		var resp *svcsdk.GetLifecyclePolicyOutput
+//		resp, err = rm.sdkapi.GetLifecyclePolicyWithContext(ctx, input)
+//		rm.metrics.RecordAPICall("READ_ONE", "GetLifecyclePolicy", err)
+//		if err != nil {
+//			awsErr, ok := ackerr.AWSError(err)
+//			if !ok || !(awsErr.Code() == "LifecyclePolicyNotFoundException") {
+//				return err
+//			}
+//			r.ko.Spec.LifecyclePolicyText = nil
+//		} else {
+//			r.ko.Spec.LifecyclePolicyText = resp.LifecyclePolicyText
+//		}
+//	}
+func FindAttachments(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// *resource variable name
+	resVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	for _, attCfg := range cfg.GetAttachments(r.Names.Original) {
+		subject := fmt.Sprintf("attachment %q", attCfg.Field)
+		fieldAccessor := attachmentFieldAccessor(r, attCfg, resVarName)
+		op := attachmentOperation(r, attCfg, attCfg.GetOperation, "get_operation")
+		outputShape := op.OutputRef.Shape
+		member := attCfg.GetMember()
+		if outputShape == nil || outputShape.MemberRefs[member] == nil {
+			panic(fmt.Sprintf("unable to find member %q in output shape of "+
+				"get_operation %q of attachment %q. crd: %q", member,
+				op.ExportedName, attCfg.Field, r.Kind))
+		}
+
+		out += fmt.Sprintf("%s// Read attachment %s\n", indent, attCfg.Field)
+		out += subAPIInputBlockOpen(r, attCfg.InputFields, subject, op, resVarName, indentLevel)
+		innerIndent := indent + "\t"
+		out += fmt.Sprintf("%svar resp %s\n", innerIndent, r.GetOutputShapeGoType(op))
+		out += SDKCall(cfg, r, op, "resp, err =", "input", indentLevel+1) + "\n"
+		out += fmt.Sprintf("%srm.metrics.RecordAPICall(\"READ_ONE\", %q, err)\n", innerIndent, op.ExportedName)
+		out += fmt.Sprintf("%sif err != nil {\n", innerIndent)
+		out += notFoundCodesCheck(attCfg.NotFoundCodes, innerIndent+"\t")
+		out += fmt.Sprintf("%s\t%s = nil\n", innerIndent, fieldAccessor)
+		out += fmt.Sprintf("%s} else {\n", innerIndent)
+		out += fmt.Sprintf("%s\t%s = resp.%s\n", innerIndent, fieldAccessor, member)
+		out += fmt.Sprintf("%s}\n", innerIndent)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
+
+// PutAttachments returns the Go code that writes the attachments of a
+// resource, as configured in the resource's `attachments` generator config,
+// from their Spec fields. With a nil delta, e.g. after the resource is
+// created, every set attachment is written. Otherwise only the attachments
+// that differ are written, and the unset ones are deleted when the
+// attachment has a delete_operation.
+//
+// Sample output:
+//
+//	// Write attachment LifecyclePolicyText
+//	if delta == nil || delta.DifferentAt("Spec.LifecyclePolicyText") {
+//		if r.ko.Spec.LifecyclePolicyText != nil {
+//			if r.ko.Spec.RepositoryName != nil {
+//				input := &svcsdk.PutLifecyclePolicyInput{}
+//				input.RepositoryName = r.ko.Spec.RepositoryName
+//				input.LifecyclePolicyText = r.ko.Spec.LifecyclePolicyText
+//				_, err = rm.sdkapi.PutLifecyclePolicyWithContext(ctx, input)
+//				rm.metrics.RecordAPICall("UPDATE", "PutLifecyclePolicy", err)
+//				if err != nil {
+//					return err
+//				}
+//			}
+//		} else if delta != nil {
+//			if r.ko.Spec.RepositoryName != nil {
+//				input := &svcsdk.DeleteLifecyclePolicyInput{}
+//				input.RepositoryName = r.ko.Spec.RepositoryName
+//				_, err = rm.sdkapi.DeleteLifecyclePolicyWithContext(ctx, input)
+//				rm.metrics.RecordAPICall("DELETE", "DeleteLifecyclePolicy", err)
+//				if err != nil {
+//					awsErr, ok := ackerr.AWSError(err)
+//					if !ok || !(awsErr.Code() == "LifecyclePolicyNotFoundException") {
+//						return err
+//					}
+//				}
+//			}
+//		}
+//	}
+func PutAttachments(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// *resource variable name
+	resVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	specPrefix := strings.TrimPrefix(cfg.PrefixConfig.SpecField, ".")
+	for _, attCfg := range cfg.GetAttachments(r.Names.Original) {
+		subject := fmt.Sprintf("attachment %q", attCfg.Field)
+		fieldAccessor := attachmentFieldAccessor(r, attCfg, resVarName)
+		putOp := attachmentOperation(r, attCfg, attCfg.PutOperation, "put_operation")
+		member := attCfg.GetMember()
+		if putOp.InputRef.Shape.MemberRefs[member] == nil {
+			panic(fmt.Sprintf("unable to find member %q in input shape of "+
+				"put_operation %q of attachment %q. crd: %q", member,
+				putOp.ExportedName, attCfg.Field, r.Kind))
+		}
+
+		out += fmt.Sprintf("%s// Write attachment %s\n", indent, attCfg.Field)
+		out += fmt.Sprintf(
+			"%sif delta == nil || delta.DifferentAt(\"%s.%s\") {\n",
+			indent, specPrefix, attCfg.Field,
+		)
+		out += fmt.Sprintf("%s\tif %s != nil {\n", indent, fieldAccessor)
+		innerIndent := indent + "\t\t\t"
+		out += subAPIInputBlockOpen(r, attCfg.InputFields, subject, putOp, resVarName, indentLevel+2)
+		out += fmt.Sprintf("%sinput.%s = %s\n", innerIndent, member, fieldAccessor)
+		out += SDKCall(cfg, r, putOp, "_, err =", "input", indentLevel+3) + "\n"
+		out += fmt.Sprintf("%srm.metrics.RecordAPICall(\"UPDATE\", %q, err)\n", innerIndent, putOp.ExportedName)
+		out += fmt.Sprintf("%sif err != nil {\n", innerIndent)
+		out += fmt.Sprintf("%s\treturn err\n", innerIndent)
+		out += fmt.Sprintf("%s}\n", innerIndent)
+		out += fmt.Sprintf("%s\t\t}\n", indent)
+		if attCfg.DeleteOperation != "" {
+			deleteOp := attachmentOperation(r, attCfg, attCfg.DeleteOperation, "delete_operation")
+			out += fmt.Sprintf("%s\t} else if delta != nil {\n", indent)
+			out += subAPIInputBlockOpen(r, attCfg.InputFields, subject, deleteOp, resVarName, indentLevel+2)
+			out += SDKCall(cfg, r, deleteOp, "_, err =", "input", indentLevel+3) + "\n"
+			out += fmt.Sprintf("%srm.metrics.RecordAPICall(\"DELETE\", %q, err)\n", innerIndent, deleteOp.ExportedName)
+			out += fmt.Sprintf("%sif err != nil {\n", innerIndent)
+			out += notFoundCodesCheck(attCfg.NotFoundCodes, innerIndent+"\t")
+			out += fmt.Sprintf("%s}\n", innerIndent)
+			out += fmt.Sprintf("%s\t\t}\n", indent)
+		}
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
+
+// attachmentFieldAccessor returns the Go accessor of the Spec field of an
+// attachment, panicking if the field does not exist or is not a scalar.
+func attachmentFieldAccessor(
+	r *model.CRD,
+	attCfg *ackgenconfig.AttachmentConfig,
+	resVarName string,
+) string {
+	field, found := r.SpecFields[attCfg.Field]
+	if !found {
+		panic(fmt.Sprintf("unable to find Spec field %q of attachment. "+
+			"crd: %q", attCfg.Field, r.Kind))
+	}
+	switch field.ShapeRef.Shape.Type {
+	case "list", "map", "structure":
+		panic(fmt.Sprintf("attachment field %q must be a scalar field. "+
+			"crd: %q", attCfg.Field, r.Kind))
+	}
+	return fmt.Sprintf(
+		"%s.ko%s.%s", resVarName, r.Config().PrefixConfig.SpecField,
+		field.Names.Camel,
+	)
+}
+
+// attachmentOperation returns the API operation with the supplied ID,
+// panicking if it does not exist.
+func attachmentOperation(
+	r *model.CRD,
+	attCfg *ackgenconfig.AttachmentConfig,
+	opID string,
+	// name of the attachment config key, for error messages
+	opKey string,
+) *awssdkmodel.Operation {
+	op := r.GetOperation(opID)
+	if op == nil {
+		panic(fmt.Sprintf("unable to find %s %q of attachment %q. crd: %q",
+			opKey, opID, attCfg.Field, r.Kind))
+	}
+	return op
+}
+
+// subAPIInputBlockOpen returns the Go code that opens the block calling one of
+// the operations of an attachment or collection, guarded by the nil checks of
+// the supplied input fields, and builds the operation's input.
+func subAPIInputBlockOpen(
+	r *model.CRD,
+	// map, keyed by Input shape member name, of resource field paths
+	inputFields map[string]string,
+	// description of the attachment or collection, for error messages
+	subject string,
+	op *awssdkmodel.Operation,
+	resVarName string,
+	indentLevel int,
+) string {
+	inputShape := op.InputRef.Shape
+
+	// Sort the input member names to generate deterministic code
+	memberNames := make([]string, 0, len(inputFields))
+	for memberName := range inputFields {
+		memberNames = append(memberNames, memberName)
+	}
+	sort.Strings(memberNames)
+
+	conditions := []string{}
+	assignments := []string{}
+	for _, memberName := range memberNames {
+		if _, found := inputShape.MemberRefs[memberName]; !found {
+			panic(fmt.Sprintf("unable to find member %q in input shape %q of "+
+				"%s. crd: %q", memberName, inputShape.ShapeName,
+				subject, r.Kind))
+		}
+		accessor, nilChecks, err := auxiliaryFieldAccessor(r, inputFields[memberName], resVarName)
+		if err != nil {
+			panic(fmt.Sprintf("invalid input field %q of %s: %v. crd: %q",
+				memberName, subject, err, r.Kind))
+		}
+		conditions = append(conditions, nilChecks...)
+		assignments = append(assignments, fmt.Sprintf("input.%s = %s", memberName, accessor))
+	}
+
+	indent := strings.Repeat("\t", indentLevel)
+	out := ""
+	if len(conditions) > 0 {
+		out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(conditions, " && "))
+	} else {
+		out += fmt.Sprintf("%s{\n", indent)
+	}
+	out += fmt.Sprintf("%s\tinput := &svcsdk.%s{}\n", indent, inputShape.ShapeName)
+	for _, assignment := range assignments {
+		out += fmt.Sprintf("%s\t%s\n", indent, assignment)
+	}
+	return out
+}
+
+// notFoundCodesCheck returns the Go code returning the error `err` of an
+// attachment's or collection's operation unless its code is one of the
+// supplied not found codes.
+func notFoundCodesCheck(
+	codes []string,
+	indent string,
+) string {
+	if len(codes) == 0 {
+		return fmt.Sprintf("%sreturn err\n", indent)
+	}
+	codeChecks := make([]string, 0, len(codes))
+	for _, code := range codes {
+		codeChecks = append(codeChecks, fmt.Sprintf("awsErr.Code() == %q", code))
+	}
+	out := fmt.Sprintf("%sawsErr, ok := ackerr.AWSError(err)\n", indent)
+	out += fmt.Sprintf("%sif !ok || !(%s) {\n", indent, strings.Join(codeChecks, " || "))
+	out += fmt.Sprintf("%s\treturn err\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestFindAttachments_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-attachments.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.True(crd.HasAttachments())
+	assert.Equal(
+		[]string{"Spec.LifecyclePolicyText", "Spec.PolicyText"},
+		crd.GetAttachmentFieldPaths(),
+	)
+
+	expected := `	// Read attachment LifecyclePolicyText
+	if r.ko.Spec.RepositoryName != nil {
+		input := &svcsdk.GetLifecyclePolicyInput{}
+		input.RepositoryName = r.ko.Spec.RepositoryName
+		var resp *svcsdk.GetLifecyclePolicyOutput
+		resp, err = rm.sdkapi.GetLifecyclePolicyWithContext(ctx, input)
+		rm.metrics.RecordAPICall("READ_ONE", "GetLifecyclePolicy", err)
+		if err != nil {
+			awsErr, ok := ackerr.AWSError(err)
+			if !ok || !(awsErr.Code() == "LifecyclePolicyNotFoundException") {
+				return err
+			}
+			r.ko.Spec.LifecyclePolicyText = nil
+		} else {
+			r.ko.Spec.LifecyclePolicyText = resp.LifecyclePolicyText
+		}
+	}
+	// Read attachment PolicyText
+	if r.ko.Spec.RepositoryName != nil {
+		input := &svcsdk.GetRepositoryPolicyInput{}
+		input.RepositoryName = r.ko.Spec.RepositoryName
+		var resp *svcsdk.GetRepositoryPolicyOutput
+		resp, err = rm.sdkapi.GetRepositoryPolicyWithContext(ctx, input)
+		rm.metrics.RecordAPICall("READ_ONE", "GetRepositoryPolicy", err)
+		if err != nil {
+			awsErr, ok := ackerr.AWSError(err)
+			if !ok || !(awsErr.Code() == "RepositoryPolicyNotFoundException") {
+				return err
+			}
+			r.ko.Spec.PolicyText = nil
+		} else {
+			r.ko.Spec.PolicyText = resp.PolicyText
+		}
+	}
+`
+	assert.Equal(expected, code.FindAttachments(crd.Config(), crd, "r", 1))
+}
+
+func TestPutAttachments_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-attachments.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	expected := `	// Write attachment LifecyclePolicyText
+	if delta == nil || delta.DifferentAt("Spec.LifecyclePolicyText") {
+		if r.ko.Spec.LifecyclePolicyText != nil {
+			if r.ko.Spec.RepositoryName != nil {
+				input := &svcsdk.PutLifecyclePolicyInput{}
+				input.RepositoryName = r.ko.Spec.RepositoryName
+				input.LifecyclePolicyText = r.ko.Spec.LifecyclePolicyText
+				_, err = rm.sdkapi.PutLifecyclePolicyWithContext(ctx, input)
+				rm.metrics.RecordAPICall("UPDATE", "PutLifecyclePolicy", err)
+				if err != nil {
+					return err
+				}
+			}
+		} else if delta != nil {
+			if r.ko.Spec.RepositoryName != nil {
+				input := &svcsdk.DeleteLifecyclePolicyInput{}
+				input.RepositoryName = r.ko.Spec.RepositoryName
+				_, err = rm.sdkapi.DeleteLifecyclePolicyWithContext(ctx, input)
+				rm.metrics.RecordAPICall("DELETE", "DeleteLifecyclePolicy", err)
+				if err != nil {
+					awsErr, ok := ackerr.AWSError(err)
+					if !ok || !(awsErr.Code() == "LifecyclePolicyNotFoundException") {
+						return err
+					}
+				}
+			}
+		}
+	}
+	// Write attachment PolicyText
+	if delta == nil || delta.DifferentAt("Spec.PolicyText") {
+		if r.ko.Spec.PolicyText != nil {
+			if r.ko.Spec.RepositoryName != nil {
+				input := &svcsdk.SetRepositoryPolicyInput{}
+				input.RepositoryName = r.ko.Spec.RepositoryName
+				input.PolicyText = r.ko.Spec.PolicyText
+				_, err = rm.sdkapi.SetRepositoryPolicyWithContext(ctx, input)
+				rm.metrics.RecordAPICall("UPDATE", "SetRepositoryPolicy", err)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+`
+	assert.Equal(expected, code.PutAttachments(crd.Config(), crd, "r", 1))
+}
@@ -0,0 +1,161 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws-controllers-k8s/pkg/names"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// attributeConversionFuncs maps an `attribute_type` to the names of the
+// functions, generated in `templates/pkg/resource/attributes.go.tpl`, that
+// convert an attribute's `*string` value to and from the field's Go type.
+var attributeConversionFuncs = map[string][2]string{
+	ackgenconfig.AttributeTypeBool:     {"attributeToBool", "attributeFromBool"},
+	ackgenconfig.AttributeTypeInt:      {"attributeToInt", "attributeFromInt"},
+	ackgenconfig.AttributeTypeDuration: {"attributeToDuration", "attributeFromDuration"},
+	ackgenconfig.AttributeTypeJSON:     {"attributeToJSON", "attributeFromJSON"},
+}
+
+// unpackAttribute returns the Go expression converting the supplied
+// `*string` attribute value expression to the attribute field's Go type.
+//
+// Sample output:
+//
+//	attributeToDuration(resp.Attributes["VisibilityTimeout"])
+func unpackAttribute(
+	fieldConfig *ackgenconfig.FieldConfig,
+	valueExpr string,
+) string {
+	funcs, found := attributeConversionFuncs[fieldConfig.AttributeType]
+	if !found {
+		return valueExpr
+	}
+	return fmt.Sprintf("%s(%s)", funcs[0], valueExpr)
+}
+
+// packAttribute returns the Go expression converting the supplied attribute
+// field value expression to the `*string` attribute value.
+//
+// Sample output:
+//
+//	attributeFromDuration(r.ko.Spec.VisibilityTimeout)
+func packAttribute(
+	fieldConfig *ackgenconfig.FieldConfig,
+	valueExpr string,
+) string {
+	funcs, found := attributeConversionFuncs[fieldConfig.AttributeType]
+	if !found {
+		return valueExpr
+	}
+	return fmt.Sprintf("%s(%s)", funcs[1], valueExpr)
+}
+
+// SetChangedAttributes returns the Go code that collects, into a
+// `map[string]*string` variable, the attributes to send in the resource's
+// SetAttributes calls when only the changed attributes are set: those whose
+// fields differ in the delta and those configured with
+// `attribute_update: always`.
+//
+// Sample output:
+//
+//	attrMap := map[string]*string{}
+//	if delta.DifferentAt("Spec.DeliveryPolicy") && r.ko.Spec.DeliveryPolicy != nil {
+//		attrMap["DeliveryPolicy"] = r.ko.Spec.DeliveryPolicy
+//	}
+//	if r.ko.Spec.Policy != nil {
+//		attrMap["Policy"] = r.ko.Spec.Policy
+//	}
+func SetChangedAttributes(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the resource,
+	// likely "r.ko"
+	sourceVarName string,
+	// String representing the name of the variable holding the
+	// *ackcompare.Delta, likely "delta"
+	deltaVarName string,
+	// String representing the name of the map variable to declare and fill
+	// in, likely "attrMap"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	fieldConfigs := cfg.GetFieldConfigs(r.Names.Original)
+
+	out := fmt.Sprintf("%s%s := map[string]*string{}\n", indent, targetVarName)
+	for _, fieldName := range r.GetSettableAttributeNames() {
+		fieldConfig := fieldConfigs[fieldName]
+		fieldNames := names.New(fieldName)
+		sourceAdaptedVarName := sourceVarName + cfg.PrefixConfig.SpecField + "." + fieldNames.Camel
+		condition := sourceAdaptedVarName + " != nil"
+		if fieldConfig.AttributeUpdate != ackgenconfig.AttributeUpdateAlways {
+			condition = fmt.Sprintf(
+				"%s.DifferentAt(%q) && %s",
+				deltaVarName, strings.TrimPrefix(cfg.PrefixConfig.SpecField, ".")+"."+fieldNames.Camel,
+				condition,
+			)
+		}
+		out += fmt.Sprintf("%sif %s {\n", indent, condition)
+		out += fmt.Sprintf(
+			"%s\t%s[%q] = %s\n",
+			indent, targetVarName, fieldName,
+			packAttribute(fieldConfig, sourceAdaptedVarName),
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
+
+// SetResourceAttributeSourcedFields returns the Go code that sets the Status
+// fields sourced, with a `from` config naming an `attribute`, from the
+// attributes map in the Output shape of the resource's GetAttributes
+// operation.
+//
+// Sample output:
+//
+//	ko.Status.ApproximateNumberOfMessages = attributeToInt(resp.Attributes["ApproximateNumberOfMessages"])
+func SetResourceAttributeSourcedFields(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the
+	// GetAttributes Output shape, likely "resp"
+	sourceVarName string,
+	// String representing the name of the variable holding the resource,
+	// likely "ko"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	out := ""
+	for _, field := range r.AttributeSourcedFields() {
+		from := field.FieldConfig.From
+		out += fmt.Sprintf(
+			"%s%s%s.%s = %s\n",
+			indent, targetVarName, cfg.PrefixConfig.StatusField, field.Names.Camel,
+			unpackAttribute(
+				field.FieldConfig,
+				fmt.Sprintf("%s.%s[%q]", sourceVarName, from.AttributesPath(), from.Attribute),
+			),
+		)
+	}
+	return out
+}
@@ -0,0 +1,200 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/fieldpath"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// DeleteAuxiliaryResources returns the Go code that cleans up the auxiliary
+// AWS resources provisioned alongside a resource, as configured in the
+// resource's `auxiliary_resources` generator config. Retained auxiliary
+// resources are skipped.
+//
+// Sample output:
+//
+//	// Clean up auxiliary resource LifecyclePolicy
+//	if r.ko.Spec.RepositoryName != nil {
+//		input := &svcsdk.DeleteLifecyclePolicyInput{}
+//		input.RepositoryName = r.ko.Spec.RepositoryName
+//		_, err = rm.sdkapi.DeleteLifecyclePolicyWithContext(ctx, input)
+//		rm.metrics.RecordAPICall("DELETE", "DeleteLifecyclePolicy", err)
+//		if err != nil {
+//			awsErr, ok := ackerr.AWSError(err)
+//			if !ok || !(awsErr.Code() == "LifecyclePolicyNotFoundException") {
+//				return err
+//			}
+//		}
+//	}
+func DeleteAuxiliaryResources(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// *resource variable name
+	resVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	for _, auxCfg := range cfg.GetAuxiliaryResources(r.Names.Original) {
+		if auxCfg.Retain {
+			out += fmt.Sprintf("%s// Auxiliary resource %s is retained\n", indent, auxCfg.Name)
+			continue
+		}
+		out += fmt.Sprintf("%s// Clean up auxiliary resource %s\n", indent, auxCfg.Name)
+		if auxCfg.CustomMethodName != "" {
+			out += fmt.Sprintf("%sif err = rm.%s(ctx, %s); err != nil {\n", indent, auxCfg.CustomMethodName, resVarName)
+			out += fmt.Sprintf("%s\treturn err\n", indent)
+			out += fmt.Sprintf("%s}\n", indent)
+			continue
+		}
+		out += deleteAuxiliaryResourceWithOperation(cfg, r, auxCfg, resVarName, indentLevel)
+	}
+	return out
+}
+
+// deleteAuxiliaryResourceWithOperation returns the Go code that calls the
+// DeleteOperation configured for an auxiliary resource.
+func deleteAuxiliaryResourceWithOperation(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	auxCfg *ackgenconfig.AuxiliaryResourceConfig,
+	resVarName string,
+	indentLevel int,
+) string {
+	op := r.GetOperation(auxCfg.DeleteOperation)
+	if op == nil {
+		panic(fmt.Sprintf("unable to find delete_operation %q of auxiliary "+
+			"resource %q. crd: %q", auxCfg.DeleteOperation, auxCfg.Name, r.Kind))
+	}
+	inputShape := op.InputRef.Shape
+
+	// Sort the input member names to generate deterministic code
+	memberNames := make([]string, 0, len(auxCfg.InputFields))
+	for memberName := range auxCfg.InputFields {
+		memberNames = append(memberNames, memberName)
+	}
+	sort.Strings(memberNames)
+
+	conditions := []string{}
+	assignments := []string{}
+	for _, memberName := range memberNames {
+		if _, found := inputShape.MemberRefs[memberName]; !found {
+			panic(fmt.Sprintf("unable to find member %q in input shape %q of "+
+				"auxiliary resource %q. crd: %q", memberName,
+				inputShape.ShapeName, auxCfg.Name, r.Kind))
+		}
+		accessor, nilChecks, err := auxiliaryFieldAccessor(r, auxCfg.InputFields[memberName], resVarName)
+		if err != nil {
+			panic(fmt.Sprintf("invalid input field %q of auxiliary resource "+
+				"%q: %v. crd: %q", memberName, auxCfg.Name, err, r.Kind))
+		}
+		conditions = append(conditions, nilChecks...)
+		assignments = append(assignments, fmt.Sprintf("input.%s = %s", memberName, accessor))
+	}
+
+	indent := strings.Repeat("\t", indentLevel)
+	innerIndent := indent
+	out := ""
+	if len(conditions) > 0 {
+		out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(conditions, " && "))
+		innerIndent += "\t"
+	} else {
+		out += fmt.Sprintf("%s{\n", indent)
+		innerIndent += "\t"
+	}
+	out += fmt.Sprintf("%sinput := &svcsdk.%s{}\n", innerIndent, inputShape.ShapeName)
+	for _, assignment := range assignments {
+		out += fmt.Sprintf("%s%s\n", innerIndent, assignment)
+	}
+	out += SDKCall(cfg, r, op, "_, err =", "input", indentLevel+1) + "\n"
+	out += fmt.Sprintf("%srm.metrics.RecordAPICall(\"DELETE\", %q, err)\n", innerIndent, op.ExportedName)
+	out += fmt.Sprintf("%sif err != nil {\n", innerIndent)
+	if len(auxCfg.NotFoundCodes) > 0 {
+		codeChecks := make([]string, 0, len(auxCfg.NotFoundCodes))
+		for _, code := range auxCfg.NotFoundCodes {
+			codeChecks = append(codeChecks, fmt.Sprintf("awsErr.Code() == %q", code))
+		}
+		out += fmt.Sprintf("%s\tawsErr, ok := ackerr.AWSError(err)\n", innerIndent)
+		out += fmt.Sprintf("%s\tif !ok || !(%s) {\n", innerIndent, strings.Join(codeChecks, " || "))
+		out += fmt.Sprintf("%s\t\treturn err\n", innerIndent)
+		out += fmt.Sprintf("%s\t}\n", innerIndent)
+		out += fmt.Sprintf("%s\terr = nil\n", innerIndent)
+	} else {
+		out += fmt.Sprintf("%s\treturn err\n", innerIndent)
+	}
+	out += fmt.Sprintf("%s}\n", innerIndent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// auxiliaryFieldAccessor returns the Go accessor of the resource field
+// identified by the supplied "Spec." or "Status." prefixed field path, along
+// with the nil checks guarding every element of the path. It returns an error
+// if the path does not start with Spec or Status or if its top-level field is
+// not a field of that part of the resource.
+func auxiliaryFieldAccessor(
+	r *model.CRD,
+	path string,
+	resVarName string,
+) (string, []string, error) {
+	fp := fieldpath.FromString(path)
+	if fp.Size() < 2 {
+		return "", nil, fmt.Errorf("path %q must start with Spec or Status", path)
+	}
+	cfg := r.Config()
+	accessor := resVarName + ".ko"
+	var fields map[string]*model.Field
+	switch head := fp.PopFront(); head {
+	case "Spec":
+		accessor += cfg.PrefixConfig.SpecField
+		fields = r.SpecFields
+	case "Status":
+		accessor += cfg.PrefixConfig.StatusField
+		fields = r.StatusFields
+	default:
+		return "", nil, fmt.Errorf("path %q must start with Spec or Status", path)
+	}
+	found := false
+	for _, f := range fields {
+		if f.Names.Camel == fp.Front() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return "", nil, fmt.Errorf(
+			"path %q does not refer to a field of %s", path, r.Names.Camel,
+		)
+	}
+	nilChecks := []string{}
+	for idx := 0; idx < fp.Size(); idx++ {
+		curFP := fp.CopyAt(idx).String()
+		cur, ok := r.Fields[curFP]
+		if !ok {
+			return "", nil, fmt.Errorf(
+				"path %q does not refer to a field of %s", path, r.Names.Camel,
+			)
+		}
+		accessor = fmt.Sprintf("%s.%s", accessor, cur.Names.Camel)
+		nilChecks = append(nilChecks, accessor+" != nil")
+	}
+	return accessor, nilChecks, nil
+}
@@ -0,0 +1,73 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestDeleteAuxiliaryResources_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-auxiliary-resources.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.True(crd.HasAuxiliaryResources())
+
+	expected := `	// Clean up auxiliary resource LifecyclePolicy
+	if r.ko.Status.RegistryID != nil && r.ko.Spec.RepositoryName != nil {
+		input := &svcsdk.DeleteLifecyclePolicyInput{}
+		input.RegistryId = r.ko.Status.RegistryID
+		input.RepositoryName = r.ko.Spec.RepositoryName
+		_, err = rm.sdkapi.DeleteLifecyclePolicyWithContext(ctx, input)
+		rm.metrics.RecordAPICall("DELETE", "DeleteLifecyclePolicy", err)
+		if err != nil {
+			awsErr, ok := ackerr.AWSError(err)
+			if !ok || !(awsErr.Code() == "LifecyclePolicyNotFoundException") {
+				return err
+			}
+			err = nil
+		}
+	}
+	// Auxiliary resource RepositoryPolicy is retained
+	// Clean up auxiliary resource ReplicationConfiguration
+	if err = rm.customDeleteReplicationConfiguration(ctx, r); err != nil {
+		return err
+	}
+`
+	assert.Equal(expected, code.DeleteAuxiliaryResources(crd.Config(), crd, "r", 1))
+
+	// RegistryID is a Status field of the Repository
+	auxCfg := crd.Config().GetAuxiliaryResources("Repository")[0]
+	auxCfg.InputFields["RegistryId"] = "Spec.RegistryID"
+	assert.PanicsWithValue(
+		`invalid input field "RegistryId" of auxiliary resource `+
+			`"LifecyclePolicy": path "Spec.RegistryID" does not refer to a `+
+			`field of Repository. crd: "Repository"`,
+		func() {
+			code.DeleteAuxiliaryResources(crd.Config(), crd, "r", 1)
+		},
+	)
+}
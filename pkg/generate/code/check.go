@@ -15,6 +15,7 @@ package code
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
@@ -22,6 +23,7 @@ import (
 	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
 	"github.com/aws-controllers-k8s/code-generator/pkg/fieldpath"
 	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
 )
 
 // CheckExceptionMessage returns Go code that contains a condition to
@@ -57,6 +59,98 @@ func CheckExceptionMessage(
 	return ""
 }
 
+// CheckTerminalExceptionMessages returns Go code that returns true when the
+// aws exception `awsErr` matches one of the terminal_messages specified for
+// the resource in generator config, panicking if a matcher has an invalid
+// regular expression or not exactly one of message_contains and
+// message_regex. The regular expressions are compiled once, in the
+// package-level variables returned by TerminalExceptionMessageRegexes.
+//
+// Sample Output:
+//
+//	if awsErr.Code() == "ValidationException" && strings.Contains(awsErr.Message(), "is not authorized to perform") {
+//		return true
+//	}
+//	if awsErr.Code() == "ValidationException" && terminalMessageRegex1.MatchString(awsErr.Message()) {
+//		return true
+//	}
+func CheckTerminalExceptionMessages(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	for x, matcher := range cfg.GetTerminalExceptionMessages(r.Names.Original) {
+		conditions := []string{}
+		if matcher.Code != "" {
+			conditions = append(conditions, fmt.Sprintf("awsErr.Code() == %q", matcher.Code))
+		}
+		switch {
+		case matcher.MessageContains != nil && matcher.MessageRegex == nil:
+			conditions = append(conditions, fmt.Sprintf(
+				"strings.Contains(awsErr.Message(), %q)", *matcher.MessageContains,
+			))
+		case matcher.MessageRegex != nil && matcher.MessageContains == nil:
+			if _, err := regexp.Compile(*matcher.MessageRegex); err != nil {
+				panic(fmt.Sprintf(
+					"invalid message_regex %q in terminal_messages of resource %q: %v",
+					*matcher.MessageRegex, r.Names.Original, err,
+				))
+			}
+			conditions = append(conditions, fmt.Sprintf(
+				"%s.MatchString(awsErr.Message())", terminalMessageRegexVarName(x),
+			))
+		default:
+			panic(fmt.Sprintf(
+				"terminal_messages of resource %q must have exactly one of "+
+					"message_contains and message_regex",
+				r.Names.Original,
+			))
+		}
+		out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(conditions, " && "))
+		out += fmt.Sprintf("%s\treturn true\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
+
+// TerminalExceptionMessageRegexes returns the Go code declaring the
+// package-level variables of the compiled message_regex of the
+// terminal_messages specified for the resource in generator config, named
+// after the index of their matcher.
+//
+// Sample Output:
+//
+//	terminalMessageRegex1 = regexp.MustCompile("^Could not access model data at .*")
+func TerminalExceptionMessageRegexes(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	for x, matcher := range cfg.GetTerminalExceptionMessages(r.Names.Original) {
+		if matcher.MessageRegex == nil {
+			continue
+		}
+		out += fmt.Sprintf(
+			"%s%s = regexp.MustCompile(%q)\n",
+			indent, terminalMessageRegexVarName(x), *matcher.MessageRegex,
+		)
+	}
+	return out
+}
+
+// terminalMessageRegexVarName returns the name of the variable of the
+// compiled message_regex of the terminal_messages matcher at the supplied
+// index
+func terminalMessageRegexVarName(index int) string {
+	return fmt.Sprintf("terminalMessageRegex%d", index)
+}
+
 // CheckRequiredFieldsMissingFromShape returns Go code that contains a
 // condition checking that the required fields in the supplied Shape have a
 // non-nil value in the corresponding CR's Spec or Status substruct.
@@ -71,13 +165,20 @@ func CheckRequiredFieldsMissingFromShape(
 	indentLevel int,
 ) string {
 	var op *awssdkmodel.Operation
+	// The read operations of a resource whose identity is a tuple of fields
+	// require all of those fields
+	var keyMissing []string
+	switch opType {
+	case model.OpTypeGet, model.OpTypeList:
+		for _, accessor := range compositeKeyAccessors(r.Config(), r, koVarName) {
+			keyMissing = append(keyMissing, accessor+" == nil")
+		}
+	}
 	switch opType {
 	case model.OpTypeGet:
 		op = r.Ops.ReadOne
 	case model.OpTypeList:
 		op = r.Ops.ReadMany
-		return checkRequiredFieldsMissingFromShapeReadMany(
-			r, koVarName, indentLevel, op, op.InputRef.Shape)
 	case model.OpTypeGetAttributes:
 		op = r.Ops.GetAttributes
 	case model.OpTypeSetAttributes:
@@ -86,6 +187,16 @@ func CheckRequiredFieldsMissingFromShape(
 		return ""
 	}
 
+	if reqCfg := r.GetRequiredFieldsConfig(op); reqCfg != nil {
+		return checkRequiredFieldsMissingFromConfig(
+			r, koVarName, indentLevel, op, reqCfg, keyMissing,
+		)
+	}
+	if opType == model.OpTypeList {
+		return checkRequiredFieldsMissingFromShapeReadMany(
+			r, koVarName, indentLevel, op, op.InputRef.Shape, keyMissing)
+	}
+
 	shape := op.InputRef.Shape
 	return checkRequiredFieldsMissingFromShape(
 		r,
@@ -93,6 +204,36 @@ func CheckRequiredFieldsMissingFromShape(
 		indentLevel,
 		op,
 		shape,
+		keyMissing,
+	)
+}
+
+// CheckRequiredFieldsMissingFromOperationInput returns Go code that contains
+// a condition checking that the fields required to call one of the
+// resource's additional ReadOne operations, configured in the resource's
+// `read_one_operations`, are missing. The operation's `required_fields`, if
+// any, take precedence over the required members of its Input shape.
+//
+// Sample Output:
+//
+// return r.ko.Spec.FunctionName == nil
+func CheckRequiredFieldsMissingFromOperationInput(
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	koVarName string,
+	indentLevel int,
+) string {
+	var keyMissing []string
+	for _, accessor := range compositeKeyAccessors(r.Config(), r, koVarName) {
+		keyMissing = append(keyMissing, accessor+" == nil")
+	}
+	if reqCfg := r.GetRequiredFieldsConfig(op); reqCfg != nil {
+		return checkRequiredFieldsMissingFromConfig(
+			r, koVarName, indentLevel, op, reqCfg, keyMissing,
+		)
+	}
+	return checkRequiredFieldsMissingFromShape(
+		r, koVarName, indentLevel, op, op.InputRef.Shape, keyMissing,
 	)
 }
 
@@ -102,9 +243,11 @@ func checkRequiredFieldsMissingFromShape(
 	indentLevel int,
 	op *awssdkmodel.Operation,
 	shape *awssdkmodel.Shape,
+	// Additional conditions on the fields of a composite primary key
+	keyMissing []string,
 ) string {
 	indent := strings.Repeat("\t", indentLevel)
-	if shape == nil || len(shape.Required) == 0 {
+	if (shape == nil || len(shape.Required) == 0) && len(keyMissing) == 0 {
 		return fmt.Sprintf("%sreturn false", indent)
 	}
 
@@ -113,7 +256,11 @@ func checkRequiredFieldsMissingFromShape(
 	// generate an if condition checking for all required fields having non-nil
 	// corresponding resource Spec/Status values
 	missing := []string{}
-	for _, memberName := range shape.Required {
+	var required []string
+	if shape != nil {
+		required = shape.Required
+	}
+	for _, memberName := range required {
 		if r.UnpacksAttributesMap() {
 			// We set the Attributes field specially... depending on whether
 			// the SetAttributes API call uses the batch or single attribute
@@ -150,12 +297,140 @@ func checkRequiredFieldsMissingFromShape(
 		}
 		missing = append(missing, fmt.Sprintf("%s == nil", resVarPath))
 	}
+	missing = appendKeyMissing(missing, keyMissing)
 	// Use '||' because if any of the required fields are missing the object
 	// is not created yet
 	missingCondition := strings.Join(missing, " || ")
 	return fmt.Sprintf("%sreturn %s\n", indent, missingCondition)
 }
 
+// checkRequiredFieldsMissingFromConfig returns Go code that contains a
+// condition checking that the fields configured in the operation's
+// `required_fields` are missing: any of the `all_of` fields or all of the
+// `any_of` fields. The fields of a composite primary key are required as
+// well.
+//
+// Sample Output:
+//
+// return r.ko.Spec.WorkGroupName == nil && r.ko.Status.ID == nil
+func checkRequiredFieldsMissingFromConfig(
+	r *model.CRD,
+	koVarName string,
+	indentLevel int,
+	op *awssdkmodel.Operation,
+	reqCfg *ackgenconfig.RequiredFieldsConfig,
+	// Additional conditions on the fields of a composite primary key
+	keyMissing []string,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	if len(reqCfg.AllOf) == 0 && len(reqCfg.AnyOf) == 0 {
+		msg := fmt.Sprintf(
+			"required_fields of operation %s must set at least one of all_of and any_of",
+			op.ExportedName,
+		)
+		panic(msg)
+	}
+	missing := []string{}
+	for _, path := range reqCfg.AllOf {
+		missing = append(missing, requiredFieldPathMissing(r, koVarName, op, path))
+	}
+	if len(reqCfg.AnyOf) > 0 {
+		allMissing := []string{}
+		for _, path := range reqCfg.AnyOf {
+			allMissing = append(allMissing, requiredFieldPathMissing(r, koVarName, op, path))
+		}
+		anyOfCondition := strings.Join(allMissing, " && ")
+		if len(missing) > 0 && len(allMissing) > 1 {
+			anyOfCondition = "(" + anyOfCondition + ")"
+		}
+		missing = append(missing, anyOfCondition)
+	}
+	missing = appendKeyMissing(missing, keyMissing)
+	return fmt.Sprintf("%sreturn %s\n", indent, strings.Join(missing, " || "))
+}
+
+// requiredFieldPathMissing returns the condition that is true when the field
+// at the supplied path, e.g. "Status.ACKResourceMetadata.ARN", is not set,
+// panicking if the path does not start with a top-level Spec or Status field
+// of the resource.
+//
+// Sample Output:
+//
+// (r.ko.Status.ACKResourceMetadata == nil || r.ko.Status.ACKResourceMetadata.ARN == nil)
+func requiredFieldPathMissing(
+	r *model.CRD,
+	koVarName string,
+	op *awssdkmodel.Operation,
+	path string,
+) string {
+	accessors, err := fieldPathAccessors(r, koVarName, path)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"invalid path in required_fields of operation %s: %v",
+			op.ExportedName, err,
+		))
+	}
+	conditions := []string{}
+	for _, accessor := range accessors {
+		conditions = append(conditions, accessor+" == nil")
+	}
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+	return "(" + strings.Join(conditions, " || ") + ")"
+}
+
+// fieldPathAccessors returns the accessors of the fields along the supplied
+// path, e.g. "Status.ACKResourceMetadata.ARN", from the top-level Spec or
+// Status field to the field at the path, or an error if the path does not
+// start with a top-level Spec or Status field of the resource.
+//
+// Sample Output:
+//
+// [r.ko.Status.ACKResourceMetadata r.ko.Status.ACKResourceMetadata.ARN]
+func fieldPathAccessors(
+	r *model.CRD,
+	koVarName string,
+	path string,
+) ([]string, error) {
+	fp := fieldpath.FromString(path)
+	if fp.Size() < 2 {
+		return nil, fmt.Errorf("path %q has no field", path)
+	}
+	root := fp.PopFront()
+	fieldName := fp.Front()
+	// The common Status.ACKResourceMetadata field is not a field of the
+	// resource's model
+	found := root == "Status" && fieldName == "ACKResourceMetadata"
+	var fields map[string]*model.Field
+	switch root {
+	case "Spec":
+		fields = r.SpecFields
+	case "Status":
+		fields = r.StatusFields
+	default:
+		return nil, fmt.Errorf("path %q must start with Spec or Status", path)
+	}
+	for _, f := range fields {
+		if f.Names.Camel == fieldName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf(
+			"path %q does not refer to a field of %s", path, r.Names.Camel,
+		)
+	}
+	accessors := []string{}
+	accessor := koVarName + "." + root
+	for fp.Size() > 0 {
+		accessor += "." + fp.PopFront()
+		accessors = append(accessors, accessor)
+	}
+	return accessors, nil
+}
+
 // checkRequiredFieldsMissingFromShapeReadMany is a special-case handling
 // of those APIs where there is no ReadOne operation and instead the only way to
 // grab information for a single object is to call the ReadMany/List operation
@@ -178,21 +453,40 @@ func checkRequiredFieldsMissingFromShapeReadMany(
 	indentLevel int,
 	op *awssdkmodel.Operation,
 	shape *awssdkmodel.Shape,
+	// Additional conditions on the fields of a composite primary key
+	keyMissing []string,
 ) string {
 	indent := strings.Repeat("\t", indentLevel)
 	result := fmt.Sprintf("%sreturn false", indent)
 
 	reqIdentifier, _ := FindPluralizedIdentifiersInShape(r, shape, op)
 
+	missing := []string{}
 	resVarPath, err := r.GetSanitizedMemberPath(reqIdentifier, op, koVarName)
-	if err != nil {
+	if err == nil {
+		missing = append(missing, fmt.Sprintf("%s == nil", resVarPath))
+	}
+	missing = appendKeyMissing(missing, keyMissing)
+	if len(missing) == 0 {
 		return result
 	}
 
-	result = fmt.Sprintf("%s == nil", resVarPath)
+	result = strings.Join(missing, " || ")
 	return fmt.Sprintf("%sreturn %s\n", indent, result)
 }
 
+// appendKeyMissing returns the supplied missing field conditions along with
+// the conditions on the fields of a composite primary key they do not
+// already contain
+func appendKeyMissing(missing []string, keyMissing []string) []string {
+	for _, condition := range keyMissing {
+		if !util.InStrings(condition, missing) {
+			missing = append(missing, condition)
+		}
+	}
+	return missing
+}
+
 // CheckNilFieldPath returns the condition statement for Nil check
 // on a field path. This nil check on field path is useful to avoid
 // nil pointer panics when accessing a field value.
@@ -118,6 +118,29 @@ func TestCheckRequiredFields_RenamedSpecField(t *testing.T) {
 	)
 }
 
+func TestCheckRequiredFields_RequiredFieldsConfig(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-required-fields.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "FargateProfile")
+	require.NotNil(crd)
+
+	expRequiredFieldsCode := `
+	return r.ko.Spec.ClusterName == nil || (r.ko.Spec.Name == nil && (r.ko.Status.ACKResourceMetadata == nil || r.ko.Status.ACKResourceMetadata.ARN == nil)) || r.ko.Spec.Name == nil
+`
+	gotCode := code.CheckRequiredFieldsMissingFromShape(
+		crd, model.OpTypeGet, "r.ko", 1,
+	)
+	assert.Equal(
+		strings.TrimSpace(expRequiredFieldsCode),
+		strings.TrimSpace(gotCode),
+	)
+}
+
 func TestCheckRequiredFields_StatusField_ReadMany(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -139,6 +162,29 @@ func TestCheckRequiredFields_StatusField_ReadMany(t *testing.T) {
 	)
 }
 
+func TestCheckRequiredFields_CompositePrimaryKey_ReadMany(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-composite-primary-key.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	expRequiredFieldsCode := `
+	return r.ko.Spec.RepositoryName == nil || r.ko.Status.RegistryID == nil
+`
+	gotCode := code.CheckRequiredFieldsMissingFromShape(
+		crd, model.OpTypeList, "r.ko", 1,
+	)
+	assert.Equal(
+		strings.TrimSpace(expRequiredFieldsCode),
+		strings.TrimSpace(gotCode),
+	)
+}
+
 func TestCheckNilFieldPath(t *testing.T) {
 	// Empty FieldPath
 	field := model.Field{Path: ""}
@@ -179,3 +225,38 @@ func TestCheckNilReferencesPath(t *testing.T) {
 		"obj.Status.ACKResourceMetadata == nil || obj.Status.ACKResourceMetadata.ARN == nil",
 		code.CheckNilReferencesPath(&field, "obj"))
 }
+
+func TestCheckTerminalExceptionMessages(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sagemaker",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-terminal-messages.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "TrainingJob")
+	require.NotNil(crd)
+	assert.Equal([]string{"ResourceLimitExceeded"}, crd.TerminalExceptionCodes())
+	assert.True(crd.HasTerminalExceptionMessageRegexes())
+
+	expected := `	if awsErr.Code() == "ValidationException" && strings.Contains(awsErr.Message(), "is not authorized to perform") {
+		return true
+	}
+	if awsErr.Code() == "ValidationException" && terminalMessageRegex1.MatchString(awsErr.Message()) {
+		return true
+	}
+`
+	assert.Equal(expected, code.CheckTerminalExceptionMessages(crd.Config(), crd, 1))
+	// The regular expressions are compiled once, in package-level variables
+	assert.Equal(
+		"\tterminalMessageRegex1 = regexp.MustCompile(\"^Could not access model data at .*\")\n",
+		code.TerminalExceptionMessageRegexes(crd.Config(), crd, 1),
+	)
+
+	crd = testutil.GetCRDByName(t, g, "Endpoint")
+	require.NotNil(crd)
+	assert.False(crd.HasTerminalExceptionMessageRegexes())
+	assert.Empty(code.CheckTerminalExceptionMessages(crd.Config(), crd, 1))
+	assert.Empty(code.TerminalExceptionMessageRegexes(crd.Config(), crd, 1))
+}
@@ -0,0 +1,355 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// FindCollections returns the Go code that reads the collections of a
+// resource, as configured in the resource's `collections` generator config,
+// into their Spec fields. All pages of the list operation are read when its
+// Input and Output shapes have either a NextToken member or Marker and
+// IsTruncated members.
+//
+// Sample output:
+//
+//	// Read collection Policies
+//	if r.ko.Spec.Name != nil {
+//		input := &svcsdk.ListAttachedRolePoliciesInput{}
+//		input.RoleName = r.ko.Spec.Name
+//		values := []*string{}
+//		for {
+//			resp, err := rm.sdkapi.ListAttachedRolePoliciesWithContext(ctx, input)
+//			rm.metrics.RecordAPICall("READ_MANY", "ListAttachedRolePolicies", err)
+//			if err != nil {
+//				return err
+//			}
+//			for _, elem := range resp.AttachedPolicies {
+//				values = append(values, elem.PolicyArn)
+//			}
+//			if resp.IsTruncated == nil || !*resp.IsTruncated {
+//				break
+//			}
+//			input.Marker = resp.Marker
+//		}
+//		r.ko.Spec.Policies = values
+//	}
+func FindCollections(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// *resource variable name
+	resVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	for _, colCfg := range cfg.GetCollections(r.Names.Original) {
+		subject := fmt.Sprintf("collection %q", colCfg.Field)
+		fieldAccessor, elemType := collectionFieldAccessor(r, colCfg, resVarName)
+		op := collectionOperation(r, colCfg, colCfg.ListOperation, "list_operation")
+		listPath := strings.Split(colCfg.GetListPath(), ".")
+		collectionListPath(r, colCfg, op, listPath)
+		paginated := ""
+		inputShape := op.InputRef.Shape
+		outputShape := op.OutputRef.Shape
+		if inputShape.MemberRefs["NextToken"] != nil &&
+			outputShape.MemberRefs["NextToken"] != nil {
+			paginated = "NextToken"
+		} else if inputShape.MemberRefs["Marker"] != nil &&
+			outputShape.MemberRefs["Marker"] != nil &&
+			outputShape.MemberRefs["IsTruncated"] != nil {
+			paginated = "Marker"
+		}
+
+		out += fmt.Sprintf("%s// Read collection %s\n", indent, colCfg.Field)
+		out += subAPIInputBlockOpen(r, colCfg.InputFields, subject, op, resVarName, indentLevel)
+		innerIndent := indent + "\t"
+		out += fmt.Sprintf("%svalues := []*%s{}\n", innerIndent, elemType)
+		if paginated != "" {
+			out += fmt.Sprintf("%sfor {\n", innerIndent)
+			innerIndent += "\t"
+		}
+		out += SDKCall(cfg, r, op, "resp, err :=", "input", len(innerIndent)) + "\n"
+		out += fmt.Sprintf("%srm.metrics.RecordAPICall(\"READ_MANY\", %q, err)\n", innerIndent, op.ExportedName)
+		out += fmt.Sprintf("%sif err != nil {\n", innerIndent)
+		out += fmt.Sprintf("%s\treturn err\n", innerIndent)
+		out += fmt.Sprintf("%s}\n", innerIndent)
+		if len(listPath) == 1 {
+			out += fmt.Sprintf("%svalues = append(values, resp.%s...)\n", innerIndent, listPath[0])
+		} else {
+			out += fmt.Sprintf("%sfor _, elem := range resp.%s {\n", innerIndent, listPath[0])
+			out += fmt.Sprintf("%s\tvalues = append(values, elem.%s)\n", innerIndent, listPath[1])
+			out += fmt.Sprintf("%s}\n", innerIndent)
+		}
+		switch paginated {
+		case "NextToken":
+			out += fmt.Sprintf("%sif resp.NextToken == nil {\n", innerIndent)
+			out += fmt.Sprintf("%s\tbreak\n", innerIndent)
+			out += fmt.Sprintf("%s}\n", innerIndent)
+			out += fmt.Sprintf("%sinput.NextToken = resp.NextToken\n", innerIndent)
+		case "Marker":
+			out += fmt.Sprintf("%sif resp.IsTruncated == nil || !*resp.IsTruncated {\n", innerIndent)
+			out += fmt.Sprintf("%s\tbreak\n", innerIndent)
+			out += fmt.Sprintf("%s}\n", innerIndent)
+			out += fmt.Sprintf("%sinput.Marker = resp.Marker\n", innerIndent)
+		}
+		if paginated != "" {
+			out += fmt.Sprintf("%s\t}\n", indent)
+		}
+		out += fmt.Sprintf("%s\t%s = values\n", indent, fieldAccessor)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
+
+// SyncCollections returns the Go code that syncs the collections of a
+// resource, as configured in the resource's `collections` generator config,
+// with their Spec fields. Members of the desired collection missing from the
+// latest observed collection are added, and members of the latest observed
+// collection missing from the desired collection are removed. With a nil
+// delta, e.g. after the resource is created, the latest observed resource is
+// nil and every member is added. Otherwise only the collections that differ
+// are synced.
+//
+// Sample output:
+//
+//	// Sync collection Policies
+//	if delta == nil || delta.DifferentAt("Spec.Policies") {
+//		desiredValues := map[string]bool{}
+//		for _, value := range r.ko.Spec.Policies {
+//			if value != nil {
+//				desiredValues[*value] = true
+//			}
+//		}
+//		latestValues := map[string]bool{}
+//		if latest != nil {
+//			for _, value := range latest.ko.Spec.Policies {
+//				if value != nil {
+//					latestValues[*value] = true
+//				}
+//			}
+//		}
+//		for value := range latestValues {
+//			if desiredValues[value] {
+//				continue
+//			}
+//			if r.ko.Spec.Name != nil {
+//				input := &svcsdk.DetachRolePolicyInput{}
+//				input.RoleName = r.ko.Spec.Name
+//				input.PolicyArn = aws.String(value)
+//				_, err := rm.sdkapi.DetachRolePolicyWithContext(ctx, input)
+//				rm.metrics.RecordAPICall("UPDATE", "DetachRolePolicy", err)
+//				if err != nil {
+//					awsErr, ok := ackerr.AWSError(err)
+//					if !ok || !(awsErr.Code() == "NoSuchEntity") {
+//						return err
+//					}
+//				}
+//			}
+//		}
+//		for value := range desiredValues {
+//			if latestValues[value] {
+//				continue
+//			}
+//			if r.ko.Spec.Name != nil {
+//				input := &svcsdk.AttachRolePolicyInput{}
+//				input.RoleName = r.ko.Spec.Name
+//				input.PolicyArn = aws.String(value)
+//				_, err := rm.sdkapi.AttachRolePolicyWithContext(ctx, input)
+//				rm.metrics.RecordAPICall("UPDATE", "AttachRolePolicy", err)
+//				if err != nil {
+//					return err
+//				}
+//			}
+//		}
+//	}
+func SyncCollections(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// *resource variable name of the desired resource
+	resVarName string,
+	// *resource variable name of the latest observed resource
+	latestVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	specPrefix := strings.TrimPrefix(cfg.PrefixConfig.SpecField, ".")
+	for _, colCfg := range cfg.GetCollections(r.Names.Original) {
+		subject := fmt.Sprintf("collection %q", colCfg.Field)
+		fieldAccessor, elemType := collectionFieldAccessor(r, colCfg, resVarName)
+		latestAccessor, _ := collectionFieldAccessor(r, colCfg, latestVarName)
+		removeOp := collectionOperation(r, colCfg, colCfg.RemoveOperation, "remove_operation")
+		addOp := collectionOperation(r, colCfg, colCfg.AddOperation, "add_operation")
+		member := colCfg.GetMember()
+		for _, op := range []*awssdkmodel.Operation{removeOp, addOp} {
+			memberRef := op.InputRef.Shape.MemberRefs[member]
+			if memberRef == nil {
+				panic(fmt.Sprintf("unable to find member %q in input shape of "+
+					"%q of collection %q. crd: %q", member,
+					op.ExportedName, colCfg.Field, r.Kind))
+			}
+			if memberRef.Shape.GoTypeElem() != elemType {
+				panic(fmt.Sprintf("member %q in input shape of %q of "+
+					"collection %q must be of type %q. crd: %q", member,
+					op.ExportedName, colCfg.Field, elemType, r.Kind))
+			}
+		}
+		awsValueFunc := collectionAWSValueFuncs[elemType]
+
+		out += fmt.Sprintf("%s// Sync collection %s\n", indent, colCfg.Field)
+		out += fmt.Sprintf(
+			"%sif delta == nil || delta.DifferentAt(\"%s.%s\") {\n",
+			indent, specPrefix, colCfg.Field,
+		)
+		out += fmt.Sprintf("%s\tdesiredValues := map[%s]bool{}\n", indent, elemType)
+		out += fmt.Sprintf("%s\tfor _, value := range %s {\n", indent, fieldAccessor)
+		out += fmt.Sprintf("%s\t\tif value != nil {\n", indent)
+		out += fmt.Sprintf("%s\t\t\tdesiredValues[*value] = true\n", indent)
+		out += fmt.Sprintf("%s\t\t}\n", indent)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf("%s\tlatestValues := map[%s]bool{}\n", indent, elemType)
+		out += fmt.Sprintf("%s\tif %s != nil {\n", indent, latestVarName)
+		out += fmt.Sprintf("%s\t\tfor _, value := range %s {\n", indent, latestAccessor)
+		out += fmt.Sprintf("%s\t\t\tif value != nil {\n", indent)
+		out += fmt.Sprintf("%s\t\t\t\tlatestValues[*value] = true\n", indent)
+		out += fmt.Sprintf("%s\t\t\t}\n", indent)
+		out += fmt.Sprintf("%s\t\t}\n", indent)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		innerIndent := indent + "\t\t\t"
+		for _, step := range []struct {
+			op       *awssdkmodel.Operation
+			from     string
+			skipIn   string
+			notFound []string
+		}{
+			{removeOp, "latestValues", "desiredValues", colCfg.NotFoundCodes},
+			{addOp, "desiredValues", "latestValues", nil},
+		} {
+			out += fmt.Sprintf("%s\tfor value := range %s {\n", indent, step.from)
+			out += fmt.Sprintf("%s\t\tif %s[value] {\n", indent, step.skipIn)
+			out += fmt.Sprintf("%s\t\t\tcontinue\n", indent)
+			out += fmt.Sprintf("%s\t\t}\n", indent)
+			out += subAPIInputBlockOpen(r, colCfg.InputFields, subject, step.op, resVarName, indentLevel+2)
+			out += fmt.Sprintf("%sinput.%s = %s(value)\n", innerIndent, member, awsValueFunc)
+			out += SDKCall(cfg, r, step.op, "_, err :=", "input", len(innerIndent)) + "\n"
+			out += fmt.Sprintf("%srm.metrics.RecordAPICall(\"UPDATE\", %q, err)\n", innerIndent, step.op.ExportedName)
+			out += fmt.Sprintf("%sif err != nil {\n", innerIndent)
+			out += notFoundCodesCheck(step.notFound, innerIndent+"\t")
+			out += fmt.Sprintf("%s}\n", innerIndent)
+			out += fmt.Sprintf("%s\t\t}\n", indent)
+			out += fmt.Sprintf("%s\t}\n", indent)
+		}
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
+
+// collectionAWSValueFuncs maps the Go types of the supported collection
+// elements to the aws package function returning a pointer to a value
+var collectionAWSValueFuncs = map[string]string{
+	"string":  "aws.String",
+	"int64":   "aws.Int64",
+	"float64": "aws.Float64",
+	"bool":    "aws.Bool",
+}
+
+// collectionFieldAccessor returns the Go accessor of the Spec field of a
+// collection and the Go type of its elements, panicking if the field does
+// not exist or is not a list of scalars.
+func collectionFieldAccessor(
+	r *model.CRD,
+	colCfg *ackgenconfig.CollectionConfig,
+	resVarName string,
+) (string, string) {
+	field, found := r.SpecFields[colCfg.Field]
+	if !found {
+		panic(fmt.Sprintf("unable to find Spec field %q of collection. "+
+			"crd: %q", colCfg.Field, r.Kind))
+	}
+	elemType := strings.TrimPrefix(field.GoType, "[]*")
+	if _, supported := collectionAWSValueFuncs[elemType]; !supported ||
+		!strings.HasPrefix(field.GoType, "[]*") {
+		panic(fmt.Sprintf("collection field %q must be a list of scalars. "+
+			"crd: %q", colCfg.Field, r.Kind))
+	}
+	return fmt.Sprintf(
+		"%s.ko%s.%s", resVarName, r.Config().PrefixConfig.SpecField,
+		field.Names.Camel,
+	), elemType
+}
+
+// collectionOperation returns the API operation with the supplied ID,
+// panicking if it does not exist.
+func collectionOperation(
+	r *model.CRD,
+	colCfg *ackgenconfig.CollectionConfig,
+	opID string,
+	// name of the collection config key, for error messages
+	opKey string,
+) *awssdkmodel.Operation {
+	op := r.GetOperation(opID)
+	if op == nil {
+		panic(fmt.Sprintf("unable to find %s %q of collection %q. crd: %q",
+			opKey, opID, colCfg.Field, r.Kind))
+	}
+	return op
+}
+
+// collectionListPath panics unless the supplied list path, in the Output
+// shape of the list operation, leads either to a list of scalars or to a
+// scalar member of a list of structures.
+func collectionListPath(
+	r *model.CRD,
+	colCfg *ackgenconfig.CollectionConfig,
+	op *awssdkmodel.Operation,
+	listPath []string,
+) {
+	invalid := func() {
+		panic(fmt.Sprintf("list_path %q in output shape of list_operation "+
+			"%q of collection %q must lead to a list of scalars or to a "+
+			"scalar member of a list of structures. crd: %q",
+			colCfg.GetListPath(), op.ExportedName, colCfg.Field, r.Kind))
+	}
+	if op.OutputRef.Shape == nil || len(listPath) > 2 {
+		invalid()
+	}
+	listRef := op.OutputRef.Shape.MemberRefs[listPath[0]]
+	if listRef == nil || listRef.Shape.Type != "list" {
+		invalid()
+	}
+	elemShape := listRef.Shape.MemberRef.Shape
+	if len(listPath) == 1 {
+		if elemShape.Type == "structure" {
+			invalid()
+		}
+		return
+	}
+	if elemShape.Type != "structure" ||
+		elemShape.MemberRefs[listPath[1]] == nil ||
+		elemShape.MemberRefs[listPath[1]].Shape.Type == "structure" ||
+		elemShape.MemberRefs[listPath[1]].Shape.Type == "list" ||
+		elemShape.MemberRefs[listPath[1]].Shape.Type == "map" {
+		invalid()
+	}
+}
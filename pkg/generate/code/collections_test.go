@@ -0,0 +1,131 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestFindCollections_IAM_Role(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-collections.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+	assert.True(crd.HasCollections())
+	assert.False(crd.HasAttachments())
+	assert.Equal([]string{"Spec.Policies"}, crd.GetCollectionFieldPaths())
+	assert.Equal([]string{"Spec.Policies"}, crd.GetSubAPIFieldPaths())
+
+	expected := `	// Read collection Policies
+	if r.ko.Spec.Name != nil {
+		input := &svcsdk.ListAttachedRolePoliciesInput{}
+		input.RoleName = r.ko.Spec.Name
+		values := []*string{}
+		for {
+			resp, err := rm.sdkapi.ListAttachedRolePoliciesWithContext(ctx, input)
+			rm.metrics.RecordAPICall("READ_MANY", "ListAttachedRolePolicies", err)
+			if err != nil {
+				return err
+			}
+			for _, elem := range resp.AttachedPolicies {
+				values = append(values, elem.PolicyArn)
+			}
+			if resp.IsTruncated == nil || !*resp.IsTruncated {
+				break
+			}
+			input.Marker = resp.Marker
+		}
+		r.ko.Spec.Policies = values
+	}
+`
+	assert.Equal(expected, code.FindCollections(crd.Config(), crd, "r", 1))
+}
+
+func TestSyncCollections_IAM_Role(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-collections.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+
+	expected := `	// Sync collection Policies
+	if delta == nil || delta.DifferentAt("Spec.Policies") {
+		desiredValues := map[string]bool{}
+		for _, value := range r.ko.Spec.Policies {
+			if value != nil {
+				desiredValues[*value] = true
+			}
+		}
+		latestValues := map[string]bool{}
+		if latest != nil {
+			for _, value := range latest.ko.Spec.Policies {
+				if value != nil {
+					latestValues[*value] = true
+				}
+			}
+		}
+		for value := range latestValues {
+			if desiredValues[value] {
+				continue
+			}
+			if r.ko.Spec.Name != nil {
+				input := &svcsdk.DetachRolePolicyInput{}
+				input.RoleName = r.ko.Spec.Name
+				input.PolicyArn = aws.String(value)
+				_, err := rm.sdkapi.DetachRolePolicyWithContext(ctx, input)
+				rm.metrics.RecordAPICall("UPDATE", "DetachRolePolicy", err)
+				if err != nil {
+					awsErr, ok := ackerr.AWSError(err)
+					if !ok || !(awsErr.Code() == "NoSuchEntity") {
+						return err
+					}
+				}
+			}
+		}
+		for value := range desiredValues {
+			if latestValues[value] {
+				continue
+			}
+			if r.ko.Spec.Name != nil {
+				input := &svcsdk.AttachRolePolicyInput{}
+				input.RoleName = r.ko.Spec.Name
+				input.PolicyArn = aws.String(value)
+				_, err := rm.sdkapi.AttachRolePolicyWithContext(ctx, input)
+				rm.metrics.RecordAPICall("UPDATE", "AttachRolePolicy", err)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+`
+	assert.Equal(expected, code.SyncCollections(crd.Config(), crd, "r", "latest", 1))
+}
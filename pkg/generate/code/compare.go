@@ -25,6 +25,25 @@ import (
 	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 )
 
+// timestampShape is the shape Spec fields holding string timestamps of the
+// AWS API, parsed into a metav1.Time, are compared as. quantityShape is the
+// shape Spec fields holding sizes of the AWS API, converted into a
+// resource.Quantity, are compared as. jsonValueShape is the shape fields
+// backed by aws.JSONValue members, which are runtime.RawExtension, are
+// compared as. The "quantity" and "jsonvalue" shape types do not exist in the
+// AWS API models.
+var (
+	timestampShape = &awssdkmodel.Shape{
+		Type: "timestamp",
+	}
+	quantityShape = &awssdkmodel.Shape{
+		Type: "quantity",
+	}
+	jsonValueShape = &awssdkmodel.Shape{
+		Type: "jsonvalue",
+	}
+)
+
 // CompareResource returns the Go code that traverses a set of two Resources,
 // adding differences between the two Resources to an `ackcompare.Delta`
 //
@@ -128,9 +147,16 @@ func CompareResource(
 		if fieldConfig != nil {
 			compareConfig = fieldConfig.Compare
 		}
-
-		if compareConfig != nil && compareConfig.IsIgnored {
-			continue
+		// Attribute fields holding JSON documents are always compared
+		// semantically
+		if fieldConfig != nil && fieldConfig.IsAttribute &&
+			fieldConfig.AttributeType == ackgenconfig.AttributeTypeJSON {
+			jsonCompareConfig := ackgenconfig.CompareFieldConfig{}
+			if compareConfig != nil {
+				jsonCompareConfig = *compareConfig
+			}
+			jsonCompareConfig.IsJSON = true
+			compareConfig = &jsonCompareConfig
 		}
 
 		// this is the "path" to the field within the structs being compared.
@@ -140,12 +166,34 @@ func CompareResource(
 			cfg.PrefixConfig.SpecField+"."+specField.Names.Camel, ".",
 		)
 
+		if compareConfig != nil && compareConfig.IsIgnored {
+			continue
+		}
+		if r.IsCompareIgnoredPath(fieldPath) {
+			continue
+		}
+
+		// Delegate the comparison to a hand-written function if configured
+		if compareConfig != nil && compareConfig.CustomMethodName != "" {
+			out += compareCustom(
+				compareConfig,
+				deltaVarName,
+				firstResAdaptedVarName,
+				secondResAdaptedVarName,
+				indentLevel,
+			)
+			continue
+		}
+
 		// Use reflect.DeepEqual for comparing Reference fields because
 		// some of reference fields are list of pointer to structs and
-		// DeepEqual is easy way to compare them
-		if specField.IsReference() {
-			out += fmt.Sprintf("%sif !reflect.DeepEqual(%s, %s) {\n",
-				indent, firstResAdaptedVarName, secondResAdaptedVarName)
+		// DeepEqual is easy way to compare them. The same goes for the
+		// SecretKeyReference fields identifying where output values are
+		// written to.
+		if specField.IsReference() || specField.IsSecretRef() {
+			out += fmt.Sprintf("%sif !%s(%s, %s) {\n",
+				indent, deepEqualFunc(compareConfig),
+				firstResAdaptedVarName, secondResAdaptedVarName)
 			out += fmt.Sprintf("%s\t%s.Add(\"%s\", %s, %s)\n", indent,
 				deltaVarName, fieldPath, firstResAdaptedVarName,
 				secondResAdaptedVarName)
@@ -156,12 +204,22 @@ func CompareResource(
 		// Use a special comparison model for tags, since they need to be
 		// converted into the common ACK tag type before doing a map delta
 		if tagField != nil && specField == tagField {
-			out += compareTags(deltaVarName, firstResAdaptedVarName, secondResAdaptedVarName, fieldPath, indentLevel)
+			out += compareTags(r, deltaVarName, firstResAdaptedVarName, secondResAdaptedVarName, fieldPath, indentLevel)
 			continue
 		}
 
 		memberShapeRef := specField.ShapeRef
 		memberShape := memberShapeRef.Shape
+		if specField.GetTimestampLayout() != "" {
+			// String timestamps of the AWS API are metav1.Time in the CRD
+			memberShape = timestampShape
+		} else if size, _ := specField.GetQuantityUnitSize(); size != 0 {
+			// Sizes of the AWS API are resource.Quantity in the CRD
+			memberShape = quantityShape
+		} else if memberShapeRef.JSONValue {
+			// aws.JSONValue members are runtime.RawExtension in the CRD
+			memberShape = jsonValueShape
+		}
 
 		// Use len, bytes.Equal and HasNilDifference to fast compare types, and
 		// try to avoid deep comparison as much as possible.
@@ -278,7 +336,8 @@ func compareNil(
 
 	switch shape.Type {
 	case "boolean", "string", "character", "byte", "short", "integer", "long",
-		"float", "double", "timestamp", "structure", "jsonvalue":
+		"float", "double", "timestamp", "structure", "jsonvalue", "duration",
+		"quantity":
 		// if ackcompare.HasNilDifference(a.ko.Spec.Name, b.ko.Spec.Name) {
 		out += fmt.Sprintf(
 			"%sif ackcompare.HasNilDifference(%s, %s) {\n",
@@ -300,6 +359,38 @@ func compareNil(
 	return out
 }
 
+// compareCustom outputs Go code that calls the hand-written function named
+// by the supplied compare config with the delta and the two field values.
+//
+// Output code will look something like this:
+//
+//	customCompareThumbprintList(delta, a.ko.Spec.ThumbprintList, b.ko.Spec.ThumbprintList)
+func compareCustom(
+	// struct informing code generator how to compare the field values
+	compareConfig *ackgenconfig.CompareFieldConfig,
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`. The custom function calls the `Add()` method of
+	// this variable when differences between fields are detected.
+	deltaVarName string,
+	// String representing the name of the variable that represents the first
+	// CR under comparison. This will typically be something like
+	// "a.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
+	firstResVarName string,
+	// String representing the name of the variable that represents the second
+	// CR under comparison. This will typically be something like
+	// "b.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
+	secondResVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	return fmt.Sprintf(
+		"%s%s(%s, %s, %s)\n",
+		indent, compareConfig.CustomMethodName,
+		deltaVarName, firstResVarName, secondResVarName,
+	)
+}
+
 // compareScalar outputs Go code that compares two scalar values from two
 // resource fields and, if there is a difference, adds the difference to a
 // variable representing an `ackcompare.Delta`.
@@ -337,12 +428,46 @@ func compareScalar(
 	indent := strings.Repeat("\t", indentLevel)
 
 	switch shape.Type {
-	case "boolean", "string", "character", "byte", "short", "integer", "long", "float", "double":
+	case "string":
+		if compareConfig != nil && compareConfig.IsJSON {
+			// if !equalJSON(*a.ko.Spec.PolicyDocument, *b.ko.Spec.PolicyDocument) {
+			out += fmt.Sprintf(
+				"%sif !equalJSON(*%s, *%s) {\n",
+				indent, firstResVarName, secondResVarName,
+			)
+			break
+		}
+		if compareConfig != nil && compareConfig.CaseInsensitive {
+			// if !strings.EqualFold(*a.ko.Spec.Name, *b.ko.Spec.Name) {
+			out += fmt.Sprintf(
+				"%sif !strings.EqualFold(*%s, *%s) {\n",
+				indent, firstResVarName, secondResVarName,
+			)
+			break
+		}
 		// if *a.ko.Spec.Name != *b.ko.Spec.Name {
 		out += fmt.Sprintf(
 			"%sif *%s != *%s {\n",
 			indent, firstResVarName, secondResVarName,
 		)
+	case "boolean", "character", "byte", "short", "integer", "long", "float", "double", "duration":
+		// if *a.ko.Spec.Name != *b.ko.Spec.Name {
+		out += fmt.Sprintf(
+			"%sif *%s != *%s {\n",
+			indent, firstResVarName, secondResVarName,
+		)
+	case "quantity":
+		// if a.ko.Spec.AllocatedStorage.Cmp(*b.ko.Spec.AllocatedStorage) != 0 {
+		out += fmt.Sprintf(
+			"%sif %s.Cmp(*%s) != 0 {\n",
+			indent, firstResVarName, secondResVarName,
+		)
+	case "jsonvalue":
+		// if !equalJSON(string(a.ko.Spec.Conditions.Raw), string(b.ko.Spec.Conditions.Raw)) {
+		out += fmt.Sprintf(
+			"%sif !equalJSON(string(%s.Raw), string(%s.Raw)) {\n",
+			indent, firstResVarName, secondResVarName,
+		)
 	case "timestamp":
 		// if !a.ko.Spec.CreatedAt.Equal(b.ko.Spec.CreatedAt) {
 		out += fmt.Sprintf(
@@ -425,8 +550,9 @@ func compareMap(
 		// building up the fieldPath appropriately and calling into a
 		// struct-specific comparator function...
 		out += fmt.Sprintf(
-			"%sif !reflect.DeepEqual(%s, %s) {\n",
-			indent, firstResVarName, secondResVarName,
+			"%sif !%s(%s, %s) {\n",
+			indent, deepEqualFunc(compareConfig),
+			firstResVarName, secondResVarName,
 		)
 	}
 	//   delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
@@ -451,6 +577,10 @@ func compareMap(
 //	if !ackcompare.SliceStringPEqual(a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs) {
 //	  delta.Add("Spec.SecurityGroupIDs", a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs)
 //	}
+//
+// For fields configured with `compare.is_set`, the elements are compared
+// regardless of their order using the `equalIgnoringOrder` function
+// generated in `templates/pkg/resource/delta.go.tpl`.
 func compareSlice(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
@@ -482,14 +612,20 @@ func compareSlice(
 
 	elemType := shape.MemberRef.Shape.Type
 
-	switch elemType {
-	case "string":
+	switch {
+	case compareConfig != nil && compareConfig.IsSet:
+		// if !equalIgnoringOrder(a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs) {
+		out += fmt.Sprintf(
+			"%sif !equalIgnoringOrder(%s, %s) {\n",
+			indent, firstResVarName, secondResVarName,
+		)
+	case elemType == "string":
 		// if !ackcompare.SliceStringPEqual(a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs) {
 		out += fmt.Sprintf(
 			"%sif !ackcompare.SliceStringPEqual(%s, %s) {\n",
 			indent, firstResVarName, secondResVarName,
 		)
-	case "structure":
+	case elemType == "structure":
 		// NOTE(jaypipes): Using reflect here is really punting. We should
 		// implement this in a cleaner, more efficient fashion by walking the
 		// struct values and comparing each struct individually, building up
@@ -497,8 +633,9 @@ func compareSlice(
 		// comparator function...the tricky part of this is figuring out how to
 		// sort the slice of structs...
 		out += fmt.Sprintf(
-			"%sif !reflect.DeepEqual(%s, %s) {\n",
-			indent, firstResVarName, secondResVarName,
+			"%sif !%s(%s, %s) {\n",
+			indent, deepEqualFunc(compareConfig),
+			firstResVarName, secondResVarName,
 		)
 	default:
 		panic("Unsupported shape type in generate.code.compareSlice: " + shape.Type)
@@ -516,6 +653,20 @@ func compareSlice(
 	return out
 }
 
+// deepEqualFunc returns the name of the function used to deeply compare two
+// values of a field: `reflect.DeepEqual`, or the `equalNilAsEmpty` function
+// generated in `templates/pkg/resource/delta.go.tpl` for fields configured
+// with `compare.nil_equals_empty`.
+func deepEqualFunc(
+	// struct informing code generator how to compare the field values
+	compareConfig *ackgenconfig.CompareFieldConfig,
+) string {
+	if compareConfig != nil && compareConfig.NilEqualsEmpty {
+		return "equalNilAsEmpty"
+	}
+	return "reflect.DeepEqual"
+}
+
 // compareTags outputs Go code that compares two slices of tags from two
 // resource fields by first converting them to the common ACK tag type and then
 // using a map comparison. If there is a difference, adds the difference to a
@@ -526,7 +677,11 @@ func compareSlice(
 //	if !ackcompare.MapStringStringEqual(ToACKTags(a.ko.Spec.Tags), ToACKTags(b.ko.Spec.Tags)) {
 //	  delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
 //	}
+//
+// The tags whose keys have one of the prefixes of `tags.ignore_key_prefixes`
+// are removed from the compared tags with withoutIgnoredTags.
 func compareTags(
+	r *model.CRD,
 	// String representing the name of the variable that is of type
 	// `*ackcompare.Delta`. We will generate Go code that calls the `Add()`
 	// method of this variable when differences between fields are detected.
@@ -549,7 +704,13 @@ func compareTags(
 	out := ""
 	indent := strings.Repeat("\t", indentLevel)
 
-	out += fmt.Sprintf("%sif !ackcompare.MapStringStringEqual(ToACKTags(%s), ToACKTags(%s)) {\n", indent, firstResVarName, secondResVarName)
+	firstTags := fmt.Sprintf("ToACKTags(%s)", firstResVarName)
+	secondTags := fmt.Sprintf("ToACKTags(%s)", secondResVarName)
+	if len(r.GetIgnoredTagKeyPrefixes()) > 0 {
+		firstTags = fmt.Sprintf("withoutIgnoredTags(%s)", firstTags)
+		secondTags = fmt.Sprintf("withoutIgnoredTags(%s)", secondTags)
+	}
+	out += fmt.Sprintf("%sif !ackcompare.MapStringStringEqual(%s, %s) {\n", indent, firstTags, secondTags)
 	out += fmt.Sprintf("%s\t%s.Add(\"%s\", %s, %s)\n", indent, deltaVarName, fieldPath, firstResVarName, secondResVarName)
 	out += fmt.Sprintf("%s}\n", indent)
 
@@ -623,13 +784,32 @@ func CompareStruct(
 		if compareConfig != nil && compareConfig.IsIgnored {
 			continue
 		}
+		if r.IsCompareIgnoredPath(memberFieldPath) {
+			continue
+		}
+
+		// Delegate the comparison to a hand-written function if configured
+		if compareConfig != nil && compareConfig.CustomMethodName != "" {
+			out += compareCustom(
+				compareConfig,
+				deltaVarName,
+				firstResAdaptedVarName,
+				secondResAdaptedVarName,
+				indentLevel,
+			)
+			continue
+		}
 
 		memberShape := memberShapeRef.Shape
+		if memberShapeRef.JSONValue {
+			// aws.JSONValue members are runtime.RawExtension in the CRD
+			memberShape = jsonValueShape
+		}
 
 		// Use a special comparison model for tags, since they need to be
 		// converted into the common ACK tag type before doing a map delta
 		if tagField != nil && tagField.Path == trimmedFieldPath {
-			out += compareTags(deltaVarName, firstResAdaptedVarName, secondResAdaptedVarName, fieldPath, indentLevel)
+			out += compareTags(r, deltaVarName, firstResAdaptedVarName, secondResAdaptedVarName, fieldPath, indentLevel)
 			continue
 		}
 
@@ -861,3 +1041,48 @@ func fastCompareTypes(
 	}
 	return out, needToCloseBlock
 }
+
+// CompareIgnoreWhen outputs Go code that discards the differences found in a
+// delta at or below the resource's `compare.ignore_when` field paths whose
+// condition is satisfied by the desired resource.
+//
+// Sample output:
+//
+//	// Ignore differences at Spec.Handler when the condition is satisfied
+//	if (a.ko.Spec.PackageType != nil && (*a.ko.Spec.PackageType == "Image")) {
+//		ignoreDifferencesAt(delta, "Spec.Handler")
+//	}
+func CompareIgnoreWhen(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`
+	deltaVarName string,
+	// String representing the name of the variable that represents the
+	// desired CR, e.g. "a.ko"
+	desiredVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	for _, ignoreCfg := range cfg.GetCompareIgnoreWhen(r.Names.Original) {
+		if _, _, err := auxiliaryFieldAccessor(r, ignoreCfg.Path, "a"); err != nil {
+			panic(fmt.Sprintf("invalid compare ignore_when path: %v. crd: %q", err, r.Kind))
+		}
+		out += fmt.Sprintf(
+			"%s// Ignore differences at %s when the condition is satisfied\n",
+			indent, ignoreCfg.Path,
+		)
+		out += fmt.Sprintf(
+			"%sif %s {\n", indent,
+			syncedConditionExpr(r, desiredVarName, ignoreCfg.When),
+		)
+		out += fmt.Sprintf(
+			"%s\tignoreDifferencesAt(%s, %q)\n", indent, deltaVarName,
+			ignoreCfg.Path,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
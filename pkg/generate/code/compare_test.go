@@ -412,6 +412,60 @@ func TestCompareResource_Lambda_Function(t *testing.T) {
 	)
 }
 
+func TestCompareResource_Lambda_Function_NilEqualsEmpty(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-compare-nil-equals-empty.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+	assert.True(crd.HasNilEqualsEmptyCompareFields())
+
+	expected := `	if len(a.ko.Spec.FileSystemConfigs) != len(b.ko.Spec.FileSystemConfigs) {
+		delta.Add("Spec.FileSystemConfigs", a.ko.Spec.FileSystemConfigs, b.ko.Spec.FileSystemConfigs)
+	} else if len(a.ko.Spec.FileSystemConfigs) > 0 {
+		if !equalNilAsEmpty(a.ko.Spec.FileSystemConfigs, b.ko.Spec.FileSystemConfigs) {
+			delta.Add("Spec.FileSystemConfigs", a.ko.Spec.FileSystemConfigs, b.ko.Spec.FileSystemConfigs)
+		}
+	}
+`
+	assert.Contains(
+		code.CompareResource(
+			crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
+		),
+		expected,
+	)
+}
+
+func TestCompareResource_Lambda_Function_IgnoredTagKeyPrefixes(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-ignored-tag-key-prefixes.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+	// The "aws:" prefix is always ignored, in addition to the configured
+	// "lambda:" prefix
+	assert.Equal([]string{"aws:", "lambda:"}, crd.GetIgnoredTagKeyPrefixes())
+
+	expected := `	if !ackcompare.MapStringStringEqual(withoutIgnoredTags(ToACKTags(a.ko.Spec.Tags)), withoutIgnoredTags(ToACKTags(b.ko.Spec.Tags))) {
+		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
+	}
+`
+	assert.Contains(
+		code.CompareResource(
+			crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
+		),
+		expected,
+	)
+}
+
 func TestCompareResource_APIGatewayv2_Route(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -552,6 +606,112 @@ func TestCompareResource_IAM_OIDC_URL(t *testing.T) {
 	)
 }
 
+func TestCompareResource_IAM_OIDC_CustomMethod(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-compare-custom-method.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "OpenIDConnectProvider")
+	require.NotNil(crd)
+
+	got := code.CompareResource(
+		crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
+	)
+	assert.Contains(
+		got,
+		"\tcustomCompareThumbprintList(delta, a.ko.Spec.ThumbprintList, b.ko.Spec.ThumbprintList)\n",
+	)
+	assert.NotContains(got, "delta.Add(\"Spec.ThumbprintList\"")
+	// Other fields are still compared by generated code
+	assert.Contains(got, "delta.Add(\"Spec.ClientIDList\"")
+}
+
+func TestCompareResource_IAM_OIDC_IsSet(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-compare-is-set.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "OpenIDConnectProvider")
+	require.NotNil(crd)
+	assert.True(crd.HasSetCompareFields())
+
+	expected := `	if len(a.ko.Spec.ThumbprintList) != len(b.ko.Spec.ThumbprintList) {
+		delta.Add("Spec.ThumbprintList", a.ko.Spec.ThumbprintList, b.ko.Spec.ThumbprintList)
+	} else if len(a.ko.Spec.ThumbprintList) > 0 {
+		if !equalIgnoringOrder(a.ko.Spec.ThumbprintList, b.ko.Spec.ThumbprintList) {
+			delta.Add("Spec.ThumbprintList", a.ko.Spec.ThumbprintList, b.ko.Spec.ThumbprintList)
+		}
+	}
+`
+	got := code.CompareResource(
+		crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
+	)
+	assert.Contains(got, expected)
+	// Lists without set semantics keep the default comparison
+	assert.Contains(got, "ackcompare.SliceStringPEqual(a.ko.Spec.ClientIDList, b.ko.Spec.ClientIDList)")
+}
+
+func TestCompareResource_IAM_OIDC_CaseInsensitive(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-compare-case-insensitive.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "OpenIDConnectProvider")
+	require.NotNil(crd)
+
+	expected := `	if ackcompare.HasNilDifference(a.ko.Spec.URL, b.ko.Spec.URL) {
+		delta.Add("Spec.URL", a.ko.Spec.URL, b.ko.Spec.URL)
+	} else if a.ko.Spec.URL != nil && b.ko.Spec.URL != nil {
+		if !strings.EqualFold(*a.ko.Spec.URL, *b.ko.Spec.URL) {
+			delta.Add("Spec.URL", a.ko.Spec.URL, b.ko.Spec.URL)
+		}
+	}
+`
+	assert.Contains(
+		code.CompareResource(
+			crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
+		),
+		expected,
+	)
+}
+
+func TestCompareResource_IAM_Role_IsJSON(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-role-policy-json.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+	assert.True(crd.HasJSONCompareFields())
+
+	expected := `	if ackcompare.HasNilDifference(a.ko.Spec.AssumeRolePolicyDocument, b.ko.Spec.AssumeRolePolicyDocument) {
+		delta.Add("Spec.AssumeRolePolicyDocument", a.ko.Spec.AssumeRolePolicyDocument, b.ko.Spec.AssumeRolePolicyDocument)
+	} else if a.ko.Spec.AssumeRolePolicyDocument != nil && b.ko.Spec.AssumeRolePolicyDocument != nil {
+		if !equalJSON(*a.ko.Spec.AssumeRolePolicyDocument, *b.ko.Spec.AssumeRolePolicyDocument) {
+			delta.Add("Spec.AssumeRolePolicyDocument", a.ko.Spec.AssumeRolePolicyDocument, b.ko.Spec.AssumeRolePolicyDocument)
+		}
+	}
+`
+	assert.Contains(
+		code.CompareResource(
+			crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
+		),
+		expected,
+	)
+}
+
 func TestCompareResource_MemoryDB_User(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -597,3 +757,79 @@ func TestCompareResource_MemoryDB_User(t *testing.T) {
 		),
 	)
 }
+
+func TestCompareResource_RDS_DBInstance_QuantityFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-quantity-fields.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	assert.Contains(
+		code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1),
+		`
+	if ackcompare.HasNilDifference(a.ko.Spec.AllocatedStorage, b.ko.Spec.AllocatedStorage) {
+		delta.Add("Spec.AllocatedStorage", a.ko.Spec.AllocatedStorage, b.ko.Spec.AllocatedStorage)
+	} else if a.ko.Spec.AllocatedStorage != nil && b.ko.Spec.AllocatedStorage != nil {
+		if a.ko.Spec.AllocatedStorage.Cmp(*b.ko.Spec.AllocatedStorage) != 0 {
+			delta.Add("Spec.AllocatedStorage", a.ko.Spec.AllocatedStorage, b.ko.Spec.AllocatedStorage)
+		}
+	}
+`,
+	)
+}
+
+func TestCompareResource_SageMaker_FlowDefinition_JSONValueFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sagemaker", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-json-values.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "FlowDefinition")
+	require.NotNil(crd)
+
+	got := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	assert.Contains(got, `
+				if !equalJSON(string(a.ko.Spec.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions.Raw), string(b.ko.Spec.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions.Raw)) {
+					delta.Add("Spec.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions", a.ko.Spec.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions, b.ko.Spec.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions)
+				}
+`)
+}
+
+func TestCompareResource_Lambda_Function_Ignore(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-compare-ignore.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+	assert.True(crd.HasCompareIgnoreWhen())
+
+	got := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	// Ignored field and fields at or under the ignored prefix are not
+	// compared, unlike the fields merely sharing its leading characters
+	assert.NotContains(got, "Spec.Description")
+	assert.NotContains(got, `"Spec.Code"`)
+	assert.NotContains(got, "Spec.Code.")
+	assert.Contains(got, `delta.Add("Spec.CodeSigningConfigARN", a.ko.Spec.CodeSigningConfigARN, b.ko.Spec.CodeSigningConfigARN)`)
+	assert.Contains(got, `delta.Add("Spec.Handler", a.ko.Spec.Handler, b.ko.Spec.Handler)`)
+
+	expected := `	// Ignore differences at Spec.Handler when the condition is satisfied
+	if (a.ko.Spec.PackageType != nil && (*a.ko.Spec.PackageType == "Image")) {
+		ignoreDifferencesAt(delta, "Spec.Handler")
+	}
+`
+	assert.Equal(
+		expected,
+		code.CompareIgnoreWhen(crd.Config(), crd, "delta", "a.ko", 1),
+	)
+}
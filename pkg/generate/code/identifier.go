@@ -0,0 +1,233 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// CompositeIdentifier returns the Go code that returns the composite
+// identifier of a resource whose identity is a tuple of fields, i.e. the
+// values of its primary key fields, in order, joined by the resource's
+// primary key separator. An empty string is returned while any of those
+// fields is not set.
+//
+//	Sample output:
+//
+//	if r.ko.Status.RegistryID == nil || r.ko.Spec.RepositoryName == nil {
+//		return ""
+//	}
+//	return strings.Join([]string{
+//		*r.ko.Status.RegistryID,
+//		*r.ko.Spec.RepositoryName,
+//	}, ":")
+func CompositeIdentifier(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the CR, e.g.
+	// "r.ko"
+	koVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	accessors := compositeKeyAccessors(cfg, r, koVarName)
+	if len(accessors) == 0 {
+		return ""
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	nilChecks := make([]string, 0, len(accessors))
+	for _, accessor := range accessors {
+		nilChecks = append(nilChecks, accessor+" == nil")
+	}
+	out := fmt.Sprintf("%sif %s {\n", indent, strings.Join(nilChecks, " || "))
+	out += fmt.Sprintf("%s\treturn \"\"\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	out += fmt.Sprintf("%sreturn strings.Join([]string{\n", indent)
+	for _, accessor := range accessors {
+		out += fmt.Sprintf("%s\t*%s,\n", indent, accessor)
+	}
+	out += fmt.Sprintf("%s}, %q)\n", indent, r.PrimaryKeySeparator())
+	return out
+}
+
+// setResourceIdentifierCompositeKey returns the Go code that sets the primary
+// key fields of a resource whose identity is a tuple of fields from the
+// composite identifier in the identifier `NameOrID` field.
+//
+//	Sample output:
+//
+//	primaryKeys := strings.Split(identifier.NameOrID, ":")
+//	if len(primaryKeys) != 2 {
+//		return fmt.Errorf(
+//			"expected identifier of the form %q, got %q",
+//			"RegistryID:RepositoryName", identifier.NameOrID,
+//		)
+//	}
+//	r.ko.Status.RegistryID = &primaryKeys[0]
+//	r.ko.Spec.RepositoryName = &primaryKeys[1]
+func setResourceIdentifierCompositeKey(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The struct or struct field that we access our source value from
+	sourceVarName string,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	primaryFields, err := r.GetPrimaryKeyFields()
+	if err != nil {
+		panic(err)
+	}
+	accessors := compositeKeyAccessors(cfg, r, targetVarName)
+	separator := r.PrimaryKeySeparator()
+	fieldNames := make([]string, 0, len(primaryFields))
+	for _, field := range primaryFields {
+		fieldNames = append(fieldNames, field.Names.Camel)
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf(
+		"%sprimaryKeys := strings.Split(%s.NameOrID, %q)\n",
+		indent, sourceVarName, separator,
+	)
+	out += fmt.Sprintf("%sif len(primaryKeys) != %d {\n", indent, len(accessors))
+	out += fmt.Sprintf("%s\treturn fmt.Errorf(\n", indent)
+	out += fmt.Sprintf("%s\t\t\"expected identifier of the form %%q, got %%q\",\n", indent)
+	out += fmt.Sprintf(
+		"%s\t\t%q, %s.NameOrID,\n", indent,
+		strings.Join(fieldNames, separator), sourceVarName,
+	)
+	out += fmt.Sprintf("%s\t)\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	for idx, accessor := range accessors {
+		out += fmt.Sprintf("%s%s = &primaryKeys[%d]\n", indent, accessor, idx)
+	}
+	return out
+}
+
+// SetResourceIdentifiersFromARN returns the Go code that sets the fields of a
+// resource whose values can be parsed from its ARN, when an ARN is supplied in
+// the identifiers of the resource. The value of each field is the single
+// group captured by its configured pattern.
+//
+//	Sample output:
+//
+//	if identifier.ARN != nil {
+//		arn := string(*identifier.ARN)
+//		f0Matches := regexp.MustCompile("^arn:[^:]+:ecr:[^:]*:([0-9]+):repository/").FindStringSubmatch(arn)
+//		if len(f0Matches) != 2 {
+//			return fmt.Errorf("cannot parse %s from ARN %q", "RegistryID", arn)
+//		}
+//		r.ko.Status.RegistryID = &f0Matches[1]
+//	}
+func SetResourceIdentifiersFromARN(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The struct or struct field that we access our source value from
+	sourceVarName string,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	fields := r.FieldsFromARN()
+	if len(fields) == 0 {
+		return ""
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf("%sif %s.ARN != nil {\n", indent, sourceVarName)
+	out += fmt.Sprintf("%s\tarn := string(*%s.ARN)\n", indent, sourceVarName)
+	for idx, field := range fields {
+		pattern := field.GetFromARNPattern()
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			panic(fmt.Sprintf("invalid from_arn pattern %q for field %q: %v. crd: %q",
+				pattern, field.Names.Camel, err, r.Kind))
+		}
+		if re.NumSubexp() != 1 {
+			panic(fmt.Sprintf("from_arn pattern %q for field %q must have "+
+				"exactly one capturing group. crd: %q",
+				pattern, field.Names.Camel, r.Kind))
+		}
+		if field.GoType != "*string" {
+			panic(fmt.Sprintf("field %q must be a string field to be parsed "+
+				"from the ARN. crd: %q", field.Names.Camel, r.Kind))
+		}
+		memberPath, targetField := findFieldInCR(cfg, r, field.Names.Original)
+		if targetField == nil {
+			panic(fmt.Sprintf("field %q must be a top-level Spec or Status "+
+				"field to be parsed from the ARN. crd: %q",
+				field.Names.Camel, r.Kind))
+		}
+		matchesVarName := fmt.Sprintf("f%dMatches", idx)
+		out += fmt.Sprintf(
+			"%s\t%s := regexp.MustCompile(%q).FindStringSubmatch(arn)\n",
+			indent, matchesVarName, pattern,
+		)
+		out += fmt.Sprintf("%s\tif len(%s) != 2 {\n", indent, matchesVarName)
+		out += fmt.Sprintf(
+			"%s\t\treturn fmt.Errorf(\"cannot parse %%s from ARN %%q\", %q, arn)\n",
+			indent, field.Names.Camel,
+		)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf(
+			"%s\t%s%s.%s = &%s[1]\n",
+			indent, targetVarName, memberPath, field.Path, matchesVarName,
+		)
+	}
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// compositeKeyAccessors returns the Go accessors, in order, of the primary key
+// fields of a resource whose identity is a tuple of fields, or nil if the
+// resource has a single primary key. Only string fields can be part of a
+// composite primary key.
+func compositeKeyAccessors(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the CR, e.g.
+	// "r.ko"
+	koVarName string,
+) []string {
+	primaryFields, err := r.GetPrimaryKeyFields()
+	if err != nil {
+		panic(err)
+	}
+	if len(primaryFields) < 2 {
+		return nil
+	}
+	accessors := make([]string, 0, len(primaryFields))
+	for _, field := range primaryFields {
+		if field.GoType != "*string" {
+			panic(fmt.Sprintf("primary key field %q must be a string field "+
+				"to be part of a composite primary key. crd: %q",
+				field.Names.Camel, r.Kind))
+		}
+		memberPath, targetField := findFieldInCR(cfg, r, field.Names.Original)
+		if targetField == nil {
+			panic(fmt.Sprintf("primary key field %q must be a top-level "+
+				"Spec or Status field. crd: %q", field.Names.Camel, r.Kind))
+		}
+		accessors = append(accessors, fmt.Sprintf(
+			"%s%s.%s", koVarName, memberPath, field.Path,
+		))
+	}
+	return accessors
+}
@@ -60,6 +60,19 @@ func FindLateInitializedFieldNames(
 // Field path separated by '..' indicates member/key in a map
 // Note: Unlike Map, updating individual element of a list is not supported. LateInitializing complete list is supported.
 //
+// For a struct field configured with `skip_fields`, the struct is created in
+// the latest resource if needed and each of its member fields that is not
+// skipped is late initialized individually:
+//
+//	if observedKo.Spec.ImageConfig != nil && latestKo.Spec.ImageConfig == nil {
+//		latestKo.Spec.ImageConfig = &svcapitypes.ImageConfig{}
+//	}
+//	if observedKo.Spec.ImageConfig != nil && latestKo.Spec.ImageConfig != nil {
+//		if observedKo.Spec.ImageConfig.Command != nil && latestKo.Spec.ImageConfig.Command == nil {
+//			latestKo.Spec.ImageConfig.Command = observedKo.Spec.ImageConfig.Command
+//		}
+//	}
+//
 // Sample generator config:
 // fields:
 //
@@ -146,59 +159,136 @@ func LateInitializeFromReadOne(
 	out += fmt.Sprintf("%slatestKo := rm.concreteResource(%s).ko.DeepCopy()\n", indent, targetResVarName)
 	// TODO(vijat@): Add validation for correct field path in lateInitializedFieldNames
 	for _, fName := range lateInitFieldNames {
-		// split the field name by period
-		// each substring represents a field.
-		fNameParts := strings.Split(fName, ".")
-		// fNameIndentLevel tracks the indentation level for every new line added
-		// This variable is incremented when building nested if blocks and decremented when closing those if blocks.
-		fNameIndentLevel := indentLevel
-		// fParentPath keeps track of parent path for any fNamePart
-		fParentPath := ""
-		mapShapedParent := false
-		// for every part except last, perform the nil check
-		// entries in both source and target koVarName should not be nil
-		for i, fNamePart := range fNameParts {
-			if fNamePart == "" {
-				mapShapedParent = true
-				continue
-			}
-			indent := strings.Repeat("\t", fNameIndentLevel)
-			fNamePartAccesor := fmt.Sprintf("Spec%s.%s", fParentPath, fNamePart)
+		skipFields := lateInitConfigs[fName].SkipFields
+		if len(skipFields) == 0 {
+			out += lateInitializeFieldPath(fName, "", indentLevel)
+			continue
+		}
+		// Late initialize the struct's member fields individually, creating
+		// the struct first if the AWS service returned it but the latest
+		// resource does not have it
+		structField, memberNames := lateInitStructMembers(r, fName, skipFields)
+		out += lateInitializeFieldPath(
+			fName, fmt.Sprintf("&svcapitypes.%s{}", structField.GoTypeElem), indentLevel,
+		)
+		for _, memberName := range memberNames {
+			out += lateInitializeFieldPath(fName+"."+memberName, "", indentLevel)
+		}
+	}
+	out += fmt.Sprintf("%sreturn &resource{latestKo}", indent)
+	return out
+}
+
+// lateInitializeFieldPath returns the Go code that late initializes the field
+// at the supplied path, separated by '.' for struct members and '..' for map
+// keys, when the field is set in the observed resource but not in the latest
+// resource. The field is set to the supplied value, or to the observed value
+// if empty.
+func lateInitializeFieldPath(
+	fName string,
+	value string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	// split the field name by period
+	// each substring represents a field.
+	fNameParts := strings.Split(fName, ".")
+	// fNameIndentLevel tracks the indentation level for every new line added
+	// This variable is incremented when building nested if blocks and decremented when closing those if blocks.
+	fNameIndentLevel := indentLevel
+	// fParentPath keeps track of parent path for any fNamePart
+	fParentPath := ""
+	mapShapedParent := false
+	// for every part except last, perform the nil check
+	// entries in both source and target koVarName should not be nil
+	for i, fNamePart := range fNameParts {
+		if fNamePart == "" {
+			mapShapedParent = true
+			continue
+		}
+		indent := strings.Repeat("\t", fNameIndentLevel)
+		fNamePartAccesor := fmt.Sprintf("Spec%s.%s", fParentPath, fNamePart)
+		if mapShapedParent {
+			fNamePartAccesor = fmt.Sprintf("Spec%s[%q]", fParentPath, fNamePart)
+		}
+		// Handling for all parts except last one
+		if i != len(fNameParts)-1 {
+			out += fmt.Sprintf("%sif observedKo.%s != nil && latestKo.%s != nil {\n", indent, fNamePartAccesor, fNamePartAccesor)
+			// update fParentPath and fNameIndentLevel for next iteration
 			if mapShapedParent {
-				fNamePartAccesor = fmt.Sprintf("Spec%s[%q]", fParentPath, fNamePart)
-			}
-			// Handling for all parts except last one
-			if i != len(fNameParts)-1 {
-				out += fmt.Sprintf("%sif observedKo.%s != nil && latestKo.%s != nil {\n", indent, fNamePartAccesor, fNamePartAccesor)
-				// update fParentPath and fNameIndentLevel for next iteration
-				if mapShapedParent {
-					fParentPath = fmt.Sprintf("%s[%q]", fParentPath, fNamePart)
-					mapShapedParent = false
-				} else {
-					fParentPath = fmt.Sprintf("%s.%s", fParentPath, fNamePart)
-				}
-				fNameIndentLevel = fNameIndentLevel + 1
+				fParentPath = fmt.Sprintf("%s[%q]", fParentPath, fNamePart)
+				mapShapedParent = false
 			} else {
-				// handle last part here
-				// for last part, set the lateInitialized field if user did not specify field value and readOne has server side defaulted value.
-				// i.e. field is not nil in sourceKoVarName but is nil in targetkoVarName
-				out += fmt.Sprintf("%sif observedKo.%s != nil && latestKo.%s == nil {\n", indent, fNamePartAccesor, fNamePartAccesor)
-				fNameIndentLevel = fNameIndentLevel + 1
-				indent = strings.Repeat("\t", fNameIndentLevel)
-				out += fmt.Sprintf("%slatestKo.%s = observedKo.%s\n", indent, fNamePartAccesor, fNamePartAccesor)
+				fParentPath = fmt.Sprintf("%s.%s", fParentPath, fNamePart)
+			}
+			fNameIndentLevel = fNameIndentLevel + 1
+		} else {
+			// handle last part here
+			// for last part, set the lateInitialized field if user did not specify field value and readOne has server side defaulted value.
+			// i.e. field is not nil in sourceKoVarName but is nil in targetkoVarName
+			out += fmt.Sprintf("%sif observedKo.%s != nil && latestKo.%s == nil {\n", indent, fNamePartAccesor, fNamePartAccesor)
+			fNameIndentLevel = fNameIndentLevel + 1
+			indent = strings.Repeat("\t", fNameIndentLevel)
+			fieldValue := value
+			if fieldValue == "" {
+				fieldValue = "observedKo." + fNamePartAccesor
 			}
+			out += fmt.Sprintf("%slatestKo.%s = %s\n", indent, fNamePartAccesor, fieldValue)
 		}
-		// Close all if blocks with proper indentation
+	}
+	// Close all if blocks with proper indentation
+	fNameIndentLevel = fNameIndentLevel - 1
+	for fNameIndentLevel >= indentLevel {
+		out += fmt.Sprintf("%s}\n", strings.Repeat("\t", fNameIndentLevel))
 		fNameIndentLevel = fNameIndentLevel - 1
-		for fNameIndentLevel >= indentLevel {
-			out += fmt.Sprintf("%s}\n", strings.Repeat("\t", fNameIndentLevel))
-			fNameIndentLevel = fNameIndentLevel - 1
-		}
 	}
-	out += fmt.Sprintf("%sreturn &resource{latestKo}", indent)
 	return out
 }
 
+// lateInitStructMembers returns the struct field at the supplied path, which
+// is configured with a `late_initialize.skip_fields` list, along with the
+// sorted names of its member fields that are not skipped.
+func lateInitStructMembers(
+	r *model.CRD,
+	fName string,
+	skipFields []string,
+) (*model.Field, []string) {
+	structField, found := r.Fields[fName]
+	if !found || structField.ShapeRef == nil || structField.ShapeRef.Shape.Type != "structure" {
+		panic(fmt.Sprintf(
+			"late_initialize.skip_fields is only supported for struct fields, "+
+				"but %s of resource %s is not a struct field",
+			fName, r.Names.Original,
+		))
+	}
+	skipped := map[string]bool{}
+	for _, skipField := range skipFields {
+		matched := false
+		for memberName := range structField.MemberFields {
+			if strings.EqualFold(memberName, skipField) {
+				skipped[memberName] = true
+				matched = true
+			}
+		}
+		if !matched {
+			panic(fmt.Sprintf(
+				"late_initialize.skip_fields of field %s of resource %s contains "+
+					"unknown member field %s",
+				fName, r.Names.Original, skipField,
+			))
+		}
+	}
+	memberNames := []string{}
+	for memberName := range structField.MemberFields {
+		if !skipped[memberName] {
+			memberNames = append(memberNames, memberName)
+		}
+	}
+	sort.Strings(memberNames)
+	return structField, memberNames
+}
+
 // IncompleteLateInitialization returns the go code which checks whether all the fields are late initialized.
 // If all the fields are not late initialized, this method also returns the requeue delay needed to attempt
 // late initialization again.
@@ -276,7 +366,12 @@ func IncompleteLateInitialization(
 	indent := strings.Repeat("\t", indentLevel)
 	var lateInitFieldNames []string
 	lateInitConfigs := cfg.GetLateInitConfigs(r.Names.Original)
-	for fieldName := range lateInitConfigs {
+	for fieldName, lateInitConfig := range lateInitConfigs {
+		// Late initialization does not wait for the member fields of structs
+		// configured with a skip list
+		if len(lateInitConfig.SkipFields) > 0 {
+			continue
+		}
 		lateInitFieldNames = append(lateInitFieldNames, fieldName)
 	}
 	if len(lateInitFieldNames) == 0 {
@@ -233,3 +233,44 @@ func Test_IncompleteLateInitialization(t *testing.T) {
 	return false`
 	assert.Equal(expected, code.IncompleteLateInitialization(crd.Config(), crd, "latest", 1))
 }
+
+func Test_LateInitializeFromReadOne_SkipFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{GeneratorConfigFile: "generator-with-late-initialize-skip-fields.yaml"})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+	expected :=
+		`	observedKo := rm.concreteResource(observed).ko.DeepCopy()
+	latestKo := rm.concreteResource(latest).ko.DeepCopy()
+	if observedKo.Spec.ImageConfig != nil && latestKo.Spec.ImageConfig == nil {
+		latestKo.Spec.ImageConfig = &svcapitypes.ImageConfig{}
+	}
+	if observedKo.Spec.ImageConfig != nil && latestKo.Spec.ImageConfig != nil {
+		if observedKo.Spec.ImageConfig.Command != nil && latestKo.Spec.ImageConfig.Command == nil {
+			latestKo.Spec.ImageConfig.Command = observedKo.Spec.ImageConfig.Command
+		}
+	}
+	if observedKo.Spec.ImageConfig != nil && latestKo.Spec.ImageConfig != nil {
+		if observedKo.Spec.ImageConfig.EntryPoint != nil && latestKo.Spec.ImageConfig.EntryPoint == nil {
+			latestKo.Spec.ImageConfig.EntryPoint = observedKo.Spec.ImageConfig.EntryPoint
+		}
+	}
+	if observedKo.Spec.MemorySize != nil && latestKo.Spec.MemorySize == nil {
+		latestKo.Spec.MemorySize = observedKo.Spec.MemorySize
+	}
+	return &resource{latestKo}`
+	assert.Equal(expected, code.LateInitializeFromReadOne(crd.Config(), crd, "observed", "latest", 1))
+
+	// Late initialization does not wait for the member fields of a struct
+	// configured with a skip list
+	expected =
+		`	ko := rm.concreteResource(latest).ko.DeepCopy()
+	if ko.Spec.MemorySize == nil {
+		return true
+	}
+	return false`
+	assert.Equal(expected, code.IncompleteLateInitialization(crd.Config(), crd, "latest", 1))
+}
@@ -0,0 +1,135 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// SetReadManyFilters returns the Go code that sets the `Filters` list of the
+// ReadMany operation's Input shape from the fields of the resource configured
+// in `list_operation.filters`. A filter is only added when its field holds a
+// non-empty value; the empty values of a list field are dropped.
+//
+//	Sample output:
+//
+//	filters := []*svcsdk.Filter{}
+//	if r.ko.Spec.Name != nil && *r.ko.Spec.Name != "" {
+//		filters = append(filters, (&svcsdk.Filter{}).SetName("group-name").SetValues([]*string{r.ko.Spec.Name}))
+//	}
+//	if r.ko.Spec.VPCID != nil && *r.ko.Spec.VPCID != "" {
+//		filters = append(filters, (&svcsdk.Filter{}).SetName("vpc-id").SetValues([]*string{r.ko.Spec.VPCID}))
+//	}
+//	if len(filters) > 0 {
+//		res.SetFilters(filters)
+//	}
+func SetReadManyFilters(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the CR, e.g.
+	// "r.ko"
+	sourceVarName string,
+	// String representing the name of the variable holding the Input shape
+	// of the ReadMany operation, e.g. "res"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	filters := r.ListOpFilters()
+	if len(filters) == 0 {
+		return ""
+	}
+	op := r.Ops.ReadMany
+	if op == nil {
+		msg := fmt.Sprintf(
+			"list_operation.filters is set for %s which has no ReadMany operation",
+			r.Names.Camel,
+		)
+		panic(msg)
+	}
+	filtersRef, found := op.InputRef.Shape.MemberRefs["Filters"]
+	if !found || filtersRef.Shape.Type != "list" {
+		msg := fmt.Sprintf(
+			"list_operation.filters is set for %s but the %s Input shape has no Filters list",
+			r.Names.Camel, op.ExportedName,
+		)
+		panic(msg)
+	}
+	filterShape := filtersRef.Shape.MemberRef.Shape
+	for _, memberName := range []string{"Name", "Values"} {
+		if _, found := filterShape.MemberRefs[memberName]; !found {
+			msg := fmt.Sprintf(
+				"the %s filter shape of %s has no %s member",
+				filterShape.ShapeName, op.ExportedName, memberName,
+			)
+			panic(msg)
+		}
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf(
+		"%sfilters := []*svcsdk.%s{}\n", indent, filterShape.ShapeName,
+	)
+	for _, filter := range filters {
+		memberPath, field := findFieldInCR(cfg, r, filter.Field)
+		if field == nil {
+			msg := fmt.Sprintf(
+				"field %s of the %s filter must be a top-level Spec or Status field of %s",
+				filter.Field, filter.Name, r.Names.Camel,
+			)
+			panic(msg)
+		}
+		accessor := fmt.Sprintf("%s%s.%s", sourceVarName, memberPath, field.Path)
+		switch field.GoType {
+		case "*string":
+			out += fmt.Sprintf(
+				"%sif %s != nil && *%s != \"\" {\n", indent, accessor, accessor,
+			)
+			out += fmt.Sprintf(
+				"%s\tfilters = append(filters, (&svcsdk.%s{}).SetName(%q).SetValues([]*string{%s}))\n",
+				indent, filterShape.ShapeName, filter.Name, accessor,
+			)
+			out += fmt.Sprintf("%s}\n", indent)
+		case "[]*string":
+			// Empty values are dropped, as is a filter left without values
+			out += fmt.Sprintf("%s{\n", indent)
+			out += fmt.Sprintf("%s\tvalues := []*string{}\n", indent)
+			out += fmt.Sprintf("%s\tfor _, value := range %s {\n", indent, accessor)
+			out += fmt.Sprintf("%s\t\tif value != nil && *value != \"\" {\n", indent)
+			out += fmt.Sprintf("%s\t\t\tvalues = append(values, value)\n", indent)
+			out += fmt.Sprintf("%s\t\t}\n", indent)
+			out += fmt.Sprintf("%s\t}\n", indent)
+			out += fmt.Sprintf("%s\tif len(values) > 0 {\n", indent)
+			out += fmt.Sprintf(
+				"%s\t\tfilters = append(filters, (&svcsdk.%s{}).SetName(%q).SetValues(values))\n",
+				indent, filterShape.ShapeName, filter.Name,
+			)
+			out += fmt.Sprintf("%s\t}\n", indent)
+			out += fmt.Sprintf("%s}\n", indent)
+		default:
+			msg := fmt.Sprintf(
+				"field %s of the %s filter must be a string or a list of strings",
+				filter.Field, filter.Name,
+			)
+			panic(msg)
+		}
+	}
+	out += fmt.Sprintf("%sif len(filters) > 0 {\n", indent)
+	out += fmt.Sprintf("%s\t%s.SetFilters(filters)\n", indent, targetVarName)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
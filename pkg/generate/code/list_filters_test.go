@@ -0,0 +1,91 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	 http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestSetReadManyFilters_EC2_SecurityGroup(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ec2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-list-filters.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "SecurityGroup")
+	require.NotNil(crd)
+
+	expected := `	filters := []*svcsdk.Filter{}
+	if r.ko.Spec.Name != nil && *r.ko.Spec.Name != "" {
+		filters = append(filters, (&svcsdk.Filter{}).SetName("group-name").SetValues([]*string{r.ko.Spec.Name}))
+	}
+	if r.ko.Spec.VPCID != nil && *r.ko.Spec.VPCID != "" {
+		filters = append(filters, (&svcsdk.Filter{}).SetName("vpc-id").SetValues([]*string{r.ko.Spec.VPCID}))
+	}
+	if len(filters) > 0 {
+		res.SetFilters(filters)
+	}
+`
+	assert.Equal(expected, code.SetReadManyFilters(crd.Config(), crd, "r.ko", "res", 1))
+}
+
+func TestSetReadManyFilters_EC2_Instance_ListField(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ec2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-list-filters.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Instance")
+	require.NotNil(crd)
+
+	expected := `	filters := []*svcsdk.Filter{}
+	{
+		values := []*string{}
+		for _, value := range r.ko.Spec.SecurityGroupIDs {
+			if value != nil && *value != "" {
+				values = append(values, value)
+			}
+		}
+		if len(values) > 0 {
+			filters = append(filters, (&svcsdk.Filter{}).SetName("instance.group-id").SetValues(values))
+		}
+	}
+	if len(filters) > 0 {
+		res.SetFilters(filters)
+	}
+`
+	assert.Equal(expected, code.SetReadManyFilters(crd.Config(), crd, "r.ko", "res", 1))
+}
+
+func TestSetReadManyFilters_NotConfigured(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "ec2")
+
+	crd := testutil.GetCRDByName(t, g, "SecurityGroup")
+	require.NotNil(crd)
+
+	assert.Equal("", code.SetReadManyFilters(crd.Config(), crd, "r.ko", "res", 1))
+}
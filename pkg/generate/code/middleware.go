@@ -0,0 +1,100 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// builtinMiddlewareMethodNames is a map, keyed by the name of a builtin
+// middleware, of the name of the generated `resourceManager` method
+// implementing it.
+var builtinMiddlewareMethodNames = map[string]string{
+	"logging":       "loggingMiddleware",
+	"metrics":       "metricsMiddleware",
+	"caching":       "cachingMiddleware",
+	"rate_limiting": "rateLimitingMiddleware",
+}
+
+// MiddlewareChain returns the Go code for the elements of a slice of
+// middleware, in the order configured in the resource's `middleware`
+// generator config.
+//
+// Sample output:
+//
+//	rm.loggingMiddleware,
+//	rm.rateLimitingMiddleware,
+//	rm.auditMiddleware,
+func MiddlewareChain(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	builtins := map[string]bool{}
+	for _, mwCfg := range cfg.GetMiddleware(r.Names.Original) {
+		methodName := mwCfg.CustomMethodName
+		if mwCfg.TTLSeconds != 0 && mwCfg.Builtin != "caching" {
+			panic(fmt.Sprintf(
+				"middleware for %s can only set ttl_seconds with the caching builtin",
+				r.Names.Camel,
+			))
+		}
+		if (mwCfg.RequestsPerSecond != 0 || mwCfg.Burst != 0) &&
+			mwCfg.Builtin != "rate_limiting" {
+			panic(fmt.Sprintf(
+				"middleware for %s can only set requests_per_second and burst "+
+					"with the rate_limiting builtin",
+				r.Names.Camel,
+			))
+		}
+		if mwCfg.Builtin != "" {
+			if methodName != "" {
+				panic(fmt.Sprintf(
+					"middleware for %s cannot set both builtin and custom_method_name",
+					r.Names.Camel,
+				))
+			}
+			var found bool
+			methodName, found = builtinMiddlewareMethodNames[mwCfg.Builtin]
+			if !found {
+				panic(fmt.Sprintf(
+					"unknown builtin middleware %q for %s",
+					mwCfg.Builtin, r.Names.Camel,
+				))
+			}
+			if builtins[mwCfg.Builtin] {
+				panic(fmt.Sprintf(
+					"builtin middleware %q is configured more than once for %s",
+					mwCfg.Builtin, r.Names.Camel,
+				))
+			}
+			builtins[mwCfg.Builtin] = true
+		}
+		if methodName == "" {
+			panic(fmt.Sprintf(
+				"middleware for %s must set either builtin or custom_method_name",
+				r.Names.Camel,
+			))
+		}
+		out += fmt.Sprintf("%srm.%s,\n", indent, methodName)
+	}
+	return out
+}
@@ -0,0 +1,51 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestMiddlewareChain_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-middleware.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.True(crd.HasMiddleware())
+	assert.True(crd.UsesBuiltinMiddleware("logging"))
+	assert.True(crd.UsesBuiltinMiddleware("caching"))
+	assert.Equal(30, crd.BuiltinMiddleware("caching").GetTTLSeconds())
+	assert.Equal(5.0, crd.BuiltinMiddleware("rate_limiting").GetRequestsPerSecond())
+	assert.Equal(10, crd.BuiltinMiddleware("rate_limiting").GetBurst())
+
+	expected := `		rm.loggingMiddleware,
+		rm.metricsMiddleware,
+		rm.rateLimitingMiddleware,
+		rm.cachingMiddleware,
+		rm.auditMiddleware,
+`
+	assert.Equal(expected, code.MiddlewareChain(crd.Config(), crd, 2))
+}
@@ -0,0 +1,255 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws-controllers-k8s/pkg/names"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// MigrateSpecFields returns the Go code of the body of a custom resource
+// Spec's UnmarshalJSON method accepting the values stored in existing custom
+// resources before the Go type or the name of Spec fields changed. Fields whose
+// type changed are first decoded with their current type and, if that fails,
+// with their previous type before being converted. The values of fields whose
+// name changed are moved from the deprecated field of their previous name.
+//
+// Output code will look something like this:
+//
+//	type spec FunctionSpec
+//	aux := &struct {
+//		*spec
+//		Architectures json.RawMessage `json:"architectures,omitempty"`
+//	}{spec: (*spec)(in)}
+//	if err := json.Unmarshal(b, aux); err != nil {
+//		return err
+//	}
+//	if len(aux.Architectures) > 0 {
+//		if err := json.Unmarshal(aux.Architectures, &in.Architectures); err != nil {
+//			var previous *string
+//			if err := json.Unmarshal(aux.Architectures, &previous); err != nil {
+//				return err
+//			}
+//			if previous != nil {
+//				in.Architectures = []*string{previous}
+//			}
+//		}
+//	}
+//	if in.RuntimeName != nil {
+//		if in.Runtime == nil {
+//			in.Runtime = in.RuntimeName
+//		}
+//		in.RuntimeName = nil
+//	}
+func MigrateSpecFields(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the Spec receiver variable, likely "in"
+	targetVarName string,
+	// String representing the name of the variable holding the JSON bytes,
+	// likely "b"
+	sourceVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	fields := r.MigratedSpecFields()
+	if len(fields) == 0 {
+		return ""
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	out := ""
+
+	retyped := []*model.Field{}
+	for _, f := range fields {
+		migrationCfg := f.FieldConfig.Migration
+		if migrationCfg.PreviousType != "" && migrationCfg.PreviousName == "" {
+			retyped = append(retyped, f)
+		}
+	}
+	// Fields of the previous type are unmarshalled separately, shadowing the
+	// Spec fields of the same JSON name
+	//
+	// type spec FunctionSpec
+	// aux := &struct {
+	//     *spec
+	//     Architectures json.RawMessage `json:"architectures,omitempty"`
+	// }{spec: (*spec)(in)}
+	out += fmt.Sprintf("%s// spec has no UnmarshalJSON method, avoiding recursion\n", indent)
+	out += fmt.Sprintf("%stype spec %sSpec\n", indent, r.Kind)
+	out += fmt.Sprintf("%saux := &struct {\n", indent)
+	out += fmt.Sprintf("%s\t*spec\n", indent)
+	for _, f := range retyped {
+		out += fmt.Sprintf(
+			"%s\t%s json.RawMessage %s\n", indent, f.Names.Camel, f.GetGoTag(),
+		)
+	}
+	out += fmt.Sprintf("%s}{spec: (*spec)(%s)}\n", indent, targetVarName)
+	out += fmt.Sprintf(
+		"%sif err := json.Unmarshal(%s, aux); err != nil {\n", indent, sourceVarName,
+	)
+	out += fmt.Sprintf("%s\treturn err\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+
+	for _, f := range retyped {
+		previousType := f.PreviousGoType()
+		rawVarName := "aux." + f.Names.Camel
+		targetFieldVarName := targetVarName + "." + f.Names.Camel
+		// if len(aux.Architectures) > 0 {
+		//     if err := json.Unmarshal(aux.Architectures, &in.Architectures); err != nil {
+		//         var previous *string
+		//         if err := json.Unmarshal(aux.Architectures, &previous); err != nil {
+		//             return err
+		//         }
+		out += fmt.Sprintf("%sif len(%s) > 0 {\n", indent, rawVarName)
+		out += fmt.Sprintf(
+			"%s\tif err := json.Unmarshal(%s, &%s); err != nil {\n",
+			indent, rawVarName, targetFieldVarName,
+		)
+		out += fmt.Sprintf("%s\t\tvar previous %s\n", indent, previousType)
+		out += fmt.Sprintf(
+			"%s\t\tif err := json.Unmarshal(%s, &previous); err != nil {\n",
+			indent, rawVarName,
+		)
+		out += fmt.Sprintf("%s\t\t\treturn err\n", indent)
+		out += fmt.Sprintf("%s\t\t}\n", indent)
+		out += migrateValue(
+			r, f, "previous", previousType, targetFieldVarName, indentLevel+2,
+		)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+
+	for _, f := range fields {
+		migrationCfg := f.FieldConfig.Migration
+		if migrationCfg.PreviousName == "" {
+			continue
+		}
+		deprecatedVarName := targetVarName + "." + names.New(migrationCfg.PreviousName).Camel
+		targetFieldVarName := targetVarName + "." + f.Names.Camel
+		previousType := f.GoType
+		if migrationCfg.PreviousType != "" {
+			previousType = f.PreviousGoType()
+		}
+		// if in.RuntimeName != nil {
+		//     if in.Runtime == nil {
+		//         in.Runtime = in.RuntimeName
+		//     }
+		//     in.RuntimeName = nil
+		// }
+		out += fmt.Sprintf("%sif %s != nil {\n", indent, deprecatedVarName)
+		out += fmt.Sprintf("%s\tif %s == nil {\n", indent, targetFieldVarName)
+		out += migrateValue(
+			r, f, deprecatedVarName, previousType, targetFieldVarName, indentLevel+2,
+		)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf("%s\t%s = nil\n", indent, deprecatedVarName)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
+
+// scalarMigrations contains, keyed by previous and then current Go type, the
+// format of the Go expression converting a dereferenced value of the previous
+// type to the current type, and whether the conversion may fail.
+var scalarMigrations = map[string]map[string]struct {
+	format  string
+	mayFail bool
+}{
+	"*string": {
+		"*int64":   {"strconv.ParseInt(%s, 10, 64)", true},
+		"*float64": {"strconv.ParseFloat(%s, 64)", true},
+		"*bool":    {"strconv.ParseBool(%s)", true},
+	},
+	"*int64": {
+		"*string":  {"strconv.FormatInt(%s, 10)", false},
+		"*float64": {"float64(%s)", false},
+	},
+	"*float64": {
+		"*string": {"strconv.FormatFloat(%s, 'f', -1, 64)", false},
+		"*int64":  {"int64(%s)", false},
+	},
+	"*bool": {
+		"*string": {"strconv.FormatBool(%s)", false},
+	},
+}
+
+// migrateValue returns the Go code that sets a field from a variable holding a
+// value of the field's previous type, converting it to the field's type.
+func migrateValue(
+	r *model.CRD,
+	f *model.Field,
+	sourceVarName string,
+	previousType string,
+	targetVarName string,
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	out := ""
+	switch {
+	case previousType == f.GoType:
+		out += fmt.Sprintf("%s%s = %s\n", indent, targetVarName, sourceVarName)
+	case f.GoType == "[]"+previousType:
+		// if previous != nil {
+		//     in.Architectures = []*string{previous}
+		// }
+		out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceVarName)
+		out += fmt.Sprintf(
+			"%s\t%s = %s{%s}\n", indent, targetVarName, f.GoType, sourceVarName,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+	case previousType == "[]"+f.GoType:
+		// if len(previous) > 0 {
+		//     in.Architecture = previous[0]
+		// }
+		out += fmt.Sprintf("%sif len(%s) > 0 {\n", indent, sourceVarName)
+		out += fmt.Sprintf(
+			"%s\t%s = %s[0]\n", indent, targetVarName, sourceVarName,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+	default:
+		conversion, found := scalarMigrations[previousType][f.GoType]
+		if !found {
+			msg := fmt.Sprintf(
+				"unsupported migration of field %s.%s from %s to %s",
+				r.Names.Original, f.Names.Original, previousType, f.GoType,
+			)
+			panic(msg)
+		}
+		// if previous != nil {
+		//     converted, err := strconv.ParseInt(*previous, 10, 64)
+		//     if err != nil {
+		//         return err
+		//     }
+		//     in.Timeout = &converted
+		// }
+		expr := fmt.Sprintf(conversion.format, "*"+sourceVarName)
+		out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceVarName)
+		if conversion.mayFail {
+			out += fmt.Sprintf("%s\tconverted, err := %s\n", indent, expr)
+			out += fmt.Sprintf("%s\tif err != nil {\n", indent)
+			out += fmt.Sprintf("%s\t\treturn err\n", indent)
+			out += fmt.Sprintf("%s\t}\n", indent)
+		} else {
+			out += fmt.Sprintf("%s\tconverted := %s\n", indent, expr)
+		}
+		out += fmt.Sprintf("%s\t%s = &converted\n", indent, targetVarName)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
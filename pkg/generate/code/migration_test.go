@@ -0,0 +1,91 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestMigrateSpecFields_Lambda_Function(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-field-migrations.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+
+	// The previous name of the Runtime field is kept as a deprecated field
+	deprecated := crd.SpecFields["RuntimeName"]
+	require.NotNil(deprecated)
+	assert.Equal("*string", deprecated.GoType)
+	assert.Contains(deprecated.GetDocumentation(), "Deprecated: use Runtime instead.")
+	assert.True(crd.SpecFieldMigrationsParseStrings())
+
+	expected := `	// spec has no UnmarshalJSON method, avoiding recursion
+	type spec FunctionSpec
+	aux := &struct {
+		*spec
+		Layers json.RawMessage ` + "`json:\"layers,omitempty\"`" + `
+		Timeout json.RawMessage ` + "`json:\"timeout,omitempty\"`" + `
+	}{spec: (*spec)(in)}
+	if err := json.Unmarshal(b, aux); err != nil {
+		return err
+	}
+	if len(aux.Layers) > 0 {
+		if err := json.Unmarshal(aux.Layers, &in.Layers); err != nil {
+			var previous *string
+			if err := json.Unmarshal(aux.Layers, &previous); err != nil {
+				return err
+			}
+			if previous != nil {
+				in.Layers = []*string{previous}
+			}
+		}
+	}
+	if len(aux.Timeout) > 0 {
+		if err := json.Unmarshal(aux.Timeout, &in.Timeout); err != nil {
+			var previous *string
+			if err := json.Unmarshal(aux.Timeout, &previous); err != nil {
+				return err
+			}
+			if previous != nil {
+				converted, err := strconv.ParseInt(*previous, 10, 64)
+				if err != nil {
+					return err
+				}
+				in.Timeout = &converted
+			}
+		}
+	}
+	if in.RuntimeName != nil {
+		if in.Runtime == nil {
+			in.Runtime = in.RuntimeName
+		}
+		in.RuntimeName = nil
+	}
+`
+	assert.Equal(
+		expected,
+		code.MigrateSpecFields(crd.Config(), crd, "in", "b", 1),
+	)
+}
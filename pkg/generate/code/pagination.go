@@ -0,0 +1,194 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/fieldpath"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// ReadManyPagination returns the Go code that reads the pages of results of
+// the ReadMany operation following the first one and appends their elements
+// to the list of the first page's Output shape, so that a resource beyond the
+// first page is not considered missing. No page is read once the page read
+// last holds an element describing the resource, unless the elements are
+// nested in the lists of the Output shape. An empty string is returned if the
+// ReadMany operation is not paginated.
+//
+//	Sample output:
+//
+//	for page := resp; page.NextToken != nil && *page.NextToken != ""; {
+//		// Stop once the page read last holds the resource
+//		matched := false
+//		for _, elem := range page.Repositories {
+//			if r.ko.Spec.RepositoryName != nil && (elem.RepositoryName == nil || *elem.RepositoryName != *r.ko.Spec.RepositoryName) {
+//				continue
+//			}
+//			matched = true
+//			break
+//		}
+//		if matched {
+//			break
+//		}
+//		input.NextToken = page.NextToken
+//		page, err = rm.sdkapi.DescribeRepositoriesWithContext(ctx, input)
+//		rm.metrics.RecordAPICall("READ_MANY", "DescribeRepositories", err)
+//		if err != nil {
+//			return nil, err
+//		}
+//		resp.Repositories = append(resp.Repositories, page.Repositories...)
+//	}
+func ReadManyPagination(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the Input shape
+	// of the ReadMany operation, e.g. "input"
+	inputVarName string,
+	// String representing the name of the variable holding the Output shape
+	// of the first call to the ReadMany operation, e.g. "resp"
+	outputVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	inputToken, outputToken := r.ReadManyPaginationTokens()
+	if inputToken == "" {
+		return ""
+	}
+	op := r.Ops.ReadMany
+	listMemberName := ""
+	// The elements describing the resource are those of the paginated list,
+	// unless they are nested in its elements
+	nestedElems := false
+	if wrapperFieldPath := r.GetOutputWrapperFieldPath(op); wrapperFieldPath != nil {
+		listMemberName, _ = fieldpath.SplitIndex(strings.Split(*wrapperFieldPath, ".")[0])
+		nestedElems = strings.Contains(*wrapperFieldPath, ".")
+	} else {
+		for _, memberName := range op.OutputRef.Shape.MemberNames() {
+			if op.OutputRef.Shape.MemberRefs[memberName].Shape.Type == "list" {
+				listMemberName = memberName
+				break
+			}
+		}
+	}
+	listMemberRef, found := op.OutputRef.Shape.MemberRefs[listMemberName]
+	if !found || listMemberRef.Shape.Type != "list" {
+		msg := fmt.Sprintf(
+			"unable to paginate %s: its Output shape has no list of %s",
+			op.ExportedName, r.Names.Camel,
+		)
+		panic(msg)
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf(
+		"%sfor page := %s; page.%s != nil && *page.%s != \"\"; {\n",
+		indent, outputVarName, outputToken, outputToken,
+	)
+	if !nestedElems {
+		guard := readManyMatchGuard(
+			cfg, r, op, listMemberRef.Shape.MemberRef.Shape, "elem", "r.ko",
+			indentLevel+2,
+		)
+		out += fmt.Sprintf("%s\t// Stop once the page read last holds the resource\n", indent)
+		if guard == "" {
+			// Any element describes the resource
+			out += fmt.Sprintf("%s\tif len(page.%s) > 0 {\n", indent, listMemberName)
+			out += fmt.Sprintf("%s\t\tbreak\n", indent)
+			out += fmt.Sprintf("%s\t}\n", indent)
+		} else {
+			out += readManyPageMatch(listMemberName, guard, indent)
+		}
+	}
+	out += fmt.Sprintf(
+		"%s\t%s.%s = page.%s\n", indent, inputVarName, inputToken, outputToken,
+	)
+	if r.LogsSDKPayloads() {
+		out += fmt.Sprintf(
+			"%s\tlogSDKPayload(ctx, %q, \"input\", %s)\n",
+			indent, op.ExportedName, inputVarName,
+		)
+	}
+	out += SDKCall(cfg, r, op, "page, err =", inputVarName, indentLevel+1) + "\n"
+	out += fmt.Sprintf(
+		"%s\trm.metrics.RecordAPICall(\"READ_MANY\", %q, err)\n",
+		indent, op.ExportedName,
+	)
+	if r.LogsSDKPayloads() {
+		out += fmt.Sprintf(
+			"%s\tlogSDKPayload(ctx, %q, \"output\", page)\n",
+			indent, op.ExportedName,
+		)
+	}
+	out += fmt.Sprintf("%s\tif err != nil {\n", indent)
+	out += fmt.Sprintf("%s\t\treturn nil, err\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf(
+		"%s\t%s.%s = append(%s.%s, page.%s...)\n",
+		indent, outputVarName, listMemberName, outputVarName, listMemberName,
+		listMemberName,
+	)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// ReadManyPageSize returns the Go code that sets the configured maximum
+// number of results returned by each call to the ReadMany operation on its
+// Input shape. An empty string is returned if no page size is configured.
+//
+//	Sample output:
+//
+//	res.SetMaxResults(100)
+func ReadManyPageSize(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the Input shape
+	// of the ReadMany operation, e.g. "res"
+	inputVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	pageSize := r.ListOpPageSize()
+	if pageSize == 0 {
+		return ""
+	}
+	memberName := r.ReadManyPageSizeMemberName()
+	if memberName == "" {
+		msg := fmt.Sprintf(
+			"list_operation.page_size is set for %s but the %s Input shape has no page size member",
+			r.Names.Camel, r.Ops.ReadMany.ExportedName,
+		)
+		panic(msg)
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	return fmt.Sprintf("%s%s.Set%s(%d)\n", indent, inputVarName, memberName, pageSize)
+}
+
+// readManyPageMatch returns the Go code stopping the pagination of a ReadMany
+// operation once the page read last holds an element passing the supplied
+// match guard.
+func readManyPageMatch(listMemberName string, guard string, indent string) string {
+	out := fmt.Sprintf("%s\tmatched := false\n", indent)
+	out += fmt.Sprintf("%s\tfor _, elem := range page.%s {\n", indent, listMemberName)
+	out += guard
+	out += fmt.Sprintf("%s\t\tmatched = true\n", indent)
+	out += fmt.Sprintf("%s\t\tbreak\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s\tif matched {\n", indent)
+	out += fmt.Sprintf("%s\t\tbreak\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	return out
+}
@@ -0,0 +1,92 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	 http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestReadManyPagination_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-list-page-size.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	expected := `	for page := resp; page.NextToken != nil && *page.NextToken != ""; {
+		// Stop once the page read last holds the resource
+		matched := false
+		for _, elem := range page.Repositories {
+			if r.ko.Spec.RepositoryName != nil && (elem.RepositoryName == nil || *elem.RepositoryName != *r.ko.Spec.RepositoryName) {
+				continue
+			}
+			matched = true
+			break
+		}
+		if matched {
+			break
+		}
+		input.NextToken = page.NextToken
+		page, err = rm.sdkapi.DescribeRepositoriesWithContext(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeRepositories", err)
+		if err != nil {
+			return nil, err
+		}
+		resp.Repositories = append(resp.Repositories, page.Repositories...)
+	}
+`
+	assert.Equal(expected, code.ReadManyPagination(crd.Config(), crd, "input", "resp", 1))
+	assert.Equal(
+		"\tres.SetMaxResults(100)\n",
+		code.ReadManyPageSize(crd.Config(), crd, "res", 1),
+	)
+}
+
+func TestReadManyPagination_RDS_DBInstance_Marker(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "rds")
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	expected := `	for page := resp; page.Marker != nil && *page.Marker != ""; {
+		// Stop once the page read last holds the resource
+		if len(page.DBInstances) > 0 {
+			break
+		}
+		input.Marker = page.Marker
+		page, err = rm.sdkapi.DescribeDBInstancesWithContext(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeDBInstances", err)
+		if err != nil {
+			return nil, err
+		}
+		resp.DBInstances = append(resp.DBInstances, page.DBInstances...)
+	}
+`
+	assert.Equal(expected, code.ReadManyPagination(crd.Config(), crd, "input", "resp", 1))
+	// No page size is configured
+	assert.Equal("", code.ReadManyPageSize(crd.Config(), crd, "res", 1))
+}
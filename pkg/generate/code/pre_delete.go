@@ -0,0 +1,447 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/fieldpath"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// PreDelete returns the Go code that prepares a resource for its deletion,
+// as configured in the resource's `pre_delete` generator config: it calls the
+// pre-delete operations, in order, empties the configured collections, then
+// returns a requeue error unless the `wait_for` field of the resource is in
+// one of the expected states. Since the operations usually change that
+// state, the resource is read again after calling them, before `wait_for` is
+// checked.
+//
+// Sample output:
+//
+//	// Pre-delete operation ModifyDBInstance
+//	if r.ko.Spec.DBInstanceIdentifier != nil {
+//		input := &svcsdk.ModifyDBInstanceInput{}
+//		input.DBInstanceIdentifier = r.ko.Spec.DBInstanceIdentifier
+//		input.SetApplyImmediately(true)
+//		input.SetDeletionProtection(false)
+//		_, err := rm.sdkapi.ModifyDBInstanceWithContext(ctx, input)
+//		rm.metrics.RecordAPICall("UPDATE", "ModifyDBInstance", err)
+//		if err != nil {
+//			return err
+//		}
+//	}
+//	// Read the state the pre-delete operations left the resource in
+//	observed, err := rm.sdkFind(ctx, r)
+//	if err != nil {
+//		if err == ackerr.NotFound {
+//			return nil
+//		}
+//		return err
+//	}
+//	r = observed
+//	if r.ko.Status.DBInstanceStatus == nil || !(*r.ko.Status.DBInstanceStatus == "available" || *r.ko.Status.DBInstanceStatus == "stopped") {
+//		return ackrequeue.NeededAfter(
+//			errors.New("waiting for Status.DBInstanceStatus to be one of available, stopped before deleting the resource"),
+//			preDeleteRequeueAfter,
+//		)
+//	}
+func PreDelete(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// *resource variable name
+	resVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	preDeleteCfg := cfg.GetPreDeleteConfig(r.Names.Original)
+	if preDeleteCfg == nil {
+		return ""
+	}
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	for _, opCfg := range preDeleteCfg.Operations {
+		subject := fmt.Sprintf("pre-delete operation %q", opCfg.Operation)
+		op := r.GetOperation(opCfg.Operation)
+		if op == nil {
+			panic(fmt.Sprintf("unable to find %s. crd: %q", subject, r.Kind))
+		}
+		inputShape := op.InputRef.Shape
+
+		out += fmt.Sprintf("%s// Pre-delete operation %s\n", indent, op.ExportedName)
+		out += subAPIInputBlockOpen(r, opCfg.InputFields, subject, op, resVarName, indentLevel)
+		innerIndent := indent + "\t"
+
+		// Sort the input member names to generate deterministic code
+		memberNames := make([]string, 0, len(opCfg.InputValues))
+		for memberName := range opCfg.InputValues {
+			memberNames = append(memberNames, memberName)
+		}
+		sort.Strings(memberNames)
+		for _, memberName := range memberNames {
+			value := opCfg.InputValues[memberName]
+			memberShapeRef, found := inputShape.MemberRefs[memberName]
+			if !found {
+				panic(fmt.Sprintf("unable to find member %q in input shape %q "+
+					"of %s. crd: %q", memberName, inputShape.ShapeName,
+					subject, r.Kind))
+			}
+			literal, err := scalarValueLiteral(memberShapeRef.Shape, value)
+			if err != nil {
+				panic(fmt.Sprintf("input_values member %q of %s: %v. crd: %q",
+					memberName, subject, err, r.Kind))
+			}
+			out += fmt.Sprintf("%sinput.Set%s(%s)\n", innerIndent, memberName, literal)
+		}
+		out += SDKCall(cfg, r, op, "_, err :=", "input", len(innerIndent)) + "\n"
+		out += fmt.Sprintf("%srm.metrics.RecordAPICall(\"UPDATE\", %q, err)\n", innerIndent, op.ExportedName)
+		out += fmt.Sprintf("%sif err != nil {\n", innerIndent)
+		out += notFoundCodesCheck(opCfg.NotFoundCodes, innerIndent+"\t")
+		out += fmt.Sprintf("%s}\n", innerIndent)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	for _, emptyCfg := range preDeleteCfg.Empty {
+		out += preDeleteEmpty(cfg, r, emptyCfg, resVarName, indentLevel)
+	}
+
+	waitFor := preDeleteCfg.WaitFor
+	if waitFor == nil {
+		return out
+	}
+	if waitFor.Path == nil || len(waitFor.In) == 0 {
+		panic(fmt.Sprintf("pre_delete wait_for must have a path and at "+
+			"least one value. crd: %q", r.Kind))
+	}
+	accessor, nilChecks, err := auxiliaryFieldAccessor(r, *waitFor.Path, resVarName)
+	if err != nil {
+		panic(fmt.Sprintf("invalid pre_delete wait_for path: %v. crd: %q", err, r.Kind))
+	}
+	fp := fieldpath.FromString(*waitFor.Path)
+	fp.PopFront()
+	if r.Fields[fp.String()].GoType != "*string" {
+		panic(fmt.Sprintf("pre_delete wait_for path %q must be a string "+
+			"field. crd: %q", *waitFor.Path, r.Kind))
+	}
+	if len(preDeleteCfg.Operations) > 0 {
+		out += fmt.Sprintf("%s// Read the state the pre-delete operations left the resource in\n", indent)
+		out += fmt.Sprintf("%sobserved, err := rm.sdkFind(ctx, %s)\n", indent, resVarName)
+		out += fmt.Sprintf("%sif err != nil {\n", indent)
+		out += fmt.Sprintf("%s\tif err == ackerr.NotFound {\n", indent)
+		out += fmt.Sprintf("%s\t\treturn nil\n", indent)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf("%s\treturn err\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+		out += fmt.Sprintf("%s%s = observed\n", indent, resVarName)
+	}
+	conditions := []string{}
+	for _, nilCheck := range nilChecks {
+		// auxiliaryFieldAccessor returns `x != nil` checks
+		conditions = append(conditions, strings.Replace(nilCheck, "!=", "==", 1))
+	}
+	matches := make([]string, 0, len(waitFor.In))
+	for _, value := range waitFor.In {
+		matches = append(matches, fmt.Sprintf("*%s == %q", accessor, value))
+	}
+	conditions = append(conditions, fmt.Sprintf("!(%s)", strings.Join(matches, " || ")))
+	out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(conditions, " || "))
+	out += fmt.Sprintf("%s\treturn ackrequeue.NeededAfter(\n", indent)
+	out += fmt.Sprintf(
+		"%s\t\terrors.New(%q),\n", indent,
+		fmt.Sprintf(
+			"waiting for %s to be one of %s before deleting the resource",
+			*waitFor.Path, strings.Join(waitFor.In, ", "),
+		),
+	)
+	out += fmt.Sprintf("%s\t\tpreDeleteRequeueAfter,\n", indent)
+	out += fmt.Sprintf("%s\t)\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// preDeleteEmpty returns the Go code that empties a collection of the
+// resource, e.g. the images of an ECR repository, before its deletion. The
+// collection is listed page by page, the elements of a page being deleted
+// before the collection is listed again from its first page.
+//
+// Sample output:
+//
+//	// Empty collection Images
+//	if (r.ko.Spec.ForceDelete != nil && *r.ko.Spec.ForceDelete) || r.ko.GetAnnotations()[forceDeleteAnnotation] == "true" {
+//		if r.ko.Spec.RepositoryName != nil {
+//			input := &svcsdk.ListImagesInput{}
+//			input.RepositoryName = r.ko.Spec.RepositoryName
+//			for {
+//				resp, err := rm.sdkapi.ListImagesWithContext(ctx, input)
+//				rm.metrics.RecordAPICall("READ_MANY", "ListImages", err)
+//				if err != nil {
+//					return err
+//				}
+//				if len(resp.ImageIds) > 0 {
+//					deleteInput := &svcsdk.BatchDeleteImageInput{}
+//					deleteInput.RepositoryName = r.ko.Spec.RepositoryName
+//					deleteInput.ImageIds = resp.ImageIds
+//					deleteResp, err := rm.sdkapi.BatchDeleteImageWithContext(ctx, deleteInput)
+//					rm.metrics.RecordAPICall("DELETE", "BatchDeleteImage", err)
+//					if err != nil {
+//						return err
+//					}
+//					if len(deleteResp.Failures) > 0 {
+//						return fmt.Errorf("failed to delete %d element(s) of collection Images: %v", len(deleteResp.Failures), deleteResp.Failures[0])
+//					}
+//					// The deleted elements are no longer listed, list the remaining
+//					// ones from the first page
+//					input.NextToken = nil
+//					continue
+//				}
+//				if resp.NextToken == nil || *resp.NextToken == "" {
+//					break
+//				}
+//				input.NextToken = resp.NextToken
+//			}
+//		}
+//	}
+func preDeleteEmpty(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	emptyCfg *ackgenconfig.PreDeleteEmptyConfig,
+	// *resource variable name
+	resVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	subject := fmt.Sprintf("pre-delete collection %q", emptyCfg.Name)
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf("%s// Empty collection %s\n", indent, emptyCfg.Name)
+	if emptyCfg.OnlyWhenForced {
+		forceCfg := cfg.GetForceDeleteConfig(r.Names.Original)
+		if forceCfg == nil {
+			panic(fmt.Sprintf("%s is only emptied when forced but the "+
+				"resource has no force_delete config. crd: %q", subject, r.Kind))
+		}
+		out += fmt.Sprintf("%sif %s {\n", indent, forceDeleteCondition(r, forceCfg, resVarName))
+		indentLevel++
+		indent += "\t"
+	}
+	if emptyCfg.CustomMethodName != "" {
+		out += fmt.Sprintf("%sif err := rm.%s(ctx, %s); err != nil {\n", indent, emptyCfg.CustomMethodName, resVarName)
+		out += fmt.Sprintf("%s\treturn err\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+	} else {
+		out += preDeleteEmptyWithOperations(cfg, r, emptyCfg, subject, resVarName, indentLevel)
+	}
+	if emptyCfg.OnlyWhenForced {
+		out += fmt.Sprintf("%s}\n", indent[1:])
+	}
+	return out
+}
+
+// preDeleteEmptyWithOperations returns the Go code that lists the elements of
+// a collection with its list operation and deletes them with its delete
+// operation.
+func preDeleteEmptyWithOperations(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	emptyCfg *ackgenconfig.PreDeleteEmptyConfig,
+	// description of the collection, for error messages
+	subject string,
+	resVarName string,
+	indentLevel int,
+) string {
+	listOp := r.GetOperation(emptyCfg.ListOperation)
+	if listOp == nil {
+		panic(fmt.Sprintf("unable to find list operation %q of %s. crd: %q",
+			emptyCfg.ListOperation, subject, r.Kind))
+	}
+	deleteOp := r.GetOperation(emptyCfg.DeleteOperation)
+	if deleteOp == nil {
+		panic(fmt.Sprintf("unable to find delete operation %q of %s. crd: %q",
+			emptyCfg.DeleteOperation, subject, r.Kind))
+	}
+	listShapeRef, found := listOp.OutputRef.Shape.MemberRefs[emptyCfg.ListPath]
+	if !found || listShapeRef.Shape.Type != "list" {
+		panic(fmt.Sprintf("list_path %q of %s must be a list member of the "+
+			"%q output shape. crd: %q", emptyCfg.ListPath, subject,
+			listOp.ExportedName, r.Kind))
+	}
+	if (emptyCfg.DeleteItemsMember == "") == (len(emptyCfg.DeleteItemMembers) == 0) {
+		panic(fmt.Sprintf("%s must have either a delete_items_member or "+
+			"delete_item_members. crd: %q", subject, r.Kind))
+	}
+	deleteShape := deleteOp.InputRef.Shape
+	for _, memberName := range sortedMemberNames(emptyCfg.DeleteInputFields) {
+		if _, found := deleteShape.MemberRefs[memberName]; !found {
+			panic(fmt.Sprintf("unable to find member %q in input shape %q of "+
+				"%s. crd: %q", memberName, deleteShape.ShapeName, subject, r.Kind))
+		}
+	}
+	itemShape := listShapeRef.Shape.MemberRef.Shape
+	deleteAssignments := []string{}
+	if emptyCfg.DeleteItemsMember != "" {
+		itemsShapeRef, found := deleteShape.MemberRefs[emptyCfg.DeleteItemsMember]
+		if !found || itemsShapeRef.Shape.Type != "list" ||
+			itemsShapeRef.Shape.MemberRef.Shape.ShapeName != itemShape.ShapeName {
+			panic(fmt.Sprintf("delete_items_member %q of %s must be a list of "+
+				"%q in input shape %q. crd: %q", emptyCfg.DeleteItemsMember,
+				subject, itemShape.ShapeName, deleteShape.ShapeName, r.Kind))
+		}
+	}
+	for _, memberName := range sortedMemberNames(emptyCfg.DeleteItemMembers) {
+		itemMemberName := emptyCfg.DeleteItemMembers[memberName]
+		if _, found := deleteShape.MemberRefs[memberName]; !found {
+			panic(fmt.Sprintf("unable to find member %q in input shape %q of "+
+				"%s. crd: %q", memberName, deleteShape.ShapeName, subject, r.Kind))
+		}
+		if _, found := itemShape.MemberRefs[itemMemberName]; !found {
+			panic(fmt.Sprintf("unable to find member %q in element shape %q "+
+				"of %s. crd: %q", itemMemberName, itemShape.ShapeName, subject, r.Kind))
+		}
+		deleteAssignments = append(deleteAssignments, fmt.Sprintf(
+			"deleteInput.%s = item.%s", memberName, itemMemberName,
+		))
+	}
+	failuresMember := emptyCfg.DeleteFailuresMember
+	if failuresMember == "" {
+		if _, found := deleteOp.OutputRef.Shape.MemberRefs["Failures"]; found {
+			failuresMember = "Failures"
+		}
+	}
+	if failuresMember != "" {
+		failuresShapeRef, found := deleteOp.OutputRef.Shape.MemberRefs[failuresMember]
+		if !found || failuresShapeRef.Shape.Type != "list" {
+			panic(fmt.Sprintf("delete_failures_member %q of %s must be a list "+
+				"member of the %q output shape. crd: %q", failuresMember,
+				subject, deleteOp.ExportedName, r.Kind))
+		}
+	}
+	inputToken, outputToken := r.PaginationTokens(listOp)
+
+	out := subAPIInputBlockOpen(r, emptyCfg.ListInputFields, subject, listOp, resVarName, indentLevel)
+	indent := strings.Repeat("\t", indentLevel+1)
+	// Fields already checked by the list input block are not checked again
+	listNilChecks := map[string]bool{}
+	for _, path := range emptyCfg.ListInputFields {
+		_, nilChecks, err := auxiliaryFieldAccessor(r, path, resVarName)
+		if err != nil {
+			panic(fmt.Sprintf("invalid list_input_fields of %s: %v. crd: %q",
+				subject, err, r.Kind))
+		}
+		for _, nilCheck := range nilChecks {
+			listNilChecks[nilCheck] = true
+		}
+	}
+	deleteNilChecks := []string{}
+	deleteFields := []string{}
+	for _, memberName := range sortedMemberNames(emptyCfg.DeleteInputFields) {
+		accessor, nilChecks, err := auxiliaryFieldAccessor(r, emptyCfg.DeleteInputFields[memberName], resVarName)
+		if err != nil {
+			panic(fmt.Sprintf("invalid delete_input_fields of %s: %v. crd: %q",
+				subject, err, r.Kind))
+		}
+		for _, nilCheck := range nilChecks {
+			if !listNilChecks[nilCheck] {
+				deleteNilChecks = append(deleteNilChecks, nilCheck)
+			}
+		}
+		deleteFields = append(deleteFields, fmt.Sprintf("deleteInput.%s = %s", memberName, accessor))
+	}
+
+	// The elements cannot be deleted, and thus the list relisted until it is
+	// empty, without the fields of the delete operation
+	if len(deleteNilChecks) > 0 {
+		out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(deleteNilChecks, " && "))
+		indent += "\t"
+	}
+	out += fmt.Sprintf("%sfor {\n", indent)
+	out += SDKCall(cfg, r, listOp, "resp, err :=", "input", len(indent)+1) + "\n"
+	out += fmt.Sprintf("%s\trm.metrics.RecordAPICall(\"READ_MANY\", %q, err)\n", indent, listOp.ExportedName)
+	out += fmt.Sprintf("%s\tif err != nil {\n", indent)
+	out += fmt.Sprintf("%s\t\treturn err\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+
+	out += fmt.Sprintf("%s\tif len(resp.%s) > 0 {\n", indent, emptyCfg.ListPath)
+	deleteIndent := indent + "\t\t"
+	if emptyCfg.DeleteItemsMember == "" {
+		out += fmt.Sprintf("%sfor _, item := range resp.%s {\n", deleteIndent, emptyCfg.ListPath)
+		deleteIndent += "\t"
+	}
+	out += fmt.Sprintf("%sdeleteInput := &svcsdk.%s{}\n", deleteIndent, deleteShape.ShapeName)
+	for _, assignment := range deleteFields {
+		out += fmt.Sprintf("%s%s\n", deleteIndent, assignment)
+	}
+	if emptyCfg.DeleteItemsMember != "" {
+		out += fmt.Sprintf("%sdeleteInput.%s = resp.%s\n", deleteIndent, emptyCfg.DeleteItemsMember, emptyCfg.ListPath)
+	}
+	for _, assignment := range deleteAssignments {
+		out += fmt.Sprintf("%s%s\n", deleteIndent, assignment)
+	}
+	if failuresMember != "" {
+		out += SDKCall(cfg, r, deleteOp, "deleteResp, err :=", "deleteInput", len(deleteIndent)) + "\n"
+	} else {
+		out += SDKCall(cfg, r, deleteOp, "_, err =", "deleteInput", len(deleteIndent)) + "\n"
+	}
+	out += fmt.Sprintf("%srm.metrics.RecordAPICall(\"DELETE\", %q, err)\n", deleteIndent, deleteOp.ExportedName)
+	out += fmt.Sprintf("%sif err != nil {\n", deleteIndent)
+	out += fmt.Sprintf("%s\treturn err\n", deleteIndent)
+	out += fmt.Sprintf("%s}\n", deleteIndent)
+	if failuresMember != "" {
+		out += fmt.Sprintf("%sif len(deleteResp.%s) > 0 {\n", deleteIndent, failuresMember)
+		out += fmt.Sprintf(
+			"%s\treturn fmt.Errorf(\"failed to delete %%d element(s) of collection %s: %%v\", len(deleteResp.%s), deleteResp.%s[0])\n",
+			deleteIndent, emptyCfg.Name, failuresMember, failuresMember,
+		)
+		out += fmt.Sprintf("%s}\n", deleteIndent)
+	}
+	if emptyCfg.DeleteItemsMember == "" {
+		out += fmt.Sprintf("%s\t\t}\n", indent)
+	}
+	if inputToken != "" {
+		out += fmt.Sprintf("%s\t\t// The deleted elements are no longer listed, list the remaining\n", indent)
+		out += fmt.Sprintf("%s\t\t// ones from the first page\n", indent)
+		out += fmt.Sprintf("%s\t\tinput.%s = nil\n", indent, inputToken)
+		out += fmt.Sprintf("%s\t\tcontinue\n", indent)
+	}
+	out += fmt.Sprintf("%s\t}\n", indent)
+
+	if inputToken == "" {
+		// Without pagination, the collection is emptied by a single page
+		out += fmt.Sprintf("%s\tbreak\n", indent)
+	} else {
+		// Pages without elements are followed by pages that may have some
+		out += fmt.Sprintf("%s\tif resp.%s == nil || *resp.%s == \"\" {\n", indent, outputToken, outputToken)
+		out += fmt.Sprintf("%s\t\tbreak\n", indent)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf("%s\tinput.%s = resp.%s\n", indent, inputToken, outputToken)
+	}
+	out += fmt.Sprintf("%s}\n", indent)
+	if len(deleteNilChecks) > 0 {
+		indent = indent[1:]
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	out += fmt.Sprintf("%s}\n", indent[1:])
+	return out
+}
+
+// sortedMemberNames returns the sorted keys of the supplied map, keyed by
+// shape member name, to generate deterministic code
+func sortedMemberNames(m map[string]string) []string {
+	memberNames := make([]string, 0, len(m))
+	for memberName := range m {
+		memberNames = append(memberNames, memberName)
+	}
+	sort.Strings(memberNames)
+	return memberNames
+}
@@ -0,0 +1,207 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestPreDelete_RDS_DBInstance(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-pre-delete.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+	assert.True(crd.HasPreDelete())
+
+	expected := `	// Pre-delete operation ModifyDBInstance
+	if r.ko.Spec.DBInstanceIdentifier != nil {
+		input := &svcsdk.ModifyDBInstanceInput{}
+		input.DBInstanceIdentifier = r.ko.Spec.DBInstanceIdentifier
+		input.SetApplyImmediately(true)
+		input.SetDeletionProtection(false)
+		_, err := rm.sdkapi.ModifyDBInstanceWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "ModifyDBInstance", err)
+		if err != nil {
+			awsErr, ok := ackerr.AWSError(err)
+			if !ok || !(awsErr.Code() == "DBInstanceNotFound") {
+				return err
+			}
+		}
+	}
+	// Read the state the pre-delete operations left the resource in
+	observed, err := rm.sdkFind(ctx, r)
+	if err != nil {
+		if err == ackerr.NotFound {
+			return nil
+		}
+		return err
+	}
+	r = observed
+	if r.ko.Status.DBInstanceStatus == nil || !(*r.ko.Status.DBInstanceStatus == "available" || *r.ko.Status.DBInstanceStatus == "stopped") {
+		return ackrequeue.NeededAfter(
+			errors.New("waiting for Status.DBInstanceStatus to be one of available, stopped before deleting the resource"),
+			preDeleteRequeueAfter,
+		)
+	}
+`
+	assert.Equal(expected, code.PreDelete(crd.Config(), crd, "r", 1))
+
+	// input_values must be valid values of their member's type, and are
+	// emitted in their canonical Go form
+	opCfg := *crd.Config().Resources["DBInstance"].PreDelete.Operations[0]
+	opCfg.InputValues = map[string]string{"ApplyImmediately": "t"}
+	crd.Config().Resources["DBInstance"].PreDelete.Operations[0] = &opCfg
+	assert.Contains(code.PreDelete(crd.Config(), crd, "r", 1), "\tinput.SetApplyImmediately(true)\n")
+	opCfg.InputValues = map[string]string{"ApplyImmediately": "yes"}
+	assert.PanicsWithValue(
+		`input_values member "ApplyImmediately" of pre-delete operation "ModifyDBInstance": "yes" is not a valid boolean value. crd: "DBInstance"`,
+		func() { code.PreDelete(crd.Config(), crd, "r", 1) },
+	)
+	opCfg.InputValues = map[string]string{"AllocatedStorage": "20GB"}
+	assert.PanicsWithValue(
+		`input_values member "AllocatedStorage" of pre-delete operation "ModifyDBInstance": "20GB" is not a valid integer value. crd: "DBInstance"`,
+		func() { code.PreDelete(crd.Config(), crd, "r", 1) },
+	)
+
+	// Resources without a pre_delete config are deleted right away
+	g = testutil.NewModelForService(t, "rds")
+	crd = testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+	assert.False(crd.HasPreDelete())
+	assert.Empty(code.PreDelete(crd.Config(), crd, "r", 1))
+}
+
+func TestPreDelete_ECR_Repository_Empty(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-pre-delete-empty.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.True(crd.HasPreDelete())
+
+	expected := `	// Empty collection Images
+	if (r.ko.Spec.ForceDelete != nil && *r.ko.Spec.ForceDelete) || r.ko.GetAnnotations()[forceDeleteAnnotation] == "true" {
+		if r.ko.Status.RegistryID != nil && r.ko.Spec.RepositoryName != nil {
+			input := &svcsdk.ListImagesInput{}
+			input.RegistryId = r.ko.Status.RegistryID
+			input.RepositoryName = r.ko.Spec.RepositoryName
+			for {
+				resp, err := rm.sdkapi.ListImagesWithContext(ctx, input)
+				rm.metrics.RecordAPICall("READ_MANY", "ListImages", err)
+				if err != nil {
+					return err
+				}
+				if len(resp.ImageIds) > 0 {
+					deleteInput := &svcsdk.BatchDeleteImageInput{}
+					deleteInput.RepositoryName = r.ko.Spec.RepositoryName
+					deleteInput.ImageIds = resp.ImageIds
+					deleteResp, err := rm.sdkapi.BatchDeleteImageWithContext(ctx, deleteInput)
+					rm.metrics.RecordAPICall("DELETE", "BatchDeleteImage", err)
+					if err != nil {
+						return err
+					}
+					if len(deleteResp.Failures) > 0 {
+						return fmt.Errorf("failed to delete %d element(s) of collection Images: %v", len(deleteResp.Failures), deleteResp.Failures[0])
+					}
+					// The deleted elements are no longer listed, list the remaining
+					// ones from the first page
+					input.NextToken = nil
+					continue
+				}
+				if resp.NextToken == nil || *resp.NextToken == "" {
+					break
+				}
+				input.NextToken = resp.NextToken
+			}
+		}
+	}
+	// Empty collection Layers
+	if err := rm.emptyLayers(ctx, r); err != nil {
+		return err
+	}
+`
+	assert.Equal(
+		expected,
+		code.PreDelete(crd.Config(), crd, "r", 1),
+	)
+}
+
+func TestPreDelete_Lambda_Function_EmptyPerElement(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-pre-delete-empty.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+
+	// The collection is only listed when its elements can be deleted, and
+	// listed again from its first page once a page is deleted
+	expected := `	// Empty collection Aliases
+	if r.ko.Spec.FunctionName != nil {
+		input := &svcsdk.ListAliasesInput{}
+		input.FunctionName = r.ko.Spec.FunctionName
+		if r.ko.Status.RevisionID != nil {
+			for {
+				resp, err := rm.sdkapi.ListAliasesWithContext(ctx, input)
+				rm.metrics.RecordAPICall("READ_MANY", "ListAliases", err)
+				if err != nil {
+					return err
+				}
+				if len(resp.Aliases) > 0 {
+					for _, item := range resp.Aliases {
+						deleteInput := &svcsdk.DeleteAliasInput{}
+						deleteInput.FunctionName = r.ko.Status.RevisionID
+						deleteInput.Name = item.Name
+						_, err = rm.sdkapi.DeleteAliasWithContext(ctx, deleteInput)
+						rm.metrics.RecordAPICall("DELETE", "DeleteAlias", err)
+						if err != nil {
+							return err
+						}
+					}
+					// The deleted elements are no longer listed, list the remaining
+					// ones from the first page
+					input.Marker = nil
+					continue
+				}
+				if resp.NextMarker == nil || *resp.NextMarker == "" {
+					break
+				}
+				input.Marker = resp.NextMarker
+			}
+		}
+	}
+`
+	assert.Equal(expected, code.PreDelete(crd.Config(), crd, "r", 1))
+}
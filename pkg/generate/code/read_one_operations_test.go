@@ -0,0 +1,71 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestReadOneOperations_Lambda_Function(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-read-one-operations.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+	assert.True(crd.HasReadOneOperations())
+	assert.Equal("GetFunction", crd.Ops.ReadOne.ExportedName)
+
+	ops := crd.GetReadOneOperations()
+	require.Len(ops, 1)
+	op := ops[0]
+	assert.Equal("GetFunctionConfiguration", op.ExportedName)
+
+	// The ReadOne operation is only called once the ARN is known, the
+	// additional operation requires the members of its Input shape
+	assert.Equal(
+		"\treturn (r.ko.Status.ACKResourceMetadata == nil || r.ko.Status.ACKResourceMetadata.ARN == nil)\n",
+		code.CheckRequiredFieldsMissingFromShape(crd, model.OpTypeGet, "r.ko", 1),
+	)
+	assert.Equal(
+		"\treturn r.ko.Spec.FunctionName == nil\n",
+		code.CheckRequiredFieldsMissingFromOperationInput(crd, op, "r.ko", 1),
+	)
+
+	expected := `
+	if r.ko.Spec.FunctionName != nil {
+		res.SetFunctionName(*r.ko.Spec.FunctionName)
+	}
+`
+	assert.Equal(expected, code.SetSDKForReadOneOperation(crd.Config(), crd, op, "r.ko", "res", 1))
+
+	expected = `	if resp.CodeSize != nil {
+		ko.Status.CodeSize = resp.CodeSize
+	} else {
+		ko.Status.CodeSize = nil
+	}
+`
+	assert.Contains(code.SetResourceForReadOneOperation(crd.Config(), crd, op, "resp", "ko", 1), expected)
+}
@@ -106,6 +106,131 @@ func ReferenceFieldsValidation(
 	return out
 }
 
+// KMSKeyFieldValidation returns the go code to validate that either a KMS Key
+// identifier field or its corresponding reference field is supplied when
+// encryption at rest is enabled for the resource. No code is returned when the
+// field has no 'enabled_by' configuration or falls back to a default KMS Key.
+//
+// Sample output:
+//
+//	if ko.Spec.StorageEncrypted != nil && *ko.Spec.StorageEncrypted {
+//		if ko.Spec.KMSKeyRef == nil && ko.Spec.KMSKeyID == nil {
+//			return ackerr.ResourceReferenceOrIDRequiredFor("KMSKeyID", "KMSKeyRef")
+//		}
+//	}
+func KMSKeyFieldValidation(
+	field *model.Field,
+	sourceVarName string,
+	indentLevel int,
+) (out string) {
+	if !field.HasKMSKeyConfig() {
+		return ""
+	}
+	kmsCfg := field.FieldConfig.KMSKey
+	if kmsCfg.EnabledBy == nil || kmsCfg.DefaultKeyID != nil {
+		return ""
+	}
+	checkTopLevelKMSKeyField(field)
+	indent := strings.Repeat("\t", indentLevel)
+	specPrefix := sourceVarName + field.CRD.Config().PrefixConfig.SpecField
+	refFieldName := field.GetReferenceFieldName().Camel
+
+	out += fmt.Sprintf("%sif %s {\n", indent, kmsKeyEnabledCondition(field, sourceVarName))
+	out += fmt.Sprintf("%s\tif %s.%s == nil && %s.%s == nil {\n", indent,
+		specPrefix, refFieldName, specPrefix, field.Names.Camel)
+	out += fmt.Sprintf("%s\t\treturn "+
+		"ackerr.ResourceReferenceOrIDRequiredFor(%q, %q)\n",
+		indent, field.Names.Camel, refFieldName)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// SetKMSKeyDefault returns the go code that sets a KMS Key identifier field to
+// its configured default KMS Key when neither the field nor its corresponding
+// reference field is supplied. When the field has an 'enabled_by'
+// configuration, the default is only applied if encryption at rest is enabled.
+//
+// Sample output:
+//
+//	if ko.Spec.StorageEncrypted != nil && *ko.Spec.StorageEncrypted {
+//		if ko.Spec.KMSKeyRef == nil && ko.Spec.KMSKeyID == nil {
+//			defaultKMSKeyID := "alias/aws/rds"
+//			ko.Spec.KMSKeyID = &defaultKMSKeyID
+//		}
+//	}
+func SetKMSKeyDefault(
+	field *model.Field,
+	targetVarName string,
+	indentLevel int,
+) (out string) {
+	if !field.HasKMSKeyConfig() {
+		return ""
+	}
+	kmsCfg := field.FieldConfig.KMSKey
+	if kmsCfg.DefaultKeyID == nil {
+		return ""
+	}
+	checkTopLevelKMSKeyField(field)
+	specPrefix := targetVarName + field.CRD.Config().PrefixConfig.SpecField
+	refFieldName := field.GetReferenceFieldName().Camel
+	defaultVarName := "default" + field.Names.Camel
+
+	outPrefix := ""
+	outSuffix := ""
+	if kmsCfg.EnabledBy != nil {
+		indent := strings.Repeat("\t", indentLevel)
+		outPrefix += fmt.Sprintf("%sif %s {\n", indent,
+			kmsKeyEnabledCondition(field, targetVarName))
+		outSuffix = fmt.Sprintf("%s}\n", indent)
+		indentLevel++
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	out += fmt.Sprintf("%sif %s.%s == nil && %s.%s == nil {\n", indent,
+		specPrefix, refFieldName, specPrefix, field.Names.Camel)
+	out += fmt.Sprintf("%s\t%s := %q\n", indent, defaultVarName, *kmsCfg.DefaultKeyID)
+	out += fmt.Sprintf("%s\t%s.%s = &%s\n", indent, specPrefix, field.Names.Camel, defaultVarName)
+	out += fmt.Sprintf("%s}\n", indent)
+	return outPrefix + out + outSuffix
+}
+
+// checkTopLevelKMSKeyField panics if the supplied KMS Key identifier field is
+// not a top-level Spec field, which is the only supported location for now.
+func checkTopLevelKMSKeyField(field *model.Field) {
+	if fieldpath.FromString(field.Path).Size() != 1 {
+		panic(fmt.Sprintf("kms_key is only supported for top-level Spec "+
+			"fields. Found %q in crd %q", field.Path, field.CRD.Kind))
+	}
+}
+
+// kmsKeyEnabledCondition returns a Go boolean expression that evaluates to
+// true when the boolean Spec field identified by the KMSKeyConfig 'enabled_by'
+// path is set to true. Every parent struct in the path is nil-checked.
+func kmsKeyEnabledCondition(field *model.Field, sourceVarName string) string {
+	r := field.CRD
+	enabledBy := *field.FieldConfig.KMSKey.EnabledBy
+	fp := fieldpath.FromString(enabledBy)
+
+	conditions := []string{}
+	accessor := sourceVarName + r.Config().PrefixConfig.SpecField
+	for idx := 0; idx < fp.Size(); idx++ {
+		curFP := fp.CopyAt(idx).String()
+		cur, ok := r.Fields[curFP]
+		if !ok {
+			panic(fmt.Sprintf("unable to find kms_key.enabled_by field with "+
+				"path %q. crd: %q", curFP, r.Kind))
+		}
+		accessor = fmt.Sprintf("%s.%s", accessor, cur.Names.Camel)
+		conditions = append(conditions, accessor+" != nil")
+		if idx == fp.Size()-1 && cur.GoType != "*bool" {
+			panic(fmt.Sprintf("kms_key.enabled_by field %q must be a boolean"+
+				" field. crd: %q", curFP, r.Kind))
+		}
+	}
+	conditions = append(conditions, "*"+accessor)
+	return strings.Join(conditions, " && ")
+}
+
 // ResolveReferencesForField returns Go code for accessing all references that
 // are related to the given concrete field, determining whether its in a valid
 // condition and updating the concrete field with the referenced value.
@@ -211,10 +336,23 @@ func ResolveReferencesForField(field *model.Field, sourceVarName string, indentL
 			outPrefix += fmt.Sprintf("%s\treturn hasReferences, fmt.Errorf(\"provided resource reference is nil or empty: %s\")\n", innerIndent, field.ReferenceFieldPath())
 			outPrefix += fmt.Sprintf("%s}\n", innerIndent)
 
-			outPrefix += fmt.Sprintf("%snamespace := ko.ObjectMeta.GetNamespace()\n", innerIndent)
-			outPrefix += fmt.Sprintf("%sif arr.Namespace != nil && *arr.Namespace != \"\" {\n", innerIndent)
-			outPrefix += fmt.Sprintf("%s\tnamespace = *arr.Namespace\n", innerIndent)
-			outPrefix += fmt.Sprintf("%s}\n", innerIndent)
+			switch {
+			case field.ReferencesClusterScopedResource():
+				// Cluster-scoped resources have no namespace
+				outPrefix += fmt.Sprintf("%snamespace := \"\"\n", innerIndent)
+			case field.CRD.IsClusterScoped():
+				// A cluster-scoped resource has no namespace to default the
+				// namespace of the referenced resource to
+				outPrefix += fmt.Sprintf("%sif arr.Namespace == nil || *arr.Namespace == \"\" {\n", innerIndent)
+				outPrefix += fmt.Sprintf("%s\treturn hasReferences, fmt.Errorf(\"provided resource reference has no namespace: %s\")\n", innerIndent, field.ReferenceFieldPath())
+				outPrefix += fmt.Sprintf("%s}\n", innerIndent)
+				outPrefix += fmt.Sprintf("%snamespace := *arr.Namespace\n", innerIndent)
+			default:
+				outPrefix += fmt.Sprintf("%snamespace := ko.ObjectMeta.GetNamespace()\n", innerIndent)
+				outPrefix += fmt.Sprintf("%sif arr.Namespace != nil && *arr.Namespace != \"\" {\n", innerIndent)
+				outPrefix += fmt.Sprintf("%s\tnamespace = *arr.Namespace\n", innerIndent)
+				outPrefix += fmt.Sprintf("%s}\n", innerIndent)
+			}
 
 			outPrefix += getReferencedStateForField(field, innerIndentLevel)
 
@@ -534,3 +534,125 @@ func Test_ClearResolvedReferencesForField_SingleReference_WithinMultipleSlices(t
 	field := crd.Fields["Notification.LambdaFunctionConfigurations.Filter.Key.FilterRules.Value"]
 	assert.Equal(expected, code.ClearResolvedReferencesForField(field, "ko", 1))
 }
+
+func Test_KMSKeyFieldValidation(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-kms-key.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	field := crd.Fields["KMSKeyID"]
+	require.NotNil(field)
+	assert.True(field.HasReference())
+	assert.NotNil(crd.Fields["KMSKeyRef"])
+	assert.Equal("kms", field.ReferencedServiceName())
+
+	expected :=
+		`	if ko.Spec.StorageEncrypted != nil && *ko.Spec.StorageEncrypted {
+		if ko.Spec.KMSKeyRef == nil && ko.Spec.KMSKeyID == nil {
+			return ackerr.ResourceReferenceOrIDRequiredFor("KMSKeyID", "KMSKeyRef")
+		}
+	}
+`
+	assert.Equal(expected, code.KMSKeyFieldValidation(field, "ko", 1))
+	assert.Equal("", code.SetKMSKeyDefault(field, "ko", 1))
+
+	// A default KMS Key makes the KMS Key identifier optional
+	field = crd.Fields["PerformanceInsightsKMSKeyID"]
+	require.NotNil(field)
+	assert.Equal("", code.KMSKeyFieldValidation(field, "ko", 1))
+}
+
+func Test_SetKMSKeyDefault(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-kms-key.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	field := crd.Fields["PerformanceInsightsKMSKeyID"]
+	require.NotNil(field)
+	expected :=
+		`	if ko.Spec.EnablePerformanceInsights != nil && *ko.Spec.EnablePerformanceInsights {
+		if ko.Spec.PerformanceInsightsKMSKeyRef == nil && ko.Spec.PerformanceInsightsKMSKeyID == nil {
+			defaultPerformanceInsightsKMSKeyID := "alias/aws/rds"
+			ko.Spec.PerformanceInsightsKMSKeyID = &defaultPerformanceInsightsKMSKeyID
+		}
+	}
+`
+	assert.Equal(expected, code.SetKMSKeyDefault(field, "ko", 1))
+}
+
+func Test_ResolveReferencesForField_FromClusterScopedResource(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-cluster-scope.yaml",
+		})
+
+	// Role is cluster-scoped and references a namespaced Policy
+	crd := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+	expected :=
+		`	if ko.Spec.PermissionsBoundaryRef != nil && ko.Spec.PermissionsBoundaryRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.PermissionsBoundaryRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: PermissionsBoundaryRef")
+		}
+		if arr.Namespace == nil || *arr.Namespace == "" {
+			return hasReferences, fmt.Errorf("provided resource reference has no namespace: PermissionsBoundaryRef")
+		}
+		namespace := *arr.Namespace
+		obj := &svcapitypes.Policy{}
+		if err := getReferencedResourceState_Policy(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.PermissionsBoundary = (*string)(obj.Status.ACKResourceMetadata.ARN)
+	}
+`
+	assert.Equal(expected, code.ResolveReferencesForField(crd.Fields["PermissionsBoundary"], "ko", 1))
+}
+
+func Test_ResolveReferencesForField_ToClusterScopedResource(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-cluster-scoped-references.yaml",
+		})
+
+	// User is namespaced and references a cluster-scoped Policy
+	crd := testutil.GetCRDByName(t, g, "User")
+	require.NotNil(crd)
+	expected :=
+		`	if ko.Spec.PermissionsBoundaryRef != nil && ko.Spec.PermissionsBoundaryRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.PermissionsBoundaryRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: PermissionsBoundaryRef")
+		}
+		namespace := ""
+		obj := &svcapitypes.Policy{}
+		if err := getReferencedResourceState_Policy(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.PermissionsBoundary = (*string)(obj.Status.ACKResourceMetadata.ARN)
+	}
+`
+	assert.Equal(expected, code.ResolveReferencesForField(crd.Fields["PermissionsBoundary"], "ko", 1))
+}
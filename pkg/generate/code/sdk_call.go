@@ -0,0 +1,151 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// SDKCall returns the Go code calling the supplied operation of the
+// aws-sdk-go client with the supplied input variable, assigning the call's
+// results with the supplied left-hand side. When the operation has a
+// `timeout_seconds`, the call is bounded by a context with that timeout,
+// which is released as soon as the call returns. When the operation has a
+// `retry`, the call is retried with an exponential backoff while it fails
+// with one of the configured error codes, until the reconciler's context is
+// cancelled; results declared by the supplied left-hand side, which must all
+// be new, are then declared before the loop.
+//
+//	Sample output:
+//
+//	for attempt := 1; ; attempt++ {
+//		sdkCtx, sdkCancel := context.WithTimeout(ctx, 30*time.Second)
+//		resp, err = rm.sdkapi.DescribeRepositoriesWithContext(sdkCtx, input)
+//		sdkCancel()
+//		awsErr, ok := ackerr.AWSError(err)
+//		if attempt == 3 || !ok || awsErr.Code() != "ThrottlingException" {
+//			break
+//		}
+//		select {
+//		case <-ctx.Done():
+//		case <-time.After(time.Duration(500<<(attempt-1)) * time.Millisecond):
+//		}
+//		if ctx.Err() != nil {
+//			break
+//		}
+//	}
+func SDKCall(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	// The left-hand side of the assignment of the call's results, e.g.
+	// "resp, err =". The error must be the last assigned variable.
+	lhs string,
+	// String representing the name of the variable holding the Input shape,
+	// e.g. "input"
+	inputVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	retryCfg := r.OperationRetry(op)
+	if retryCfg == nil {
+		return sdkCall(r, op, lhs, inputVarName, indentLevel)
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	out := ""
+	assigned := strings.Split(strings.TrimSuffix(lhs, "="), ",")
+	errVarName := strings.TrimSpace(assigned[len(assigned)-1])
+	if strings.HasSuffix(lhs, ":=") {
+		// The results of the call are declared before the loop retrying it
+		assigned = strings.Split(strings.TrimSuffix(lhs, ":="), ",")
+		errVarName = strings.TrimSpace(assigned[len(assigned)-1])
+		for _, varName := range assigned[:len(assigned)-1] {
+			if varName = strings.TrimSpace(varName); varName != "_" {
+				out += fmt.Sprintf(
+					"%svar %s %s\n", indent, varName, r.GetOutputShapeGoType(op),
+				)
+			}
+		}
+		out += fmt.Sprintf("%svar %s error\n", indent, errVarName)
+		lhs = strings.TrimSuffix(lhs, ":=") + "="
+	}
+	out += fmt.Sprintf("%sfor attempt := 1; ; attempt++ {\n", indent)
+	out += sdkCall(r, op, lhs, inputVarName, indentLevel+1) + "\n"
+	out += fmt.Sprintf(
+		"%s\tawsErr, ok := ackerr.AWSError(%s)\n", indent, errVarName,
+	)
+	codeMismatches := []string{}
+	for _, errCode := range retryCfg.ErrorCodes {
+		codeMismatches = append(
+			codeMismatches, fmt.Sprintf("awsErr.Code() != %q", errCode),
+		)
+	}
+	codeMismatch := strings.Join(codeMismatches, " && ")
+	if len(codeMismatches) > 1 {
+		codeMismatch = "(" + codeMismatch + ")"
+	}
+	out += fmt.Sprintf(
+		"%s\tif attempt == %d || !ok || %s {\n",
+		indent, retryCfg.MaxAttempts, codeMismatch,
+	)
+	out += fmt.Sprintf("%s\t\tbreak\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s\tselect {\n", indent)
+	out += fmt.Sprintf("%s\tcase <-ctx.Done():\n", indent)
+	out += fmt.Sprintf(
+		"%s\tcase <-time.After(time.Duration(%d<<(attempt-1)) * time.Millisecond):\n",
+		indent, retryCfg.BackoffMilliseconds,
+	)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s\tif ctx.Err() != nil {\n", indent)
+	out += fmt.Sprintf("%s\t\tbreak\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}", indent)
+	return out
+}
+
+// sdkCall returns the Go code making a single call to the supplied operation,
+// bounded by the operation's timeout, if any.
+func sdkCall(
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	lhs string,
+	inputVarName string,
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	timeoutSeconds := r.OperationTimeoutSeconds(op)
+	if timeoutSeconds == 0 {
+		return fmt.Sprintf(
+			"%s%s rm.sdkapi.%sWithContext(ctx, %s)",
+			indent, lhs, op.ExportedName, inputVarName,
+		)
+	}
+	out := fmt.Sprintf(
+		"%ssdkCtx, sdkCancel := context.WithTimeout(ctx, %d*time.Second)\n",
+		indent, timeoutSeconds,
+	)
+	out += fmt.Sprintf(
+		"%s%s rm.sdkapi.%sWithContext(sdkCtx, %s)\n",
+		indent, lhs, op.ExportedName, inputVarName,
+	)
+	out += fmt.Sprintf("%ssdkCancel()", indent)
+	return out
+}
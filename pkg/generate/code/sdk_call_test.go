@@ -0,0 +1,149 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	 http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestSDKCall_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-operation-timeouts.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.True(crd.HasOperationTimeouts())
+
+	expected := `	sdkCtx, sdkCancel := context.WithTimeout(ctx, 30*time.Second)
+	resp, err = rm.sdkapi.DescribeRepositoriesWithContext(sdkCtx, input)
+	sdkCancel()`
+	assert.Equal(
+		expected,
+		code.SDKCall(crd.Config(), crd, crd.Ops.ReadMany, "resp, err =", "input", 1),
+	)
+
+	// Each page of results is bounded by its own context
+	expected = `	for page := resp; page.NextToken != nil && *page.NextToken != ""; {
+		// Stop once the page read last holds the resource
+		matched := false
+		for _, elem := range page.Repositories {
+			if r.ko.Spec.RepositoryName != nil && (elem.RepositoryName == nil || *elem.RepositoryName != *r.ko.Spec.RepositoryName) {
+				continue
+			}
+			matched = true
+			break
+		}
+		if matched {
+			break
+		}
+		input.NextToken = page.NextToken
+		sdkCtx, sdkCancel := context.WithTimeout(ctx, 30*time.Second)
+		page, err = rm.sdkapi.DescribeRepositoriesWithContext(sdkCtx, input)
+		sdkCancel()
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeRepositories", err)
+		if err != nil {
+			return nil, err
+		}
+		resp.Repositories = append(resp.Repositories, page.Repositories...)
+	}
+`
+	assert.Equal(expected, code.ReadManyPagination(crd.Config(), crd, "input", "resp", 1))
+
+	// Operations without timeout are only bounded by the reconciler's context
+	assert.Equal(
+		"\t_, err = rm.sdkapi.DeleteRepositoryWithContext(ctx, input)",
+		code.SDKCall(crd.Config(), crd, crd.Ops.Delete, "_, err =", "input", 1),
+	)
+}
+
+func TestSDKCall_ECR_Repository_Retry(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-operation-timeouts.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.True(crd.HasOperationRetries())
+
+	expected := `	for attempt := 1; ; attempt++ {
+		resp, err = rm.sdkapi.CreateRepositoryWithContext(ctx, input)
+		awsErr, ok := ackerr.AWSError(err)
+		if attempt == 5 || !ok || (awsErr.Code() != "LimitExceededException" && awsErr.Code() != "TooManyTagsException") {
+			break
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Duration(500<<(attempt-1)) * time.Millisecond):
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}`
+	assert.Equal(
+		expected,
+		code.SDKCall(crd.Config(), crd, crd.Ops.Create, "resp, err =", "input", 1),
+	)
+
+	// Results declared by the left-hand side are declared before the loop
+	expected = `	var respErr error
+	for attempt := 1; ; attempt++ {
+		_, respErr = rm.sdkapi.CreateRepositoryWithContext(ctx, input)
+		awsErr, ok := ackerr.AWSError(respErr)`
+	assert.True(strings.HasPrefix(
+		code.SDKCall(crd.Config(), crd, crd.Ops.Create, "_, respErr :=", "input", 1),
+		expected,
+	))
+}
+
+func TestSDKCall_ECR_Repository_AuxiliaryResource(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-operation-timeouts.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// The operations of auxiliary resources are bounded by their timeouts and
+	// retried too
+	expected := `		for attempt := 1; ; attempt++ {
+			sdkCtx, sdkCancel := context.WithTimeout(ctx, 10*time.Second)
+			_, err = rm.sdkapi.DeleteLifecyclePolicyWithContext(sdkCtx, input)
+			sdkCancel()
+			awsErr, ok := ackerr.AWSError(err)
+			if attempt == 3 || !ok || awsErr.Code() != "ThrottlingException" {
+				break
+			}
+`
+	assert.Contains(
+		code.DeleteAuxiliaryResources(crd.Config(), crd, "r", 1),
+		expected,
+	)
+}
@@ -0,0 +1,141 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// WriteSecretOutputs returns the Go code that writes the values of the
+// supplied operation's Output shape members configured with
+// `write_to_secret` into the Secrets identified by the corresponding
+// `{FieldName}SecretRef` Spec fields, and records the SecretKeyReference in
+// the Status field. When the SecretRef field is not set the value is
+// discarded.
+//
+// Sample output:
+//
+//	if resp.KeyMaterial != nil && ko.Spec.KeyMaterialSecretRef != nil {
+//		secretRef := ko.Spec.KeyMaterialSecretRef.DeepCopy()
+//		if secretRef.Namespace == "" {
+//			secretRef.Namespace = ko.Namespace
+//		}
+//		if err = rm.rr.WriteToSecret(ctx, *resp.KeyMaterial, secretRef.Namespace, secretRef.Name, secretRef.Key); err != nil {
+//			return nil, err
+//		}
+//		ko.Status.KeyMaterial = secretRef
+//	}
+func WriteSecretOutputs(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	// String representing the name of the variable that we will grab the
+	// Output shape from. This will likely be "resp" since in the templates
+	// that call this method, the "source variable" is the response struct
+	// returned by the aws-sdk-go's SDK API call corresponding to the Operation
+	sourceVarName string,
+	// String representing the name of the variable that we will be **setting**
+	// with values we get from the Output shape. This will likely be
+	// "ko" since that is the name of the "target variable" that the
+	// templates that call this method use for the Kubernetes object
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	if op == nil || len(r.SecretOutputFields()) == 0 {
+		return ""
+	}
+	outputShape, _ := r.GetOutputShape(op)
+	if outputShape == nil {
+		return ""
+	}
+	// The list elements selected by the indexes of the output wrapper field
+	// path, if any, are checked for existence before being dereferenced
+	wrapperInBounds := []string{}
+	if wrapperFieldPath := r.GetOutputWrapperFieldPath(op); wrapperFieldPath != nil {
+		wrapperInBounds, _, _ = indexedWrapperFieldPathChecks(
+			op.OutputRef.Shape, sourceVarName, *wrapperFieldPath,
+		)
+		sourceVarName += "." + *wrapperFieldPath
+	}
+
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	for _, memberName := range outputShape.MemberNames() {
+		fieldName := cfg.GetResourceFieldName(
+			r.Names.Original,
+			op.ExportedName,
+			memberName,
+		)
+		f, found := r.StatusFields[fieldName]
+		if !found || !f.WritesToSecret() {
+			continue
+		}
+		if outputShape.MemberRefs[memberName].Shape.Type != "string" {
+			panic(fmt.Sprintf(
+				"field %s of %s has write_to_secret but is not a string",
+				f.Names.Original, r.Names.Camel,
+			))
+		}
+		sourceAdaptedVarName := sourceVarName + "." + memberName
+		secretRefVarName := targetVarName + cfg.PrefixConfig.SpecField +
+			"." + f.GetSecretRefFieldName().Camel
+
+		// if resp.KeyMaterial != nil && ko.Spec.KeyMaterialSecretRef != nil {
+		conditions := append([]string{}, wrapperInBounds...)
+		conditions = append(conditions,
+			sourceAdaptedVarName+" != nil", secretRefVarName+" != nil",
+		)
+		out += fmt.Sprintf(
+			"%sif %s {\n", indent, strings.Join(conditions, " && "),
+		)
+		//	secretRef := ko.Spec.KeyMaterialSecretRef.DeepCopy()
+		out += fmt.Sprintf(
+			"%s\tsecretRef := %s.DeepCopy()\n", indent, secretRefVarName,
+		)
+		// Default to the namespace of the resource. Cluster-scoped resources
+		// have none, so the Secret's namespace must be set.
+		out += fmt.Sprintf("%s\tif secretRef.Namespace == \"\" {\n", indent)
+		if r.IsClusterScoped() {
+			out += fmt.Sprintf(
+				"%s\t\treturn nil, ackerr.NewTerminalError(fmt.Errorf(\"%s has no namespace\"))\n",
+				indent, f.GetSecretRefFieldName().Camel,
+			)
+		} else {
+			out += fmt.Sprintf(
+				"%s\t\tsecretRef.Namespace = %s.Namespace\n", indent, targetVarName,
+			)
+		}
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf(
+			"%s\tif err = rm.rr.WriteToSecret(ctx, *%s, secretRef.Namespace, secretRef.Name, secretRef.Key); err != nil {\n",
+			indent, sourceAdaptedVarName,
+		)
+		out += fmt.Sprintf("%s\t\treturn nil, err\n", indent)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		//	ko.Status.KeyMaterial = secretRef
+		out += fmt.Sprintf(
+			"%s\t%s%s.%s = secretRef\n",
+			indent, targetVarName, cfg.PrefixConfig.StatusField, f.Names.Camel,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
@@ -0,0 +1,64 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestWriteSecretOutputs_EC2_KeyPair(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ec2",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-secret-output.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "KeyPair")
+	require.NotNil(crd)
+
+	require.Contains(crd.StatusFields, "KeyMaterial")
+	assert.Equal("*ackv1alpha1.SecretKeyReference", crd.StatusFields["KeyMaterial"].GoType)
+	require.Contains(crd.SpecFields, "KeyMaterialSecretRef")
+	assert.True(crd.SpecFields["KeyMaterialSecretRef"].IsSecretRef())
+
+	expected := `	if resp.KeyMaterial != nil && ko.Spec.KeyMaterialSecretRef != nil {
+		secretRef := ko.Spec.KeyMaterialSecretRef.DeepCopy()
+		if secretRef.Namespace == "" {
+			secretRef.Namespace = ko.Namespace
+		}
+		if err = rm.rr.WriteToSecret(ctx, *resp.KeyMaterial, secretRef.Namespace, secretRef.Name, secretRef.Key); err != nil {
+			return nil, err
+		}
+		ko.Status.KeyMaterial = secretRef
+	}
+`
+	assert.Equal(
+		expected,
+		code.WriteSecretOutputs(crd.Config(), crd, crd.Ops.Create, "resp", "ko", 1),
+	)
+	// The value must never be copied into the resource as-is
+	assert.NotContains(
+		code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1),
+		"KeyMaterial",
+	)
+}
@@ -110,33 +110,77 @@ func SetResource(
 	if op == nil {
 		return ""
 	}
+	return setResourceForOperation(
+		cfg, r, opType, op, sourceVarName, targetVarName, indentLevel,
+	)
+}
+
+// SetResourceForReadOneOperation returns the Go code that sets a CRD's
+// fields from the Output shape of one of the resource's additional ReadOne
+// operations, configured in the resource's `read_one_operations`.
+func SetResourceForReadOneOperation(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	// String representing the name of the variable that we will grab the
+	// Output shape from, e.g. "resp"
+	sourceVarName string,
+	// String representing the name of the variable that we will be **setting**
+	// with values we get from the Output shape, e.g. "ko"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	return setResourceForOperation(
+		cfg, r, model.OpTypeGet, op, sourceVarName, targetVarName, indentLevel,
+	)
+}
+
+// setResourceForOperation returns the Go code that sets a CRD's fields from
+// the Output shape of the supplied operation.
+func setResourceForOperation(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	opType model.OpType,
+	op *awssdkmodel.Operation,
+	sourceVarName string,
+	targetVarName string,
+	indentLevel int,
+) string {
 	outputShape, _ := r.GetOutputShape(op)
 	if outputShape == nil {
 		return ""
 	}
 
+	// `from` source paths are relative to the unwrapped Output shape
+	opSourceVarName := sourceVarName
+
 	// If the output shape has a list containing the resource,
 	// then call setResourceReadMany to generate for-range loops.
 	// Output shape will be a list for ReadMany operations or if
 	// designated via output wrapper config.
 	wrapperFieldPath := r.GetOutputWrapperFieldPath(op)
+	// Conditions under which the elements selected by the indexes of the
+	// output wrapper field path do not exist
+	wrapperOutOfBounds := []string{}
 	if op == r.Ops.ReadMany {
 		return setResourceReadMany(
 			cfg, r,
 			op, sourceVarName, targetVarName, indentLevel,
 		)
 	} else if wrapperFieldPath != nil {
-		// fieldpath api requires fully-qualified path
-		qwfp := fieldpath.FromString(op.OutputRef.ShapeName + "." + *wrapperFieldPath)
-		for _, sref := range qwfp.IterShapeRefs(&op.OutputRef) {
-			// if there's at least 1 list to unpack, call setResourceReadMany
-			if sref.Shape.Type == "list" {
-				return setResourceReadMany(
-					cfg, r,
-					op, sourceVarName, targetVarName, indentLevel,
-				)
-			}
+		// if there's at least 1 list to unpack, i.e. a list of which no
+		// element is selected with an index, call setResourceReadMany
+		_, outOfBounds, indexed := indexedWrapperFieldPathChecks(
+			op.OutputRef.Shape, sourceVarName, *wrapperFieldPath,
+		)
+		if !indexed {
+			return setResourceReadMany(
+				cfg, r,
+				op, sourceVarName, targetVarName, indentLevel,
+			)
 		}
+		wrapperOutOfBounds = outOfBounds
 		sourceVarName += "." + *wrapperFieldPath
 	} else {
 		// If the wrapper field path is not specified in the config file and if
@@ -155,6 +199,24 @@ func SetResource(
 	out := "\n"
 	indent := strings.Repeat("\t", indentLevel)
 
+	if len(wrapperOutOfBounds) > 0 {
+		// if len(resp.Reservations) == 0 || len(resp.Reservations[0].Instances) == 0 {
+		//     return nil, ackerr.NotFound
+		// }
+		out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(wrapperOutOfBounds, " || "))
+		if opType == model.OpTypeGet {
+			out += fmt.Sprintf("%s\treturn nil, ackerr.NotFound\n", indent)
+		} else {
+			// The resource exists after a successful Create or Update, only
+			// its description is missing from the output
+			out += fmt.Sprintf(
+				"%s\treturn nil, errors.New(%q)\n", indent,
+				fmt.Sprintf("%s returned no element at %s", op.ExportedName, *wrapperFieldPath),
+			)
+		}
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+
 	// Recursively descend through the set of fields on the Output shape,
 	// creating temporary variables, populating those temporary variables'
 	// fields with further-nested fields as needed
@@ -214,7 +276,10 @@ func SetResource(
 			memberName,
 		)
 		inSpec, inStatus := r.HasMember(fieldName, op.ExportedName)
-		if inSpec {
+		if inSpec && r.IsObserveOnly() {
+			// The Spec of observe-only resources is only set by the user
+			continue
+		} else if inSpec {
 			targetAdaptedVarName += cfg.PrefixConfig.SpecField
 			f = r.SpecFields[fieldName]
 		} else if inStatus {
@@ -225,6 +290,22 @@ func SetResource(
 			continue
 		}
 
+		// Passthrough fields are only interpreted by hook code
+		if f.IsPassthrough() {
+			continue
+		}
+
+		// Values written to a Secret are handled by
+		// WriteSecretOutputs and never stored in the resource
+		if f.WritesToSecret() {
+			continue
+		}
+
+		// Values read from a ConfigMap are only set by the user
+		if f.FieldConfig != nil && f.FieldConfig.IsConfigMap {
+			continue
+		}
+
 		targetMemberShapeRef = f.ShapeRef
 
 		// We may have some special instructions for how to handle setting the
@@ -311,6 +392,14 @@ func SetResource(
 
 		switch targetMemberShape.Type {
 		case "list", "structure", "map":
+			if r.GetTagConversion().Converts(f, sourceMemberShapeRef.Shape) {
+				// ko.Spec.Tags = fromSDKTags(resp.Tags)
+				out += fmt.Sprintf(
+					"%s\t%s = fromSDKTags(%s)\n",
+					indent, qualifiedTargetVar, sourceAdaptedVarName,
+				)
+				break
+			}
 			{
 				memberVarName := fmt.Sprintf("f%d", memberIndex)
 				out += varEmptyConstructorK8sType(
@@ -331,10 +420,17 @@ func SetResource(
 					opType,
 					indentLevel+1,
 				)
+				out += setResourceListLimit(
+					r, f,
+					memberVarName,
+					targetAdaptedVarName,
+					indentLevel+1,
+				)
 				out += setResourceForScalar(
 					qualifiedTargetVar,
 					memberVarName,
 					sourceMemberShapeRef,
+					memberVarName,
 					indentLevel+1,
 				)
 			}
@@ -344,23 +440,771 @@ func SetResource(
 				// different field or member...
 				sourceAdaptedVarName = sourceVarName + "." + *setCfg.From
 			}
+			if f.IsTypedEnum() {
+				out += setResourceForTypedEnum(
+					qualifiedTargetVar,
+					sourceAdaptedVarName,
+					f,
+					indentLevel+1,
+				)
+			} else if layout := f.GetTimestampLayout(); layout != "" {
+				out += setResourceForTimestampString(
+					qualifiedTargetVar,
+					sourceAdaptedVarName,
+					layout,
+					fmt.Sprintf("f%d", memberIndex),
+					indentLevel+1,
+				)
+			} else if size, binary := f.GetQuantityUnitSize(); size != 0 {
+				out += setResourceForQuantity(
+					qualifiedTargetVar,
+					sourceAdaptedVarName,
+					size, binary,
+					indentLevel+1,
+				)
+			} else if factor := f.GetTransformFactor(); factor != 1 {
+				out += setResourceForTransformedScalar(
+					qualifiedTargetVar,
+					sourceAdaptedVarName,
+					factor,
+					fmt.Sprintf("f%d", memberIndex),
+					indentLevel+1,
+				)
+			} else {
+				out += setResourceForScalar(
+					qualifiedTargetVar,
+					sourceAdaptedVarName,
+					sourceMemberShapeRef,
+					fmt.Sprintf("f%d", memberIndex),
+					indentLevel+1,
+				)
+			}
+		}
+		out += fmt.Sprintf(
+			"%s} else {\n", indent,
+		)
+		out += fmt.Sprintf(
+			"%s%s%s.%s = nil\n", indent, indent,
+			targetAdaptedVarName, f.Names.Camel,
+		)
+		out += setResourceListLimitReset(
+			f, targetAdaptedVarName, indentLevel+1,
+		)
+		out += fmt.Sprintf(
+			"%s}\n", indent,
+		)
+	}
+	out += setResourceSourceFallbacks(
+		cfg, r, op, opSourceVarName, targetVarName, indentLevel,
+	)
+	return out
+}
+
+// setResourceSourceFallbacks returns the Go code that sets the Status fields
+// whose `from` config is an ordered list of sources from the first of the
+// supplied operation's sources that has a value in its Output shape. The field
+// is only set to nil when none of them has a value and all of the field's
+// sources are members of the operation's Output shape.
+//
+// Output code will look something like this:
+//
+//	if resp.Code != nil && resp.Code.Location != nil {
+//		ko.Status.CodeLocation = resp.Code.Location
+//	} else if resp.Code != nil && resp.Code.ImageUri != nil {
+//		ko.Status.CodeLocation = resp.Code.ImageUri
+//	} else {
+//		ko.Status.CodeLocation = nil
+//	}
+func setResourceSourceFallbacks(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	// String representing the name of the variable that we will grab the
+	// Output shape from, likely "resp"
+	sourceVarName string,
+	// String representing the name of the variable that we will be setting
+	// with values we get from the Output shape, likely "ko"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	out := ""
+	for _, fieldName := range r.StatusFieldNames() {
+		f := r.StatusFields[fieldName]
+		if f.FieldConfig == nil || !f.FieldConfig.From.HasFallbacks() {
+			continue
+		}
+		qualifiedTargetVar := targetVarName + cfg.PrefixConfig.StatusField + "." + f.Names.Camel
+		branches := 0
+		allFromOp := true
+		for sourceIndex, source := range f.FieldConfig.From.Sources() {
+			if source.Operation != op.ExportedName {
+				allFromOp = false
+				continue
+			}
+			sourceAdaptedVarName, nilChecks, sourceShapeRef := sourcePathAccessor(
+				op.OutputRef.Shape, sourceVarName, source.Path,
+			)
+			if sourceShapeRef == nil {
+				msg := fmt.Sprintf(
+					"from.path %s of field %s must be a path of structure members in the %s Output shape",
+					source.Path, f.Names.Camel, op.ExportedName,
+				)
+				panic(msg)
+			}
+			// if resp.Code != nil && resp.Code.Location != nil {
+			// } else if resp.Code != nil && resp.Code.ImageUri != nil {
+			if branches == 0 {
+				out += fmt.Sprintf(
+					"%sif %s {\n", indent, strings.Join(nilChecks, " && "),
+				)
+			} else {
+				out += fmt.Sprintf(
+					"%s} else if %s {\n", indent, strings.Join(nilChecks, " && "),
+				)
+			}
+			branches++
+			switch sourceShapeRef.Shape.Type {
+			case "list", "structure", "map":
+				memberVarName := fmt.Sprintf("f%d", sourceIndex)
+				out += varEmptyConstructorK8sType(
+					cfg, r,
+					memberVarName,
+					f.ShapeRef.Shape,
+					indentLevel+1,
+				)
+				out += setResourceForContainer(
+					cfg, r,
+					f.Names.Camel,
+					memberVarName,
+					f.ShapeRef,
+					nil,
+					sourceAdaptedVarName,
+					sourceShapeRef,
+					f.Names.Camel,
+					model.OpTypeGet,
+					indentLevel+1,
+				)
+				out += setResourceForScalar(
+					qualifiedTargetVar,
+					memberVarName,
+					sourceShapeRef,
+					memberVarName,
+					indentLevel+1,
+				)
+			default:
+				if f.IsTypedEnum() {
+					out += setResourceForTypedEnum(
+						qualifiedTargetVar,
+						sourceAdaptedVarName,
+						f,
+						indentLevel+1,
+					)
+				} else {
+					out += setResourceForScalar(
+						qualifiedTargetVar,
+						sourceAdaptedVarName,
+						sourceShapeRef,
+						fmt.Sprintf("f%d", sourceIndex),
+						indentLevel+1,
+					)
+				}
+			}
+		}
+		if branches == 0 {
+			continue
+		}
+		if allFromOp {
+			// } else {
+			//     ko.Status.CodeLocation = nil
+			out += fmt.Sprintf("%s} else {\n", indent)
+			out += fmt.Sprintf("%s\t%s = nil\n", indent, qualifiedTargetVar)
+		}
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
+
+// sourcePathAccessor returns the Go expression accessing the member at the
+// supplied dot-notation path of a shape, the nil checks guarding that access
+// and the member's ShapeRef. The returned ShapeRef is nil if the path does not
+// exist or traverses anything other than structures.
+func sourcePathAccessor(
+	shape *awssdkmodel.Shape,
+	varName string,
+	path string,
+) (string, []string, *awssdkmodel.ShapeRef) {
+	nilChecks := []string{}
+	var shapeRef *awssdkmodel.ShapeRef
+	for _, memberName := range strings.Split(path, ".") {
+		if shape == nil || shape.Type != "structure" {
+			return "", nil, nil
+		}
+		memberRef, found := shape.MemberRefs[memberName]
+		if !found {
+			return "", nil, nil
+		}
+		varName += "." + memberName
+		nilChecks = append(nilChecks, varName+" != nil")
+		shapeRef = memberRef
+		shape = memberRef.Shape
+	}
+	return varName, nilChecks, shapeRef
+}
+
+// SetResourceReadManySourcedFields returns the Go code that calls the
+// ReadMany operation and sets the Status fields whose values are sourced, via
+// a `from` config, from the repeated member of its Output shape, for resources
+// read with their ReadOne or GetAttributes operation. The element describing
+// the resource is the one whose list operation match fields, or identifier
+// field if the list operation has no match fields, have the resource's values.
+// The pages of results are read until that element is found, and the fields
+// are cleared when it is not found.
+//
+// Output code will look something like this:
+//
+//	ko.Status.ListedTracingMode = nil
+//	for {
+//		var resp *svcsdk.ListFunctionsOutput
+//		resp, err = rm.sdkapi.ListFunctionsWithContext(ctx, input)
+//		rm.metrics.RecordAPICall("READ_MANY", "ListFunctions", err)
+//		if err != nil {
+//			return err
+//		}
+//		found := false
+//		for _, elem := range resp.Functions {
+//			if elem.FunctionName == nil || ko.Spec.FunctionName == nil || *elem.FunctionName != *ko.Spec.FunctionName {
+//				continue
+//			}
+//			found = true
+//			if elem.TracingConfig != nil && elem.TracingConfig.Mode != nil {
+//				ko.Status.ListedTracingMode = elem.TracingConfig.Mode
+//			} else {
+//				ko.Status.ListedTracingMode = nil
+//			}
+//			break
+//		}
+//		if found || resp.NextMarker == nil || *resp.NextMarker == "" {
+//			break
+//		}
+//		input.Marker = resp.NextMarker
+//	}
+func SetResourceReadManySourcedFields(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the ReadMany
+	// Input shape, likely "input"
+	inputVarName string,
+	// String representing the name of the variable that we will be setting
+	// with values we get from the Output shape, likely "ko"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	fields := r.ReadManySourcedFields()
+	if len(fields) == 0 {
+		return ""
+	}
+	op := r.Ops.ReadMany
+	outputShape := op.OutputRef.Shape
+
+	listMemberName := ""
+	var elemShape *awssdkmodel.Shape
+	for _, f := range fields {
+		from := f.FieldConfig.From
+		pathParts := strings.SplitN(from.Path, ".", 2)
+		memberRef, found := outputShape.MemberRefs[pathParts[0]]
+		if !found || memberRef.Shape.Type != "list" || len(pathParts) < 2 {
+			msg := fmt.Sprintf(
+				"from.path %s of field %s must point into a list member of the %s Output shape",
+				from.Path, f.Names.Camel, op.ExportedName,
+			)
+			panic(msg)
+		}
+		if listMemberName != "" && listMemberName != pathParts[0] {
+			msg := fmt.Sprintf(
+				"fields of %s sourced from %s must all point into the same list member",
+				r.Names.Camel, op.ExportedName,
+			)
+			panic(msg)
+		}
+		listMemberName = pathParts[0]
+		elemShape = memberRef.Shape.MemberRef.Shape
+	}
+
+	outerIndent := strings.Repeat("\t", indentLevel)
+	out := ""
+	// ko.Status.ListedTracingMode = nil
+	for _, f := range fields {
+		out += fmt.Sprintf(
+			"%s%s%s.%s = nil\n",
+			outerIndent, targetVarName, cfg.PrefixConfig.StatusField, f.Names.Camel,
+		)
+	}
+	// for {
+	//     var resp *svcsdk.ListFunctionsOutput
+	//     resp, err = rm.sdkapi.ListFunctionsWithContext(ctx, input)
+	//     rm.metrics.RecordAPICall("READ_MANY", "ListFunctions", err)
+	//     if err != nil {
+	//         return err
+	//     }
+	out += fmt.Sprintf("%sfor {\n", outerIndent)
+	out += fmt.Sprintf(
+		"%s\tvar resp %s\n", outerIndent, r.GetOutputShapeGoType(op),
+	)
+	if r.LogsSDKPayloads() {
+		out += fmt.Sprintf(
+			"%s\tlogSDKPayload(ctx, %q, \"input\", %s)\n",
+			outerIndent, op.ExportedName, inputVarName,
+		)
+	}
+	out += SDKCall(cfg, r, op, "resp, err =", inputVarName, indentLevel+1) + "\n"
+	out += fmt.Sprintf(
+		"%s\trm.metrics.RecordAPICall(\"READ_MANY\", %q, err)\n",
+		outerIndent, op.ExportedName,
+	)
+	if r.LogsSDKPayloads() {
+		out += fmt.Sprintf(
+			"%s\tlogSDKPayload(ctx, %q, \"output\", resp)\n",
+			outerIndent, op.ExportedName,
+		)
+	}
+	out += fmt.Sprintf("%s\tif err != nil {\n", outerIndent)
+	out += fmt.Sprintf("%s\t\treturn err\n", outerIndent)
+	out += fmt.Sprintf("%s\t}\n", outerIndent)
+
+	indent := outerIndent + "\t"
+	indentLevel++
+	// Without pagination, the single page of results is searched
+	inputToken, outputToken := r.ReadManyPaginationTokens()
+	// found := false
+	// for _, elem := range resp.Functions {
+	if inputToken != "" {
+		out += fmt.Sprintf("%sfound := false\n", indent)
+	}
+	out += fmt.Sprintf(
+		"%sfor _, elem := range resp.%s {\n", indent, listMemberName,
+	)
+	for _, memberName := range readManyMatchMemberNames(cfg, r, elemShape) {
+		fieldName := cfg.GetResourceFieldName(
+			r.Names.Original, op.ExportedName, memberName,
+		)
+		var matchVarName string
+		if f, found := r.SpecFields[fieldName]; found {
+			matchVarName = targetVarName + cfg.PrefixConfig.SpecField + "." + f.Names.Camel
+		} else if f, found := r.StatusFields[fieldName]; found {
+			matchVarName = targetVarName + cfg.PrefixConfig.StatusField + "." + f.Names.Camel
+		} else {
+			msg := fmt.Sprintf(
+				"Match field name %s is not in %s Spec or Status fields",
+				memberName, r.Names.Camel,
+			)
+			panic(msg)
+		}
+		//     if elem.FunctionName == nil || ko.Spec.FunctionName == nil || *elem.FunctionName != *ko.Spec.FunctionName {
+		//         continue
+		//     }
+		out += fmt.Sprintf(
+			"%s\tif elem.%s == nil || %s == nil || *elem.%s != *%s {\n",
+			indent, memberName, matchVarName, memberName, matchVarName,
+		)
+		out += fmt.Sprintf("%s\t\tcontinue\n", indent)
+		out += fmt.Sprintf("%s\t}\n", indent)
+	}
+	//     found = true
+	if inputToken != "" {
+		out += fmt.Sprintf("%s\tfound = true\n", indent)
+	}
+
+	for fIndex, f := range fields {
+		memberPath := strings.SplitN(f.FieldConfig.From.Path, ".", 2)[1]
+		sourceVarName := "elem"
+		nilChecks := []string{}
+		var sourceShapeRef *awssdkmodel.ShapeRef
+		shape := elemShape
+		for _, memberName := range strings.Split(memberPath, ".") {
+			if shape == nil || shape.Type != "structure" {
+				msg := fmt.Sprintf(
+					"from.path %s of field %s must not traverse nested lists or maps",
+					f.FieldConfig.From.Path, f.Names.Camel,
+				)
+				panic(msg)
+			}
+			memberRef, found := shape.MemberRefs[memberName]
+			if !found {
+				msg := fmt.Sprintf(
+					"unknown member %s in from.path %s of field %s",
+					memberName, f.FieldConfig.From.Path, f.Names.Camel,
+				)
+				panic(msg)
+			}
+			sourceVarName += "." + memberName
+			nilChecks = append(nilChecks, sourceVarName+" != nil")
+			sourceShapeRef = memberRef
+			shape = memberRef.Shape
+		}
+		qualifiedTargetVar := targetVarName + cfg.PrefixConfig.StatusField + "." + f.Names.Camel
+
+		//     if elem.TracingConfig != nil && elem.TracingConfig.Mode != nil {
+		out += fmt.Sprintf(
+			"%s\tif %s {\n", indent, strings.Join(nilChecks, " && "),
+		)
+		switch sourceShapeRef.Shape.Type {
+		case "list", "structure", "map":
+			memberVarName := fmt.Sprintf("f%d", fIndex)
+			out += varEmptyConstructorK8sType(
+				cfg, r,
+				memberVarName,
+				f.ShapeRef.Shape,
+				indentLevel+2,
+			)
+			out += setResourceForContainer(
+				cfg, r,
+				f.Names.Camel,
+				memberVarName,
+				f.ShapeRef,
+				nil,
+				sourceVarName,
+				sourceShapeRef,
+				f.Names.Camel,
+				model.OpTypeList,
+				indentLevel+2,
+			)
 			out += setResourceForScalar(
 				qualifiedTargetVar,
-				sourceAdaptedVarName,
-				sourceMemberShapeRef,
-				indentLevel+1,
+				memberVarName,
+				sourceShapeRef,
+				memberVarName,
+				indentLevel+2,
+			)
+		default:
+			if f.IsTypedEnum() {
+				out += setResourceForTypedEnum(
+					qualifiedTargetVar,
+					sourceVarName,
+					f,
+					indentLevel+2,
+				)
+			} else {
+				out += setResourceForScalar(
+					qualifiedTargetVar,
+					sourceVarName,
+					sourceShapeRef,
+					fmt.Sprintf("f%d", fIndex),
+					indentLevel+2,
+				)
+			}
+		}
+		//     } else {
+		//         ko.Status.ListedTracingMode = nil
+		//     }
+		out += fmt.Sprintf("%s\t} else {\n", indent)
+		out += fmt.Sprintf("%s\t\t%s = nil\n", indent, qualifiedTargetVar)
+		out += fmt.Sprintf("%s\t}\n", indent)
+	}
+	//     break
+	// }
+	out += fmt.Sprintf("%s\tbreak\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+
+	//     if found || resp.NextMarker == nil || *resp.NextMarker == "" {
+	//         break
+	//     }
+	//     input.Marker = resp.NextMarker
+	// }
+	if inputToken == "" {
+		out += fmt.Sprintf("%sbreak\n", indent)
+	} else {
+		out += fmt.Sprintf(
+			"%sif found || resp.%s == nil || *resp.%s == \"\" {\n",
+			indent, outputToken, outputToken,
+		)
+		out += fmt.Sprintf("%s\tbreak\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+		out += fmt.Sprintf(
+			"%s%s.%s = resp.%s\n", indent, inputVarName, inputToken, outputToken,
+		)
+	}
+	out += fmt.Sprintf("%s}\n", outerIndent)
+	return out
+}
+
+// readManyMatchMemberNames returns the names of the members of the supplied
+// ReadMany Output shape's list element used to find the element describing
+// the resource: the list operation's match fields or, if there are none, the
+// member corresponding to the resource's identifier field.
+func readManyMatchMemberNames(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	elemShape *awssdkmodel.Shape,
+) []string {
+	matchMemberNames := r.ListOpMatchFieldNames()
+	if len(matchMemberNames) == 0 {
+		if identifier := r.SpecIdentifierField(); identifier != nil {
+			for _, memberName := range elemShape.MemberNames() {
+				fieldName := cfg.GetResourceFieldName(
+					r.Names.Original, r.Ops.ReadMany.ExportedName, memberName,
+				)
+				f, found := r.SpecFields[fieldName]
+				if found && (f.Names.Camel == *identifier || strings.EqualFold(fieldName, *identifier)) {
+					matchMemberNames = []string{memberName}
+					break
+				}
+			}
+		}
+	}
+	if len(matchMemberNames) == 0 {
+		msg := fmt.Sprintf(
+			"unable to find the %s element describing a %s: configure list_operation.match_fields",
+			r.Ops.ReadMany.ExportedName, r.Names.Camel,
+		)
+		panic(msg)
+	}
+	for _, memberName := range matchMemberNames {
+		if _, found := elemShape.MemberRefs[memberName]; !found {
+			msg := fmt.Sprintf(
+				"Match field name %s is not a member of the %s list element",
+				memberName, r.Ops.ReadMany.ExportedName,
+			)
+			panic(msg)
+		}
+	}
+	return matchMemberNames
+}
+
+// readManyMatchGuard returns the Go code that skips the elements of the
+// ReadMany Output shape's list that do not describe the resource: those with a
+// match field differing from the resource's and those for which a list
+// operation predicate does not hold. A match field that is not set in the
+// resource matches any element.
+//
+//	Sample output:
+//
+//	if ko.Status.RegistryID != nil && (elem.RegistryId == nil || *elem.RegistryId != *ko.Status.RegistryID) {
+//		continue
+//	}
+//	if ko.Spec.RepositoryName != nil && (elem.RepositoryName == nil || *elem.RepositoryName != *ko.Spec.RepositoryName) {
+//		continue
+//	}
+//	if elem.ImageTagMutability == nil || (*elem.ImageTagMutability != "IMMUTABLE") {
+//		continue
+//	}
+func readManyMatchGuard(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The ReadMany operation descriptor
+	op *awssdkmodel.Operation,
+	// The Shape of the list element in the ReadMany Output shape
+	elemShape *awssdkmodel.Shape,
+	// String representing the name of the variable holding the list element
+	elemVarName string,
+	// String representing the name of the variable holding the CR
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	matchFieldNames := r.ListOpMatchFieldNames()
+	matchedFieldNames := []string{}
+	for _, memberName := range elemShape.MemberNames() {
+		fieldName := cfg.GetResourceFieldName(
+			r.Names.Original, op.ExportedName, memberName,
+		)
+		if !util.InStrings(fieldName, matchFieldNames) {
+			continue
+		}
+		var f *model.Field
+		targetAdaptedVarName := targetVarName
+		inSpec, inStatus := r.HasMember(fieldName, op.ExportedName)
+		if inSpec {
+			targetAdaptedVarName += cfg.PrefixConfig.SpecField
+			f = r.SpecFields[fieldName]
+		} else if inStatus {
+			targetAdaptedVarName += cfg.PrefixConfig.StatusField
+			f = r.StatusFields[fieldName]
+		} else {
+			continue
+		}
+		switch elemShape.MemberRefs[memberName].Shape.Type {
+		case "list", "structure", "map":
+			msg := fmt.Sprintf(
+				"Match field name %s of %s must be a scalar member of the %s list element",
+				fieldName, r.Names.Camel, op.ExportedName,
+			)
+			panic(msg)
+		}
+		matchedFieldNames = append(matchedFieldNames, fieldName)
+		sourceAdaptedVarName := elemVarName + "." + memberName
+		qualifiedTargetVar := fmt.Sprintf(
+			"%s.%s", targetAdaptedVarName, f.Names.Camel,
+		)
+		targetValue := "*" + qualifiedTargetVar
+		if f.IsTypedEnum() {
+			targetValue = fmt.Sprintf("string(%s)", targetValue)
+		}
+		//  if ko.Spec.Name != nil && (elem.Name == nil || *elem.Name != *ko.Spec.Name) {
+		out += fmt.Sprintf(
+			"%sif %s != nil && (%s == nil || *%s != %s) {\n",
+			indent, qualifiedTargetVar, sourceAdaptedVarName,
+			sourceAdaptedVarName, targetValue,
+		)
+		out += fmt.Sprintf("%s\tcontinue\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	for _, fieldName := range matchFieldNames {
+		if !util.InStrings(fieldName, matchedFieldNames) {
+			msg := fmt.Sprintf(
+				"Match field name %s is not a member of the %s list element",
+				fieldName, op.ExportedName,
+			)
+			panic(msg)
+		}
+	}
+	for _, predicate := range r.ListOpPredicates() {
+		memberRef, found := elemShape.MemberRefs[predicate.Path]
+		if !found || memberRef.Shape.Type != "string" {
+			msg := fmt.Sprintf(
+				"list_operation predicate path %s must be a string member of the %s list element",
+				predicate.Path, op.ExportedName,
+			)
+			panic(msg)
+		}
+		if (len(predicate.In) == 0) == (len(predicate.NotIn) == 0) {
+			msg := fmt.Sprintf(
+				"list_operation predicate on %s must set exactly one of in or not_in",
+				predicate.Path,
+			)
+			panic(msg)
+		}
+		sourceAdaptedVarName := elemVarName + "." + predicate.Path
+		conditions := []string{}
+		if len(predicate.In) > 0 {
+			//  if elem.Scope == nil || (*elem.Scope != "REGIONAL") {
+			for _, value := range predicate.In {
+				conditions = append(conditions, fmt.Sprintf(
+					"*%s != %q", sourceAdaptedVarName, value,
+				))
+			}
+			out += fmt.Sprintf(
+				"%sif %s == nil || (%s) {\n",
+				indent, sourceAdaptedVarName, strings.Join(conditions, " && "),
+			)
+		} else {
+			//  if elem.Status != nil && (*elem.Status == "DELETING") {
+			for _, value := range predicate.NotIn {
+				conditions = append(conditions, fmt.Sprintf(
+					"*%s == %q", sourceAdaptedVarName, value,
+				))
+			}
+			out += fmt.Sprintf(
+				"%sif %s != nil && (%s) {\n",
+				indent, sourceAdaptedVarName, strings.Join(conditions, " || "),
 			)
 		}
+		out += fmt.Sprintf("%s\tcontinue\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	if r.ListOpMatchTag() != nil {
+		out += readManyMatchTagGuard(r, op, elemShape, elemVarName, targetVarName, indentLevel)
+	}
+	return out
+}
+
+// readManyMatchTagGuard returns the Go code skipping the elements of the
+// ReadMany Output shape that do not carry the identity tag of the resource,
+// whose value is the namespace and name of the CR, or only its name if the CR
+// is cluster-scoped.
+//
+// Sample output, for tags shaped as a list of key/value structs:
+//
+//	identityTagFound := false
+//	for _, tag := range elem.Tags {
+//		if tag.Key != nil && *tag.Key == identityTagKey &&
+//			tag.Value != nil && *tag.Value == ko.Namespace+"/"+ko.Name {
+//			identityTagFound = true
+//			break
+//		}
+//	}
+//	if !identityTagFound {
+//		continue
+//	}
+func readManyMatchTagGuard(
+	r *model.CRD,
+	// The ReadMany operation descriptor
+	op *awssdkmodel.Operation,
+	// The Shape of the list element in the ReadMany Output shape
+	elemShape *awssdkmodel.Shape,
+	// String representing the name of the variable holding the list element
+	elemVarName string,
+	// String representing the name of the variable holding the CR
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	tagField, _ := r.GetTagField()
+	tagsMemberName := ""
+	for _, memberName := range elemShape.MemberNames() {
+		if strings.EqualFold(memberName, tagField.Names.Original) {
+			tagsMemberName = memberName
+			break
+		}
+	}
+	if tagsMemberName == "" {
+		msg := fmt.Sprintf(
+			"list_operation.match_tag requires the %s list element to have a %s member",
+			op.ExportedName, tagField.Names.Original,
+		)
+		panic(msg)
+	}
+	tagsVarName := elemVarName + "." + tagsMemberName
+	tagValue := fmt.Sprintf("%s.Namespace+\"/\"+%s.Name", targetVarName, targetVarName)
+	if r.IsClusterScoped() {
+		// Cluster-scoped CRs have no namespace
+		tagValue = targetVarName + ".Name"
+	}
+	tagsShape := elemShape.MemberRefs[tagsMemberName].Shape
+	switch {
+	case tagsShape.Type == "map":
+		//  if tagValue, ok := elem.Tags[identityTagKey]; !ok || tagValue == nil || *tagValue != ko.Namespace+"/"+ko.Name {
 		out += fmt.Sprintf(
-			"%s} else {\n", indent,
+			"%sif tagValue, ok := %s[identityTagKey]; !ok || tagValue == nil || *tagValue != %s {\n",
+			indent, tagsVarName, tagValue,
 		)
+		out += fmt.Sprintf("%s\tcontinue\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+	case tagsShape.Type == "list" && tagsShape.MemberRef.Shape.Type == "structure":
+		// The tag field may have another representation, see
+		// `tags.representation`
+		keyMemberName, valueMemberName := r.GetTagStructMemberNames(
+			tagsShape.MemberRef.Shape,
+		)
+		out += fmt.Sprintf("%sidentityTagFound := false\n", indent)
+		out += fmt.Sprintf("%sfor _, tag := range %s {\n", indent, tagsVarName)
 		out += fmt.Sprintf(
-			"%s%s%s.%s = nil\n", indent, indent,
-			targetAdaptedVarName, f.Names.Camel,
+			"%s\tif tag.%s != nil && *tag.%s == identityTagKey &&\n",
+			indent, keyMemberName, keyMemberName,
 		)
 		out += fmt.Sprintf(
-			"%s}\n", indent,
+			"%s\t\ttag.%s != nil && *tag.%s == %s {\n",
+			indent, valueMemberName, valueMemberName, tagValue,
+		)
+		out += fmt.Sprintf("%s\t\tidentityTagFound = true\n", indent)
+		out += fmt.Sprintf("%s\t\tbreak\n", indent)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+		out += fmt.Sprintf("%sif !identityTagFound {\n", indent)
+		out += fmt.Sprintf("%s\tcontinue\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+	default:
+		msg := fmt.Sprintf(
+			"list_operation.match_tag requires the %s member of the %s list element to be a map or a list of structs",
+			tagsMemberName, op.ExportedName,
 		)
+		panic(msg)
 	}
 	return out
 }
@@ -467,8 +1311,20 @@ func setResourceReadMany(
 	// point directly to the shape.
 	wrapperFieldPath := r.GetOutputWrapperFieldPath(op)
 	if wrapperFieldPath != nil {
-		// fieldpath API needs fully qualified name
-		wfp := fieldpath.FromString(outputShape.ShapeName + "." + *wrapperFieldPath)
+		if _, _, indexed := indexedWrapperFieldPathChecks(
+			outputShape, sourceVarName, *wrapperFieldPath,
+		); indexed && strings.Contains(*wrapperFieldPath, "[") {
+			msg := fmt.Sprintf(
+				"output_wrapper_field_path %s of %s must go through at least "+
+					"one list whose element is not selected with an index",
+				*wrapperFieldPath, op.ExportedName,
+			)
+			panic(msg)
+		}
+		// fieldpath API needs fully qualified name, without list indexes
+		wfp := fieldpath.FromString(
+			outputShape.ShapeName + "." + fieldpath.StripIndices(*wrapperFieldPath),
+		)
 		wfpShapeRef := wfp.ShapeRef(&op.OutputRef)
 		if wfpShapeRef != nil {
 			listShapeName = wfpShapeRef.ShapeName
@@ -521,6 +1377,17 @@ func setResourceReadMany(
 	innerForIndent := strings.Repeat("\t", flIndentLvl)
 	out += opening
 
+	// With multiple match fields, predicates or a match tag, all the
+	// conditions are checked before any value of the element is copied to
+	// the resource
+	strictMatch := len(matchFieldNames) > 1 || len(r.ListOpPredicates()) > 0 ||
+		r.ListOpMatchTag() != nil
+	if strictMatch {
+		out += readManyMatchGuard(
+			cfg, r, op, sourceElemShape, elemVarName, targetVarName, flIndentLvl,
+		)
+	}
+
 	for memberIndex, memberName := range sourceElemShape.MemberNames() {
 		sourceMemberShapeRef := sourceElemShape.MemberRefs[memberName]
 		sourceMemberShape := sourceMemberShapeRef.Shape
@@ -573,7 +1440,10 @@ func setResourceReadMany(
 			memberName,
 		)
 		inSpec, inStatus := r.HasMember(fieldName, op.ExportedName)
-		if inSpec {
+		if inSpec && r.IsObserveOnly() {
+			// The Spec of observe-only resources is only set by the user
+			continue
+		} else if inSpec {
 			targetAdaptedVarName += cfg.PrefixConfig.SpecField
 			f = r.SpecFields[fieldName]
 		} else if inStatus {
@@ -584,6 +1454,17 @@ func setResourceReadMany(
 			continue
 		}
 
+		// Passthrough fields are only interpreted by hook code
+		if f.IsPassthrough() {
+			continue
+		}
+
+		// Values written to a Secret are handled by
+		// WriteSecretOutputs and never stored in the resource
+		if f.WritesToSecret() {
+			continue
+		}
+
 		// We may have some special instructions for how to handle setting the
 		// field value...
 		setCfg := f.GetSetterConfig(model.OpTypeList)
@@ -603,6 +1484,14 @@ func setResourceReadMany(
 		)
 		switch sourceMemberShape.Type {
 		case "list", "structure", "map":
+			if r.GetTagConversion().Converts(f, sourceMemberShape) {
+				// ko.Spec.Tags = fromSDKTags(elem.Tags)
+				out += fmt.Sprintf(
+					"%s\t%s = fromSDKTags(%s)\n",
+					innerForIndent, qualifiedTargetVar, sourceAdaptedVarName,
+				)
+				break
+			}
 			{
 				memberVarName := fmt.Sprintf("f%d", memberIndex)
 				out += varEmptyConstructorK8sType(
@@ -623,10 +1512,17 @@ func setResourceReadMany(
 					model.OpTypeList,
 					flIndentLvl+1,
 				)
+				out += setResourceListLimit(
+					r, f,
+					memberVarName,
+					targetAdaptedVarName,
+					flIndentLvl+1,
+				)
 				out += setResourceForScalar(
 					qualifiedTargetVar,
 					memberVarName,
 					sourceMemberShapeRef,
+					memberVarName,
 					flIndentLvl+1,
 				)
 			}
@@ -636,7 +1532,7 @@ func setResourceReadMany(
 			//                  continue
 			//              }
 			//          }
-			if util.InStrings(fieldName, matchFieldNames) {
+			if !strictMatch && util.InStrings(fieldName, matchFieldNames) {
 				out += fmt.Sprintf(
 					"%s\tif %s.%s != nil {\n",
 					innerForIndent,
@@ -661,12 +1557,45 @@ func setResourceReadMany(
 				)
 			}
 			//          r.ko.Spec.CacheClusterID = elem.CacheClusterId
-			out += setResourceForScalar(
-				qualifiedTargetVar,
-				sourceAdaptedVarName,
-				sourceMemberShapeRef,
-				flIndentLvl+1,
-			)
+			if f.IsTypedEnum() {
+				out += setResourceForTypedEnum(
+					qualifiedTargetVar,
+					sourceAdaptedVarName,
+					f,
+					flIndentLvl+1,
+				)
+			} else if layout := f.GetTimestampLayout(); layout != "" {
+				out += setResourceForTimestampString(
+					qualifiedTargetVar,
+					sourceAdaptedVarName,
+					layout,
+					fmt.Sprintf("f%d", memberIndex),
+					flIndentLvl+1,
+				)
+			} else if size, binary := f.GetQuantityUnitSize(); size != 0 {
+				out += setResourceForQuantity(
+					qualifiedTargetVar,
+					sourceAdaptedVarName,
+					size, binary,
+					flIndentLvl+1,
+				)
+			} else if factor := f.GetTransformFactor(); factor != 1 {
+				out += setResourceForTransformedScalar(
+					qualifiedTargetVar,
+					sourceAdaptedVarName,
+					factor,
+					fmt.Sprintf("f%d", memberIndex),
+					flIndentLvl+1,
+				)
+			} else {
+				out += setResourceForScalar(
+					qualifiedTargetVar,
+					sourceAdaptedVarName,
+					sourceMemberShapeRef,
+					fmt.Sprintf("f%d", memberIndex),
+					flIndentLvl+1,
+				)
+			}
 		}
 		out += fmt.Sprintf(
 			"%s} else {\n", innerForIndent,
@@ -675,6 +1604,9 @@ func setResourceReadMany(
 			"%s\t%s.%s = nil\n", innerForIndent,
 			targetAdaptedVarName, f.Names.Camel,
 		)
+		out += setResourceListLimitReset(
+			f, targetAdaptedVarName, flIndentLvl+1,
+		)
 		out += fmt.Sprintf(
 			"%s}\n", innerForIndent,
 		)
@@ -780,10 +1712,10 @@ func SetResourceGetAttributes(
 	// Number of levels of indentation to use
 	indentLevel int,
 ) string {
-	if !r.UnpacksAttributesMap() {
+	if !r.UnpacksAttributesMap() && len(r.AttributeSourcedFields()) == 0 {
 		// This is a bug in the code generation if this occurs...
 		msg := fmt.Sprintf(
-			"called SetResourceGetAttributes for a resource '%s' that doesn't unpack attributes map",
+			"called SetResourceGetAttributes for a resource '%s' that neither unpacks attributes map nor has attribute-sourced fields",
 			r.Ops.GetAttributes.Name,
 		)
 		panic(msg)
@@ -857,15 +1789,21 @@ func SetResourceGetAttributes(
 
 		fieldNames := names.New(fieldName)
 		if !fieldConfig.IsReadOnly {
+			if r.IsObserveOnly() {
+				// The Spec of observe-only resources is only set by the user
+				continue
+			}
 			adaptiveTargetVarName = targetVarName + cfg.PrefixConfig.SpecField
 		}
 		out += fmt.Sprintf(
-			"%s%s.%s = %s.Attributes[\"%s\"]\n",
+			"%s%s.%s = %s\n",
 			indent,
 			adaptiveTargetVarName,
 			fieldNames.Camel,
-			sourceVarName,
-			fieldName,
+			unpackAttribute(
+				fieldConfig,
+				fmt.Sprintf("%s.Attributes[\"%s\"]", sourceVarName, fieldName),
+			),
 		)
 	}
 	return out
@@ -992,16 +1930,35 @@ func SetResourceIdentifiers(
 
 	// Check if the CRD defines the primary keys
 	if r.IsARNPrimaryKey() {
+		if r.IsARNLess() {
+			panic(fmt.Sprintf(
+				"resource %s cannot be both ARN-less and use its ARN as "+
+					"primary key", r.Names.Original,
+			))
+		}
 		return arnOut
 	}
-	primaryField, err := r.GetPrimaryKeyField()
+	primaryFields, err := r.GetPrimaryKeyFields()
 	if err != nil {
 		panic(err)
 	}
+	isPrimaryField := func(field *model.Field) bool {
+		for _, primaryField := range primaryFields {
+			if field == primaryField {
+				return true
+			}
+		}
+		return false
+	}
 
 	var primaryCRField, primaryShapeField string
-	isPrimarySet := primaryField != nil
-	if isPrimarySet {
+	isPrimarySet := len(primaryFields) > 0
+	if len(primaryFields) > 1 {
+		primaryKeyOut += setResourceIdentifierCompositeKey(
+			cfg, r, sourceVarName, targetVarName, indentLevel,
+		)
+	} else if isPrimarySet {
+		primaryField := primaryFields[0]
 		memberPath, _ := findFieldInCR(cfg, r, primaryField.Names.Original)
 		targetVarPath := fmt.Sprintf("%s%s", targetVarName, memberPath)
 		primaryKeyOut += setResourceIdentifierPrimaryIdentifier(cfg, r,
@@ -1054,7 +2011,7 @@ func SetResourceIdentifiers(
 		)
 
 		// Check to see if we've already set the field as the primary identifier
-		if isPrimarySet && fieldName == primaryField.Names.Camel {
+		if f, found := r.Fields[fieldName]; found && isPrimaryField(f) {
 			continue
 		}
 
@@ -1068,7 +2025,7 @@ func SetResourceIdentifiers(
 		}
 
 		memberPath, targetField := findFieldInCR(cfg, r, searchField)
-		if targetField == nil || (isPrimarySet && targetField == primaryField) {
+		if targetField == nil || isPrimaryField(targetField) {
 			continue
 		}
 
@@ -1144,10 +2101,19 @@ func setResourceIdentifierPrimaryIdentifier(
 	adaptedMemberPath := fmt.Sprintf("&%s.NameOrID", sourceVarName)
 	qualifiedTargetVar := fmt.Sprintf("%s.%s", targetVarName, targetField.Path)
 
+	if targetField.IsTypedEnum() {
+		return setResourceForTypedEnum(
+			qualifiedTargetVar,
+			adaptedMemberPath,
+			targetField,
+			indentLevel,
+		)
+	}
 	return setResourceForScalar(
 		qualifiedTargetVar,
 		adaptedMemberPath,
 		targetField.ShapeRef,
+		"",
 		indentLevel,
 	)
 }
@@ -1188,12 +2154,22 @@ func setResourceIdentifierAdditionalKey(
 	additionalKeyOut += fmt.Sprintf("%s%s, %sok := %s\n", indent, fieldIndexName, fieldIndexName, sourceAdaptedVarName)
 	additionalKeyOut += fmt.Sprintf("%sif %sok {\n", indent, fieldIndexName)
 	qualifiedTargetVar := fmt.Sprintf("%s.%s", targetVarName, targetField.Path)
-	additionalKeyOut += setResourceForScalar(
-		qualifiedTargetVar,
-		fmt.Sprintf("&%s", fieldIndexName),
-		targetField.ShapeRef,
-		indentLevel+1,
-	)
+	if targetField.IsTypedEnum() {
+		additionalKeyOut += setResourceForTypedEnum(
+			qualifiedTargetVar,
+			fmt.Sprintf("&%s", fieldIndexName),
+			targetField,
+			indentLevel+1,
+		)
+	} else {
+		additionalKeyOut += setResourceForScalar(
+			qualifiedTargetVar,
+			fmt.Sprintf("&%s", fieldIndexName),
+			targetField.ShapeRef,
+			"",
+			indentLevel+1,
+		)
+	}
 	additionalKeyOut += fmt.Sprintf("%s}\n", indent)
 
 	return additionalKeyOut
@@ -1267,6 +2243,7 @@ func setResourceForContainer(
 			fmt.Sprintf("%s.%s", targetFieldName, targetVarName),
 			sourceVarName,
 			sourceShapeRef,
+			targetVarName+"json",
 			indentLevel,
 		)
 	}
@@ -1366,6 +2343,7 @@ func SetResourceForStruct(
 					qualifiedTargetVar,
 					indexedVarName,
 					sourceMemberShapeRef,
+					indexedVarName,
 					indentLevel+1,
 				)
 			}
@@ -1374,6 +2352,7 @@ func SetResourceForStruct(
 				qualifiedTargetVar,
 				sourceAdaptedVarName,
 				sourceMemberShapeRef,
+				indexedVarName,
 				indentLevel+1,
 			)
 		}
@@ -1418,6 +2397,7 @@ func SetResourceForStruct(
 						qualifiedTargetVar,
 						sourceAdaptedVarName,
 						sourceMemberShapeRef,
+						targetVarName+"json",
 						indentLevel+1,
 					)
 					out += fmt.Sprintf(
@@ -1500,6 +2480,7 @@ func setResourceForSlice(
 				elemVarName,
 				fmt.Sprintf("*%s.%s", iterVarName, *targetSetCfg.From),
 				sourceMemberShapeRef,
+				elemVarName+"json",
 				indentLevel+1,
 			)
 		} else {
@@ -1615,6 +2596,295 @@ func setResourceForMap(
 	return out
 }
 
+// setResourceListLimit returns a string of Go code that deterministically
+// orders and caps the elements of a list Status field that has a
+// `list_limit` configuration, recording whether elements were dropped.
+//
+// Output code will look something like this:
+//
+//	sort.SliceStable(f0, func(i, j int) bool {
+//		a, b := f0[i].Address, f0[j].Address
+//		return b != nil && (a == nil || *a < *b)
+//	})
+//	if len(f0) > 100 {
+//		f0 = f0[:100]
+//		ko.Status.EndpointsTruncated = aws.Bool(true)
+//	} else {
+//		ko.Status.EndpointsTruncated = aws.Bool(false)
+//	}
+func setResourceListLimit(
+	r *model.CRD,
+	f *model.Field,
+	// The name of the variable holding the list field's elements
+	varName string,
+	// The name of the variable holding the resource's Status struct
+	statusVarName string,
+	indentLevel int,
+) string {
+	listCfg := f.GetListLimitConfig()
+	if listCfg == nil {
+		return ""
+	}
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+
+	elemShape := f.ShapeRef.Shape.MemberRef.Shape
+	sortMember := ""
+	sortShape := elemShape
+	if elemShape.Type == "structure" {
+		if listCfg.SortBy != "" {
+			sortRef, found := elemShape.MemberRefs[listCfg.SortBy]
+			if !found {
+				panic(fmt.Sprintf(
+					"list_limit.sort_by of %s.%s refers to unknown member %s",
+					r.Names.Original, f.Names.Camel, listCfg.SortBy,
+				))
+			}
+			sortMember = "." + names.New(listCfg.SortBy).Camel
+			sortShape = sortRef.Shape
+		} else {
+			sortShape = nil
+		}
+	}
+	if sortShape != nil {
+		switch sortShape.Type {
+		case "string", "integer", "long", "float", "double":
+			// sort.SliceStable(f0, func(i, j int) bool {
+			//     a, b := f0[i].Address, f0[j].Address
+			//     return b != nil && (a == nil || *a < *b)
+			// })
+			out += fmt.Sprintf(
+				"%ssort.SliceStable(%s, func(i, j int) bool {\n",
+				indent, varName,
+			)
+			out += fmt.Sprintf(
+				"%s\ta, b := %s[i]%s, %s[j]%s\n",
+				indent, varName, sortMember, varName, sortMember,
+			)
+			out += fmt.Sprintf(
+				"%s\treturn b != nil && (a == nil || *a < *b)\n", indent,
+			)
+			out += fmt.Sprintf("%s})\n", indent)
+		default:
+			if listCfg.SortBy != "" {
+				panic(fmt.Sprintf(
+					"list_limit.sort_by of %s.%s refers to member %s of unsortable type %s",
+					r.Names.Original, f.Names.Camel, listCfg.SortBy, sortShape.Type,
+				))
+			}
+		}
+	}
+
+	truncatedVar := ""
+	if listCfg.TruncatedFieldName != "" {
+		truncatedVar = fmt.Sprintf(
+			"%s.%s", statusVarName, names.New(listCfg.TruncatedFieldName).Camel,
+		)
+	}
+	if listCfg.MaxItems <= 0 {
+		if truncatedVar != "" {
+			out += fmt.Sprintf("%s%s = aws.Bool(false)\n", indent, truncatedVar)
+		}
+		return out
+	}
+	// if len(f0) > 100 {
+	//     f0 = f0[:100]
+	out += fmt.Sprintf(
+		"%sif len(%s) > %d {\n", indent, varName, listCfg.MaxItems,
+	)
+	out += fmt.Sprintf(
+		"%s\t%s = %s[:%d]\n", indent, varName, varName, listCfg.MaxItems,
+	)
+	if truncatedVar != "" {
+		//     ko.Status.EndpointsTruncated = aws.Bool(true)
+		// } else {
+		//     ko.Status.EndpointsTruncated = aws.Bool(false)
+		out += fmt.Sprintf("%s\t%s = aws.Bool(true)\n", indent, truncatedVar)
+		out += fmt.Sprintf("%s} else {\n", indent)
+		out += fmt.Sprintf("%s\t%s = aws.Bool(false)\n", indent, truncatedVar)
+	}
+	// }
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// setResourceForTransformedScalar returns the Go code that sets an integer
+// field from an SDK output shape's member whose value is converted from the
+// API's unit to the CRD's unit.
+//
+// Output code will look something like this:
+//
+//	f3 := *resp.DBInstance.AllocatedStorage * 1024
+//	ko.Spec.AllocatedStorage = &f3
+func setResourceForTransformedScalar(
+	// The fully-qualified variable that will be set to sourceVar
+	targetVar string,
+	// The struct field that we access our source value from
+	sourceVar string,
+	// The factor converting the API value into the CRD value
+	factor int64,
+	// The field-indexed name of the variable holding the converted value
+	indexedVarName string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	out += fmt.Sprintf("%s%s := *%s * %d\n", indent, indexedVarName, sourceVar, factor)
+	out += fmt.Sprintf("%s%s = &%s\n", indent, targetVar, indexedVarName)
+	return out
+}
+
+// setResourceForTypedEnum returns the Go code that sets a field typed with
+// the Go string alias of its enum from an SDK output shape's string member.
+//
+// Output code will look something like this:
+//
+//	ko.Spec.EncryptionType = (*svcapitypes.EncryptionType)(resp.EncryptionType)
+func setResourceForTypedEnum(
+	// The fully-qualified variable that will be set to sourceVar
+	targetVar string,
+	// The struct field that we access our source value from
+	sourceVar string,
+	// The field typed with the Go string alias of its enum
+	f *model.Field,
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	return fmt.Sprintf(
+		"%s%s = (*svcapitypes.%s)(%s)\n",
+		indent, targetVar, f.GoTypeElem, sourceVar,
+	)
+}
+
+// setResourceForQuantity returns the Go code that sets a resource.Quantity
+// field from an SDK output shape's integer member, converting the value from
+// the unit of the AWS API.
+//
+// Output code will look something like this:
+//
+//	ko.Spec.AllocatedStorage = k8sresource.NewQuantity(*resp.DBInstance.AllocatedStorage*1073741824, k8sresource.BinarySI)
+func setResourceForQuantity(
+	// The fully-qualified variable that will be set to sourceVar
+	targetVar string,
+	// The struct field that we access our source value from
+	sourceVar string,
+	// The size in bytes of the unit of the value in the AWS API, and whether
+	// the unit is a binary one
+	size int64,
+	binary bool,
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	value := "*" + sourceVar
+	if size != 1 {
+		value = fmt.Sprintf("%s*%d", value, size)
+	}
+	format := "k8sresource.DecimalSI"
+	if binary {
+		format = "k8sresource.BinarySI"
+	}
+	return fmt.Sprintf(
+		"%s%s = k8sresource.NewQuantity(%s, %s)\n",
+		indent, targetVar, value, format,
+	)
+}
+
+// setResourceForTimestampString returns the Go code that sets a metav1.Time
+// field from an SDK output shape's string member, parsing the timestamp with
+// the layout of the AWS API.
+//
+// Output code will look something like this:
+//
+//	f3, err := time.Parse("2006-01-02T15:04:05Z07:00", *resp.Certificate.NotAfter)
+//	if err != nil {
+//		return nil, err
+//	}
+//	ko.Spec.NotAfter = &metav1.Time{Time: f3}
+func setResourceForTimestampString(
+	// The fully-qualified variable that will be set to sourceVar
+	targetVar string,
+	// The struct field that we access our source value from
+	sourceVar string,
+	// The Go reference time layout of the API's string
+	layout string,
+	// The field-indexed name of the variable holding the parsed time
+	indexedVarName string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	out += fmt.Sprintf(
+		"%s%s, err := time.Parse(%q, *%s)\n",
+		indent, indexedVarName, layout, sourceVar,
+	)
+	out += fmt.Sprintf("%sif err != nil {\n", indent)
+	out += fmt.Sprintf("%s\treturn nil, err\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	out += fmt.Sprintf(
+		"%s%s = &metav1.Time{Time: %s}\n", indent, targetVar, indexedVarName,
+	)
+	return out
+}
+
+// setResourceForJSONValue returns the Go code that sets a
+// runtime.RawExtension field from an SDK output shape's aws.JSONValue member,
+// encoding the JSON document into the raw bytes of the field.
+//
+// Output code will look something like this:
+//
+//	f3, err := json.Marshal(resp.Conditions)
+//	if err != nil {
+//		return nil, err
+//	}
+//	ko.Spec.Conditions = &runtime.RawExtension{Raw: f3}
+func setResourceForJSONValue(
+	// The fully-qualified variable that will be set to sourceVar
+	targetVar string,
+	// The struct field that we access our source value from
+	sourceVar string,
+	// The name of the variable holding the encoded JSON document
+	rawVarName string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	setTo := fmt.Sprintf("&runtime.RawExtension{Raw: %s}", rawVarName)
+	if strings.HasPrefix(targetVar, ".") {
+		targetVar = targetVar[1:]
+		setTo = setTo[1:]
+	}
+	out += fmt.Sprintf("%s%s, err := json.Marshal(%s)\n", indent, rawVarName, sourceVar)
+	out += fmt.Sprintf("%sif err != nil {\n", indent)
+	out += fmt.Sprintf("%s\treturn nil, err\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	out += fmt.Sprintf("%s%s = %s\n", indent, targetVar, setTo)
+	return out
+}
+
+// setResourceListLimitReset returns a string of Go code that resets the
+// truncation indicator of a list Status field that has a `list_limit`
+// configuration when the list is absent from the Output shape.
+//
+// Output code will look something like this:
+//
+//	ko.Status.EndpointsTruncated = aws.Bool(false)
+func setResourceListLimitReset(
+	f *model.Field,
+	// The name of the variable holding the resource's Status struct
+	statusVarName string,
+	indentLevel int,
+) string {
+	listCfg := f.GetListLimitConfig()
+	if listCfg == nil || listCfg.TruncatedFieldName == "" {
+		return ""
+	}
+	return fmt.Sprintf(
+		"%s%s.%s = aws.Bool(false)\n",
+		strings.Repeat("\t", indentLevel), statusVarName,
+		names.New(listCfg.TruncatedFieldName).Camel,
+	)
+}
+
 // setResourceForScalar returns a string of Go code that sets a target variable
 // value to a source variable when the type of the source variable is a scalar
 // type (not a map, slice or struct).
@@ -1624,8 +2894,16 @@ func setResourceForScalar(
 	// The struct or struct field that we access our source value from
 	sourceVar string,
 	shapeRef *awssdkmodel.ShapeRef,
+	// The field-indexed name of the variable holding the encoded value of an
+	// aws.JSONValue member, e.g. "f0"
+	indexedVarName string,
 	indentLevel int,
 ) string {
+	if shapeRef.JSONValue {
+		return setResourceForJSONValue(
+			targetVar, sourceVar, indexedVarName, indentLevel,
+		)
+	}
 	out := ""
 	indent := strings.Repeat("\t", indentLevel)
 	setTo := sourceVar
@@ -1686,13 +2964,28 @@ func generateForRangeLoops(
 	unpackShape := shapeRef.Shape
 
 	for fp.Size() > 0 {
-		pathPart := fp.PopFront()
+		pathPart, index := fieldpath.SplitIndex(fp.PopFront())
 		partShapeRef, _ := unpackShape.MemberRefs[pathPart]
 		unpackShape = partShapeRef.Shape
 		indent := strings.Repeat("\t", updatedIndentLevel)
 		iterVarName = fmt.Sprintf("iter%d", unwrapCount)
 		collectionVarName += "." + pathPart
 
+		// If pathPart selects an element of a list with an index, then
+		// generate a bounds check instead of a for-range loop.
+		if partShapeRef.Shape.Type == "list" && index >= 0 {
+			// ex: if len(resp.Reservations) > 0 {
+			//         iter0 := resp.Reservations[0]
+			opening += fmt.Sprintf("%sif len(%s) > %d {\n", indent, collectionVarName, index)
+			opening += fmt.Sprintf("%s\t%s := %s[%d]\n", indent, iterVarName, collectionVarName, index)
+			closing = fmt.Sprintf("%s}\n", indent) + closing
+			collectionVarName = iterVarName
+			unpackShape = partShapeRef.Shape.MemberRef.Shape
+			updatedIndentLevel += 1
+			unwrapCount += 1
+			continue
+		}
+
 		// Using the fieldpath as a guide, unwrap the shapeRef
 		// to generate for-range loops. If pathPart points
 		// to a struct member, then simply append struct name
@@ -1725,3 +3018,61 @@ func generateForRangeLoops(
 	opening = strings.Replace(opening, iterVarName, outputVarName, 1)
 	return opening, closing, updatedIndentLevel
 }
+
+// indexedWrapperFieldPathChecks returns the Go boolean expressions that are
+// all true when the list elements selected by the indexes of the supplied
+// output wrapper field path, e.g. "Reservations[0].Instances[0]", exist, and
+// their negations. Structure fields followed by an indexed list are checked
+// for nil. The returned boolean is false if the path goes through a list
+// whose element is not selected with an index.
+//
+// Sample output for "Reservations[0].Instances[0]":
+//
+//	inBounds:    len(resp.Reservations) > 0, len(resp.Reservations[0].Instances) > 0
+//	outOfBounds: len(resp.Reservations) == 0, len(resp.Reservations[0].Instances) == 0
+func indexedWrapperFieldPathChecks(
+	// The Output shape of the operation
+	outputShape *awssdkmodel.Shape,
+	// String representing the name of the variable holding the Output shape,
+	// likely "resp"
+	sourceVarName string,
+	// The output wrapper field path, relative to the Output shape
+	wrapperFieldPath string,
+) (inBounds []string, outOfBounds []string, indexed bool) {
+	accessor := sourceVarName
+	shape := outputShape
+	nilChecks := []string{}
+	for _, part := range strings.Split(wrapperFieldPath, ".") {
+		memberName, index := fieldpath.SplitIndex(part)
+		memberRef, found := shape.MemberRefs[memberName]
+		if !found {
+			panic(fmt.Sprintf(
+				"unable to find member %s of output wrapper field path %s "+
+					"in shape %s", memberName, wrapperFieldPath, shape.ShapeName,
+			))
+		}
+		accessor += "." + memberName
+		if index < 0 {
+			if memberRef.Shape.Type == "list" {
+				return nil, nil, false
+			}
+			nilChecks = append(nilChecks, accessor)
+			shape = memberRef.Shape
+			continue
+		}
+		for _, nilCheck := range nilChecks {
+			inBounds = append(inBounds, nilCheck+" != nil")
+			outOfBounds = append(outOfBounds, nilCheck+" == nil")
+		}
+		nilChecks = []string{}
+		inBounds = append(inBounds, fmt.Sprintf("len(%s) > %d", accessor, index))
+		if index == 0 {
+			outOfBounds = append(outOfBounds, fmt.Sprintf("len(%s) == 0", accessor))
+		} else {
+			outOfBounds = append(outOfBounds, fmt.Sprintf("len(%s) <= %d", accessor, index))
+		}
+		accessor += fmt.Sprintf("[%d]", index)
+		shape = memberRef.Shape.MemberRef.Shape
+	}
+	return inBounds, outOfBounds, true
+}
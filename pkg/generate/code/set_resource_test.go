@@ -14,6 +14,7 @@
 package code_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -2795,6 +2796,113 @@ func TestSetResource_SQS_Queue_GetAttributes(t *testing.T) {
 	)
 }
 
+func TestSetResource_SQS_Queue_GetAttributes_TypedAttributes(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sqs", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-typed-attributes.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Queue")
+	require.NotNil(crd)
+	assert.True(crd.HasTypedAttributes())
+	assert.Equal("*bool", crd.SpecFields["FifoQueue"].GoType)
+	assert.Equal("*int64", crd.SpecFields["MaximumMessageSize"].GoType)
+	assert.Equal("*metav1.Duration", crd.SpecFields["VisibilityTimeout"].GoType)
+	assert.Equal("*string", crd.SpecFields["Policy"].GoType)
+
+	got := code.SetResourceGetAttributes(crd.Config(), crd, "resp", "ko", 1)
+	assert.Contains(got, `	ko.Spec.FIFOQueue = attributeToBool(resp.Attributes["FifoQueue"])
+`)
+	assert.Contains(got, `	ko.Spec.MaximumMessageSize = attributeToInt(resp.Attributes["MaximumMessageSize"])
+`)
+	assert.Contains(got, `	ko.Spec.Policy = attributeToJSON(resp.Attributes["Policy"])
+`)
+	assert.Contains(got, `	ko.Spec.VisibilityTimeout = attributeToDuration(resp.Attributes["VisibilityTimeout"])
+`)
+	// Attributes without an attribute_type are left as strings
+	assert.Contains(got, `	ko.Spec.DelaySeconds = resp.Attributes["DelaySeconds"]
+`)
+}
+
+func TestSetResource_SQS_Queue_AttributeSourcedFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sqs", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-attribute-sourced-fields.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Queue")
+	require.NotNil(crd)
+	assert.False(crd.UnpacksAttributesMap())
+	assert.True(crd.HasTypedAttributes())
+	require.Len(crd.AttributeSourcedFields(), 2)
+	assert.Equal("*int64", crd.StatusFields["ApproximateNumberOfMessages"].GoType)
+	assert.Equal("*string", crd.StatusFields["RedrivePolicy"].GoType)
+
+	expected := `	ko.Status.ApproximateNumberOfMessages = attributeToInt(resp.Attributes["ApproximateNumberOfMessages"])
+	ko.Status.RedrivePolicy = resp.Attributes["RedrivePolicy"]
+`
+	assert.Equal(
+		expected,
+		code.SetResourceAttributeSourcedFields(crd.Config(), crd, "resp", "ko", 1),
+	)
+
+	// Only the attributes the fields are sourced from are requested
+	got := code.SetSDKGetAttributes(crd.Config(), crd, "r.ko", "res", 1)
+	assert.Contains(got, `		tmpVal0 := "ApproximateNumberOfMessages"
+		tmpVals = append(tmpVals, &tmpVal0)
+		tmpVal1 := "RedrivePolicy"
+		tmpVals = append(tmpVals, &tmpVal1)
+		res.SetAttributeNames(tmpVals)
+`)
+}
+
+func TestSetResource_Elasticache_ReplicationGroup_ListLimit(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "elasticache", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-list-limit.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "ReplicationGroup")
+	require.NotNil(crd)
+	assert.True(crd.HasListLimitFields())
+	require.NotNil(crd.StatusFields["NodeGroupsTruncated"])
+	assert.Equal("*bool", crd.StatusFields["NodeGroupsTruncated"].GoType)
+
+	got := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	// Lists of scalars are sorted by value
+	assert.Contains(got, `		sort.SliceStable(f12, func(i, j int) bool {
+			a, b := f12[i], f12[j]
+			return b != nil && (a == nil || *a < *b)
+		})
+		if len(f12) > 10 {
+			f12 = f12[:10]
+		}
+		ko.Status.MemberClusters = f12
+`)
+	assert.Contains(got, `		sort.SliceStable(f15, func(i, j int) bool {
+			a, b := f15[i].NodeGroupID, f15[j].NodeGroupID
+			return b != nil && (a == nil || *a < *b)
+		})
+		if len(f15) > 2 {
+			f15 = f15[:2]
+			ko.Status.NodeGroupsTruncated = aws.Bool(true)
+		} else {
+			ko.Status.NodeGroupsTruncated = aws.Bool(false)
+		}
+		ko.Status.NodeGroups = f15
+	} else {
+		ko.Status.NodeGroups = nil
+		ko.Status.NodeGroupsTruncated = aws.Bool(false)
+	}
+`)
+}
+
 func TestSetResource_RDS_DBSubnetGroup_ReadMany(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -3107,6 +3215,68 @@ func TestSetResource_EC2_VPC_SetResourceIdentifiers(t *testing.T) {
 	)
 }
 
+func TestSetResource_EC2_DHCPOptions_ARNLess_SetResourceIdentifiers(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ec2",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-arn-less.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "DhcpOptions")
+	require.NotNil(crd)
+	assert.True(crd.IsARNLess())
+	assert.False(crd.IsPrimaryARNField("Arn"))
+
+	// The identifier field is set from the NameOrID, never from an ARN
+	expected := `
+	if identifier.NameOrID == "" {
+		return ackerrors.MissingNameIdentifier
+	}
+	r.ko.Status.DHCPOptionsID = &identifier.NameOrID
+
+`
+	assert.Equal(
+		expected,
+		code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1),
+	)
+}
+
+func TestSetResource_EC2_DHCPOptions_ReadMany_MatchTag(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ec2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-match-tag.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DhcpOptions")
+	require.NotNil(crd)
+	require.NotNil(crd.ListOpMatchTag())
+	assert.Equal("services.k8s.aws/namespaced-name", crd.ListOpMatchTag().Key)
+
+	// Elements not carrying the identity tag of the resource are skipped
+	// before any of their values is copied to the resource
+	expected := `
+	found := false
+	for _, elem := range resp.DhcpOptions {
+		identityTagFound := false
+		for _, tag := range elem.Tags {
+			if tag.Key != nil && *tag.Key == identityTagKey &&
+				tag.Value != nil && *tag.Value == ko.Namespace+"/"+ko.Name {
+				identityTagFound = true
+				break
+			}
+		}
+		if !identityTagFound {
+			continue
+		}
+		if elem.DhcpConfigurations != nil {`
+	got := code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1)
+	assert.True(strings.HasPrefix(got, expected), got)
+}
+
 func TestSetResource_EC2_SecurityGroups_SetResourceIdentifiers(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -4692,3 +4862,467 @@ func TestSetResource_EC2_Instance_ReadMany(t *testing.T) {
 		code.SetResource(crd.Config(), crd, op, "resp", "ko", 1),
 	)
 }
+
+func TestSetResource_Lambda_Function_ReadManySourcedFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-read-many-sourced-fields.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+
+	fields := crd.ReadManySourcedFields()
+	require.Len(fields, 2)
+	assert.Equal("ListedCodeSize", fields[0].Names.Camel)
+	assert.Equal("ListedTracingMode", fields[1].Names.Camel)
+
+	expected := `	ko.Status.ListedCodeSize = nil
+	ko.Status.ListedTracingMode = nil
+	for {
+		var resp *svcsdk.ListFunctionsOutput
+		resp, err = rm.sdkapi.ListFunctionsWithContext(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "ListFunctions", err)
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, elem := range resp.Functions {
+			if elem.FunctionName == nil || ko.Spec.FunctionName == nil || *elem.FunctionName != *ko.Spec.FunctionName {
+				continue
+			}
+			found = true
+			if elem.CodeSize != nil {
+				ko.Status.ListedCodeSize = elem.CodeSize
+			} else {
+				ko.Status.ListedCodeSize = nil
+			}
+			if elem.TracingConfig != nil && elem.TracingConfig.Mode != nil {
+				ko.Status.ListedTracingMode = elem.TracingConfig.Mode
+			} else {
+				ko.Status.ListedTracingMode = nil
+			}
+			break
+		}
+		if found || resp.NextMarker == nil || *resp.NextMarker == "" {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetResourceReadManySourcedFields(crd.Config(), crd, "input", "ko", 1),
+	)
+}
+
+func TestSetResource_Lambda_Function_SourceFallbacks(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-source-fallbacks.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+	require.NotNil(crd.StatusFields["ReportedState"])
+	// DescribeFunction does not exist, so the type comes from GetFunction
+	assert.Equal("*string", crd.StatusFields["ReportedState"].GoType)
+
+	got := code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1)
+	assert.Contains(got, `	if resp.Code != nil && resp.Code.Location != nil {
+		ko.Status.CodeLocation = resp.Code.Location
+	} else if resp.Code != nil && resp.Code.ImageUri != nil {
+		ko.Status.CodeLocation = resp.Code.ImageUri
+	} else {
+		ko.Status.CodeLocation = nil
+	}
+`)
+	// The value is kept when the other sources might have set it
+	assert.Contains(got, `	if resp.Configuration != nil && resp.Configuration.State != nil {
+		ko.Status.ReportedState = resp.Configuration.State
+	}
+`)
+}
+
+func TestSetResource_RDS_DBInstance_Create_FieldTransforms(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-field-transforms.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	got := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+
+	// The API's GiB are converted to the CRD's MiB
+	assert.Contains(got, `
+	if resp.DBInstance.AllocatedStorage != nil {
+		f0 := *resp.DBInstance.AllocatedStorage * 1024
+		ko.Spec.AllocatedStorage = &f0
+	} else {
+		ko.Spec.AllocatedStorage = nil
+	}
+`)
+	// The API's seconds are converted to the CRD's milliseconds
+	assert.Contains(got, `
+	if resp.DBInstance.MonitoringInterval != nil {
+		f35 := *resp.DBInstance.MonitoringInterval * 1000
+		ko.Spec.MonitoringInterval = &f35
+	} else {
+		ko.Spec.MonitoringInterval = nil
+	}
+`)
+}
+
+func TestSetResource_Lambda_Function_Create_TimestampFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-timestamp-fields.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+	require.NotNil(crd.StatusFields["LastModified"])
+	assert.Equal("*metav1.Time", crd.StatusFields["LastModified"].GoType)
+	assert.True(crd.HasTimestampStringFields())
+
+	got := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	assert.Contains(got, `
+	if resp.LastModified != nil {
+		f11, err := time.Parse("2006-01-02T15:04:05.000-0700", *resp.LastModified)
+		if err != nil {
+			return nil, err
+		}
+		ko.Status.LastModified = &metav1.Time{Time: f11}
+	} else {
+		ko.Status.LastModified = nil
+	}
+`)
+
+	// A timestamp of the ReadMany output that cannot be parsed fails the read
+	got = code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1)
+	assert.Contains(got, `
+		if elem.LastModified != nil {
+			f11, err := time.Parse("2006-01-02T15:04:05.000-0700", *elem.LastModified)
+			if err != nil {
+				return nil, err
+			}
+			ko.Status.LastModified = &metav1.Time{Time: f11}
+		} else {
+			ko.Status.LastModified = nil
+		}
+`)
+}
+
+func TestSetResource_RDS_DBInstance_Create_QuantityFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-quantity-fields.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+	assert.Equal("*k8sresource.Quantity", crd.SpecFields["AllocatedStorage"].GoType)
+	assert.Equal(
+		"k8sresource",
+		crd.TypeImports["k8s.io/apimachinery/pkg/api/resource"],
+	)
+
+	got := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	assert.Contains(got, `
+	if resp.DBInstance.AllocatedStorage != nil {
+		ko.Spec.AllocatedStorage = k8sresource.NewQuantity(*resp.DBInstance.AllocatedStorage*1073741824, k8sresource.BinarySI)
+	} else {
+		ko.Spec.AllocatedStorage = nil
+	}
+`)
+}
+
+func TestSetResource_ECR_Repository_TypedEnums(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-typed-enums.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	got := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	assert.Contains(got, `
+	if resp.Repository.ImageTagMutability != nil {
+		ko.Spec.ImageTagMutability = (*svcapitypes.ImageTagMutability)(resp.Repository.ImageTagMutability)
+	} else {
+		ko.Spec.ImageTagMutability = nil
+	}
+`)
+
+	got = code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1)
+	assert.Contains(got, `
+		if elem.ImageTagMutability != nil {
+			ko.Spec.ImageTagMutability = (*svcapitypes.ImageTagMutability)(elem.ImageTagMutability)
+		} else {
+			ko.Spec.ImageTagMutability = nil
+		}
+`)
+}
+
+func TestSetResource_SageMaker_App_SetResourceIdentifiers_TypedEnums(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sagemaker", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-typed-enums.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "App")
+	require.NotNil(crd)
+
+	got := code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1)
+	assert.Contains(got, `
+	f1, f1ok := identifier.AdditionalKeys["appType"]
+	if f1ok {
+		r.ko.Spec.AppType = (*svcapitypes.AppType)(&f1)
+	}
+`)
+}
+
+func TestSetResource_SageMaker_FlowDefinition_ReadOne_JSONValueFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sagemaker", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-json-values.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "FlowDefinition")
+	require.NotNil(crd)
+
+	got := code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1)
+	assert.Contains(got, `
+			if resp.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions != nil {
+				f5f0f0, err := json.Marshal(resp.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions)
+				if err != nil {
+					return nil, err
+				}
+				f5f0.HumanLoopActivationConditions = &runtime.RawExtension{Raw: f5f0f0}
+			}
+`)
+}
+
+func TestSetResource_ECR_Repository_CompositePrimaryKey_SetResourceIdentifiers(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-composite-primary-key.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.True(crd.HasCompositePrimaryKey())
+	assert.Equal("|", crd.PrimaryKeySeparator())
+
+	expected := `
+	if identifier.NameOrID == "" {
+		return ackerrors.MissingNameIdentifier
+	}
+	primaryKeys := strings.Split(identifier.NameOrID, "|")
+	if len(primaryKeys) != 2 {
+		return fmt.Errorf(
+			"expected identifier of the form %q, got %q",
+			"RegistryID|RepositoryName", identifier.NameOrID,
+		)
+	}
+	r.ko.Status.RegistryID = &primaryKeys[0]
+	r.ko.Spec.RepositoryName = &primaryKeys[1]
+
+`
+	assert.Equal(
+		expected,
+		code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1),
+	)
+}
+
+func TestSetResource_ECR_Repository_CompositePrimaryKey_CompositeIdentifier(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-composite-primary-key.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	expected := `	if r.ko.Status.RegistryID == nil || r.ko.Spec.RepositoryName == nil {
+		return ""
+	}
+	return strings.Join([]string{
+		*r.ko.Status.RegistryID,
+		*r.ko.Spec.RepositoryName,
+	}, "|")
+`
+	assert.Equal(
+		expected,
+		code.CompositeIdentifier(crd.Config(), crd, "r.ko", 1),
+	)
+}
+
+func TestSetResource_ECR_Repository_SetResourceIdentifiersFromARN(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-fields-from-arn.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	expected := `	if identifier.ARN != nil {
+		arn := string(*identifier.ARN)
+		f0Matches := regexp.MustCompile("^arn:[^:]+:ecr:[^:]*:([0-9]+):repository/").FindStringSubmatch(arn)
+		if len(f0Matches) != 2 {
+			return fmt.Errorf("cannot parse %s from ARN %q", "RegistryID", arn)
+		}
+		r.ko.Status.RegistryID = &f0Matches[1]
+		f1Matches := regexp.MustCompile(":repository/(.+)$").FindStringSubmatch(arn)
+		if len(f1Matches) != 2 {
+			return fmt.Errorf("cannot parse %s from ARN %q", "RepositoryName", arn)
+		}
+		r.ko.Spec.RepositoryName = &f1Matches[1]
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetResourceIdentifiersFromARN(crd.Config(), crd, "identifier", "r.ko", 1),
+	)
+}
+
+func TestSetResource_ECR_Repository_ReadMany_MultipleMatchFieldsAndPredicates(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-list-predicates.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	got := code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1)
+	// All the conditions are checked before any value of the element is
+	// copied to the resource
+	assert.Contains(got, `	for _, elem := range resp.Repositories {
+		if ko.Status.RegistryID != nil && (elem.RegistryId == nil || *elem.RegistryId != *ko.Status.RegistryID) {
+			continue
+		}
+		if ko.Spec.RepositoryName != nil && (elem.RepositoryName == nil || *elem.RepositoryName != *ko.Spec.RepositoryName) {
+			continue
+		}
+		if elem.ImageTagMutability == nil || (*elem.ImageTagMutability != "IMMUTABLE") {
+			continue
+		}
+		if elem.CreatedAt != nil {
+`)
+	assert.NotContains(got, `			if *elem.RepositoryName != *ko.Spec.RepositoryName {
+				continue
+			}
+`)
+}
+
+func TestSetResource_EC2_Instance_IndexedOutputWrapper(t *testing.T) {
+	// RunInstances uses the output wrapper field path Instances[0] and
+	// DescribeInstances uses Reservations[0].Instances, so the selected
+	// elements must be bounds-checked instead of iterated over.
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ec2",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-indexed-wrapper.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Instance")
+	require.NotNil(crd)
+
+	create := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	assert.True(strings.HasPrefix(create, `
+	if len(resp.Instances) == 0 {
+		return nil, errors.New("RunInstances returned no element at Instances[0]")
+	}
+`))
+	assert.Contains(create, `
+	if resp.Instances[0].AmiLaunchIndex != nil {
+		ko.Status.AMILaunchIndex = resp.Instances[0].AmiLaunchIndex
+	} else {
+		ko.Status.AMILaunchIndex = nil
+	}
+`)
+	assert.NotContains(create, "range resp.Instances {")
+	assert.NotContains(create, "ackerr.NotFound")
+
+	readMany := code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1)
+	assert.True(strings.HasPrefix(readMany, `
+	found := false
+	if len(resp.Reservations) > 0 {
+		iter0 := resp.Reservations[0]
+		for _, elem := range iter0.Instances {
+			if elem.AmiLaunchIndex != nil {
+`))
+	assert.NotContains(readMany, "range resp.Reservations {")
+}
+
+func TestSetResource_IAM_Role_ObserveOnly_ReadOne(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-observe-only.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+
+	// The Spec of observe-only resources is never written, even with the
+	// members of the Output shape matching Spec fields
+	got := code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1)
+	assert.NotContains(got, "ko.Spec.")
+	assert.Contains(got, `	if resp.Role.RoleId != nil {
+		ko.Status.RoleID = resp.Role.RoleId
+	} else {
+		ko.Status.RoleID = nil
+	}
+`)
+}
+
+func TestSetResource_IAM_Role_ConfigMap(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-configmap.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+
+	// Fields read from a ConfigMap are only set by the user, even with the
+	// Output shape returning their value
+	got := code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1)
+	assert.NotContains(got, "AssumeRolePolicyDocument")
+	assert.Contains(got, "ko.Spec.Description = resp.Role.Description")
+}
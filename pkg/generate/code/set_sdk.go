@@ -15,14 +15,18 @@ package code
 
 import (
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/aws-controllers-k8s/pkg/names"
 	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
 
 	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/fieldpath"
 	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
 )
 
 // SetSDK returns the Go code that sets an SDK input shape's member fields from
@@ -112,6 +116,65 @@ func SetSDK(
 	if op == nil {
 		return ""
 	}
+	return setSDKForOperation(
+		cfg, r, opType, op, sourceVarName, targetVarName, indentLevel,
+	)
+}
+
+// SetSDKForOperation returns the Go code that sets the Input shape of one of
+// a resource's additional update operations, configured in the resource's
+// `update_operations`, from the resource. The Spec fields assigned to the
+// operation are only set when they changed.
+func SetSDKForOperation(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	// String representing the name of the variable that we will grab the Input
+	// shape from, e.g. "r.ko"
+	sourceVarName string,
+	// String representing the name of the variable that we will be **setting**
+	// with values of the resource, e.g. "res"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	return setSDKForOperation(
+		cfg, r, model.OpTypeUpdate, op, sourceVarName, targetVarName, indentLevel,
+	)
+}
+
+// SetSDKForReadOneOperation returns the Go code that sets the Input shape of
+// one of a resource's additional ReadOne operations, configured in the
+// resource's `read_one_operations`, from the resource.
+func SetSDKForReadOneOperation(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	// String representing the name of the variable that we will grab the Input
+	// shape from, e.g. "r.ko"
+	sourceVarName string,
+	// String representing the name of the variable that we will be **setting**
+	// with values of the resource, e.g. "res"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	return setSDKForOperation(
+		cfg, r, model.OpTypeGet, op, sourceVarName, targetVarName, indentLevel,
+	)
+}
+
+// setSDKForOperation returns the Go code that sets the Input shape of the
+// supplied operation from the resource.
+func setSDKForOperation(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	opType model.OpType,
+	op *awssdkmodel.Operation,
+	sourceVarName string,
+	targetVarName string,
+	indentLevel int,
+) string {
 	inputShape := op.InputRef.Shape
 	if inputShape == nil {
 		return ""
@@ -160,7 +223,8 @@ func SetSDK(
 				)
 				out += fmt.Sprintf(
 					"%s\tattrMap[\"%s\"] = %s\n",
-					indent, fieldName, sourceAdaptedVarName,
+					indent, fieldName,
+					packAttribute(fieldConfig, sourceAdaptedVarName),
 				)
 				out += fmt.Sprintf(
 					"%s}\n", indent,
@@ -176,29 +240,44 @@ func SetSDK(
 		)
 	}
 
-	opConfig, override := cfg.GetOverrideValues(op.ExportedName)
+	overrides := overrideValues(cfg, op)
+	inputFields := inputFieldPaths(cfg, op)
+	idempotencyTokenMember := r.GetIdempotencyTokenMember(op)
 	for memberIndex, memberName := range inputShape.MemberNames() {
 		if r.UnpacksAttributesMap() && memberName == "Attributes" {
 			continue
 		}
 
-		if override {
-			value, ok := opConfig[memberName]
-			memberShapeRef, _ := inputShape.MemberRefs[memberName]
-			memberShape := memberShapeRef.Shape
-
-			if ok {
-				switch memberShape.Type {
-				case "boolean", "integer":
-				case "string":
-					value = "\"" + value + "\""
-				default:
-					panic("Member type not handled")
-				}
-
-				out += fmt.Sprintf("%s%s.Set%s(%s)\n", indent, targetVarName, memberName, value)
+		if memberName == idempotencyTokenMember {
+			// The token is stable across the calls made for a generation of
+			// the resource, e.g. res.SetClientToken(fmt.Sprintf("%s-%d",
+			// r.ko.GetUID(), r.ko.GetGeneration()))
+			if op == r.Ops.Create {
+				// and, for the Create operation, until the resource is
+				// created, e.g. res.SetClientToken(fmt.Sprintf("%s-%d-%s",
+				// r.ko.GetUID(), r.ko.GetGeneration(),
+				// r.ko.GetAnnotations()[createCountAnnotation]))
+				out += fmt.Sprintf(
+					"%s%s.Set%s(fmt.Sprintf(\"%%s-%%d-%%s\", %s.GetUID(), %s.GetGeneration(), %s.GetAnnotations()[createCountAnnotation]))\n",
+					indent, targetVarName, memberName, sourceVarName, sourceVarName, sourceVarName,
+				)
 				continue
 			}
+			out += fmt.Sprintf(
+				"%s%s.Set%s(fmt.Sprintf(\"%%s-%%d\", %s.GetUID(), %s.GetGeneration()))\n",
+				indent, targetVarName, memberName, sourceVarName, sourceVarName,
+			)
+			continue
+		}
+		if value, ok := overrides[memberName]; ok {
+			out += fmt.Sprintf("%s%s.Set%s(%s)\n", indent, targetVarName, memberName, value)
+			continue
+		}
+		if path, ok := inputFields[memberName]; ok {
+			out += setSDKFromFieldPath(
+				r, op, memberName, path, sourceVarName, targetVarName, indentLevel,
+			)
+			continue
 		}
 
 		if r.IsPrimaryARNField(memberName) {
@@ -251,6 +330,16 @@ func SetSDK(
 			continue
 		}
 
+		// Passthrough fields are only interpreted by hook code
+		if f.IsPassthrough() {
+			continue
+		}
+		// The tags are added with the tag operation once the resource is
+		// created, see `tags.sync.after_create`
+		if opType == model.OpTypeCreate && r.TagsAfterCreate() && r.IsTagField(f) {
+			continue
+		}
+
 		sourceAdaptedVarName += "." + f.Names.Camel
 		sourceFieldPath := f.Names.Camel
 
@@ -312,7 +401,17 @@ func SetSDK(
 		//     res.VpnMemberships = f0
 		// }
 
-		omitUnchangedFieldsOnUpdate := op == r.Ops.Update && r.OmitUnchangedFieldsOnUpdate()
+		updateOpID := ""
+		if inSpec && opType == model.OpTypeUpdate {
+			updateOpID = r.GetUpdateOperationOfField(f.Names.Camel)
+		}
+		if updateOpID != "" && updateOpID != op.ExportedName {
+			// The field is updated by another of the resource's update
+			// operations
+			continue
+		}
+		omitUnchangedFieldsOnUpdate := (op == r.Ops.Update && r.OmitUnchangedFieldsOnUpdate()) ||
+			updateOpID != ""
 		if omitUnchangedFieldsOnUpdate && inSpec {
 			fieldJSONPath := fmt.Sprintf("%s.%s", cfg.PrefixConfig.SpecField[1:], f.Names.Camel)
 			out += fmt.Sprintf(
@@ -330,6 +429,14 @@ func SetSDK(
 
 		switch memberShape.Type {
 		case "list", "structure", "map":
+			if r.GetTagConversion().Converts(f, memberShape) {
+				// res.SetTags(toSDKTags(r.ko.Spec.Tags))
+				out += fmt.Sprintf(
+					"%s\t%s.Set%s(toSDKTags(%s))\n",
+					indent, targetVarName, memberName, sourceAdaptedVarName,
+				)
+				break
+			}
 			{
 				memberVarName := fmt.Sprintf("f%d", memberIndex)
 				out += varEmptyConstructorSDKType(
@@ -356,6 +463,7 @@ func SetSDK(
 					sourceFieldPath,
 					memberVarName,
 					memberShapeRef,
+					memberVarName,
 					indentLevel+1,
 				)
 			}
@@ -368,6 +476,47 @@ func SetSDK(
 					sourceAdaptedVarName,
 					indentLevel,
 				)
+			} else if r.IsConfigMapField(memberName) {
+				out += setSDKForConfigMap(
+					memberName,
+					targetVarName,
+					sourceAdaptedVarName,
+					indentLevel,
+				)
+			} else if f.IsTypedEnum() {
+				out += setSDKForTypedEnum(
+					memberName,
+					targetVarName,
+					sourceAdaptedVarName,
+					indentLevel+1,
+				)
+			} else if layout := f.GetTimestampLayout(); layout != "" {
+				out += setSDKForTimestampString(
+					memberName,
+					targetVarName,
+					sourceAdaptedVarName,
+					layout,
+					indentLevel+1,
+				)
+			} else if size, _ := f.GetQuantityUnitSize(); size != 0 {
+				out += setSDKForQuantity(
+					memberName,
+					targetVarName,
+					sourceAdaptedVarName,
+					f.Names.Camel,
+					f.FieldConfig.Quantity.Unit,
+					size,
+					indentLevel+1,
+				)
+			} else if factor := f.GetTransformFactor(); factor != 1 {
+				out += setSDKForTransformedScalar(
+					memberName,
+					targetVarName,
+					sourceAdaptedVarName,
+					f.Names.Camel,
+					factor,
+					indentLevel+1,
+				)
 			} else {
 				out += setSDKForScalar(
 					cfg, r,
@@ -377,6 +526,7 @@ func SetSDK(
 					sourceFieldPath,
 					sourceAdaptedVarName,
 					memberShapeRef,
+					fmt.Sprintf("f%d", memberIndex),
 					indentLevel+1,
 				)
 			}
@@ -436,10 +586,11 @@ func SetSDKGetAttributes(
 	if inputShape == nil {
 		return ""
 	}
-	if !r.UnpacksAttributesMap() {
+	attrSourcedFields := r.AttributeSourcedFields()
+	if !r.UnpacksAttributesMap() && len(attrSourcedFields) == 0 {
 		// This is a bug in the code generation if this occurs...
 		msg := fmt.Sprintf(
-			"called SetSDKGetAttributes for a resource '%s' that doesn't unpack attributes map",
+			"called SetSDKGetAttributes for a resource '%s' that neither unpacks attributes map nor has attribute-sourced fields",
 			r.Names.Original,
 		)
 		panic(msg)
@@ -464,11 +615,37 @@ func SetSDKGetAttributes(
 			inputFieldOverrides[memberName] = override.Values
 		}
 	}
-	for _, memberName := range inputShape.MemberNames() {
+	if !r.UnpacksAttributesMap() {
+		// Resources that don't unpack the attributes map only request the
+		// attributes their attribute-sourced fields are set from, in the
+		// list of attribute names member of the Input shape, if any.
+		attrNames := []string{}
+		for _, field := range attrSourcedFields {
+			attrNames = append(attrNames, field.FieldConfig.From.Attribute)
+		}
+		for _, memberName := range inputShape.MemberNames() {
+			memberShapeRef := inputShape.MemberRefs[memberName]
+			if _, found := inputFieldOverrides[memberName]; found {
+				continue
+			}
+			if memberShapeRef.Shape.Type == "list" &&
+				memberShapeRef.Shape.MemberRef.Shape.Type == "string" {
+				inputFieldOverrides[memberName] = attrNames
+			}
+		}
+	}
+	inputFields := inputFieldPaths(cfg, op)
+	for memberIndex, memberName := range inputShape.MemberNames() {
+		if path, ok := inputFields[memberName]; ok {
+			out += setSDKFromFieldPath(
+				r, op, memberName, path, sourceVarName, targetVarName, indentLevel,
+			)
+			continue
+		}
 		if r.IsPrimaryARNField(memberName) {
 			// if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
 			//     res.SetTopicArn(string(*ko.Status.ACKResourceMetadata.ARN))
-			// } else {
+			// } else if ko.Spec.Name != nil {
 			//     res.SetTopicArn(rm.ARNFromName(*ko.Spec.Name))
 			// }
 			out += fmt.Sprintf(
@@ -484,7 +661,8 @@ func SetSDKGetAttributes(
 				// There is no name or ID field for the resource, so don't try
 				// to set an ARN from a name. Example: Subscription from SNS...
 				out += fmt.Sprintf(
-					"%s} else {\n", indent,
+					"%s} else if %s.Spec.%s != nil {\n",
+					indent, sourceVarName, *nameField,
 				)
 				out += fmt.Sprintf(
 					"%s\t%s.Set%s(rm.ARNFromName(*%s.Spec.%s))\n",
@@ -538,6 +716,10 @@ func SetSDKGetAttributes(
 		sourceVarPath := sourceVarName
 		field, found := r.SpecFields[memberName]
 		if found {
+			// Passthrough fields are only interpreted by hook code
+			if field.IsPassthrough() {
+				continue
+			}
 			sourceVarPath = sourceVarName + cfg.PrefixConfig.SpecField + "." + cleanMemberName
 		} else {
 			field, found = r.StatusFields[memberName]
@@ -551,16 +733,26 @@ func SetSDKGetAttributes(
 			"%sif %s != nil {\n",
 			indent, sourceVarPath,
 		)
-		out += setSDKForScalar(
-			cfg, r,
-			memberName,
-			targetVarName,
-			inputShape.Type,
-			cleanMemberName,
-			sourceVarPath,
-			field.ShapeRef,
-			indentLevel+1,
-		)
+		if field.IsTypedEnum() {
+			out += setSDKForTypedEnum(
+				memberName,
+				targetVarName,
+				sourceVarPath,
+				indentLevel+1,
+			)
+		} else {
+			out += setSDKForScalar(
+				cfg, r,
+				memberName,
+				targetVarName,
+				inputShape.Type,
+				cleanMemberName,
+				sourceVarPath,
+				field.ShapeRef,
+				fmt.Sprintf("f%d", memberIndex),
+				indentLevel+1,
+			)
+		}
 		out += fmt.Sprintf(
 			"%s}\n", indent,
 		)
@@ -637,21 +829,24 @@ func SetSDKSetAttributes(
 		panic(msg)
 	}
 
-	if r.SetAttributesSingleAttribute() {
+	if r.SetAttributesSingleAttribute() && !r.SetsChangedAttributesOnly() {
 		// TODO(jaypipes): For now, because these APIs require *multiple* calls
 		// to the backend, one for each attribute being set, we'll go ahead and
 		// rely on the CustomOperation functionality to write code for these...
+		//
+		// Unless only the changed attributes are set, in which case sdkUpdate
+		// sets the attribute name and value of each call itself.
 		return ""
 	}
 
 	out := "\n"
 	indent := strings.Repeat("\t", indentLevel)
 
-	for _, memberName := range inputShape.MemberNames() {
+	for memberIndex, memberName := range inputShape.MemberNames() {
 		if r.IsPrimaryARNField(memberName) {
 			// if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
 			//     res.SetTopicArn(string(*ko.Status.ACKResourceMetadata.ARN))
-			// } else {
+			// } else if ko.Spec.Name != nil {
 			//     res.SetTopicArn(rm.ARNFromName(*ko.Spec.Name))
 			// }
 			out += fmt.Sprintf(
@@ -667,7 +862,8 @@ func SetSDKSetAttributes(
 				// There is no name or ID field for the resource, so don't try
 				// to set an ARN from a name. Example: Subscription from SNS...
 				out += fmt.Sprintf(
-					"%s} else {\n", indent,
+					"%s} else if %s.Spec.%s != nil {\n",
+					indent, sourceVarName, *nameField,
 				)
 				out += fmt.Sprintf(
 					"%s\t%s.Set%s(rm.ARNFromName(*%s.Spec.%s))\n",
@@ -704,30 +900,22 @@ func SetSDKSetAttributes(
 			// res.SetAttributes(attrMap)
 			fieldConfigs := cfg.GetFieldConfigs(r.Names.Original)
 			out += fmt.Sprintf("%sattrMap := map[string]*string{}\n", indent)
-			sortedAttrFieldNames := []string{}
-			for fName, fConfig := range fieldConfigs {
-				if fConfig.IsAttribute {
-					sortedAttrFieldNames = append(sortedAttrFieldNames, fName)
-				}
-			}
-			sort.Strings(sortedAttrFieldNames)
-			for _, fieldName := range sortedAttrFieldNames {
+			for _, fieldName := range r.GetSettableAttributeNames() {
 				fieldConfig := fieldConfigs[fieldName]
 				fieldNames := names.New(fieldName)
-				if !fieldConfig.IsReadOnly {
-					sourceAdaptedVarName := sourceVarName + cfg.PrefixConfig.SpecField + "." + fieldNames.Camel
-					out += fmt.Sprintf(
-						"%sif %s != nil {\n",
-						indent, sourceAdaptedVarName,
-					)
-					out += fmt.Sprintf(
-						"%s\tattrMap[\"%s\"] = %s\n",
-						indent, fieldName, sourceAdaptedVarName,
-					)
-					out += fmt.Sprintf(
-						"%s}\n", indent,
-					)
-				}
+				sourceAdaptedVarName := sourceVarName + cfg.PrefixConfig.SpecField + "." + fieldNames.Camel
+				out += fmt.Sprintf(
+					"%sif %s != nil {\n",
+					indent, sourceAdaptedVarName,
+				)
+				out += fmt.Sprintf(
+					"%s\tattrMap[\"%s\"] = %s\n",
+					indent, fieldName,
+					packAttribute(fieldConfig, sourceAdaptedVarName),
+				)
+				out += fmt.Sprintf(
+					"%s}\n", indent,
+				)
 			}
 			out += fmt.Sprintf("%s%s.SetAttributes(attrMap)\n", indent, targetVarName)
 			continue
@@ -742,6 +930,10 @@ func SetSDKSetAttributes(
 		sourceVarPath := sourceVarName
 		field, found := r.SpecFields[memberName]
 		if found {
+			// Passthrough fields are only interpreted by hook code
+			if field.IsPassthrough() {
+				continue
+			}
 			sourceVarPath = sourceVarName + cfg.PrefixConfig.SpecField + "." + cleanMemberName
 		} else {
 			field, found = r.StatusFields[memberName]
@@ -755,16 +947,26 @@ func SetSDKSetAttributes(
 			"%sif %s != nil {\n",
 			indent, sourceVarPath,
 		)
-		out += setSDKForScalar(
-			cfg, r,
-			memberName,
-			targetVarName,
-			inputShape.Type,
-			cleanMemberName,
-			sourceVarPath,
-			field.ShapeRef,
-			indentLevel+1,
-		)
+		if field.IsTypedEnum() {
+			out += setSDKForTypedEnum(
+				memberName,
+				targetVarName,
+				sourceVarPath,
+				indentLevel+1,
+			)
+		} else {
+			out += setSDKForScalar(
+				cfg, r,
+				memberName,
+				targetVarName,
+				inputShape.Type,
+				cleanMemberName,
+				sourceVarPath,
+				field.ShapeRef,
+				fmt.Sprintf("f%d", memberIndex),
+				indentLevel+1,
+			)
+		}
 		out += fmt.Sprintf(
 			"%s}\n", indent,
 		)
@@ -772,6 +974,144 @@ func SetSDKSetAttributes(
 	return out
 }
 
+// SetSDKSingletonReset returns the Go code that sets the members of a
+// singleton resource's Create operation Input shape configured in its
+// `singleton.reset_values` to their default values, resetting the resource's
+// settings when its custom resource is deleted.
+//
+// Sample Output:
+//
+//	input.SetScanType("BASIC")
+func SetSDKSingletonReset(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the Create
+	// operation's Input shape, likely "input"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	singletonCfg := cfg.GetSingletonConfig(r.Names.Original)
+	if singletonCfg == nil || r.Ops.Create == nil {
+		return ""
+	}
+	inputShape := r.Ops.Create.InputRef.Shape
+	indent := strings.Repeat("\t", indentLevel)
+	memberNames := make([]string, 0, len(singletonCfg.ResetValues))
+	for memberName := range singletonCfg.ResetValues {
+		memberNames = append(memberNames, memberName)
+	}
+	sort.Strings(memberNames)
+	out := ""
+	for _, memberName := range memberNames {
+		value := singletonCfg.ResetValues[memberName]
+		memberShapeRef, found := inputShape.MemberRefs[memberName]
+		if !found {
+			msg := fmt.Sprintf(
+				"reset_values member %s of singleton resource %s is not a member of the %s Input shape",
+				memberName, r.Names.Original, r.Ops.Create.ExportedName,
+			)
+			panic(msg)
+		}
+		value, err := scalarValueLiteral(memberShapeRef.Shape, value)
+		if err != nil {
+			msg := fmt.Sprintf(
+				"reset_values member %s of singleton resource %s: %v",
+				memberName, r.Names.Original, err,
+			)
+			panic(msg)
+		}
+		out += fmt.Sprintf("%s%s.Set%s(%s)\n", indent, targetVarName, memberName, value)
+	}
+	return out
+}
+
+// SetSDKForceDelete returns the Go code that sets the boolean member of a
+// resource's Delete operation Input shape configured in its `force_delete`
+// to true when the resource's deletion is forced, either with its configured
+// field or with the force-delete annotation.
+//
+// Sample Output:
+//
+//	if (r.ko.Spec.ForceDelete != nil && *r.ko.Spec.ForceDelete) || r.ko.GetAnnotations()[forceDeleteAnnotation] == "true" {
+//		res.SetForce(true)
+//	}
+func SetSDKForceDelete(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// *resource variable name
+	resVarName string,
+	// String representing the name of the variable holding the Delete
+	// operation's Input shape, likely "res"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	forceCfg := cfg.GetForceDeleteConfig(r.Names.Original)
+	if forceCfg == nil || r.Ops.Delete == nil {
+		return ""
+	}
+	memberShapeRef, found := r.Ops.Delete.InputRef.Shape.MemberRefs[forceCfg.Member]
+	if !found || memberShapeRef.Shape.Type != "boolean" {
+		msg := fmt.Sprintf(
+			"force_delete member %s of resource %s must be a boolean member of the %s Input shape",
+			forceCfg.Member, r.Names.Original, r.Ops.Delete.ExportedName,
+		)
+		panic(msg)
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf("%sif %s {\n", indent, forceDeleteCondition(r, forceCfg, resVarName))
+	out += fmt.Sprintf("%s\t%s.Set%s(true)\n", indent, targetVarName, forceCfg.Member)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// forceDeleteCondition returns the Go condition that is true when the
+// deletion of the supplied resource is forced, either with its force-delete
+// field or annotation.
+func forceDeleteCondition(
+	r *model.CRD,
+	forceCfg *ackgenconfig.ForceDeleteConfig,
+	// *resource variable name
+	resVarName string,
+) string {
+	conditions := []string{}
+	if forceCfg.Field != "" {
+		accessor, nilChecks, err := auxiliaryFieldAccessor(r, forceCfg.Field, resVarName)
+		if err != nil {
+			panic(fmt.Sprintf(
+				"invalid force_delete field of resource %s: %v",
+				r.Names.Original, err,
+			))
+		}
+		fp := fieldpath.FromString(forceCfg.Field)
+		fp.PopFront()
+		if r.Fields[fp.String()].GoType != "*bool" {
+			msg := fmt.Sprintf(
+				"force_delete field %s of resource %s must be a boolean field",
+				forceCfg.Field, r.Names.Original,
+			)
+			panic(msg)
+		}
+		conditions = append(conditions, fmt.Sprintf(
+			"(%s && *%s)", strings.Join(nilChecks, " && "), accessor,
+		))
+	}
+	if forceCfg.Annotation {
+		conditions = append(conditions, fmt.Sprintf(
+			"%s.ko.GetAnnotations()[forceDeleteAnnotation] == \"true\"", resVarName,
+		))
+	}
+	if len(conditions) == 0 {
+		msg := fmt.Sprintf(
+			"force_delete of resource %s must have a field or an annotation",
+			r.Names.Original,
+		)
+		panic(msg)
+	}
+	return strings.Join(conditions, " || ")
+}
+
 // setSDKReadMany is a special-case handling of those APIs where there is no
 // ReadOne operation and instead the only way to grab information for a single
 // object is to call the ReadMany/List operation with one of more filtering
@@ -810,26 +1150,19 @@ func setSDKReadMany(
 	indent := strings.Repeat("\t", indentLevel)
 
 	resVarPath := ""
-	opConfig, override := cfg.GetOverrideValues(op.ExportedName)
+	overrides := overrideValues(cfg, op)
 	var err error
+	inputFields := inputFieldPaths(cfg, op)
 	for memberIndex, memberName := range inputShape.MemberNames() {
-		if override {
-			value, ok := opConfig[memberName]
-			memberShapeRef, _ := inputShape.MemberRefs[memberName]
-			memberShape := memberShapeRef.Shape
-			if ok {
-				switch memberShape.Type {
-				case "boolean", "integer":
-				case "string":
-					value = "\"" + value + "\""
-				default:
-					panic(fmt.Sprintf("Unsupported shape type %s in "+
-						"generate.code.setSDKReadMany", memberShape.Type))
-				}
-
-				out += fmt.Sprintf("%s%s.Set%s(%s)\n", indent, targetVarName, memberName, value)
-				continue
-			}
+		if value, ok := overrides[memberName]; ok {
+			out += fmt.Sprintf("%s%s.Set%s(%s)\n", indent, targetVarName, memberName, value)
+			continue
+		}
+		if path, ok := inputFields[memberName]; ok {
+			out += setSDKFromFieldPath(
+				r, op, memberName, path, sourceVarName, targetVarName, indentLevel,
+			)
+			continue
 		}
 
 		// Handles field renames, if applicable
@@ -838,6 +1171,11 @@ func setSDKReadMany(
 			op.ExportedName,
 			memberName,
 		)
+		// Passthrough fields are only interpreted by hook code
+		if f, found := r.SpecFields[fieldName]; found && f.IsPassthrough() {
+			continue
+		}
+		sourceFieldName := fieldName
 		resVarPath, err = r.GetSanitizedMemberPath(memberName, op, sourceVarName)
 		if err != nil {
 			// memberName could be a plural identifier field, so check for
@@ -851,11 +1189,17 @@ func setSDKReadMany(
 						"Unable to locate identifier field %s in "+
 							"%s Spec/Status in generate.code.setSDKReadMany", crIdentifier, r.Kind))
 				}
+				sourceFieldName = crIdentifier
 			} else {
 				// TODO(jaypipes): check generator config for exceptions?
 				continue
 			}
 		}
+		sourceField, found := r.SpecFields[sourceFieldName]
+		if !found {
+			sourceField = r.StatusFields[sourceFieldName]
+		}
+		typedEnum := sourceField != nil && sourceField.IsTypedEnum()
 
 		memberShapeRef, _ := inputShape.MemberRefs[memberName]
 		memberShape := memberShapeRef.Shape
@@ -876,8 +1220,12 @@ func setSDKReadMany(
 			)
 
 			//  f0 = append(f0, sourceVarName)
+			elemVarPath := resVarPath
+			if typedEnum {
+				elemVarPath = fmt.Sprintf("(*string)(%s)", resVarPath)
+			}
 			out += fmt.Sprintf("%s\t%s = append(%s, %s)\n", indent,
-				memberVarName, memberVarName, resVarPath)
+				memberVarName, memberVarName, elemVarPath)
 
 			// res.SetIds(f0)
 			out += setSDKForScalar(
@@ -888,21 +1236,32 @@ func setSDKReadMany(
 				sourceVarName,
 				memberVarName,
 				memberShapeRef,
+				memberVarName,
 				indentLevel+1,
 			)
 		default:
 			// For ReadMany that have a singular identifier field.
 			// ex: DescribeReplicationGroups
-			out += setSDKForScalar(
-				cfg, r,
-				memberName,
-				targetVarName,
-				inputShape.Type,
-				sourceVarName,
-				resVarPath,
-				memberShapeRef,
-				indentLevel+1,
-			)
+			if typedEnum {
+				out += setSDKForTypedEnum(
+					memberName,
+					targetVarName,
+					resVarPath,
+					indentLevel+1,
+				)
+			} else {
+				out += setSDKForScalar(
+					cfg, r,
+					memberName,
+					targetVarName,
+					inputShape.Type,
+					sourceVarName,
+					resVarPath,
+					memberShapeRef,
+					fmt.Sprintf("f%d", memberIndex),
+					indentLevel+1,
+				)
+			}
 		}
 		out += fmt.Sprintf(
 			"%s}\n", indent,
@@ -985,6 +1344,21 @@ func setSDKForContainer(
 			out += fmt.Sprintf("%s}\n", indent)
 			return out
 		}
+		if r.IsConfigMapField(sourceFieldPath) {
+			indent := strings.Repeat("\t", indentLevel)
+			out := fmt.Sprintf(
+				"%sif %s != nil {\n",
+				indent, sourceVarName,
+			)
+			out += setSDKForConfigMap(
+				"",
+				targetVarName,
+				sourceVarName,
+				indentLevel,
+			)
+			out += fmt.Sprintf("%s}\n", indent)
+			return out
+		}
 
 		return setSDKForScalar(
 			cfg, r,
@@ -994,6 +1368,7 @@ func setSDKForContainer(
 			sourceFieldPath,
 			sourceVarName,
 			targetShapeRef,
+			targetVarName+"json",
 			indentLevel,
 		)
 	}
@@ -1072,6 +1447,62 @@ func setSDKForSecret(
 	return out
 }
 
+// setSDKForConfigMap returns a string of Go code that sets a target variable
+// to the value of a ConfigMap key when the type of the source variable is a
+// ConfigMapKeyReference.
+//
+// The Go code output from this function looks like this:
+//
+//	tmpConfigMap, err := rm.configMapValueFromReference(ctx, ko.Spec.PolicyDocument)
+//	if err != nil {
+//	    return nil, ackrequeue.Needed(err)
+//	}
+//	if tmpConfigMap != "" {
+//	    res.SetPolicyDocument(tmpConfigMap)
+//	}
+func setSDKForConfigMap(
+	// The name of the SDK Shape field we're setting
+	targetFieldName string,
+	// The variable name that we want to set a value on
+	targetVarName string,
+	// The CR field that we access our source value from
+	sourceVarName string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	cmVar := "tmpConfigMap"
+
+	//     tmpConfigMap, err := rm.configMapValueFromReference(ctx, ko.Spec.PolicyDocument)
+	out += fmt.Sprintf(
+		"%s\t%s, err := rm.configMapValueFromReference(ctx, %s)\n",
+		indent, cmVar, sourceVarName,
+	)
+	//     if err != nil {
+	//         return nil, ackrequeue.Needed(err)
+	//     }
+	out += fmt.Sprintf("%s\tif err != nil {\n", indent)
+	out += fmt.Sprintf("%s\t\treturn nil, ackrequeue.Needed(err)\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	//     if tmpConfigMap != "" {
+	//         res.SetPolicyDocument(tmpConfigMap)
+	//     }
+	out += fmt.Sprintf("%s\tif %s != \"\" {\n", indent, cmVar)
+	if targetFieldName == "" {
+		out += fmt.Sprintf(
+			"%s\t\t%s = %s\n",
+			indent, targetVarName, cmVar,
+		)
+	} else {
+		out += fmt.Sprintf(
+			"%s\t\t%s.Set%s(%s)\n",
+			indent, targetVarName, targetFieldName, cmVar,
+		)
+	}
+	out += fmt.Sprintf("%s\t}\n", indent)
+	return out
+}
+
 // SetSDKForStruct returns a string of Go code that sets a target variable
 // value to a source variable when the type of the source variable is a struct.
 func SetSDKForStruct(
@@ -1158,6 +1589,7 @@ func SetSDKForStruct(
 					memberFieldPath,
 					memberVarName,
 					memberShapeRef,
+					memberVarName,
 					indentLevel+1,
 				)
 			}
@@ -1170,6 +1602,13 @@ func SetSDKForStruct(
 					sourceAdaptedVarName,
 					indentLevel,
 				)
+			} else if r.IsConfigMapField(memberFieldPath) {
+				out += setSDKForConfigMap(
+					memberName,
+					targetVarName,
+					sourceAdaptedVarName,
+					indentLevel,
+				)
 			} else {
 				out += setSDKForScalar(
 					cfg, r,
@@ -1179,6 +1618,7 @@ func SetSDKForStruct(
 					memberFieldPath,
 					sourceAdaptedVarName,
 					memberShapeRef,
+					fmt.Sprintf("%sf%d", targetVarName, memberIndex),
 					indentLevel+1,
 				)
 			}
@@ -1399,6 +1839,177 @@ func varEmptyConstructorK8sType(
 	return out
 }
 
+// setSDKForJSONValue returns the Go code that sets an Input shape's
+// aws.JSONValue member from a runtime.RawExtension field, decoding the raw
+// JSON document held by the field.
+//
+// Output code will look something like this:
+//
+//	f3 := aws.JSONValue{}
+//	if err := json.Unmarshal(r.ko.Spec.Conditions.Raw, &f3); err != nil {
+//		return nil, ackerr.NewTerminalError(err)
+//	}
+//	res.SetConditions(f3)
+func setSDKForJSONValue(
+	// The name of the Input SDK Shape member we're outputting for
+	targetFieldName string,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// The type of shape of the target variable
+	targetVarType string,
+	// The struct or struct field that we access our source value from
+	sourceVarName string,
+	// The name of the variable holding the decoded JSON document
+	jsonVarName string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	out += fmt.Sprintf("%s%s := aws.JSONValue{}\n", indent, jsonVarName)
+	out += fmt.Sprintf(
+		"%sif err := json.Unmarshal(%s.Raw, &%s); err != nil {\n",
+		indent, sourceVarName, jsonVarName,
+	)
+	out += fmt.Sprintf("%s\treturn nil, ackerr.NewTerminalError(err)\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	if targetVarType == "structure" {
+		out += fmt.Sprintf("%s%s.Set%s(%s)\n", indent, targetVarName, targetFieldName, jsonVarName)
+	} else {
+		targetVarPath := targetVarName
+		if targetFieldName != "" {
+			targetVarPath += "." + targetFieldName
+		}
+		out += fmt.Sprintf("%s%s = %s\n", indent, targetVarPath, jsonVarName)
+	}
+	return out
+}
+
+// setSDKForTransformedScalar returns the Go code that sets an integer member of
+// an SDK input shape from a field whose value is converted from the CRD's unit
+// to the API's unit, rejecting values that are not a whole number of the API's
+// unit.
+//
+// Output code will look something like this:
+//
+//	if *r.ko.Spec.AllocatedStorage%1024 != 0 {
+//		return nil, ackerr.NewTerminalError(fmt.Errorf("AllocatedStorage must be a multiple of 1024"))
+//	}
+//	res.SetAllocatedStorage(*r.ko.Spec.AllocatedStorage / 1024)
+func setSDKForTransformedScalar(
+	// The name of the Input SDK Shape member we're outputting for
+	targetFieldName string,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// The struct field that we access our source value from
+	sourceVarName string,
+	// The name of the field in the CRD, used in the error message
+	fieldName string,
+	// The factor converting the API value into the CRD value
+	factor int64,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	out += fmt.Sprintf("%sif *%s%%%d != 0 {\n", indent, sourceVarName, factor)
+	out += fmt.Sprintf(
+		"%s\treturn nil, ackerr.NewTerminalError(fmt.Errorf(\"%s must be a multiple of %d\"))\n",
+		indent, fieldName, factor,
+	)
+	out += fmt.Sprintf("%s}\n", indent)
+	out += fmt.Sprintf(
+		"%s%s.Set%s(*%s / %d)\n",
+		indent, targetVarName, targetFieldName, sourceVarName, factor,
+	)
+	return out
+}
+
+// setSDKForTypedEnum returns the Go code that sets an Input shape's enum
+// string member from a field typed with the Go string alias of the enum.
+//
+// Output code will look something like this:
+//
+//	res.SetEncryptionType(string(*r.ko.Spec.EncryptionType))
+func setSDKForTypedEnum(
+	// The name of the Input SDK Shape member we're outputting for
+	targetFieldName string,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// The struct field that we access our source value from
+	sourceVarName string,
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	return fmt.Sprintf(
+		"%s%s.Set%s(string(*%s))\n",
+		indent, targetVarName, targetFieldName, sourceVarName,
+	)
+}
+
+// setSDKForQuantity returns the Go code that sets an Input shape's integer
+// member from a resource.Quantity field, converting the quantity into the
+// unit of the AWS API.
+//
+// Output code will look something like this:
+//
+//	if r.ko.Spec.AllocatedStorage.Value()%1073741824 != 0 {
+//		return nil, ackerr.NewTerminalError(fmt.Errorf("AllocatedStorage must be a whole number of GiB"))
+//	}
+//	res.SetAllocatedStorage(r.ko.Spec.AllocatedStorage.Value() / 1073741824)
+func setSDKForQuantity(
+	// The name of the Input SDK Shape member we're outputting for
+	targetFieldName string,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// The struct field that we access our source value from
+	sourceVarName string,
+	// The name of the field in the CRD, used in the error message
+	fieldName string,
+	// The unit of the value in the AWS API and its size in bytes
+	unit string,
+	size int64,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	setTo := sourceVarName + ".Value()"
+	if size != 1 {
+		out += fmt.Sprintf("%sif %s%%%d != 0 {\n", indent, setTo, size)
+		out += fmt.Sprintf(
+			"%s\treturn nil, ackerr.NewTerminalError(fmt.Errorf(\"%s must be a whole number of %s\"))\n",
+			indent, fieldName, unit,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+		setTo = fmt.Sprintf("%s / %d", setTo, size)
+	}
+	out += fmt.Sprintf("%s%s.Set%s(%s)\n", indent, targetVarName, targetFieldName, setTo)
+	return out
+}
+
+// setSDKForTimestampString returns the Go code that sets an Input shape's
+// string member from a metav1.Time field, formatting the timestamp with the
+// layout of the AWS API.
+//
+// Output code will look something like this:
+//
+//	res.SetNotAfter(r.ko.Spec.NotAfter.UTC().Format("2006-01-02T15:04:05Z07:00"))
+func setSDKForTimestampString(
+	// The name of the Input SDK Shape member we're outputting for
+	targetFieldName string,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// The struct field that we access our source value from
+	sourceVarName string,
+	// The Go reference time layout of the API's string
+	layout string,
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	return fmt.Sprintf(
+		"%s%s.Set%s(%s.UTC().Format(%q))\n",
+		indent, targetVarName, targetFieldName, sourceVarName, layout,
+	)
+}
+
 // setSDKForScalar returns the Go code that sets the value of a target variable
 // or field to a scalar value. For target variables that are structs, we output
 // the aws-sdk-go's common SetXXX() method. For everything else, we output
@@ -1417,8 +2028,17 @@ func setSDKForScalar(
 	// The struct or struct field that we access our source value from
 	sourceVarName string,
 	shapeRef *awssdkmodel.ShapeRef,
+	// The field-indexed name of the variable holding the decoded value of
+	// an aws.JSONValue member, e.g. "f0"
+	indexedVarName string,
 	indentLevel int,
 ) string {
+	if shapeRef.JSONValue {
+		return setSDKForJSONValue(
+			targetFieldName, targetVarName, targetVarType, sourceVarName,
+			indexedVarName, indentLevel,
+		)
+	}
 	out := ""
 	indent := strings.Repeat("\t", indentLevel)
 	setTo := sourceVarName
@@ -1439,3 +2059,189 @@ func setSDKForScalar(
 	}
 	return out
 }
+
+// inputFieldPaths returns the paths, keyed by member name, of the resource's
+// fields that the supplied operation's `input_fields` set members of its
+// Input shape from. It panics if a member is not a member of the Input shape.
+func inputFieldPaths(
+	cfg *ackgenconfig.Config,
+	op *awssdkmodel.Operation,
+) map[string]string {
+	inputFields := cfg.GetInputFields(op)
+	for memberName := range inputFields {
+		if _, found := op.InputRef.Shape.MemberRefs[memberName]; !found {
+			panic(fmt.Sprintf(
+				"input_fields member %s of operation %s is not a member of "+
+					"its Input shape",
+				memberName, op.ExportedName,
+			))
+		}
+	}
+	return inputFields
+}
+
+// setSDKFromFieldPath returns the Go code that sets the supplied scalar member
+// of an operation's Input shape from the resource's field at the supplied
+// path, configured in the operation's `input_fields`.
+//
+// Sample output:
+//
+//	if r.ko.Status.ACKResourceMetadata != nil && r.ko.Status.ACKResourceMetadata.OwnerAccountID != nil {
+//		res.SetRegistryId(string(*r.ko.Status.ACKResourceMetadata.OwnerAccountID))
+//	}
+func setSDKFromFieldPath(
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	memberName string,
+	path string,
+	sourceVarName string,
+	targetVarName string,
+	indentLevel int,
+) string {
+	memberShape := op.InputRef.Shape.MemberRefs[memberName].Shape
+	switch memberShape.Type {
+	case "boolean", "integer", "long", "float", "double", "string":
+	default:
+		panic(fmt.Sprintf(
+			"input_fields member %s of operation %s must be a scalar",
+			memberName, op.ExportedName,
+		))
+	}
+	accessors, err := fieldPathAccessors(r, sourceVarName, path)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"invalid path in input_fields of operation %s: %v",
+			op.ExportedName, err,
+		))
+	}
+	conditions := []string{}
+	for _, accessor := range accessors {
+		conditions = append(conditions, accessor+" != nil")
+	}
+	value := "*" + accessors[len(accessors)-1]
+	// The fields of the common Status.ACKResourceMetadata field have their
+	// own string types, as do typed enums
+	converted := strings.HasPrefix(path, "Status.ACKResourceMetadata.")
+	if !converted {
+		fp := fieldpath.FromString(path)
+		fp.PopFront()
+		field, found := r.Fields[fp.String()]
+		if !found {
+			panic(fmt.Sprintf(
+				"input_fields path %s of operation %s does not refer to a field of %s",
+				path, op.ExportedName, r.Names.Camel,
+			))
+		}
+		converted = field.IsTypedEnum()
+		if !converted && field.GoType != inputFieldGoTypes[memberShape.Type] {
+			panic(fmt.Sprintf(
+				"input_fields path %s of operation %s is a %s field, "+
+					"not a %s field like member %s",
+				path, op.ExportedName, field.GoType,
+				inputFieldGoTypes[memberShape.Type], memberName,
+			))
+		}
+	}
+	if converted {
+		if memberShape.Type != "string" {
+			panic(fmt.Sprintf(
+				"input_fields path %s of operation %s is a string field, "+
+					"not a %s field like member %s",
+				path, op.ExportedName, inputFieldGoTypes[memberShape.Type],
+				memberName,
+			))
+		}
+		value = "string(" + value + ")"
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf("%sif %s {\n", indent, strings.Join(conditions, " && "))
+	out += fmt.Sprintf(
+		"%s\t%s.Set%s(%s)\n", indent, targetVarName, memberName, value,
+	)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// inputFieldGoTypes maps the types of the scalar shapes to the Go types of
+// the fields whose values `input_fields` may set on members of those shapes
+var inputFieldGoTypes = map[string]string{
+	"boolean": "*bool",
+	"integer": "*int64",
+	"long":    "*int64",
+	"float":   "*float64",
+	"double":  "*float64",
+	"string":  "*string",
+}
+
+// overrideValues returns the Go literals, keyed by member name, of the
+// constant values that the supplied operation's `override_values` set on the
+// members of its Input shape. It panics if a member is not a scalar member of
+// the Input shape or if its value is not a valid value of the member.
+func overrideValues(
+	cfg *ackgenconfig.Config,
+	op *awssdkmodel.Operation,
+) map[string]string {
+	values, _ := cfg.GetOverrideValues(op.ExportedName)
+	if len(values) == 0 {
+		return nil
+	}
+	res := make(map[string]string, len(values))
+	for memberName, value := range values {
+		memberShapeRef, found := op.InputRef.Shape.MemberRefs[memberName]
+		if !found {
+			panic(fmt.Sprintf(
+				"override_values member %s of operation %s is not a member "+
+					"of its Input shape",
+				memberName, op.ExportedName,
+			))
+		}
+		literal, err := scalarValueLiteral(memberShapeRef.Shape, value)
+		if err != nil {
+			panic(fmt.Sprintf(
+				"override_values member %s of operation %s: %v",
+				memberName, op.ExportedName, err,
+			))
+		}
+		res[memberName] = literal
+	}
+	return res
+}
+
+// scalarValueLiteral returns the Go literal of the supplied constant value of
+// a member of the supplied shape, or an error if the shape is not a scalar or
+// the value is not a valid value of the shape.
+func scalarValueLiteral(
+	shape *awssdkmodel.Shape,
+	value string,
+) (string, error) {
+	invalid := fmt.Errorf("%q is not a valid %s value", value, shape.Type)
+	switch shape.Type {
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", invalid
+		}
+		return strconv.FormatBool(b), nil
+	case "integer", "long":
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", invalid
+		}
+		return strconv.FormatInt(i, 10), nil
+	case "float", "double":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return "", invalid
+		}
+		return strconv.FormatFloat(f, 'g', -1, 64), nil
+	case "string":
+		if len(shape.Enum) > 0 && !util.InStrings(value, shape.Enum) {
+			return "", fmt.Errorf(
+				"%q is not one of the values of enum %s", value, shape.ShapeName,
+			)
+		}
+		return strconv.Quote(value), nil
+	default:
+		return "", fmt.Errorf("%s shapes are not scalars", shape.Type)
+	}
+}
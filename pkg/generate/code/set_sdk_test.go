@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
 	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
@@ -1820,7 +1821,7 @@ func TestSetSDK_SNS_Topic_GetAttributes(t *testing.T) {
 	expected := `
 	if r.ko.Status.ACKResourceMetadata != nil && r.ko.Status.ACKResourceMetadata.ARN != nil {
 		res.SetTopicArn(string(*r.ko.Status.ACKResourceMetadata.ARN))
-	} else {
+	} else if r.ko.Spec.Name != nil {
 		res.SetTopicArn(rm.ARNFromName(*r.ko.Spec.Name))
 	}
 `
@@ -1896,6 +1897,30 @@ func TestSetSDK_SQS_Queue_Create(t *testing.T) {
 	)
 }
 
+func TestSetSDK_SQS_Queue_Create_TypedAttributes(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sqs", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-typed-attributes.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Queue")
+	require.NotNil(crd)
+
+	got := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	assert.Contains(got, `		attrMap["FifoQueue"] = attributeFromBool(r.ko.Spec.FIFOQueue)
+`)
+	assert.Contains(got, `		attrMap["MaximumMessageSize"] = attributeFromInt(r.ko.Spec.MaximumMessageSize)
+`)
+	assert.Contains(got, `		attrMap["Policy"] = attributeFromJSON(r.ko.Spec.Policy)
+`)
+	assert.Contains(got, `		attrMap["VisibilityTimeout"] = attributeFromDuration(r.ko.Spec.VisibilityTimeout)
+`)
+	assert.Contains(got, `		attrMap["DelaySeconds"] = r.ko.Spec.DelaySeconds
+`)
+}
+
 func TestSetSDK_SQS_Queue_GetAttributes(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -2191,6 +2216,36 @@ func TestSetSDK_IAM_User_NewPath(t *testing.T) {
 	)
 }
 
+func TestSetSDK_IAM_Role_ConfigMap(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-configmap.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+	assert.True(crd.HasConfigMapFields())
+	assert.Equal("*ConfigMapKeyReference", crd.SpecFields["AssumeRolePolicyDocument"].GoType)
+
+	expected := `
+	if r.ko.Spec.AssumeRolePolicyDocument != nil {
+		tmpConfigMap, err := rm.configMapValueFromReference(ctx, r.ko.Spec.AssumeRolePolicyDocument)
+		if err != nil {
+			return nil, ackrequeue.Needed(err)
+		}
+		if tmpConfigMap != "" {
+			res.SetAssumeRolePolicyDocument(tmpConfigMap)
+		}
+	}
+`
+	assert.Contains(
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
+		expected,
+	)
+}
+
 func TestSetSDK_Lambda_Ignore_Code_SHA256(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -2367,3 +2422,438 @@ func TestSetSDK_Lambda_Ignore_Code_SHA256(t *testing.T) {
 		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
 	)
 }
+
+func TestSetSDK_ECR_ImageTagMutabilitySetting_SingletonReset(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-singleton.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "ImageTagMutabilitySetting")
+	require.NotNil(crd)
+
+	expected := `	input.SetImageTagMutability("MUTABLE")
+`
+	assert.Equal(
+		expected,
+		code.SetSDKSingletonReset(crd.Config(), crd, "input", 1),
+	)
+}
+
+func TestSetSDK_ECR_Repository_OverrideValues(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-override-values.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// The constant value replaces the value of the member's Spec field
+	createCode := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	assert.Contains(createCode, "\tres.SetImageTagMutability(\"IMMUTABLE\")\n")
+	assert.NotContains(createCode, "r.ko.Spec.ImageTagMutability")
+	assert.Contains(
+		code.SetSDK(crd.Config(), crd, model.OpTypeList, "r.ko", "res", 1),
+		"\tres.SetMaxResults(100)\n",
+	)
+
+	// Values are emitted in their canonical Go form
+	opCfg := crd.Config().Operations["DescribeRepositories"]
+	opCfg.OverrideValues = map[string]string{"MaxResults": "+100"}
+	crd.Config().Operations["DescribeRepositories"] = opCfg
+	assert.Contains(
+		code.SetSDK(crd.Config(), crd, model.OpTypeList, "r.ko", "res", 1),
+		"\tres.SetMaxResults(100)\n",
+	)
+	opCfg = crd.Config().Operations["DeleteRepository"]
+	opCfg.OverrideValues = map[string]string{"Force": "t"}
+	crd.Config().Operations["DeleteRepository"] = opCfg
+	assert.Contains(
+		code.SetSDK(crd.Config(), crd, model.OpTypeDelete, "r.ko", "res", 1),
+		"\tres.SetForce(true)\n",
+	)
+
+	opCfg = crd.Config().Operations["CreateRepository"]
+	opCfg.OverrideValues = map[string]string{"ImageTagMutability": "FROZEN"}
+	crd.Config().Operations["CreateRepository"] = opCfg
+	assert.Panics(func() {
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	})
+}
+
+func TestSetSDK_ECR_Repository_ForceDelete(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-force-delete.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.True(crd.HasForceDelete())
+	assert.True(crd.ForceDeleteWithAnnotation())
+
+	expected := `	if (r.ko.Spec.ForceDelete != nil && *r.ko.Spec.ForceDelete) || r.ko.GetAnnotations()[forceDeleteAnnotation] == "true" {
+		res.SetForce(true)
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetSDKForceDelete(crd.Config(), crd, "r", "res", 1),
+	)
+}
+
+func TestSetSDK_ECR_Repository_Delete_InputFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-input-fields.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// The registry ID is set from the ID of the account owning the
+	// repository instead of the Status field of the same name
+	expected := `	if r.ko.Status.ACKResourceMetadata != nil && r.ko.Status.ACKResourceMetadata.OwnerAccountID != nil {
+		res.SetRegistryId(string(*r.ko.Status.ACKResourceMetadata.OwnerAccountID))
+	}
+`
+	deleteCode := code.SetSDK(crd.Config(), crd, model.OpTypeDelete, "r.ko", "res", 1)
+	assert.Contains(deleteCode, expected)
+	assert.NotContains(deleteCode, "r.ko.Status.RegistryID")
+
+	// The ReadMany operation's input_fields are honoured too
+	listCode := code.SetSDK(crd.Config(), crd, model.OpTypeList, "r.ko", "res", 1)
+	assert.Contains(listCode, expected)
+	assert.NotContains(listCode, "r.ko.Status.RegistryID")
+
+	opCfg := crd.Config().Operations["DeleteRepository"]
+	opCfg.InputFields = map[string]string{"RegistryId": "Status.Unknown"}
+	crd.Config().Operations["DeleteRepository"] = opCfg
+	assert.Panics(func() {
+		code.SetSDK(crd.Config(), crd, model.OpTypeDelete, "r.ko", "res", 1)
+	})
+
+	// The field must have the Go type of the member
+	opCfg.InputFields = map[string]string{"Force": "Spec.RepositoryName"}
+	crd.Config().Operations["DeleteRepository"] = opCfg
+	assert.PanicsWithValue(
+		"input_fields path Spec.RepositoryName of operation DeleteRepository "+
+			"is a *string field, not a *bool field like member Force",
+		func() {
+			code.SetSDK(crd.Config(), crd, model.OpTypeDelete, "r.ko", "res", 1)
+		},
+	)
+}
+
+func TestSetSDK_ECR_Repository_InputFieldsTypedEnum(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-typed-enums.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	require.True(crd.SpecFields["ImageTagMutability"].IsTypedEnum())
+
+	// Typed enums are converted to the string of the member
+	crd.Config().Operations = map[string]ackgenconfig.OperationConfig{
+		"DeleteRepository": {
+			InputFields: map[string]string{"RegistryId": "Spec.ImageTagMutability"},
+		},
+	}
+	assert.Contains(
+		code.SetSDK(crd.Config(), crd, model.OpTypeDelete, "r.ko", "res", 1),
+		"\t\tres.SetRegistryId(string(*r.ko.Spec.ImageTagMutability))\n",
+	)
+}
+
+func TestSetSDK_SQS_Queue_GetAttributesInputFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "sqs")
+
+	crd := testutil.GetCRDByName(t, g, "Queue")
+	require.NotNil(crd)
+
+	// The GetAttributes operation's input_fields are honoured
+	crd.Config().Operations = map[string]ackgenconfig.OperationConfig{
+		"GetQueueAttributes": {
+			InputFields: map[string]string{"QueueUrl": "Spec.QueueName"},
+		},
+	}
+	got := code.SetSDKGetAttributes(crd.Config(), crd, "r.ko", "res", 1)
+	assert.Contains(got, `	if r.ko.Spec.QueueName != nil {
+		res.SetQueueUrl(*r.ko.Spec.QueueName)
+	}
+`)
+	assert.NotContains(got, "r.ko.Status.QueueURL")
+}
+
+func TestSetSDK_EC2_IdempotencyTokens(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ec2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-idempotency-tokens.yaml",
+	})
+
+	// The token of the Create operation changes once the resource is created,
+	// so that a resource deleted outside of the controller is created anew
+	expected := `	res.SetClientToken(fmt.Sprintf("%s-%d-%s", r.ko.GetUID(), r.ko.GetGeneration(), r.ko.GetAnnotations()[createCountAnnotation]))
+`
+	// RunInstances' ClientToken member is marked as an idempotency token in
+	// the API model
+	instance := testutil.GetCRDByName(t, g, "Instance")
+	require.NotNil(instance)
+	assert.NotContains(instance.SpecFields, "ClientToken")
+	assert.Contains(
+		code.SetSDK(instance.Config(), instance, model.OpTypeCreate, "r.ko", "res", 1),
+		expected,
+	)
+
+	// CreateLaunchTemplate's ClientToken member is configured explicitly
+	launchTemplate := testutil.GetCRDByName(t, g, "LaunchTemplate")
+	require.NotNil(launchTemplate)
+	assert.NotContains(launchTemplate.SpecFields, "ClientToken")
+	createCode := code.SetSDK(launchTemplate.Config(), launchTemplate, model.OpTypeCreate, "r.ko", "res", 1)
+	assert.Contains(createCode, expected)
+	assert.NotContains(createCode, "r.ko.Spec.ClientToken")
+
+	opCfg := launchTemplate.Config().Operations["CreateLaunchTemplate"]
+	opCfg.IdempotencyTokenMember = "LaunchTemplateData"
+	launchTemplate.Config().Operations["CreateLaunchTemplate"] = opCfg
+	assert.Panics(func() {
+		code.SetSDK(launchTemplate.Config(), launchTemplate, model.OpTypeCreate, "r.ko", "res", 1)
+	})
+}
+
+func TestSetSDK_RDS_DBInstance_Delete_PassthroughFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-passthrough-fields.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	skipFinalSnapshot := crd.SpecFields["SkipFinalSnapshot"]
+	require.NotNil(skipFinalSnapshot)
+	assert.True(skipFinalSnapshot.IsPassthrough())
+	assert.Equal("*bool", skipFinalSnapshot.GoType)
+	require.NotNil(crd.SpecFields["DeletionPolicy"])
+
+	// SkipFinalSnapshot is a member of the DeleteDBInstance input shape but is
+	// only interpreted by hook code
+	expected := `
+	if r.ko.Spec.DBInstanceIdentifier != nil {
+		res.SetDBInstanceIdentifier(*r.ko.Spec.DBInstanceIdentifier)
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetSDK(crd.Config(), crd, model.OpTypeDelete, "r.ko", "res", 1),
+	)
+}
+
+func TestSetSDK_RDS_DBInstance_Create_FieldTransforms(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-field-transforms.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	got := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+
+	// The CRD's MiB are converted to the API's GiB
+	assert.Contains(got, `
+	if r.ko.Spec.AllocatedStorage != nil {
+		if *r.ko.Spec.AllocatedStorage%1024 != 0 {
+			return nil, ackerr.NewTerminalError(fmt.Errorf("AllocatedStorage must be a multiple of 1024"))
+		}
+		res.SetAllocatedStorage(*r.ko.Spec.AllocatedStorage / 1024)
+	}
+`)
+	// The CRD's milliseconds are converted to the API's seconds
+	assert.Contains(got, `
+	if r.ko.Spec.MonitoringInterval != nil {
+		if *r.ko.Spec.MonitoringInterval%1000 != 0 {
+			return nil, ackerr.NewTerminalError(fmt.Errorf("MonitoringInterval must be a multiple of 1000"))
+		}
+		res.SetMonitoringInterval(*r.ko.Spec.MonitoringInterval / 1000)
+	}
+`)
+
+	// A CRD unit coarser than the API's unit would not round-trip
+	crd.SpecFields["MonitoringInterval"].FieldConfig.Transform.Unit.CRD = "min"
+	assert.Panics(func() {
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	})
+}
+
+func TestSetSDK_RDS_DBInstance_Create_QuantityFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-quantity-fields.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	got := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	assert.Contains(got, `
+	if r.ko.Spec.AllocatedStorage != nil {
+		if r.ko.Spec.AllocatedStorage.Value()%1073741824 != 0 {
+			return nil, ackerr.NewTerminalError(fmt.Errorf("AllocatedStorage must be a whole number of GiB"))
+		}
+		res.SetAllocatedStorage(r.ko.Spec.AllocatedStorage.Value() / 1073741824)
+	}
+`)
+}
+
+func TestSetSDK_ECR_Repository_Create_TypedEnums(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-typed-enums.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	got := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	assert.Contains(got, `
+	if r.ko.Spec.ImageTagMutability != nil {
+		res.SetImageTagMutability(string(*r.ko.Spec.ImageTagMutability))
+	}
+`)
+}
+
+func TestSetSDK_SageMaker_ModelPackage_ReadMany_TypedEnums(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sagemaker", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-typed-enums.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "ModelPackage")
+	require.NotNil(crd)
+
+	got := code.SetSDK(crd.Config(), crd, model.OpTypeList, "r.ko", "res", 1)
+	assert.Contains(got, `
+	if r.ko.Spec.ModelApprovalStatus != nil {
+		res.SetModelApprovalStatus(string(*r.ko.Spec.ModelApprovalStatus))
+	}
+`)
+}
+
+func TestSetSDK_SageMaker_FlowDefinition_Create_JSONValueFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sagemaker", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-json-values.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "FlowDefinition")
+	require.NotNil(crd)
+
+	got := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	assert.Contains(got, `
+			if r.ko.Spec.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions != nil {
+				f1f0f0 := aws.JSONValue{}
+				if err := json.Unmarshal(r.ko.Spec.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions.Raw, &f1f0f0); err != nil {
+					return nil, ackerr.NewTerminalError(err)
+				}
+				f1f0.SetHumanLoopActivationConditions(f1f0f0)
+			}
+`)
+}
+
+func TestSetSDK_SQS_Queue_SetChangedAttributes(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sqs", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-changed-attributes.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Queue")
+	require.NotNil(crd)
+	require.True(crd.SetsChangedAttributesOnly())
+
+	// FifoQueue cannot be changed after creation and Policy is always sent
+	expected := `	attrMap := map[string]*string{}
+	if delta.DifferentAt("Spec.DelaySeconds") && r.ko.Spec.DelaySeconds != nil {
+		attrMap["DelaySeconds"] = r.ko.Spec.DelaySeconds
+	}
+	if r.ko.Spec.Policy != nil {
+		attrMap["Policy"] = attributeFromJSON(r.ko.Spec.Policy)
+	}
+	if delta.DifferentAt("Spec.VisibilityTimeout") && r.ko.Spec.VisibilityTimeout != nil {
+		attrMap["VisibilityTimeout"] = attributeFromDuration(r.ko.Spec.VisibilityTimeout)
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetChangedAttributes(crd.Config(), crd, "r.ko", "delta", "attrMap", 1),
+	)
+	assert.NotContains(
+		code.SetSDKSetAttributes(crd.Config(), crd, "r.ko", "res", 1),
+		"FifoQueue",
+	)
+}
+
+func TestSetSDK_RDS_DBSubnetGroup_TagsAfterCreate(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-tags-after-create.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBSubnetGroup")
+	require.NotNil(crd)
+	assert.True(crd.TagsAfterCreate())
+	require.Contains(crd.SpecFields, "Tags")
+
+	// The tags are added with AddTagsToResource once the DB subnet group is
+	// created instead of being passed to CreateDBSubnetGroup
+	createCode := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	assert.NotContains(createCode, "res.SetTags(")
+	assert.Contains(createCode, "res.SetDBSubnetGroupName(*r.ko.Spec.Name)")
+
+	// Without `tags.sync.after_create` the tags are passed to
+	// CreateDBSubnetGroup
+	g = testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-tag-sync.yaml",
+	})
+	crd = testutil.GetCRDByName(t, g, "DBSubnetGroup")
+	require.NotNil(crd)
+	assert.False(crd.TagsAfterCreate())
+	assert.Contains(
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
+		"res.SetTags(",
+	)
+}
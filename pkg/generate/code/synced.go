@@ -15,6 +15,7 @@ package code
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
@@ -63,6 +64,22 @@ func ResourceIsSynced(
 	}
 
 	for _, condCfg := range resConfig.Synced.When {
+		if isBooleanSyncedCondition(condCfg) {
+			expr := syncedConditionExpr(r, resVarName, condCfg)
+			if strings.HasPrefix(expr, "!") {
+				// Avoid a double negation of `not` conditions
+				expr = strings.TrimPrefix(expr, "!")
+			} else {
+				expr = "!" + expr
+			}
+			// if !(r.ko.Status.Status != nil && (*r.ko.Status.Status == "ACTIVE")) {
+			out += fmt.Sprintf("\tif %s {\n", expr)
+			// return false, nil
+			out += "\t\treturn false, nil\n"
+			// }
+			out += "\t}\n"
+			continue
+		}
 		if condCfg.Path == nil || *condCfg.Path == "" {
 			panic("Received an empty sync condition path. 'SyncCondition.Path' must be provided.")
 		}
@@ -86,6 +103,263 @@ func ResourceIsSynced(
 	return out
 }
 
+// isBooleanSyncedCondition returns true if the supplied condition cannot be
+// expressed as a single set of candidate values for a field, i.e. it uses
+// `not_in` or `empty` or combines other conditions.
+func isBooleanSyncedCondition(condCfg ackgenconfig.SyncedCondition) bool {
+	return len(condCfg.NotIn) > 0 || condCfg.Empty != nil ||
+		len(condCfg.AllOf) > 0 || len(condCfg.AnyOf) > 0 || condCfg.Not != nil
+}
+
+// syncedConditionExpr returns a parenthesized Go boolean expression that is
+// true when the supplied condition is satisfied. Fields along the condition's
+// path are checked for nil before being dereferenced.
+//
+//	Sample output for a condition combining two other conditions with
+//	`all_of`:
+//
+//	((r.ko.Status.DBInstanceStatus != nil && (*r.ko.Status.DBInstanceStatus == "available")) && (r.ko.Status.PendingModifiedValues == nil))
+func syncedConditionExpr(
+	r *model.CRD,
+	// resource variable name
+	resVarName string,
+	condCfg ackgenconfig.SyncedCondition,
+) string {
+	set := 0
+	for _, isSet := range []bool{
+		condCfg.Path != nil, len(condCfg.AllOf) > 0,
+		len(condCfg.AnyOf) > 0, condCfg.Not != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		panic(fmt.Sprintf("sync condition must have exactly one of 'path', "+
+			"'all_of', 'any_of' or 'not'. crd: %q", r.Kind))
+	}
+	switch {
+	case condCfg.Not != nil:
+		return "!" + syncedConditionExpr(r, resVarName, *condCfg.Not)
+	case len(condCfg.AllOf) > 0, len(condCfg.AnyOf) > 0:
+		operands, operator := condCfg.AllOf, " && "
+		if len(condCfg.AnyOf) > 0 {
+			operands, operator = condCfg.AnyOf, " || "
+		}
+		exprs := make([]string, 0, len(operands))
+		for _, operand := range operands {
+			exprs = append(exprs, syncedConditionExpr(r, resVarName, operand))
+		}
+		return "(" + strings.Join(exprs, operator) + ")"
+	}
+	return syncedFieldConditionExpr(r, resVarName, condCfg)
+}
+
+// syncedFieldConditionExpr returns a parenthesized Go boolean expression that
+// is true when the value of the field at the supplied condition's path
+// matches the condition's `in`, `not_in` or `empty` criterion.
+func syncedFieldConditionExpr(
+	r *model.CRD,
+	// resource variable name
+	resVarName string,
+	condCfg ackgenconfig.SyncedCondition,
+) string {
+	path := *condCfg.Path
+	criteria := 0
+	for _, isSet := range []bool{
+		len(condCfg.In) > 0, len(condCfg.NotIn) > 0, condCfg.Empty != nil,
+	} {
+		if isSet {
+			criteria++
+		}
+	}
+	if criteria != 1 {
+		panic(fmt.Sprintf("sync condition on path %q must have exactly one "+
+			"of 'in', 'not_in' or 'empty'. crd: %q", path, r.Kind))
+	}
+	fp := fieldpath.FromString(path)
+	if _, err := getTopLevelField(r, path); err != nil {
+		panic(fmt.Sprintf("cannot find top level field of path '%s': %v", path, err))
+	}
+	accessor := resVarName + "." + fp.PopFront()
+	parentNilChecks := []string{}
+	var field *model.Field
+	for idx := 0; idx < fp.Size(); idx++ {
+		curFP := fp.CopyAt(idx).String()
+		cur, ok := r.Fields[curFP]
+		if !ok {
+			panic(fmt.Sprintf("unable to find field with path %q. crd: %q", curFP, r.Kind))
+		}
+		if field != nil {
+			parentNilChecks = append(parentNilChecks, accessor+" != nil")
+		}
+		accessor += "." + fp.At(idx)
+		field = cur
+	}
+	isCollection := strings.HasPrefix(field.GoType, "[]") ||
+		strings.HasPrefix(field.GoType, "map[")
+
+	if condCfg.Empty != nil {
+		// A field is empty when any of its parents is nil
+		checks := make([]string, 0, len(parentNilChecks)+1)
+		for _, nilCheck := range parentNilChecks {
+			checks = append(checks, strings.Replace(nilCheck, "!=", "==", 1))
+		}
+		if isCollection {
+			checks = append(checks, fmt.Sprintf("len(%s) == 0", accessor))
+		} else {
+			checks = append(checks, accessor+" == nil")
+		}
+		expr := "(" + strings.Join(checks, " || ") + ")"
+		if !*condCfg.Empty {
+			return "!" + expr
+		}
+		return expr
+	}
+
+	if isCollection {
+		panic(fmt.Sprintf("sync condition on path %q cannot compare the "+
+			"values of a list or map field. crd: %q", path, r.Kind))
+	}
+	values := condCfg.In
+	if len(condCfg.NotIn) > 0 {
+		values = condCfg.NotIn
+	}
+	matches := make([]string, 0, len(values))
+	for _, value := range values {
+		if field.GoType == "*string" {
+			value = fmt.Sprintf("%q", value)
+		}
+		matches = append(matches, fmt.Sprintf("*%s == %s", accessor, value))
+	}
+	checks := append(parentNilChecks, accessor+" != nil")
+	checks = append(checks, "("+strings.Join(matches, " || ")+")")
+	expr := "(" + strings.Join(checks, " && ") + ")"
+	if len(condCfg.NotIn) > 0 {
+		// A field that is not set is not equal to any of the values
+		return "!" + expr
+	}
+	return expr
+}
+
+// ResourceTerminalState returns the Go code that returns a terminal error
+// when the resource's state field has one of the terminal values configured
+// in the resource's `terminal_states` generator config.
+//
+//	Sample output:
+//
+//	if r.ko.Status.StackStatus != nil {
+//		switch *r.ko.Status.StackStatus {
+//		case "CREATE_FAILED", "ROLLBACK_COMPLETE":
+//			return ackerr.NewTerminalError(fmt.Errorf(
+//				"resource is in terminal state %q", *r.ko.Status.StackStatus,
+//			))
+//		}
+//	}
+func ResourceTerminalState(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// resource variable name
+	resVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	terminalCfg := cfg.GetTerminalStatesConfig(r.Names.Original)
+	if terminalCfg == nil {
+		return out
+	}
+	if terminalCfg.Path == "" || len(terminalCfg.In) == 0 {
+		panic(fmt.Sprintf("terminal_states must have a path and at least "+
+			"one value. crd: %q", r.Kind))
+	}
+	accessor, nilChecks, err := auxiliaryFieldAccessor(r, terminalCfg.Path, resVarName)
+	if err != nil {
+		panic(fmt.Sprintf("invalid terminal_states path: %v. crd: %q", err, r.Kind))
+	}
+	fp := fieldpath.FromString(terminalCfg.Path)
+	fp.PopFront()
+	if r.Fields[fp.String()].GoType != "*string" {
+		panic(fmt.Sprintf("terminal_states path %q must be a string field. "+
+			"crd: %q", terminalCfg.Path, r.Kind))
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	values := make([]string, 0, len(terminalCfg.In))
+	for _, value := range terminalCfg.In {
+		values = append(values, fmt.Sprintf("%q", value))
+	}
+	out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(nilChecks, " && "))
+	out += fmt.Sprintf("%s\tswitch *%s {\n", indent, accessor)
+	out += fmt.Sprintf("%s\tcase %s:\n", indent, strings.Join(values, ", "))
+	out += fmt.Sprintf("%s\t\treturn ackerr.NewTerminalError(fmt.Errorf(\n", indent)
+	out += fmt.Sprintf(
+		"%s\t\t\t\"resource is in terminal state %%q\", *%s,\n", indent, accessor,
+	)
+	out += fmt.Sprintf("%s\t\t))\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// RequeueOnSuccessByState returns the Go code that returns a request to
+// requeue the resource after the delay configured for the observed value of
+// its state field in the resource's `reconcile.requeue_on_success_by_state`
+// generator config.
+//
+//	Sample output:
+//
+//	if r.ko.Status.EndpointStatus != nil {
+//		switch *r.ko.Status.EndpointStatus {
+//		case "Creating":
+//			return ackrequeue.NeededAfter(nil, 15*time.Second)
+//		case "Updating":
+//			return ackrequeue.NeededAfter(nil, 30*time.Second)
+//		}
+//	}
+func RequeueOnSuccessByState(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// resource variable name
+	resVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	byStateCfg := cfg.GetReconcileRequeueOnSuccessByState(r.Names.Original)
+	if byStateCfg == nil {
+		return out
+	}
+	accessor, nilChecks, err := auxiliaryFieldAccessor(r, byStateCfg.Path, resVarName)
+	if err != nil {
+		panic(fmt.Sprintf("invalid requeue_on_success_by_state path: %v. "+
+			"crd: %q", err, r.Kind))
+	}
+	fp := fieldpath.FromString(byStateCfg.Path)
+	fp.PopFront()
+	if r.Fields[fp.String()].GoType != "*string" {
+		panic(fmt.Sprintf("requeue_on_success_by_state path %q must be a "+
+			"string field. crd: %q", byStateCfg.Path, r.Kind))
+	}
+	states := make([]string, 0, len(byStateCfg.Seconds))
+	for state := range byStateCfg.Seconds {
+		states = append(states, state)
+	}
+	sort.Strings(states)
+	indent := strings.Repeat("\t", indentLevel)
+	out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(nilChecks, " && "))
+	out += fmt.Sprintf("%s\tswitch *%s {\n", indent, accessor)
+	for _, state := range states {
+		out += fmt.Sprintf("%s\tcase %q:\n", indent, state)
+		out += fmt.Sprintf(
+			"%s\t\treturn ackrequeue.NeededAfter(nil, %d*time.Second)\n", indent,
+			byStateCfg.Seconds[state],
+		)
+	}
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
 func getTopLevelField(r *model.CRD, fieldPath string) (*model.Field, error) {
 	fp := fieldpath.FromString(fieldPath)
 	if fp.Size() < 2 {
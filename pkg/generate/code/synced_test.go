@@ -105,3 +105,105 @@ func TestSyncedDynamodbTable(t *testing.T) {
 		),
 	)
 }
+
+func TestSyncedRDSDBInstance_BooleanMatchers(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-synced-matchers.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	expectedSyncedConditions := `
+	if r.ko.Status.DBInstanceStatus == nil {
+		return false, nil
+	}
+	dbInstanceStatusCandidates := []string{"available"}
+	if !ackutil.InStrings(*r.ko.Status.DBInstanceStatus, dbInstanceStatusCandidates) {
+		return false, nil
+	}
+	if !((r.ko.Status.PendingModifiedValues == nil) && (len(r.ko.Status.StatusInfos) == 0)) {
+		return false, nil
+	}
+	if !(!(r.ko.Spec.StorageType != nil && (*r.ko.Spec.StorageType == "io1" || *r.ko.Spec.StorageType == "io2")) || !(r.ko.Spec.IOPS == nil)) {
+		return false, nil
+	}
+	if (r.ko.Status.DBInstanceStatus != nil && (*r.ko.Status.DBInstanceStatus == "storage-full")) {
+		return false, nil
+	}
+`
+	assert.Equal(
+		expectedSyncedConditions,
+		code.ResourceIsSynced(
+			crd.Config(), crd, "r.ko", 1,
+		),
+	)
+}
+
+func TestResourceTerminalState_EKSCluster(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-terminal-states.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Cluster")
+	require.NotNil(crd)
+
+	expected := `	if r.ko.Status.Status != nil {
+		switch *r.ko.Status.Status {
+		case "FAILED":
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"resource is in terminal state %q", *r.ko.Status.Status,
+			))
+		}
+	}
+`
+	assert.Equal(
+		expected,
+		code.ResourceTerminalState(crd.Config(), crd, "r", 1),
+	)
+
+	g = testutil.NewModelForService(t, "eks")
+	crd = testutil.GetCRDByName(t, g, "Cluster")
+	require.NotNil(crd)
+	assert.Empty(code.ResourceTerminalState(crd.Config(), crd, "r", 1))
+}
+
+func TestRequeueOnSuccessByState_EKSCluster(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-requeue-by-state.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Cluster")
+	require.NotNil(crd)
+	require.NotNil(crd.ReconcileRequeueOnSuccessByState())
+	assert.Equal(600, crd.ReconcileRequeuOnSuccessSeconds())
+
+	expected := `	if r.ko.Status.Status != nil {
+		switch *r.ko.Status.Status {
+		case "CREATING":
+			return ackrequeue.NeededAfter(nil, 15*time.Second)
+		case "UPDATING":
+			return ackrequeue.NeededAfter(nil, 30*time.Second)
+		}
+	}
+`
+	assert.Equal(
+		expected,
+		code.RequeueOnSuccessByState(crd.Config(), crd, "r", 1),
+	)
+
+	g = testutil.NewModelForService(t, "eks")
+	crd = testutil.GetCRDByName(t, g, "Cluster")
+	require.NotNil(crd)
+	assert.Nil(crd.ReconcileRequeueOnSuccessByState())
+	assert.Empty(code.RequeueOnSuccessByState(crd.Config(), crd, "r", 1))
+}
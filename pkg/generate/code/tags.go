@@ -0,0 +1,344 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// SyncTags returns the Go code that updates the tags of the AWS resource with
+// the tagging operations of its service, see `tags.sync`. The tags of the
+// desired resource missing from the latest resource or having another value
+// are added and the tags of the latest resource missing from the desired
+// resource are removed.
+//
+// Sample output:
+//
+//	desiredTags := ToACKTags(desired.ko.Spec.Tags)
+//	latestTags := ToACKTags(latest.ko.Spec.Tags)
+//	toAdd := map[string]string{}
+//	for k, v := range desiredTags {
+//		if latestValue, found := latestTags[k]; !found || latestValue != v {
+//			toAdd[k] = v
+//		}
+//	}
+//	toRemove := []string{}
+//	for k := range latestTags {
+//		if _, found := desiredTags[k]; !found {
+//			toRemove = append(toRemove, k)
+//		}
+//	}
+//	sort.Strings(toRemove)
+//	if len(toRemove) > 0 {
+//		input := &svcsdk.RemoveTagsFromResourceInput{}
+//		if desired.ko.Status.ACKResourceMetadata != nil && desired.ko.Status.ACKResourceMetadata.ARN != nil {
+//			input.SetResourceName(string(*desired.ko.Status.ACKResourceMetadata.ARN))
+//		}
+//		input.SetTagKeys(aws.StringSlice(toRemove))
+//		_, err = rm.sdkapi.RemoveTagsFromResourceWithContext(ctx, input)
+//		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
+//		if err != nil {
+//			return err
+//		}
+//	}
+//	if len(toAdd) > 0 {
+//		...
+//	}
+func SyncTags(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// *resource variable name of the desired resource
+	desiredVarName string,
+	// *resource variable name of the latest resource
+	latestVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	tagSync := r.GetTagSync()
+	if tagSync == nil {
+		return ""
+	}
+	indent := strings.Repeat("\t", indentLevel)
+
+	desiredTags := fmt.Sprintf("ToACKTags(%s.ko.%s)", desiredVarName, tagSync.FieldPath)
+	latestTags := fmt.Sprintf("ToACKTags(%s.ko.%s)", latestVarName, tagSync.FieldPath)
+	if len(r.GetIgnoredTagKeyPrefixes()) > 0 {
+		// Tags whose keys have one of the prefixes of
+		// `tags.ignore_key_prefixes` are neither added nor removed
+		desiredTags = fmt.Sprintf("withoutIgnoredTags(%s)", desiredTags)
+		latestTags = fmt.Sprintf("withoutIgnoredTags(%s)", latestTags)
+	}
+	out := fmt.Sprintf("%sdesiredTags := %s\n", indent, desiredTags)
+	out += fmt.Sprintf("%slatestTags := %s\n", indent, latestTags)
+	out += fmt.Sprintf("%stoAdd := map[string]string{}\n", indent)
+	out += fmt.Sprintf("%sfor k, v := range desiredTags {\n", indent)
+	out += fmt.Sprintf("%s\tif latestValue, found := latestTags[k]; !found || latestValue != v {\n", indent)
+	out += fmt.Sprintf("%s\t\ttoAdd[k] = v\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	out += fmt.Sprintf("%stoRemove := []string{}\n", indent)
+	out += fmt.Sprintf("%sfor k := range latestTags {\n", indent)
+	out += fmt.Sprintf("%s\tif _, found := desiredTags[k]; !found {\n", indent)
+	out += fmt.Sprintf("%s\t\ttoRemove = append(toRemove, k)\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	out += fmt.Sprintf("%ssort.Strings(toRemove)\n", indent)
+
+	koVarName := desiredVarName + ".ko"
+	if tagSync.TagOp == tagSync.UntagOp {
+		// A single call adds and removes the tags
+		op := tagSync.TagOp
+		out += fmt.Sprintf("%sif len(toAdd) > 0 || len(toRemove) > 0 {\n", indent)
+		out += tagOperationInput(cfg, r, tagSync, op, koVarName, indentLevel+1)
+		out += fmt.Sprintf("%s\tif len(toAdd) > 0 {\n", indent)
+		out += setTagsMember(r, tagSync, "toAdd", indentLevel+2)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf("%s\tif len(toRemove) > 0 {\n", indent)
+		out += fmt.Sprintf(
+			"%s\t\tinput.Set%s(aws.StringSlice(toRemove))\n",
+			indent, tagSync.TagKeysMember,
+		)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += tagOperationCall(cfg, r, op, indentLevel+1)
+		out += fmt.Sprintf("%s}\n", indent)
+		return out
+	}
+	out += fmt.Sprintf("%sif len(toRemove) > 0 {\n", indent)
+	out += tagOperationInput(cfg, r, tagSync, tagSync.UntagOp, koVarName, indentLevel+1)
+	out += fmt.Sprintf(
+		"%s\tinput.Set%s(aws.StringSlice(toRemove))\n",
+		indent, tagSync.TagKeysMember,
+	)
+	out += tagOperationCall(cfg, r, tagSync.UntagOp, indentLevel+1)
+	out += fmt.Sprintf("%s}\n", indent)
+	out += fmt.Sprintf("%sif len(toAdd) > 0 {\n", indent)
+	out += tagOperationInput(cfg, r, tagSync, tagSync.TagOp, koVarName, indentLevel+1)
+	out += setTagsMember(r, tagSync, "toAdd", indentLevel+1)
+	out += tagOperationCall(cfg, r, tagSync.TagOp, indentLevel+1)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// ReadTags returns the Go code that sets the tag field of the supplied
+// resource to the tags of the AWS resource read with the list operation of
+// `tags.sync`, or an empty string if the operation reading the resource
+// returns its tags.
+//
+// Sample output:
+//
+//	input := &svcsdk.ListTagsForResourceInput{}
+//	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
+//		input.SetResourceName(string(*ko.Status.ACKResourceMetadata.ARN))
+//	}
+//	var resp *svcsdk.ListTagsForResourceOutput
+//	resp, err = rm.sdkapi.ListTagsForResourceWithContext(ctx, input)
+//	rm.metrics.RecordAPICall("READ_ONE", "ListTagsForResource", err)
+//	if err != nil {
+//		return err
+//	}
+//	if resp.TagList != nil {
+//		f0 := []*svcapitypes.Tag{}
+//		...
+//		ko.Spec.Tags = f0
+//	} else {
+//		ko.Spec.Tags = nil
+//	}
+func ReadTags(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// *ko variable name of the resource
+	koVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	tagSync := r.GetTagSync()
+	if tagSync == nil || tagSync.ListOp == nil {
+		return ""
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	op := tagSync.ListOp
+	out := tagOperationInput(cfg, r, tagSync, op, koVarName, indentLevel)
+	out += fmt.Sprintf(
+		"%svar resp %s\n", indent, r.GetOutputShapeGoType(op),
+	)
+	out += SDKCall(cfg, r, op, "resp, err =", "input", indentLevel) + "\n"
+	out += fmt.Sprintf(
+		"%srm.metrics.RecordAPICall(\"READ_ONE\", %q, err)\n",
+		indent, op.ExportedName,
+	)
+	out += fmt.Sprintf("%sif err != nil {\n", indent)
+	out += fmt.Sprintf("%s\treturn err\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+
+	tagField, _ := r.GetTagField()
+	sourceVarName := "resp." + tagSync.ListTagsMember
+	sourceShapeRef := op.OutputRef.Shape.MemberRefs[tagSync.ListTagsMember]
+	targetVarName := koVarName + "." + tagSync.FieldPath
+	out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceVarName)
+	if r.GetTagConversion().Converts(tagField, sourceShapeRef.Shape) {
+		// ko.Spec.Tags = fromSDKTags(resp.Tags)
+		out += fmt.Sprintf(
+			"%s\t%s = fromSDKTags(%s)\n", indent, targetVarName, sourceVarName,
+		)
+	} else {
+		out += varEmptyConstructorK8sType(
+			cfg, r, "f0", tagField.ShapeRef.Shape, indentLevel+1,
+		)
+		out += setResourceForContainer(
+			cfg, r,
+			tagField.Names.Camel,
+			"f0",
+			tagField.ShapeRef,
+			nil,
+			sourceVarName,
+			sourceShapeRef,
+			tagField.Names.Camel,
+			model.OpTypeGet,
+			indentLevel+1,
+		)
+		out += setResourceForScalar(
+			targetVarName, "f0", sourceShapeRef, "f0", indentLevel+1,
+		)
+	}
+	out += fmt.Sprintf("%s} else {\n", indent)
+	out += fmt.Sprintf("%s\t%s = nil\n", indent, targetVarName)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// tagOperationInput returns the Go code that constructs the Input shape of
+// the supplied tagging operation in an `input` variable and sets its member
+// identifying the AWS resource and the members with `override_values`.
+func tagOperationInput(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	tagSync *model.TagSync,
+	op *awssdkmodel.Operation,
+	// *ko variable name
+	koVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf(
+		"%sinput := &svcsdk.%s{}\n", indent, op.InputRef.Shape.ShapeName,
+	)
+	accessors, err := fieldPathAccessors(r, koVarName, tagSync.ResourceFieldPath)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"invalid tags.sync.resource_field of resource %s: %v",
+			r.Names.Original, err,
+		))
+	}
+	conditions := []string{}
+	for _, accessor := range accessors {
+		conditions = append(conditions, accessor+" != nil")
+	}
+	value := "*" + accessors[len(accessors)-1]
+	// The fields of the common Status.ACKResourceMetadata field have their
+	// own string types
+	if strings.HasPrefix(tagSync.ResourceFieldPath, "Status.ACKResourceMetadata.") {
+		value = "string(" + value + ")"
+	}
+	out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(conditions, " && "))
+	out += fmt.Sprintf(
+		"%s\tinput.Set%s(%s)\n", indent, tagSync.ResourceMember, value,
+	)
+	out += fmt.Sprintf("%s}\n", indent)
+
+	overrides := overrideValues(cfg, op)
+	memberNames := make([]string, 0, len(overrides))
+	for memberName := range overrides {
+		memberNames = append(memberNames, memberName)
+	}
+	sort.Strings(memberNames)
+	for _, memberName := range memberNames {
+		out += fmt.Sprintf(
+			"%sinput.Set%s(%s)\n", indent, memberName, overrides[memberName],
+		)
+	}
+	return out
+}
+
+// setTagsMember returns the Go code that sets the member of the tag
+// operation's Input shape holding the tags to add from the supplied
+// map[string]string variable. The member is either a map of strings or a
+// list of structs with key and value members.
+func setTagsMember(
+	r *model.CRD,
+	tagSync *model.TagSync,
+	// map[string]string variable name
+	tagsVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	memberShape := tagSync.TagOp.InputRef.Shape.MemberRefs[tagSync.TagsMember].Shape
+	out := ""
+	switch {
+	case memberShape.Type == "map" && memberShape.ValueRef.Shape.Type == "string":
+		out += fmt.Sprintf("%stags := map[string]*string{}\n", indent)
+		out += fmt.Sprintf("%sfor k, v := range %s {\n", indent, tagsVarName)
+		out += fmt.Sprintf("%s\ttags[k] = aws.String(v)\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+	case memberShape.Type == "list" && memberShape.MemberRef.Shape.Type == "structure":
+		elemShape := memberShape.MemberRef.Shape
+		keyMemberName, valueMemberName := r.GetTagStructMemberNames(elemShape)
+		out += fmt.Sprintf(
+			"%stags := []*svcsdk.%s{}\n", indent, elemShape.ShapeName,
+		)
+		out += fmt.Sprintf("%sfor k, v := range %s {\n", indent, tagsVarName)
+		out += fmt.Sprintf(
+			"%s\ttags = append(tags, &svcsdk.%s{%s: aws.String(k), %s: aws.String(v)})\n",
+			indent, elemShape.ShapeName, keyMemberName, valueMemberName,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+	default:
+		panic(fmt.Sprintf(
+			"member %q of tag operation %q of resource %q must be a map of "+
+				"strings or a list of structs",
+			tagSync.TagsMember, tagSync.TagOp.ExportedName, r.Names.Original,
+		))
+	}
+	out += fmt.Sprintf("%sinput.Set%s(tags)\n", indent, tagSync.TagsMember)
+	return out
+}
+
+// tagOperationCall returns the Go code that calls the supplied tagging
+// operation with the `input` variable and returns the error of a failed call.
+func tagOperationCall(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	out := SDKCall(cfg, r, op, "_, err =", "input", indentLevel) + "\n"
+	out += fmt.Sprintf(
+		"%srm.metrics.RecordAPICall(\"UPDATE\", %q, err)\n",
+		indent, op.ExportedName,
+	)
+	out += fmt.Sprintf("%sif err != nil {\n", indent)
+	out += fmt.Sprintf("%s\treturn err\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
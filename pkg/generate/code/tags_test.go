@@ -0,0 +1,276 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestSyncTags_RDS_DBSubnetGroup(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-tag-sync.yaml",
+		},
+	)
+
+	crd := testutil.GetCRDByName(t, g, "DBSubnetGroup")
+	require.NotNil(crd)
+
+	tagSync := crd.GetTagSync()
+	require.NotNil(tagSync)
+	assert.Equal("AddTagsToResource", tagSync.TagOp.ExportedName)
+	assert.Equal("RemoveTagsFromResource", tagSync.UntagOp.ExportedName)
+	assert.Equal("ResourceName", tagSync.ResourceMember)
+	assert.Equal("Spec.Tags", tagSync.FieldPath)
+	require.NotNil(tagSync.ListOp)
+	assert.Equal("ListTagsForResource", tagSync.ListOp.ExportedName)
+	assert.Equal("TagList", tagSync.ListTagsMember)
+
+	expected := `	desiredTags := ToACKTags(desired.ko.Spec.Tags)
+	latestTags := ToACKTags(latest.ko.Spec.Tags)
+	toAdd := map[string]string{}
+	for k, v := range desiredTags {
+		if latestValue, found := latestTags[k]; !found || latestValue != v {
+			toAdd[k] = v
+		}
+	}
+	toRemove := []string{}
+	for k := range latestTags {
+		if _, found := desiredTags[k]; !found {
+			toRemove = append(toRemove, k)
+		}
+	}
+	sort.Strings(toRemove)
+	if len(toRemove) > 0 {
+		input := &svcsdk.RemoveTagsFromResourceInput{}
+		if desired.ko.Status.ACKResourceMetadata != nil && desired.ko.Status.ACKResourceMetadata.ARN != nil {
+			input.SetResourceName(string(*desired.ko.Status.ACKResourceMetadata.ARN))
+		}
+		input.SetTagKeys(aws.StringSlice(toRemove))
+		_, err = rm.sdkapi.RemoveTagsFromResourceWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
+		if err != nil {
+			return err
+		}
+	}
+	if len(toAdd) > 0 {
+		input := &svcsdk.AddTagsToResourceInput{}
+		if desired.ko.Status.ACKResourceMetadata != nil && desired.ko.Status.ACKResourceMetadata.ARN != nil {
+			input.SetResourceName(string(*desired.ko.Status.ACKResourceMetadata.ARN))
+		}
+		tags := []*svcsdk.Tag{}
+		for k, v := range toAdd {
+			tags = append(tags, &svcsdk.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		input.SetTags(tags)
+		_, err = rm.sdkapi.AddTagsToResourceWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
+		if err != nil {
+			return err
+		}
+	}
+`
+	assert.Equal(
+		expected,
+		code.SyncTags(crd.Config(), crd, "desired", "latest", 1),
+	)
+
+	// Tags are not synced without `tags.sync`
+	dbInstance := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(dbInstance)
+	assert.Nil(dbInstance.GetTagSync())
+	assert.Empty(code.SyncTags(dbInstance.Config(), dbInstance, "desired", "latest", 1))
+
+	crd.Config().Resources["DBSubnetGroup"].TagConfig.Sync.TagKeysMember = "Tags"
+	assert.Panics(func() {
+		crd.GetTagSync()
+	})
+}
+
+func TestReadTags_RDS_DBSubnetGroup(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-tag-sync.yaml",
+		},
+	)
+
+	crd := testutil.GetCRDByName(t, g, "DBSubnetGroup")
+	require.NotNil(crd)
+	assert.True(crd.ReadsTags())
+
+	// DescribeDBSubnetGroups does not return the tags of the DBSubnetGroup,
+	// they are read with ListTagsForResource
+	expected := `	input := &svcsdk.ListTagsForResourceInput{}
+	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
+		input.SetResourceName(string(*ko.Status.ACKResourceMetadata.ARN))
+	}
+	var resp *svcsdk.ListTagsForResourceOutput
+	resp, err = rm.sdkapi.ListTagsForResourceWithContext(ctx, input)
+	rm.metrics.RecordAPICall("READ_ONE", "ListTagsForResource", err)
+	if err != nil {
+		return err
+	}
+	if resp.TagList != nil {
+		f0 := []*svcapitypes.Tag{}
+		for _, f0iter := range resp.TagList {
+			f0elem := &svcapitypes.Tag{}
+			if f0iter.Key != nil {
+				f0elem.Key = f0iter.Key
+			}
+			if f0iter.Value != nil {
+				f0elem.Value = f0iter.Value
+			}
+			f0 = append(f0, f0elem)
+		}
+		ko.Spec.Tags = f0
+	} else {
+		ko.Spec.Tags = nil
+	}
+`
+	assert.Equal(expected, code.ReadTags(crd.Config(), crd, "ko", 1))
+
+	// The member holding the tags must exist
+	syncCfg := crd.Config().Resources["DBSubnetGroup"].TagConfig.Sync
+	syncCfg.ListTagsMember = "Tags"
+	assert.Panics(func() {
+		crd.GetTagSync()
+	})
+
+	// Tags that cannot be read are never removed from the AWS resource
+	syncCfg.ListOperation = ""
+	syncCfg.ListTagsMember = ""
+	assert.PanicsWithValue(
+		"tags.sync is configured for DBSubnetGroup but the operation reading "+
+			"the resource does not return its tags, set tags.sync.list_operation",
+		func() {
+			crd.GetTagSync()
+		},
+	)
+}
+
+func TestTagConversion_RDS_DBSubnetGroup(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-tag-representation.yaml",
+		},
+	)
+
+	crd := testutil.GetCRDByName(t, g, "DBSubnetGroup")
+	require.NotNil(crd)
+
+	// The API's list of Tag structs is a map in the Spec
+	tagField := crd.SpecFields["Tags"]
+	require.NotNil(tagField)
+	assert.Equal("map[string]*string", tagField.GoType)
+
+	tagConversion := crd.GetTagConversion()
+	require.NotNil(tagConversion)
+	assert.Equal(tagField, tagConversion.Field)
+	assert.Equal("list", tagConversion.SDKShape.Type)
+	assert.Equal("Key", tagConversion.SDKKeyMemberName)
+	assert.Equal("Value", tagConversion.SDKValueMemberName)
+
+	expected := `	if r.ko.Spec.Tags != nil {
+		res.SetTags(toSDKTags(r.ko.Spec.Tags))
+	}
+`
+	assert.Contains(
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
+		expected,
+	)
+
+	// Without `tags.representation` the tags are not converted
+	dbInstance := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(dbInstance)
+	assert.Nil(dbInstance.GetTagConversion())
+}
+
+func TestTagConversion_Lambda_Function(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-tag-representation.yaml",
+		},
+	)
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+
+	// The API's map of tags is a list of the FunctionTag custom structs in
+	// the Spec
+	tagField := crd.SpecFields["Tags"]
+	require.NotNil(tagField)
+	assert.Equal("[]*FunctionTag", tagField.GoType)
+
+	tagConversion := crd.GetTagConversion()
+	require.NotNil(tagConversion)
+	assert.Equal(tagField, tagConversion.Field)
+	assert.Equal("map", tagConversion.SDKShape.Type)
+
+	expected := `	if r.ko.Spec.Tags != nil {
+		res.SetTags(toSDKTags(r.ko.Spec.Tags))
+	}
+`
+	assert.Contains(
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
+		expected,
+	)
+	expected = `	if resp.Tags != nil {
+		ko.Spec.Tags = fromSDKTags(resp.Tags)
+	} else {
+		ko.Spec.Tags = nil
+	}
+`
+	assert.Contains(
+		code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1),
+		expected,
+	)
+}
+
+func TestTagConversion_Lambda_Function_NoTagStruct(t *testing.T) {
+	assert := assert.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-tag-representation.yaml",
+		},
+	)
+	// Lambda has no Tag struct of its own
+	g.GetConfig().Resources["Function"].TagConfig.StructName = ""
+	assert.PanicsWithValue(
+		"tags.representation of Function is list but the API has no Tag "+
+			"struct, set tags.struct_name or define it in custom_shapes",
+		func() {
+			g.GetCRDs()
+		},
+	)
+}
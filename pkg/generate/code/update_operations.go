@@ -0,0 +1,86 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// UpdateWithOperations returns the Go code that calls the first, in config
+// order, of the additional update operations of a resource, as configured in
+// the resource's `update_operations` generator config, whose fields differ.
+// A single operation is called per reconciliation: the resource is requeued
+// when other fields still differ, and so are the errors of the operation.
+//
+// Sample output:
+//
+//	if delta.DifferentAt("Spec.Version") {
+//		if err := rm.updateWithUpdateClusterVersion(ctx, desired, delta); err != nil {
+//			return ackrequeue.Needed(err)
+//		}
+//		if delta.DifferentExcept("Spec.Version") {
+//			// The other fields that differ are updated in the next
+//			// reconciliations
+//			return ackrequeue.NeededAfter(nil, updateOperationsRequeueAfter)
+//		}
+//		return nil
+//	}
+func UpdateWithOperations(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// *resource variable name
+	resVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	for _, op := range r.GetUpdateOperations() {
+		paths := r.GetUpdateOperationFieldPaths(op.ExportedName)
+		if len(paths) == 0 {
+			panic(fmt.Sprintf(
+				"update operation %q of resource %q has no fields",
+				op.ExportedName, r.Names.Original,
+			))
+		}
+		conditions := make([]string, 0, len(paths))
+		quotedPaths := make([]string, 0, len(paths))
+		for _, path := range paths {
+			conditions = append(conditions, fmt.Sprintf("delta.DifferentAt(%q)", path))
+			quotedPaths = append(quotedPaths, fmt.Sprintf("%q", path))
+		}
+		out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(conditions, " || "))
+		out += fmt.Sprintf(
+			"%s\tif err := rm.updateWith%s(ctx, %s, delta); err != nil {\n",
+			indent, op.ExportedName, resVarName,
+		)
+		out += fmt.Sprintf("%s\t\treturn ackrequeue.Needed(err)\n", indent)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf(
+			"%s\tif delta.DifferentExcept(%s) {\n",
+			indent, strings.Join(quotedPaths, ", "),
+		)
+		out += fmt.Sprintf("%s\t\t// The other fields that differ are updated in the next\n", indent)
+		out += fmt.Sprintf("%s\t\t// reconciliations\n", indent)
+		out += fmt.Sprintf("%s\t\treturn ackrequeue.NeededAfter(nil, updateOperationsRequeueAfter)\n", indent)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf("%s\treturn nil\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
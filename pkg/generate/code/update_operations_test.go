@@ -0,0 +1,102 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestUpdateWithOperations_EKS_Cluster(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-update-operations.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Cluster")
+	require.NotNil(crd)
+	assert.True(crd.HasUpdateOperations())
+	assert.Nil(crd.Ops.Update)
+	assert.Equal(
+		[]string{"Spec.Logging", "Spec.ResourcesVPCConfig", "Spec.Version"},
+		crd.GetUpdateOperationFieldPaths(""),
+	)
+	assert.Equal("UpdateClusterConfig", crd.GetUpdateOperationOfField("ResourcesVPCConfig"))
+	assert.Equal("", crd.GetUpdateOperationOfField("Name"))
+
+	// The operations are called in config order, one per reconciliation
+	expected := `	if delta.DifferentAt("Spec.Version") {
+		if err := rm.updateWithUpdateClusterVersion(ctx, desired, delta); err != nil {
+			return ackrequeue.Needed(err)
+		}
+		if delta.DifferentExcept("Spec.Version") {
+			// The other fields that differ are updated in the next
+			// reconciliations
+			return ackrequeue.NeededAfter(nil, updateOperationsRequeueAfter)
+		}
+		return nil
+	}
+	if delta.DifferentAt("Spec.Logging") || delta.DifferentAt("Spec.ResourcesVPCConfig") {
+		if err := rm.updateWithUpdateClusterConfig(ctx, desired, delta); err != nil {
+			return ackrequeue.Needed(err)
+		}
+		if delta.DifferentExcept("Spec.Logging", "Spec.ResourcesVPCConfig") {
+			// The other fields that differ are updated in the next
+			// reconciliations
+			return ackrequeue.NeededAfter(nil, updateOperationsRequeueAfter)
+		}
+		return nil
+	}
+`
+	assert.Equal(expected, code.UpdateWithOperations(crd.Config(), crd, "desired", 1))
+}
+
+func TestSetSDKForOperation_EKS_Cluster_UpdateClusterVersion(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-update-operations.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Cluster")
+	require.NotNil(crd)
+
+	ops := crd.GetUpdateOperations()
+	require.Len(ops, 2)
+	assert.Equal("UpdateClusterVersion", ops[0].ExportedName)
+
+	// Only the changed fields assigned to the operation are set, the other
+	// members identify the resource
+	expected := `
+	if r.ko.Spec.Name != nil {
+		res.SetName(*r.ko.Spec.Name)
+	}
+	if delta.DifferentAt("Spec.Version") {
+		if r.ko.Spec.Version != nil {
+			res.SetVersion(*r.ko.Spec.Version)
+		}
+	}
+`
+	assert.Equal(expected, code.SetSDKForOperation(crd.Config(), crd, ops[0], "r.ko", "res", 1))
+}
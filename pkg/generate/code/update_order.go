@@ -0,0 +1,86 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// DeferDependentUpdates returns the Go code that resets, in the target
+// resource, the Spec fields configured with `update_after` to their latest
+// value when any of the fields they depend on differ, and records the paths
+// of those deferred fields.
+//
+// Sample output:
+//
+//	if delta.DifferentAt("Spec.ScalingPolicy") && (delta.DifferentAt("Spec.Capacity")) {
+//		ko.Spec.ScalingPolicy = latest.ko.Spec.ScalingPolicy
+//		deferred = append(deferred, "Spec.ScalingPolicy")
+//	}
+func DeferDependentUpdates(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta` holding the differences between the desired and
+	// latest resources
+	deltaVarName string,
+	// String representing the name of the variable that represents the latest
+	// CR. This will typically be "latest.ko"
+	latestVarName string,
+	// String representing the name of the variable that represents the CR
+	// whose fields are reset. This will typically be "ko"
+	targetVarName string,
+	// String representing the name of the `[]string` variable the deferred
+	// field paths are appended to
+	deferredVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	specPrefix := strings.TrimPrefix(cfg.PrefixConfig.SpecField, ".")
+	for _, f := range r.DeferredUpdateFields() {
+		fieldPath := specPrefix + "." + f.Names.Camel
+		deps := []string{}
+		for _, dep := range f.FieldConfig.UpdateAfter {
+			depPath := specPrefix + "." + r.SpecFields[dep].Names.Camel
+			deps = append(deps, fmt.Sprintf(
+				"%s.DifferentAt(%q)", deltaVarName, depPath,
+			))
+		}
+		// if delta.DifferentAt("Spec.ScalingPolicy") && (delta.DifferentAt("Spec.Capacity")) {
+		out += fmt.Sprintf(
+			"%sif %s.DifferentAt(%q) && (%s) {\n",
+			indent, deltaVarName, fieldPath, strings.Join(deps, " || "),
+		)
+		//	ko.Spec.ScalingPolicy = latest.ko.Spec.ScalingPolicy
+		out += fmt.Sprintf(
+			"%s\t%s%s.%s = %s%s.%s\n",
+			indent,
+			targetVarName, cfg.PrefixConfig.SpecField, f.Names.Camel,
+			latestVarName, cfg.PrefixConfig.SpecField, f.Names.Camel,
+		)
+		//	deferred = append(deferred, "Spec.ScalingPolicy")
+		out += fmt.Sprintf(
+			"%s\t%s = append(%s, %q)\n",
+			indent, deferredVarName, deferredVarName, fieldPath,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
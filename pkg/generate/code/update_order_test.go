@@ -0,0 +1,52 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestDeferDependentUpdates_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-update-after.yaml",
+		})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.True(crd.HasDeferredUpdateFields())
+
+	expected := `	if delta.DifferentAt("Spec.ImageScanningConfiguration") && (delta.DifferentAt("Spec.ImageTagMutability")) {
+		ko.Spec.ImageScanningConfiguration = latest.ko.Spec.ImageScanningConfiguration
+		deferred = append(deferred, "Spec.ImageScanningConfiguration")
+	}
+	if delta.DifferentAt("Spec.Tags") && (delta.DifferentAt("Spec.ImageScanningConfiguration")) {
+		ko.Spec.Tags = latest.ko.Spec.Tags
+		deferred = append(deferred, "Spec.Tags")
+	}
+`
+	assert.Equal(
+		expected,
+		code.DeferDependentUpdates(crd.Config(), crd, "delta", "latest.ko", "ko", "deferred", 1),
+	)
+}
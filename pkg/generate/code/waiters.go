@@ -0,0 +1,92 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// WaitUntil returns the Go code that calls the aws-sdk-go waiter configured
+// for the supplied operation type, with the Input shape of the resource's
+// ReadOne operation, bounded by the waiter's timeout. The waiter's error is
+// only recorded: a resource that has not reached the expected state yet is
+// left to its synced condition, and a deletion under way to complete. An
+// empty string is returned if no waiter is configured for the operation
+// type.
+//
+//	Sample output:
+//
+//	// The resource not reaching the expected state before the timeout is not
+//	// an error, its synced condition carries the rest of the wait
+//	if waitInput, err := rm.newDescribeRequestPayload(created); err == nil {
+//		waitCtx, waitCancel := context.WithTimeout(ctx, 600*time.Second)
+//		err = rm.sdkapi.WaitUntilTableExistsWithContext(waitCtx, waitInput)
+//		waitCancel()
+//		rm.metrics.RecordAPICall("READ_ONE", "WaitUntilTableExists", err)
+//	}
+func WaitUntil(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The type of the operation after which the waiter is called
+	opType model.OpType,
+	// String representing the name of the variable holding the resource,
+	// e.g. "created"
+	resVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	waiter := r.Waiter(opType)
+	if waiter == nil {
+		return ""
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	waiterMethod := "WaitUntil" + waiter.Name
+	out := ""
+	if opType == model.OpTypeDelete {
+		out += fmt.Sprintf(
+			"%s// The resource not being gone before the timeout is not an error,\n"+
+				"%s// its deletion is under way\n",
+			indent, indent,
+		)
+	} else {
+		out += fmt.Sprintf(
+			"%s// The resource not reaching the expected state before the timeout is not\n"+
+				"%s// an error, its synced condition carries the rest of the wait\n",
+			indent, indent,
+		)
+	}
+	out += fmt.Sprintf(
+		"%sif waitInput, err := rm.newDescribeRequestPayload(%s); err == nil {\n",
+		indent, resVarName,
+	)
+	out += fmt.Sprintf(
+		"%s\twaitCtx, waitCancel := context.WithTimeout(ctx, %d*time.Second)\n",
+		indent, waiter.TimeoutSeconds,
+	)
+	out += fmt.Sprintf(
+		"%s\terr = rm.sdkapi.%sWithContext(waitCtx, waitInput)\n",
+		indent, waiterMethod,
+	)
+	out += fmt.Sprintf("%s\twaitCancel()\n", indent)
+	out += fmt.Sprintf(
+		"%s\trm.metrics.RecordAPICall(\"READ_ONE\", %q, err)\n",
+		indent, waiterMethod,
+	)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
@@ -0,0 +1,91 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	 http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestWaitUntil_DynamoDB_Table(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "dynamodb", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-waiters.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Table")
+	require.NotNil(crd)
+
+	expected := `	// The resource not reaching the expected state before the timeout is not
+	// an error, its synced condition carries the rest of the wait
+	if waitInput, err := rm.newDescribeRequestPayload(created); err == nil {
+		waitCtx, waitCancel := context.WithTimeout(ctx, 600*time.Second)
+		err = rm.sdkapi.WaitUntilTableExistsWithContext(waitCtx, waitInput)
+		waitCancel()
+		rm.metrics.RecordAPICall("READ_ONE", "WaitUntilTableExists", err)
+	}
+`
+	assert.Equal(expected, code.WaitUntil(crd.Config(), crd, model.OpTypeCreate, "created", 1))
+
+	// The timeout defaults to 30 seconds
+	expected = `	// The resource not being gone before the timeout is not an error,
+	// its deletion is under way
+	if waitInput, err := rm.newDescribeRequestPayload(r); err == nil {
+		waitCtx, waitCancel := context.WithTimeout(ctx, 30*time.Second)
+		err = rm.sdkapi.WaitUntilTableNotExistsWithContext(waitCtx, waitInput)
+		waitCancel()
+		rm.metrics.RecordAPICall("READ_ONE", "WaitUntilTableNotExists", err)
+	}
+`
+	assert.Equal(expected, code.WaitUntil(crd.Config(), crd, model.OpTypeDelete, "r", 1))
+}
+
+func TestWaitUntil_UnknownWaiter(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "dynamodb", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-waiters.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Table")
+	require.NotNil(crd)
+
+	crd.Config().Resources["Table"].Waiters.Update.Name = "TableUpdated"
+	assert.PanicsWithValue(
+		"waiter TableUpdated of Table is not a waiter of the dynamodb API",
+		func() { code.WaitUntil(crd.Config(), crd, model.OpTypeUpdate, "updated", 1) },
+	)
+}
+
+func TestWaitUntil_NotConfigured(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "dynamodb")
+
+	crd := testutil.GetCRDByName(t, g, "Table")
+	require.NotNil(crd)
+
+	assert.False(crd.HasWaiters())
+	assert.Equal("", code.WaitUntil(crd.Config(), crd, model.OpTypeCreate, "created", 1))
+}
@@ -57,6 +57,9 @@ type TemplateSet struct {
 	templates       map[string]templateWithVars
 	funcMap         ttpl.FuncMap
 	executed        map[string]*bytes.Buffer
+	// sources is a map, keyed by the template or copy file path, of the
+	// template, include and copy file paths used to produce that output
+	sources map[string][]string
 }
 
 // New returns a pointer to a TemplateSet
@@ -73,6 +76,7 @@ func New(
 		funcMap:         funcMap,
 		templates:       map[string]templateWithVars{},
 		executed:        map[string]*bytes.Buffer{},
+		sources:         map[string][]string{},
 	}
 }
 
@@ -105,16 +109,20 @@ func (ts *TemplateSet) Add(
 	if err != nil {
 		return err
 	}
-	if err = ts.joinIncludes(t); err != nil {
+	includes, err := ts.joinIncludes(t)
+	if err != nil {
 		return err
 	}
 	ts.templates[outPath] = templateWithVars{t, vars}
+	ts.sources[outPath] = append([]string{foundPath}, includes...)
 	return nil
 }
 
-// joinIncludes adds all include templates to the supplied template
-func (ts *TemplateSet) joinIncludes(t *ttpl.Template) error {
+// joinIncludes adds all include templates to the supplied template and
+// returns the paths of the included templates
+func (ts *TemplateSet) joinIncludes(t *ttpl.Template) ([]string, error) {
 	var err error
+	included := []string{}
 	for _, basePath := range ts.baseSearchPaths {
 		for _, includePath := range ts.includePaths {
 			tplPath := filepath.Join(basePath, includePath)
@@ -122,11 +130,12 @@ func (ts *TemplateSet) joinIncludes(t *ttpl.Template) error {
 				continue
 			}
 			if t, err = includeTemplate(t, tplPath); err != nil {
-				return err
+				return nil, err
 			}
+			included = append(included, tplPath)
 		}
 	}
-	return nil
+	return included, nil
 }
 
 // Execute runs all of the template and copy files in our TemplateSet and
@@ -152,6 +161,7 @@ func (ts *TemplateSet) Execute() error {
 				return err
 			}
 			ts.executed[path] = b
+			ts.sources[path] = []string{copyPath}
 		}
 	}
 	return nil
@@ -163,6 +173,12 @@ func (ts *TemplateSet) Executed() map[string]*bytes.Buffer {
 	return ts.executed
 }
 
+// Sources returns a map, keyed by the template or copy file path, of the
+// template, include and copy file paths that were used to produce each output
+func (ts *TemplateSet) Sources() map[string][]string {
+	return ts.sources
+}
+
 func byteBufferFromFile(path string) (*bytes.Buffer, error) {
 	f, err := os.Open(path)
 	if err != nil {
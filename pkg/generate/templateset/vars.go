@@ -47,4 +47,10 @@ type MetaVars struct {
 	ClientStructTypeName string
 	//CRDNames contains all crds names lowercased and in plural
 	CRDNames []string
+	// NamespacedCRDNames contains the names, lowercased and in plural, of the
+	// namespaced crds
+	NamespacedCRDNames []string
+	// ClusterScopedCRDNames contains the names, lowercased and in plural, of
+	// the cluster-scoped crds
+	ClusterScopedCRDNames []string
 }
@@ -0,0 +1,172 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package templateset
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	ackutil "github.com/aws-controllers-k8s/code-generator/pkg/util"
+	"github.com/aws-controllers-k8s/code-generator/pkg/version"
+)
+
+const (
+	// VendoredTemplatesDir is the directory, relative to the service
+	// controller's repository, where a snapshot of the templates used to
+	// generate the controller is stored. It is distinct from the `templates`
+	// directory holding the controller's hook templates.
+	VendoredTemplatesDir = "ack-templates"
+	// VendoredManifestFileName is the name of the file, in the vendored
+	// templates directory, recording where the snapshot was taken from
+	VendoredManifestFileName = "manifest.yaml"
+	// ControllerTemplatesDir is the directory, relative to the service
+	// controller's repository, holding the controller's own hook templates.
+	// It is never vendored.
+	ControllerTemplatesDir = "templates"
+)
+
+// VendoredTemplatesPath returns the path of the directory the snapshot of
+// templates is stored in for the supplied service controller repository path.
+func VendoredTemplatesPath(controllerRepoPath string) string {
+	return filepath.Join(controllerRepoPath, VendoredTemplatesDir)
+}
+
+// SplitControllerTemplateDirs splits the supplied template directories, in
+// search order, into the ones that are, or are inside, the service controller
+// repository's own templates directory and the others.
+func SplitControllerTemplateDirs(
+	controllerRepoPath string,
+	dirs []string,
+) (controllerDirs []string, baseDirs []string, err error) {
+	controllerTemplatesPath, err := filepath.Abs(
+		filepath.Join(controllerRepoPath, ControllerTemplatesDir),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, dir := range dirs {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, nil, err
+		}
+		relPath, err := filepath.Rel(controllerTemplatesPath, absDir)
+		if err == nil && relPath != ".." &&
+			!strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			controllerDirs = append(controllerDirs, dir)
+		} else {
+			baseDirs = append(baseDirs, dir)
+		}
+	}
+	return controllerDirs, baseDirs, nil
+}
+
+// VendoredManifest records the origin of a snapshot of templates and the
+// order in which its directories are searched for templates.
+type VendoredManifest struct {
+	// Version of the ack-generate binary that took the snapshot
+	ACKGenerateVersion string `json:"ack_generate_version"`
+	// The template directories the snapshot was taken from, in search order
+	SourceDirs []string `json:"source_dirs"`
+	// The directories of the snapshot, relative to the vendored templates
+	// directory, in search order. There is one directory per source
+	// directory.
+	SearchPaths []string `json:"search_paths"`
+}
+
+// Vendor replaces the contents of the supplied vendor directory with a copy
+// of the files found in the supplied base search paths. Each base search path
+// is copied into its own numbered directory, so that generating from the
+// snapshot resolves templates, includes and copied files exactly as
+// generating from the base search paths does, and a manifest records the
+// origin of the snapshot.
+func Vendor(baseSearchPaths []string, vendorDir string) (*VendoredManifest, error) {
+	if len(baseSearchPaths) == 0 {
+		return nil, fmt.Errorf("no template directories to vendor")
+	}
+	if err := os.RemoveAll(vendorDir); err != nil {
+		return nil, err
+	}
+	manifest := &VendoredManifest{
+		ACKGenerateVersion: version.Version,
+	}
+	for index, basePath := range baseSearchPaths {
+		searchPath := strconv.Itoa(index)
+		if err := copyDir(basePath, filepath.Join(vendorDir, searchPath)); err != nil {
+			return nil, err
+		}
+		manifest.SourceDirs = append(manifest.SourceDirs, basePath)
+		manifest.SearchPaths = append(manifest.SearchPaths, searchPath)
+	}
+	b, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	manifestPath := filepath.Join(vendorDir, VendoredManifestFileName)
+	if err = ioutil.WriteFile(manifestPath, b, 0666); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// VendoredSearchPaths returns the base search paths, in search order, of the
+// snapshot of templates in the supplied vendor directory.
+func VendoredSearchPaths(vendorDir string) ([]string, error) {
+	manifestPath := filepath.Join(vendorDir, VendoredManifestFileName)
+	b, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot read vendored templates manifest: %w", err,
+		)
+	}
+	manifest := &VendoredManifest{}
+	if err = yaml.Unmarshal(b, manifest); err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(manifest.SearchPaths))
+	for _, searchPath := range manifest.SearchPaths {
+		path := filepath.Join(vendorDir, searchPath)
+		if !ackutil.FileExists(path) {
+			return nil, fmt.Errorf(
+				"vendored templates directory %s not found", path,
+			)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// copyDir recursively copies the files of the source directory into the
+// destination directory
+func copyDir(srcDir string, destDir string) error {
+	return filepath.Walk(srcDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		destPath := filepath.Join(destDir, relPath)
+		if info.IsDir() {
+			return os.MkdirAll(destPath, 0755)
+		}
+		return ackutil.CopyFile(path, destPath)
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package templateset_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
+)
+
+// writeFile writes the supplied contents to the file at the supplied path,
+// creating its parent directories
+func writeFile(t *testing.T, path string, contents string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+}
+
+func TestVendor(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	srcDir := t.TempDir()
+	overrideDir := filepath.Join(srcDir, "override")
+	baseDir := filepath.Join(srcDir, "base")
+	writeFile(t, filepath.Join(overrideDir, "pkg", "resource", "sdk.go.tpl"), "override")
+	writeFile(t, filepath.Join(baseDir, "pkg", "resource", "sdk.go.tpl"), "base")
+	writeFile(t, filepath.Join(baseDir, "boilerplate.txt"), "boilerplate")
+
+	vendorDir := templateset.VendoredTemplatesPath(t.TempDir())
+	// A previous snapshot is replaced
+	writeFile(t, filepath.Join(vendorDir, "stale.tpl"), "stale")
+
+	manifest, err := templateset.Vendor([]string{overrideDir, baseDir}, vendorDir)
+	require.NoError(err)
+	assert.Equal([]string{overrideDir, baseDir}, manifest.SourceDirs)
+	assert.Equal([]string{"0", "1"}, manifest.SearchPaths)
+	assert.NoFileExists(filepath.Join(vendorDir, "stale.tpl"))
+
+	searchPaths, err := templateset.VendoredSearchPaths(vendorDir)
+	require.NoError(err)
+	require.Equal([]string{
+		filepath.Join(vendorDir, "0"),
+		filepath.Join(vendorDir, "1"),
+	}, searchPaths)
+
+	// The search order of the snapshot is the one of the source directories
+	got, err := os.ReadFile(filepath.Join(searchPaths[0], "pkg", "resource", "sdk.go.tpl"))
+	require.NoError(err)
+	assert.Equal("override", string(got))
+	got, err = os.ReadFile(filepath.Join(searchPaths[1], "boilerplate.txt"))
+	require.NoError(err)
+	assert.Equal("boilerplate", string(got))
+
+	_, err = templateset.Vendor(nil, vendorDir)
+	assert.Error(err)
+}
+
+func TestVendoredSearchPaths_Missing(t *testing.T) {
+	assert := assert.New(t)
+
+	vendorDir := t.TempDir()
+	_, err := templateset.VendoredSearchPaths(vendorDir)
+	assert.Error(err)
+
+	// The manifest refers to a directory that is not in the snapshot
+	writeFile(t, filepath.Join(vendorDir, templateset.VendoredManifestFileName), "search_paths:\n- \"0\"\n")
+	_, err = templateset.VendoredSearchPaths(vendorDir)
+	assert.Error(err)
+}
+
+func TestSplitControllerTemplateDirs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	repoPath := t.TempDir()
+	hooksDir := filepath.Join(repoPath, "templates")
+	nestedHooksDir := filepath.Join(repoPath, "templates", "hooks")
+	baseDir := filepath.Join(t.TempDir(), "templates")
+	// A sibling directory sharing the prefix of the templates directory
+	siblingDir := filepath.Join(repoPath, "templates-extra")
+
+	controllerDirs, baseDirs, err := templateset.SplitControllerTemplateDirs(
+		repoPath, []string{hooksDir, baseDir, nestedHooksDir, siblingDir},
+	)
+	require.NoError(err)
+	assert.Equal([]string{hooksDir, nestedHooksDir}, controllerDirs)
+	assert.Equal([]string{baseDir, siblingDir}, baseDirs)
+}
@@ -0,0 +1,289 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package metadata
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/version"
+)
+
+const (
+	// GenerationLockFileName is the name of the file, written at the root of
+	// a controller's output directory, recording the inputs used to generate
+	// each output file
+	GenerationLockFileName = "generation.lock"
+
+	// GenerationLockVersion is the version of the generation lock format.
+	// It must be incremented whenever a field is removed or the meaning of
+	// an existing field changes. Adding new optional fields does not require
+	// a version bump.
+	GenerationLockVersion = 1
+)
+
+// GenerationLock records the generator version, SDK model checksum, generator
+// config checksum and, for every output file, the checksums of the templates
+// used to produce it along with the checksum of the output itself.
+type GenerationLock struct {
+	// Version of the generation lock format
+	LockVersion int `json:"lock_version"`
+	// Information about the ack-generate binary used to generate the outputs
+	ACKGenerateInfo ackGenerateInfo `json:"ack_generate_info"`
+	// AWS SDK Go version used to generate the outputs
+	AWSSDKGoVersion string `json:"aws_sdk_go_version"`
+	// The checksum of the aws-sdk-go api-2.json model file
+	SDKModelChecksum string `json:"sdk_model_checksum"`
+	// Information about the generator config file used to generate the outputs
+	GeneratorConfigInfo generatorConfigInfo `json:"generator_config_info"`
+	// Map, keyed by output file path relative to the output directory, of
+	// information about how the file was generated
+	Files map[string]GenerationLockFile `json:"files"`
+}
+
+// GenerationLockFile records the inputs and output checksum for a single
+// generated file
+type GenerationLockFile struct {
+	// Map, keyed by template path relative to the template base path
+	// containing it, of the checksums of the templates (and included
+	// templates or copied files) used to produce the file
+	TemplateChecksums map[string]string `json:"template_checksums"`
+	// The checksum of the generated file contents
+	OutputChecksum string `json:"output_checksum"`
+}
+
+// NewGenerationLock returns a GenerationLock describing the supplied outputs.
+// The outputs and sources maps are both keyed by output file path relative to
+// the output directory, and contain respectively the generated contents and
+// the paths to the templates used to produce those contents. The templates
+// are recorded by their path relative to the template base path containing
+// them so that the lock does not depend on where the templates are checked
+// out.
+func NewGenerationLock(
+	awsSDKGo string,
+	sdkModelPath string,
+	generatorFileName string,
+	templateBasePaths []string,
+	outputs map[string][]byte,
+	sources map[string][]string,
+) (*GenerationLock, error) {
+	sdkModelHash, err := hashFile(sdkModelPath)
+	if err != nil {
+		return nil, err
+	}
+	cfgInfo := generatorConfigInfo{}
+	if generatorFileName != "" {
+		generatorFileHash, err := hashFile(generatorFileName)
+		if err != nil {
+			return nil, err
+		}
+		cfgInfo.OriginalFileName = filepath.Base(generatorFileName)
+		cfgInfo.FileChecksum = generatorFileHash
+	}
+
+	// Templates are typically shared between many outputs so we only hash
+	// each of them once
+	templateHashes := map[string]string{}
+	files := make(map[string]GenerationLockFile, len(outputs))
+	for path, contents := range outputs {
+		tplChecksums := map[string]string{}
+		for _, tplPath := range sources[path] {
+			hash, found := templateHashes[tplPath]
+			if !found {
+				if hash, err = hashFile(tplPath); err != nil {
+					return nil, err
+				}
+				templateHashes[tplPath] = hash
+			}
+			tplChecksums[relativeTemplatePath(templateBasePaths, tplPath)] = hash
+		}
+		files[path] = GenerationLockFile{
+			TemplateChecksums: tplChecksums,
+			OutputChecksum:    hashBytes(contents),
+		}
+	}
+
+	return &GenerationLock{
+		LockVersion: GenerationLockVersion,
+		ACKGenerateInfo: ackGenerateInfo{
+			Version:   version.Version,
+			BuildDate: version.BuildDate,
+			BuildHash: version.BuildHash,
+			GoVersion: runtime.Version(),
+		},
+		AWSSDKGoVersion:     awsSDKGo,
+		SDKModelChecksum:    sdkModelHash,
+		GeneratorConfigInfo: cfgInfo,
+		Files:               files,
+	}, nil
+}
+
+// LoadGenerationLock reads the generation lock file found in the supplied
+// output directory. An error is returned if the lock file was written with a
+// newer lock format than the one supported by this generator.
+func LoadGenerationLock(outputPath string) (*GenerationLock, error) {
+	data, err := ioutil.ReadFile(filepath.Join(outputPath, GenerationLockFileName))
+	if err != nil {
+		return nil, err
+	}
+	lock := &GenerationLock{}
+	if err = yaml.Unmarshal(data, lock); err != nil {
+		return nil, err
+	}
+	if lock.LockVersion > GenerationLockVersion {
+		return nil, fmt.Errorf(
+			"generation lock version %d is newer than the supported version %d",
+			lock.LockVersion, GenerationLockVersion,
+		)
+	}
+	return lock, nil
+}
+
+// Write saves the generation lock in the supplied output directory
+func (l *GenerationLock) Write(outputPath string) error {
+	data, err := yaml.Marshal(l)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(
+		filepath.Join(outputPath, GenerationLockFileName),
+		data,
+		os.ModePerm,
+	)
+}
+
+// Verify compares the generation lock against one computed from the current
+// inputs and outputs and returns a sorted list of human-readable
+// descriptions of every mismatch. An empty list means the recorded outputs
+// were produced by the same inputs.
+func (l *GenerationLock) Verify(current *GenerationLock) []string {
+	mismatches := []string{}
+	if l.ACKGenerateInfo.Version != current.ACKGenerateInfo.Version {
+		mismatches = append(mismatches, fmt.Sprintf(
+			"generator version: locked %q, current %q",
+			l.ACKGenerateInfo.Version, current.ACKGenerateInfo.Version,
+		))
+	}
+	if l.SDKModelChecksum != current.SDKModelChecksum {
+		mismatches = append(mismatches, "SDK model checksum differs")
+	}
+	if l.GeneratorConfigInfo.FileChecksum != current.GeneratorConfigInfo.FileChecksum {
+		mismatches = append(mismatches, "generator config checksum differs")
+	}
+
+	fileMismatches := []string{}
+	for path, locked := range l.Files {
+		cur, found := current.Files[path]
+		if !found {
+			fileMismatches = append(fileMismatches, fmt.Sprintf(
+				"%s: no longer generated", path,
+			))
+			continue
+		}
+		if locked.OutputChecksum != cur.OutputChecksum {
+			fileMismatches = append(fileMismatches, fmt.Sprintf(
+				"%s: output checksum differs", path,
+			))
+		}
+		for tplPath, hash := range locked.TemplateChecksums {
+			if curHash, found := cur.TemplateChecksums[tplPath]; !found {
+				fileMismatches = append(fileMismatches, fmt.Sprintf(
+					"%s: template %s no longer used", path, tplPath,
+				))
+			} else if curHash != hash {
+				fileMismatches = append(fileMismatches, fmt.Sprintf(
+					"%s: template %s checksum differs", path, tplPath,
+				))
+			}
+		}
+		for tplPath := range cur.TemplateChecksums {
+			if _, found := locked.TemplateChecksums[tplPath]; !found {
+				fileMismatches = append(fileMismatches, fmt.Sprintf(
+					"%s: template %s not in lock", path, tplPath,
+				))
+			}
+		}
+	}
+	for path := range current.Files {
+		if _, found := l.Files[path]; !found {
+			fileMismatches = append(fileMismatches, fmt.Sprintf(
+				"%s: not in lock", path,
+			))
+		}
+	}
+	sort.Strings(fileMismatches)
+	return append(mismatches, fileMismatches...)
+}
+
+// VerifyOutputs compares the output checksums recorded in the generation lock
+// with the files found in the supplied output directory and returns a sorted
+// list of the files that are missing or were modified after generation.
+func (l *GenerationLock) VerifyOutputs(outputPath string) ([]string, error) {
+	mismatches := []string{}
+	for path, locked := range l.Files {
+		hash, err := hashFile(filepath.Join(outputPath, path))
+		if os.IsNotExist(err) {
+			mismatches = append(mismatches, fmt.Sprintf("%s: missing", path))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hash != locked.OutputChecksum {
+			mismatches = append(mismatches, fmt.Sprintf(
+				"%s: modified after generation", path,
+			))
+		}
+	}
+	sort.Strings(mismatches)
+	return mismatches, nil
+}
+
+// relativeTemplatePath returns the supplied template path relative to the
+// first of the supplied template base paths containing it, or the template
+// path itself if none does.
+func relativeTemplatePath(templateBasePaths []string, tplPath string) string {
+	absTplPath, err := filepath.Abs(tplPath)
+	if err != nil {
+		return filepath.ToSlash(tplPath)
+	}
+	for _, basePath := range templateBasePaths {
+		absBasePath, err := filepath.Abs(basePath)
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(absBasePath, absTplPath)
+		if err != nil || rel == ".." ||
+			strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		return filepath.ToSlash(rel)
+	}
+	return filepath.ToSlash(tplPath)
+}
+
+// hashBytes returns the sha1 hash of the supplied bytes
+func hashBytes(b []byte) string {
+	h := sha1.Sum(b)
+	return hex.EncodeToString(h[:])
+}
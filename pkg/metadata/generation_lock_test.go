@@ -0,0 +1,146 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package metadata_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ackmetadata "github.com/aws-controllers-k8s/code-generator/pkg/metadata"
+)
+
+// writeFile writes the supplied contents to the supplied path, creating its
+// parent directories
+func writeFile(t *testing.T, path string, contents string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+}
+
+// newTestGenerationLock returns the generation lock of a single output file
+// generated from a template found in a templates directory created under
+// the supplied root directory
+func newTestGenerationLock(
+	t *testing.T,
+	root string,
+	tplContents string,
+	output string,
+) *ackmetadata.GenerationLock {
+	t.Helper()
+	tplDir := filepath.Join(root, "templates")
+	tplPath := filepath.Join(tplDir, "pkg", "resource", "sdk.go.tpl")
+	writeFile(t, tplPath, tplContents)
+	modelPath := filepath.Join(root, "api-2.json")
+	writeFile(t, modelPath, "{}")
+	cfgPath := filepath.Join(root, "generator.yaml")
+	writeFile(t, cfgPath, "resources: {}")
+
+	lock, err := ackmetadata.NewGenerationLock(
+		"v1.44.0", modelPath, cfgPath, []string{tplDir},
+		map[string][]byte{"pkg/resource/repository/sdk.go": []byte(output)},
+		map[string][]string{"pkg/resource/repository/sdk.go": {tplPath}},
+	)
+	require.NoError(t, err)
+	return lock
+}
+
+func TestGenerationLock_TemplatePathsRelativeToTemplateDirs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	first := newTestGenerationLock(t, t.TempDir(), "tpl", "out")
+	second := newTestGenerationLock(t, t.TempDir(), "tpl", "out")
+
+	require.Contains(first.Files, "pkg/resource/repository/sdk.go")
+	assert.Equal(
+		[]string{"pkg/resource/sdk.go.tpl"},
+		keys(first.Files["pkg/resource/repository/sdk.go"].TemplateChecksums),
+	)
+	// The same inputs checked out in different directories produce the same
+	// lock
+	assert.Equal(first.Files, second.Files)
+	assert.Empty(first.Verify(second))
+}
+
+func TestGenerationLock_Verify(t *testing.T) {
+	assert := assert.New(t)
+
+	locked := newTestGenerationLock(t, t.TempDir(), "tpl", "out")
+	current := newTestGenerationLock(t, t.TempDir(), "changed tpl", "changed out")
+
+	assert.Equal(
+		[]string{
+			"pkg/resource/repository/sdk.go: output checksum differs",
+			"pkg/resource/repository/sdk.go: template pkg/resource/sdk.go.tpl checksum differs",
+		},
+		locked.Verify(current),
+	)
+}
+
+func TestGenerationLock_WriteLoadAndVerifyOutputs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	root := t.TempDir()
+	outDir := t.TempDir()
+	lock := newTestGenerationLock(t, root, "tpl", "out")
+	require.NoError(lock.Write(outDir))
+
+	loaded, err := ackmetadata.LoadGenerationLock(outDir)
+	require.NoError(err)
+	assert.Equal(lock.Files, loaded.Files)
+
+	mismatches, err := loaded.VerifyOutputs(outDir)
+	require.NoError(err)
+	assert.Equal(
+		[]string{"pkg/resource/repository/sdk.go: missing"}, mismatches,
+	)
+
+	outPath := filepath.Join(outDir, "pkg", "resource", "repository", "sdk.go")
+	writeFile(t, outPath, "out")
+	mismatches, err = loaded.VerifyOutputs(outDir)
+	require.NoError(err)
+	assert.Empty(mismatches)
+
+	writeFile(t, outPath, "edited")
+	mismatches, err = loaded.VerifyOutputs(outDir)
+	require.NoError(err)
+	assert.Equal(
+		[]string{"pkg/resource/repository/sdk.go: modified after generation"},
+		mismatches,
+	)
+}
+
+func TestLoadGenerationLock_NewerVersion(t *testing.T) {
+	outDir := t.TempDir()
+	writeFile(
+		t, filepath.Join(outDir, ackmetadata.GenerationLockFileName),
+		"lock_version: 1000\n",
+	)
+	_, err := ackmetadata.LoadGenerationLock(outDir)
+	assert.Error(t, err)
+}
+
+// keys returns the keys of the supplied map
+func keys(m map[string]string) []string {
+	res := []string{}
+	for k := range m {
+		res = append(res, k)
+	}
+	return res
+}
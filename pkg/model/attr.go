@@ -25,6 +25,14 @@ type Attr struct {
 	GoType string
 	Shape  *awssdkmodel.Shape
 	GoTag  string
+	// IsRequired is true when the attribute is a list map key of a list
+	// field, which Kubernetes requires to be set, or a required member of its
+	// shape, see `infer_nested_required_fields`
+	IsRequired bool
+	// Markers are the kubebuilder markers of the attribute, such as the
+	// server-side apply `+listType` marker or the markers configured with
+	// `markers`
+	Markers []string
 }
 
 func NewAttr(
@@ -48,3 +56,9 @@ func (a *Attr) GetGoTag() string {
 	}
 	return fmt.Sprintf("`json:\"%s,omitempty\"`", a.Names.CamelLower)
 }
+
+// IsJSONValue returns true if the attribute is backed by an aws.JSONValue
+// member of the AWS API and is therefore a runtime.RawExtension.
+func (a *Attr) IsJSONValue() bool {
+	return a.GoType == "*runtime.RawExtension"
+}
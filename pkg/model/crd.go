@@ -16,6 +16,7 @@ package model
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -91,6 +92,11 @@ type CRD struct {
 	// ShortNames represent the CRD list of aliases. Short names allow shorter
 	// strings to match a CR on the CLI.
 	ShortNames []string
+	// Categories are the groups of resources the CRD belongs to, e.g. `aws`
+	Categories []string
+	// tagSDKShape is the shape of the tags in the API when the tag field has
+	// another representation, see `tags.representation`
+	tagSDKShape *awssdkmodel.Shape
 }
 
 // Config returns a pointer to the generator config
@@ -122,6 +128,12 @@ func (r *CRD) SDKAPIPackageName() string {
 	return r.sdkAPI.API.PackageName()
 }
 
+// GetOperation returns the API Operation with the supplied ID, or nil if the
+// API has no such Operation.
+func (r *CRD) GetOperation(opID string) *awssdkmodel.Operation {
+	return r.sdkAPI.API.Operations[opID]
+}
+
 // TypeRenames returns a map of original type name to renamed name (some
 // type definition names conflict with generated names)
 func (r *CRD) TypeRenames() map[string]string {
@@ -202,8 +214,25 @@ func (r *CRD) AddSpecField(
 	shapeRef *awssdkmodel.ShapeRef,
 ) {
 	fPath := memberNames.Camel
+	if r.cfg.IsChildResourceField(r.Names.Original, fPath) {
+		// The field is managed by a child resource
+		return
+	}
+	shapeRef = r.tagFieldShapeRef(fPath, shapeRef)
 	fConfig := r.cfg.GetFieldConfigByPath(r.Names.Original, fPath)
+	if fConfig != nil && fConfig.ListLimit != nil {
+		panic(fmt.Sprintf(
+			"list_limit is only supported for Status fields, but %s.%s is a Spec field",
+			r.Names.Original, fPath,
+		))
+	}
 	f := NewField(r, fPath, memberNames, shapeRef, fConfig)
+	if fConfig != nil && fConfig.Quantity != nil {
+		r.AddTypeImport("k8s.io/apimachinery/pkg/api/resource", "k8sresource")
+	}
+	if shapeRef != nil && shapeRef.JSONValue {
+		r.AddTypeImport("k8s.io/apimachinery/pkg/runtime", "")
+	}
 	if fConfig != nil && fConfig.Print != nil {
 		r.addSpecPrintableColumn(f)
 	}
@@ -227,13 +256,64 @@ func (r *CRD) AddStatusField(
 	shapeRef *awssdkmodel.ShapeRef,
 ) {
 	fPath := memberNames.Camel
+	if r.cfg.IsChildResourceField(r.Names.Original, fPath) {
+		// The field is managed by a child resource
+		return
+	}
 	fConfig := r.cfg.GetFieldConfigByPath(r.Names.Original, fPath)
+	if fConfig != nil && fConfig.Migration != nil {
+		panic(fmt.Sprintf(
+			"migration is only supported for Spec fields, but %s.%s is a Status field",
+			r.Names.Original, fPath,
+		))
+	}
+	if fConfig != nil && fConfig.IsPassthrough {
+		panic(fmt.Sprintf(
+			"is_passthrough is only supported for Spec fields, but %s.%s is a Status field",
+			r.Names.Original, fPath,
+		))
+	}
 	f := NewField(r, fPath, memberNames, shapeRef, fConfig)
+	if fConfig != nil && fConfig.Quantity != nil {
+		r.AddTypeImport("k8s.io/apimachinery/pkg/api/resource", "k8sresource")
+	}
+	if shapeRef != nil && shapeRef.JSONValue {
+		r.AddTypeImport("k8s.io/apimachinery/pkg/runtime", "")
+	}
 	if fConfig != nil && fConfig.Print != nil {
 		r.addStatusPrintableColumn(f)
 	}
 	r.StatusFields[memberNames.Original] = f
 	r.Fields[fPath] = f
+
+	// If this field's value is written to a Secret, add the Spec field
+	// identifying that Secret
+	if fConfig != nil && fConfig.WriteToSecret {
+		secretRefFieldNames := f.GetSecretRefFieldName()
+		sf := NewSecretRefField(r, secretRefFieldNames)
+		r.SpecFields[secretRefFieldNames.Original] = sf
+		r.Fields[secretRefFieldNames.Camel] = sf
+	}
+
+	// If this list field is truncated, add the Status field recording
+	// whether elements were dropped
+	if fConfig != nil && fConfig.ListLimit != nil {
+		if shapeRef == nil || shapeRef.Shape == nil || shapeRef.Shape.Type != "list" {
+			panic(fmt.Sprintf(
+				"list_limit is only supported for list fields, but %s.%s is not a list",
+				r.Names.Original, fPath,
+			))
+		}
+		if fConfig.ListLimit.TruncatedFieldName != "" {
+			truncatedFieldNames := names.New(fConfig.ListLimit.TruncatedFieldName)
+			tf := NewField(
+				r, truncatedFieldNames.Camel, truncatedFieldNames,
+				simpleBoolShapeRef, nil,
+			)
+			r.StatusFields[truncatedFieldNames.Original] = tf
+			r.Fields[truncatedFieldNames.Camel] = tf
+		}
+	}
 }
 
 // AddTypeImport adds an entry in the CRD's TypeImports map for an import line
@@ -258,6 +338,16 @@ func (r *CRD) SpecFieldNames() []string {
 	return res
 }
 
+// StatusFieldNames returns a sorted slice of field names for the Status fields
+func (r *CRD) StatusFieldNames() []string {
+	res := make([]string, 0, len(r.StatusFields))
+	for fieldName := range r.StatusFields {
+		res = append(res, fieldName)
+	}
+	sort.Strings(res)
+	return res
+}
+
 // UnpacksAttributesMap returns true if the underlying API has
 // Get{Resource}Attributes/Set{Resource}Attributes API calls that map real,
 // schema'd fields to a raw `map[string]*string` for this resource (see SNS and
@@ -266,11 +356,89 @@ func (r *CRD) UnpacksAttributesMap() bool {
 	return r.cfg.ResourceContainsAttributesMap(r.Names.Original)
 }
 
+// HasTypedAttributes returns true if any of the resource's attribute fields
+// coerce their value with `attribute_type`, in which case the conversion
+// functions are generated in the resource's package.
+func (r *CRD) HasTypedAttributes() bool {
+	for _, fConfig := range r.cfg.GetFieldConfigs(r.Names.Original) {
+		if r.isAttributeField(fConfig) && fConfig.AttributeType != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAttributeType returns true if any of the resource's attribute fields
+// coerce their value to the supplied `attribute_type`.
+func (r *CRD) HasAttributeType(attrType string) bool {
+	for _, fConfig := range r.cfg.GetFieldConfigs(r.Names.Original) {
+		if r.isAttributeField(fConfig) && fConfig.AttributeType == attrType {
+			return true
+		}
+	}
+	return false
+}
+
+// isAttributeField returns true if the field with the supplied config takes
+// its value from an attributes map: it is either an unpacked attribute field
+// or sourced from an attribute with `from`.
+func (r *CRD) isAttributeField(fConfig *ackgenconfig.FieldConfig) bool {
+	return (fConfig.IsAttribute && r.UnpacksAttributesMap()) ||
+		fConfig.From.IsAttribute()
+}
+
+// AttributeSourcedFields returns the Status fields, sorted by name, whose
+// values are sourced, via a `from` config with an `attribute`, from a key of
+// the attributes map returned by the resource's GetAttributes operation.
+func (r *CRD) AttributeSourcedFields() []*Field {
+	fieldNames := []string{}
+	for fieldName, f := range r.StatusFields {
+		if f.FieldConfig != nil && f.FieldConfig.From.IsAttribute() {
+			fieldNames = append(fieldNames, fieldName)
+		}
+	}
+	sort.Strings(fieldNames)
+	fields := make([]*Field, 0, len(fieldNames))
+	for _, fieldName := range fieldNames {
+		fields = append(fields, r.StatusFields[fieldName])
+	}
+	return fields
+}
+
 // CompareIgnoredFields returns the list of fields compare logic should ignore
 func (r *CRD) CompareIgnoredFields() []string {
 	return r.cfg.GetCompareIgnoredFieldPaths(r.Names.Original)
 }
 
+// IsCompareIgnoredPath returns true if the compare logic should ignore the
+// field with the supplied path, e.g. "Spec.Name", because it is listed in the
+// resource's `compare.ignore` config or is at or below one of its
+// `compare.ignore_prefixes`, matched on whole path segments.
+func (r *CRD) IsCompareIgnoredPath(fieldPath string) bool {
+	if util.InStrings(fieldPath, r.CompareIgnoredFields()) {
+		return true
+	}
+	fp := fieldpath.FromString(fieldPath)
+	for _, prefix := range r.cfg.GetCompareIgnoredFieldPathPrefixes(r.Names.Original) {
+		if fp.HasPrefix(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// CompareIgnoreWhen returns the fields whose differences compare logic should
+// ignore when their condition is satisfied.
+func (r *CRD) CompareIgnoreWhen() []ackgenconfig.CompareIgnoreWhenConfig {
+	return r.cfg.GetCompareIgnoreWhen(r.Names.Original)
+}
+
+// HasCompareIgnoreWhen returns true if compare logic should ignore the
+// differences in any field when a condition is satisfied.
+func (r *CRD) HasCompareIgnoreWhen() bool {
+	return len(r.CompareIgnoreWhen()) > 0
+}
+
 // SetAttributesSingleAttribute returns true if the supplied resource name has
 // a SetAttributes operation that only actually changes a single attribute at a
 // time. See: SNS SetTopicAttributes API call, which is entirely different from
@@ -280,15 +448,64 @@ func (r *CRD) SetAttributesSingleAttribute() bool {
 	return r.cfg.ResourceSetsSingleAttribute(r.Names.Original)
 }
 
+// SetsChangedAttributesOnly returns true if the resource only sends the
+// attributes whose fields changed in its SetAttributes calls, see
+// `set_changed_attributes_only`.
+func (r *CRD) SetsChangedAttributesOnly() bool {
+	return r.cfg.ResourceSetsChangedAttributesOnly(r.Names.Original)
+}
+
+// GetSettableAttributeNames returns the sorted names of the resource's
+// attribute fields that are sent in its SetAttributes calls, i.e. those that
+// are neither read-only nor configured with `attribute_update: never`.
+func (r *CRD) GetSettableAttributeNames() []string {
+	attrNames := []string{}
+	for fieldName, fieldConfig := range r.cfg.GetFieldConfigs(r.Names.Original) {
+		if !fieldConfig.IsAttribute || fieldConfig.IsReadOnly {
+			continue
+		}
+		switch fieldConfig.AttributeUpdate {
+		case "", ackgenconfig.AttributeUpdateAlways:
+		case ackgenconfig.AttributeUpdateNever:
+			continue
+		default:
+			panic(fmt.Sprintf(
+				"unsupported attribute_update %s for field %s of resource %s",
+				fieldConfig.AttributeUpdate, fieldName, r.Names.Original,
+			))
+		}
+		attrNames = append(attrNames, fieldName)
+	}
+	sort.Strings(attrNames)
+	return attrNames
+}
+
 // UnpackAttributes grabs instructions about fields that are represented in the
 // AWS API as a `map[string]*string` but are actually real, schema'd fields and
 // adds Field definitions for those fields.
-func (r *CRD) UnpackAttributes() {
+func (r *CRD) UnpackAttributes() error {
 	if !r.cfg.ResourceContainsAttributesMap(r.Names.Original) {
-		return
+		return nil
 	}
 	fieldConfigs := r.cfg.GetFieldConfigs(r.Names.Original)
 	for fieldName, fieldConfig := range fieldConfigs {
+		if fieldConfig.AttributeType != "" {
+			if !fieldConfig.IsAttribute && !fieldConfig.From.IsAttribute() {
+				return fmt.Errorf(
+					"field %s of resource %s has an attribute_type but is not an attribute",
+					fieldName, r.Names.Original,
+				)
+			}
+			switch fieldConfig.AttributeType {
+			case ackgenconfig.AttributeTypeBool, ackgenconfig.AttributeTypeInt,
+				ackgenconfig.AttributeTypeDuration, ackgenconfig.AttributeTypeJSON:
+			default:
+				return fmt.Errorf(
+					"unsupported attribute_type %q for field %s of resource %s",
+					fieldConfig.AttributeType, fieldName, r.Names.Original,
+				)
+			}
+		}
 		if !fieldConfig.IsAttribute {
 			continue
 		}
@@ -307,12 +524,13 @@ func (r *CRD) UnpackAttributes() {
 		}
 		r.Fields[fPath] = f
 	}
+	return nil
 }
 
 // IsPrimaryARNField returns true if the supplied field name is likely the resource's
 // ARN identifier field.
 func (r *CRD) IsPrimaryARNField(fieldName string) bool {
-	if !r.cfg.IncludeACKMetadata {
+	if !r.cfg.IncludeACKMetadata || r.IsARNLess() {
 		return false
 	}
 	fieldConfigs := r.cfg.GetFieldConfigs(r.Names.Original)
@@ -336,6 +554,28 @@ func (r *CRD) IsSecretField(path string) bool {
 	return false
 }
 
+// IsConfigMapField returns true if the supplied field *path* refers to a
+// Field that is a ConfigMapKeyReference
+func (r *CRD) IsConfigMapField(path string) bool {
+	fConfigs := r.cfg.GetFieldConfigs(r.Names.Original)
+	fConfig, found := fConfigs[path]
+	if found {
+		return fConfig.IsConfigMap
+	}
+	return false
+}
+
+// HasConfigMapFields returns true if any of the resource's fields is a
+// ConfigMapKeyReference
+func (r *CRD) HasConfigMapFields() bool {
+	for _, fConfig := range r.cfg.GetFieldConfigs(r.Names.Original) {
+		if fConfig != nil && fConfig.IsConfigMap {
+			return true
+		}
+	}
+	return false
+}
+
 // GetImmutableFieldPaths returns list of immutable field paths present in CRD
 func (r *CRD) GetImmutableFieldPaths() []string {
 	fConfigs := r.cfg.GetFieldConfigs(r.Names.Original)
@@ -363,6 +603,65 @@ func (r *CRD) HasImmutableFieldChanges() bool {
 	return false
 }
 
+// DeferredUpdateFields returns a slice, sorted by name, of the Spec fields
+// whose updates must wait for the updates of other Spec fields, i.e. have an
+// `update_after` FieldConfig.
+//
+// This function panics if one of those fields, or a field it depends on, is
+// not a top-level Spec field, or if the dependencies form a cycle.
+func (r *CRD) DeferredUpdateFields() []*Field {
+	fields := []*Field{}
+	for _, f := range r.SpecFields {
+		if f.FieldConfig == nil || len(f.FieldConfig.UpdateAfter) == 0 {
+			continue
+		}
+		for _, dep := range f.FieldConfig.UpdateAfter {
+			if _, found := r.SpecFields[dep]; !found {
+				panic(fmt.Sprintf(
+					"field %s of %s has update_after %s which is not a Spec field",
+					f.Names.Original, r.Names.Camel, dep,
+				))
+			}
+		}
+		fields = append(fields, f)
+	}
+	for _, f := range fields {
+		r.checkUpdateAfterCycle(f.Names.Original, f.Names.Original, map[string]bool{})
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Names.Camel < fields[j].Names.Camel
+	})
+	return fields
+}
+
+// checkUpdateAfterCycle panics if the Spec field named `origin` is reachable
+// from the `update_after` dependencies of the Spec field named `name`.
+func (r *CRD) checkUpdateAfterCycle(origin string, name string, visited map[string]bool) {
+	if visited[name] {
+		return
+	}
+	visited[name] = true
+	f := r.SpecFields[name]
+	if f.FieldConfig == nil {
+		return
+	}
+	for _, dep := range f.FieldConfig.UpdateAfter {
+		if dep == origin {
+			panic(fmt.Sprintf(
+				"update_after dependencies of field %s of %s form a cycle",
+				origin, r.Names.Camel,
+			))
+		}
+		r.checkUpdateAfterCycle(origin, dep, visited)
+	}
+}
+
+// HasDeferredUpdateFields returns true if the update of any of the resource's
+// Spec fields must wait for the updates of other Spec fields.
+func (r *CRD) HasDeferredUpdateFields() bool {
+	return len(r.DeferredUpdateFields()) > 0
+}
+
 // OmitUnchangedFieldsOnUpdate returns whether the controller needs to omit
 // unchanged fields from an update request or not.
 func (r *CRD) OmitUnchangedFieldsOnUpdate() bool {
@@ -371,13 +670,147 @@ func (r *CRD) OmitUnchangedFieldsOnUpdate() bool {
 	}
 	rConfig, found := r.Config().Resources[r.Names.Original]
 	if found {
-		if rConfig.UpdateOperation != nil {
-			return rConfig.UpdateOperation.OmitUnchangedFields
+		if rConfig.UpdateOperation != nil && rConfig.UpdateOperation.OmitUnchangedFields {
+			if r.IsUpsert() {
+				// Omitted fields would be reset by the upsert operation
+				panic(fmt.Sprintf(
+					"omit_unchanged_fields is not supported for resource %q "+
+						"with an upsert_operation", r.Names.Original,
+				))
+			}
+			return true
 		}
 	}
 	return false
 }
 
+// HasUpdateOperations returns true if the resource's Spec fields are updated
+// by different update operations, see `update_operations`.
+func (r *CRD) HasUpdateOperations() bool {
+	return len(r.cfg.GetUpdateOperations(r.Names.Original)) > 0
+}
+
+// GetUpdateOperations returns the resource's additional update operations,
+// see `update_operations`, in the order they are configured. It panics if an
+// operation does not exist, is configured twice or is the resource's Update
+// operation.
+func (r *CRD) GetUpdateOperations() []*awssdkmodel.Operation {
+	ops := []*awssdkmodel.Operation{}
+	for _, updateOp := range r.cfg.GetUpdateOperations(r.Names.Original) {
+		opID := updateOp.Operation
+		op := r.GetOperation(opID)
+		if op == nil {
+			panic(fmt.Sprintf(
+				"unable to find update operation %q of resource %q",
+				opID, r.Names.Original,
+			))
+		}
+		if op == r.Ops.Update {
+			panic(fmt.Sprintf(
+				"update operation %q of resource %q is its Update operation",
+				opID, r.Names.Original,
+			))
+		}
+		if slices.Contains(ops, op) {
+			panic(fmt.Sprintf(
+				"update operation %q of resource %q is configured twice",
+				opID, r.Names.Original,
+			))
+		}
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+// GetUpdateOperationFieldPaths returns the sorted paths, including the Spec
+// prefix, of the fields updated by the supplied update operation, or of all
+// the fields updated by the resource's additional update operations if opID
+// is empty. It panics if a field is not a top-level Spec field.
+func (r *CRD) GetUpdateOperationFieldPaths(opID string) []string {
+	specPrefix := strings.TrimPrefix(r.cfg.PrefixConfig.SpecField, ".")
+	paths := []string{}
+	for _, updateOp := range r.cfg.GetUpdateOperations(r.Names.Original) {
+		updateOpID := updateOp.Operation
+		if opID != "" && updateOpID != opID {
+			continue
+		}
+		for _, fieldName := range updateOp.Fields {
+			f, found := r.Fields[names.New(fieldName).Camel]
+			if !found || r.SpecFields[f.Names.Original] != f {
+				panic(fmt.Sprintf(
+					"unable to find Spec field %q of update operation %q of resource %q",
+					fieldName, updateOpID, r.Names.Original,
+				))
+			}
+			paths = append(paths, specPrefix+"."+f.Names.Camel)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// GetUpdateOperationOfField returns the ID of the additional update operation
+// updating the supplied Spec field, or an empty string if the field is
+// updated by the resource's Update operation.
+func (r *CRD) GetUpdateOperationOfField(fieldName string) string {
+	fieldCamel := names.New(fieldName).Camel
+	for _, updateOp := range r.cfg.GetUpdateOperations(r.Names.Original) {
+		for _, name := range updateOp.Fields {
+			if names.New(name).Camel == fieldCamel {
+				return updateOp.Operation
+			}
+		}
+	}
+	return ""
+}
+
+// HasReadOneOperations returns true if the resource can be read with
+// additional ReadOne operations, see `read_one_operations`.
+func (r *CRD) HasReadOneOperations() bool {
+	return len(r.cfg.GetReadOneOperations(r.Names.Original)) > 0
+}
+
+// GetReadOneOperations returns the resource's additional ReadOne operations,
+// see `read_one_operations`, in order of precedence. It panics if the
+// resource has no ReadOne operation or if an operation does not exist or is
+// the resource's ReadOne operation.
+func (r *CRD) GetReadOneOperations() []*awssdkmodel.Operation {
+	opIDs := r.cfg.GetReadOneOperations(r.Names.Original)
+	if len(opIDs) == 0 {
+		return nil
+	}
+	if r.Ops.ReadOne == nil {
+		panic(fmt.Sprintf(
+			"resource %q has read_one_operations but no ReadOne operation",
+			r.Names.Original,
+		))
+	}
+	ops := make([]*awssdkmodel.Operation, 0, len(opIDs))
+	for _, opID := range opIDs {
+		op := r.GetOperation(opID)
+		if op == nil {
+			panic(fmt.Sprintf(
+				"unable to find read one operation %q of resource %q",
+				opID, r.Names.Original,
+			))
+		}
+		if op == r.Ops.ReadOne {
+			panic(fmt.Sprintf(
+				"read one operation %q of resource %q is its ReadOne operation",
+				opID, r.Names.Original,
+			))
+		}
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+// IsUpsert returns true if the resource is created and updated with the same
+// Put-style operation with upsert semantics, see `upsert_operation`.
+func (r *CRD) IsUpsert() bool {
+	return r.cfg.GetUpsertOperation(r.Names.Original) != ""
+}
+
 // IsARNPrimaryKey returns true if the CRD uses its ARN as its primary key in
 // ReadOne calls.
 func (r *CRD) IsARNPrimaryKey() bool {
@@ -388,32 +821,94 @@ func (r *CRD) IsARNPrimaryKey() bool {
 	return resGenConfig.IsARNPrimaryKey
 }
 
+// IsARNLess returns true if the resource never exposes an ARN, i.e. it has an
+// `arn_less` config.
+func (r *CRD) IsARNLess() bool {
+	return r.cfg.GetARNLessConfig(r.Names.Original) != nil
+}
+
 // GetPrimaryKeyField returns the field designated as the primary key, nil if
 // none are specified or an error if multiple are designated.
 func (r *CRD) GetPrimaryKeyField() (*Field, error) {
+	primaryFields, err := r.GetPrimaryKeyFields()
+	if err != nil {
+		return nil, err
+	}
+	switch len(primaryFields) {
+	case 0:
+		return nil, nil
+	case 1:
+		return primaryFields[0], nil
+	default:
+		return nil, fmt.Errorf("multiple fields are marked with is_primary_key")
+	}
+}
+
+// GetPrimaryKeyFields returns the fields designated as the primary key,
+// ordered by their `primary_key_order`, or an error if multiple fields are
+// designated without distinct orders.
+func (r *CRD) GetPrimaryKeyFields() ([]*Field, error) {
 	fConfigs := r.cfg.GetFieldConfigs(r.Names.Original)
 
-	var primaryField *Field
+	primaryFields := []*Field{}
+	orders := map[int]string{}
 	for fieldName, fieldConfig := range fConfigs {
 		if !fieldConfig.IsPrimaryKey {
 			continue
 		}
-
-		// Multiple primary fields
-		if primaryField != nil {
-			return nil, fmt.Errorf("multiple fields are marked with is_primary_key")
-		}
-
 		fieldNames := names.New(fieldName)
 		fPath := fieldNames.Camel
-		var found bool
-		primaryField, found = r.Fields[fPath]
+		primaryField, found := r.Fields[fPath]
 		if !found {
 			return nil, fmt.Errorf("could not find field with path " + fPath +
 				" for primary key " + fieldName)
 		}
+		if other, found := orders[fieldConfig.PrimaryKeyOrder]; found {
+			return nil, fmt.Errorf(
+				"fields %s and %s are marked with is_primary_key and have "+
+					"the same primary_key_order", other, fieldName,
+			)
+		}
+		orders[fieldConfig.PrimaryKeyOrder] = fieldName
+		primaryFields = append(primaryFields, primaryField)
+	}
+	sort.Slice(primaryFields, func(i, j int) bool {
+		return primaryFields[i].FieldConfig.PrimaryKeyOrder <
+			primaryFields[j].FieldConfig.PrimaryKeyOrder
+	})
+	if len(primaryFields) == 0 && r.IsARNLess() {
+		// The identifier field of an ARN-less resource is its primary key
+		// unless another field is explicitly marked as such
+		identifierField := r.cfg.GetARNLessConfig(r.Names.Original).IdentifierField
+		field, found := r.Fields[names.New(identifierField).Camel]
+		if !found {
+			return nil, fmt.Errorf(
+				"could not find identifier field %s of ARN-less resource %s",
+				identifierField, r.Names.Original,
+			)
+		}
+		primaryFields = append(primaryFields, field)
+	}
+	return primaryFields, nil
+}
+
+// HasCompositePrimaryKey returns true if the resource's identity is a tuple
+// of fields, i.e. multiple fields are designated as the primary key.
+func (r *CRD) HasCompositePrimaryKey() bool {
+	primaryFields, err := r.GetPrimaryKeyFields()
+	if err != nil {
+		panic(err)
+	}
+	return len(primaryFields) > 1
+}
+
+// PrimaryKeySeparator returns the separator of the values of the primary key
+// fields in the composite identifier of the resource
+func (r *CRD) PrimaryKeySeparator() string {
+	if separator := r.cfg.GetPrimaryKeySeparator(r.Names.Original); separator != "" {
+		return separator
 	}
-	return primaryField, nil
+	return ":"
 }
 
 // GetMatchingInputShapeFieldName returns the name of the field in the Input shape.
@@ -506,7 +1001,8 @@ func (r *CRD) GetOutputShape(
 // getWrapperOutputShape returns the shape of the last element of a given field
 // Path. It unwraps the output shape and verifies that every element of the
 // field path exists in their corresponding parent shape and that they are
-// structures.
+// structures. An element of the field path may select an element of a list,
+// e.g. "Reservations[0]".
 func (r *CRD) getWrapperOutputShape(
 	shape *awssdkmodel.Shape,
 	fieldPath string,
@@ -515,7 +1011,7 @@ func (r *CRD) getWrapperOutputShape(
 		return shape, nil
 	}
 	fp := fieldpath.FromString(fieldPath)
-	wrapperField := fp.PopFront()
+	wrapperField, index := fieldpath.SplitIndex(fp.PopFront())
 
 	memberRef, ok := shape.MemberRefs[wrapperField]
 	if !ok {
@@ -523,6 +1019,12 @@ func (r *CRD) getWrapperOutputShape(
 			"could not find wrapper override field %s in Shape %s",
 			wrapperField, shape.ShapeName)
 	}
+	if index >= 0 && memberRef.Shape.Type != "list" {
+		return nil, fmt.Errorf(
+			"output wrapper override field %s of type '%s' cannot be "+
+				"indexed, only fields of type 'list' can",
+			wrapperField, memberRef.Shape.Type)
+	}
 
 	// wrapper field must be list or structure; otherwise cannot unpack
 	if memberRef.Shape.Type == "list" {
@@ -537,6 +1039,86 @@ func (r *CRD) getWrapperOutputShape(
 	return r.getWrapperOutputShape(memberRef.Shape, fp.String())
 }
 
+// CountsCreations returns true if the resource's Create operation is sent a
+// generated idempotency token, which then includes the number of times the
+// resource was created, so that a resource deleted outside of the controller
+// is created anew rather than matched with the deleted one.
+func (r *CRD) CountsCreations() bool {
+	return r.Ops.Create != nil && r.GetIdempotencyTokenMember(r.Ops.Create) != ""
+}
+
+// GetIdempotencyTokenMember returns the name of the member of the supplied
+// operation's Input shape set to a generated idempotency token, see
+// `generate_idempotency_token`, or an empty string if none is generated. It
+// panics if the operation has no string member marked as an idempotency
+// token in the API model or configured with `idempotency_token_member`.
+func (r *CRD) GetIdempotencyTokenMember(op *awssdkmodel.Operation) string {
+	generate, memberName := r.cfg.GetIdempotencyTokenConfig(op)
+	if !generate {
+		return ""
+	}
+	inputShape := op.InputRef.Shape
+	if memberName == "" && inputShape != nil {
+		for _, name := range inputShape.MemberNames() {
+			ref := inputShape.MemberRefs[name]
+			if ref.IdempotencyToken || ref.Shape.IdempotencyToken {
+				memberName = name
+				break
+			}
+		}
+	}
+	if memberName == "" || inputShape == nil {
+		panic(fmt.Sprintf(
+			"operation %q of resource %q has no idempotency token member, "+
+				"set idempotency_token_member",
+			op.ExportedName, r.Names.Original,
+		))
+	}
+	ref, found := inputShape.MemberRefs[memberName]
+	if !found || ref.Shape.Type != "string" {
+		panic(fmt.Sprintf(
+			"idempotency token member %q of operation %q of resource %q must "+
+				"be a string member of its Input shape",
+			memberName, op.ExportedName, r.Names.Original,
+		))
+	}
+	return memberName
+}
+
+// ProcessOutputCustomMethodName returns the name of the custom method called
+// with the Output shape of the supplied operation before the resource's
+// fields are set from it, see `process_output_custom_method_name`, or an
+// empty string if none is configured.
+func (r *CRD) ProcessOutputCustomMethodName(
+	op *awssdkmodel.Operation,
+) string {
+	return r.cfg.GetProcessOutputCustomMethodName(op)
+}
+
+// checkProcessOutputCustomMethods returns an error if a
+// `process_output_custom_method_name` is configured for an operation whose
+// Output shape the resource's generated code does not read: its Delete
+// operation and its `update_operations`.
+func (r *CRD) checkProcessOutputCustomMethods() error {
+	opIDs := []string{}
+	if r.Ops.Delete != nil {
+		opIDs = append(opIDs, r.Ops.Delete.ExportedName)
+	}
+	for _, updateOp := range r.cfg.GetUpdateOperations(r.Names.Original) {
+		opIDs = append(opIDs, updateOp.Operation)
+	}
+	for _, opID := range opIDs {
+		if r.cfg.Operations[opID].ProcessOutputCustomMethodName != "" {
+			return fmt.Errorf(
+				"process_output_custom_method_name of operation %s is not "+
+					"supported: resource %s does not read its Output shape",
+				opID, r.Names.Original,
+			)
+		}
+	}
+	return nil
+}
+
 // GetCustomImplementation returns custom implementation method name for the
 // supplied operation as specified in generator config
 func (r *CRD) GetCustomImplementation(
@@ -546,6 +1128,135 @@ func (r *CRD) GetCustomImplementation(
 	return r.cfg.GetCustomImplementation(op)
 }
 
+// SkipsGeneration returns true if the generated code calling the supplied
+// operation is replaced entirely by a call to its `custom_implementation`
+// method. It panics if the operation has `skip_generation` but no
+// `custom_implementation`.
+func (r *CRD) SkipsGeneration(op *awssdkmodel.Operation) bool {
+	if !r.cfg.GetSkipGeneration(op) {
+		return false
+	}
+	if r.cfg.GetCustomImplementation(op) == "" {
+		panic(fmt.Sprintf(
+			"operation %q of resource %q has skip_generation but no custom_implementation",
+			op.ExportedName, r.Names.Original,
+		))
+	}
+	return true
+}
+
+// OperationTimeoutSeconds returns the number of seconds after which a call to
+// the supplied operation is cancelled, see `timeout_seconds`, or 0 if calls
+// are not bounded. It panics if the timeout is negative.
+func (r *CRD) OperationTimeoutSeconds(op *awssdkmodel.Operation) int {
+	timeoutSeconds := r.cfg.GetOperationTimeoutSeconds(op)
+	if timeoutSeconds < 0 {
+		panic(fmt.Sprintf(
+			"timeout_seconds of operation %q of resource %q must be positive",
+			op.ExportedName, r.Names.Original,
+		))
+	}
+	return timeoutSeconds
+}
+
+// OperationRetry returns the retry policy, see `retry`, of the calls to the
+// supplied operation with its defaults applied, or nil if calls are not
+// retried. It panics if the policy has no error codes or allows less than two
+// attempts.
+func (r *CRD) OperationRetry(op *awssdkmodel.Operation) *ackgenconfig.RetryConfig {
+	retryCfg := r.cfg.GetOperationRetry(op)
+	if retryCfg == nil {
+		return nil
+	}
+	res := *retryCfg
+	if res.MaxAttempts == 0 {
+		res.MaxAttempts = 3
+	}
+	if res.BackoffMilliseconds == 0 {
+		res.BackoffMilliseconds = 500
+	}
+	if len(res.ErrorCodes) == 0 {
+		panic(fmt.Sprintf(
+			"retry of operation %q of resource %q has no error_codes",
+			op.ExportedName, r.Names.Original,
+		))
+	}
+	if res.MaxAttempts < 2 || res.BackoffMilliseconds < 0 {
+		panic(fmt.Sprintf(
+			"retry of operation %q of resource %q must allow at least 2 "+
+				"attempts and have a positive backoff",
+			op.ExportedName, r.Names.Original,
+		))
+	}
+	return &res
+}
+
+// HasOperationTimeouts returns true if any of the operations called by the
+// resource's generated sdk code has a `timeout_seconds`.
+func (r *CRD) HasOperationTimeouts() bool {
+	for _, op := range r.sdkCallOperations() {
+		if r.OperationTimeoutSeconds(op) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasOperationRetries returns true if any of the operations called by the
+// resource's generated sdk code has a `retry`.
+func (r *CRD) HasOperationRetries() bool {
+	for _, op := range r.sdkCallOperations() {
+		if r.OperationRetry(op) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// sdkCallOperations returns the operations called by the resource's
+// generated sdk code.
+func (r *CRD) sdkCallOperations() []*awssdkmodel.Operation {
+	ops := append(
+		r.Ops.IterOps(), r.Ops.GetAttributes, r.Ops.SetAttributes,
+	)
+	ops = append(ops, r.GetUpdateOperations()...)
+	if tagSync := r.GetTagSync(); tagSync != nil {
+		ops = append(ops, tagSync.TagOp, tagSync.UntagOp)
+	}
+	ops = append(ops, r.GetReadOneOperations()...)
+	for _, opID := range r.subAPIOperationIDs() {
+		if op := r.GetOperation(opID); op != nil {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+// subAPIOperationIDs returns the IDs of the operations called by the
+// resource's generated sdk code to manage its attachments, collections,
+// auxiliary resources and pre-delete steps.
+func (r *CRD) subAPIOperationIDs() []string {
+	opIDs := []string{}
+	for _, attCfg := range r.cfg.GetAttachments(r.Names.Original) {
+		opIDs = append(opIDs, attCfg.GetOperation, attCfg.PutOperation, attCfg.DeleteOperation)
+	}
+	for _, colCfg := range r.cfg.GetCollections(r.Names.Original) {
+		opIDs = append(opIDs, colCfg.ListOperation, colCfg.AddOperation, colCfg.RemoveOperation)
+	}
+	for _, auxCfg := range r.cfg.GetAuxiliaryResources(r.Names.Original) {
+		opIDs = append(opIDs, auxCfg.DeleteOperation)
+	}
+	if preDeleteCfg := r.cfg.GetPreDeleteConfig(r.Names.Original); preDeleteCfg != nil {
+		for _, opCfg := range preDeleteCfg.Operations {
+			opIDs = append(opIDs, opCfg.Operation)
+		}
+		for _, emptyCfg := range preDeleteCfg.Empty {
+			opIDs = append(opIDs, emptyCfg.ListOperation, emptyCfg.DeleteOperation)
+		}
+	}
+	return opIDs
+}
+
 // UpdateConditionsCustomMethodName returns custom update conditions operation
 // as *string for custom resource
 func (r *CRD) UpdateConditionsCustomMethodName() string {
@@ -561,16 +1272,40 @@ func (r *CRD) GetCustomCheckRequiredFieldsMissingMethod(
 	return r.cfg.GetCustomCheckRequiredFieldsMissingMethod(op)
 }
 
+// GetRequiredFieldsConfig returns the fields of the resource that must be set
+// for the supplied operation to be called, or nil if they are inferred from
+// the operation's Input shape.
+func (r *CRD) GetRequiredFieldsConfig(
+	// The type of operation
+	op *awssdkmodel.Operation,
+) *ackgenconfig.RequiredFieldsConfig {
+	return r.cfg.GetRequiredFieldsConfig(op)
+}
+
 // SpecIdentifierField returns the name of the "Name" or string identifier field
 // in the Spec.
 func (r *CRD) SpecIdentifierField() *string {
 	rConfig := r.cfg.GetResourceConfig(r.Names.Original)
 	if rConfig != nil {
+		var identifierField *string
 		for fName, fConfig := range rConfig.Fields {
-			if fConfig.IsPrimaryKey {
-				return &fName
+			if !fConfig.IsPrimaryKey {
+				continue
+			}
+			// The first field of a composite primary key identifies the
+			// resource
+			if identifierField == nil ||
+				fConfig.PrimaryKeyOrder < rConfig.Fields[*identifierField].PrimaryKeyOrder {
+				name := fName
+				identifierField = &name
 			}
 		}
+		if identifierField != nil {
+			return identifierField
+		}
+		if rConfig.ARNLess != nil {
+			return &rConfig.ARNLess.IdentifierField
+		}
 	}
 	lookup := []string{
 		"Name",
@@ -590,33 +1325,277 @@ func (r *CRD) IsAdoptable() bool {
 	return r.cfg.ResourceIsAdoptable(r.Names.Original)
 }
 
-// GetResourcePrintOrderByName returns the Printer Column order-by field name
-func (r *CRD) GetResourcePrintOrderByName() string {
-	orderBy := r.cfg.GetResourcePrintOrderByName(r.Names.Camel)
-	if orderBy == "" {
-		return "name"
-	}
-	return orderBy
+// defaultAdoptionRetryableErrorCodes contains the AWS error codes retried
+// while adopting a resource when no `retryable_error_codes` are configured
+var defaultAdoptionRetryableErrorCodes = []string{
+	"Throttling",
+	"ThrottlingException",
+	"TooManyRequestsException",
+	"RequestLimitExceeded",
 }
 
-// PrintAgeColumn returns whether the code generator should append 'Age'
-// kubebuilder:printcolumn comment marker
-func (r *CRD) PrintAgeColumn() bool {
-	return r.cfg.ResourceDisplaysAgeColumn(r.Names.Camel)
+// AdoptionRetry returns the adoption retry behavior configured for the
+// resource, with defaults applied, or nil if the ReadOne operation of a
+// resource being adopted is not retried.
+func (r *CRD) AdoptionRetry() *ackgenconfig.AdoptionRetryConfig {
+	retryCfg := r.cfg.GetAdoptionRetryConfig(r.Names.Original)
+	if retryCfg == nil {
+		return nil
+	}
+	res := *retryCfg
+	if res.MaxAttempts <= 0 {
+		res.MaxAttempts = 5
+	}
+	if res.InitialBackoffSeconds <= 0 {
+		res.InitialBackoffSeconds = 1
+	}
+	if res.MaxBackoffSeconds <= 0 {
+		res.MaxBackoffSeconds = 30
+	}
+	if len(res.RetryableErrorCodes) == 0 {
+		res.RetryableErrorCodes = defaultAdoptionRetryableErrorCodes
+	}
+	return &res
 }
 
-// PrintSyncedColumn returns whether the code generator should append 'Sync'
-// kubebuilder:printcolumn comment marker
-func (r *CRD) PrintSyncedColumn() bool {
-	return r.cfg.ResourceDisplaysSyncedColumn(r.Names.Camel)
+// TerminalStates returns the terminal values of the resource's state field,
+// or nil if no terminal states are configured for the resource.
+func (r *CRD) TerminalStates() *ackgenconfig.TerminalStatesConfig {
+	return r.cfg.GetTerminalStatesConfig(r.Names.Original)
 }
 
-func (r *CRD) addAdditionalPrinterColumns(additionalColumns []*ackgenconfig.AdditionalColumnConfig) {
-	for _, additionalColumn := range additionalColumns {
-		printerColumn := &PrinterColumn{}
-		printerColumn.Name = additionalColumn.Name
-		printerColumn.JSONPath = additionalColumn.JSONPath
-		printerColumn.Type = additionalColumn.Type
+// Waiter returns the aws-sdk-go waiter called after the supplied operation
+// type, with defaults applied, or nil if no waiter is configured for it. It
+// panics if the resource has no ReadOne operation, whose Input shape is used
+// to call the waiter, or if the API model has no waiter of that name polling
+// the ReadOne operation.
+func (r *CRD) Waiter(opType OpType) *ackgenconfig.WaiterConfig {
+	waitersCfg := r.cfg.GetWaitersConfig(r.Names.Original)
+	if waitersCfg == nil {
+		return nil
+	}
+	var waiterCfg *ackgenconfig.WaiterConfig
+	switch opType {
+	case OpTypeCreate:
+		waiterCfg = waitersCfg.Create
+	case OpTypeUpdate:
+		waiterCfg = waitersCfg.Update
+	case OpTypeDelete:
+		waiterCfg = waitersCfg.Delete
+	}
+	if waiterCfg == nil {
+		return nil
+	}
+	if waiterCfg.Name == "" {
+		panic(fmt.Sprintf("waiters of %s must have a name", r.Names.Camel))
+	}
+	if r.Ops.ReadOne == nil {
+		panic(fmt.Sprintf(
+			"waiters of %s require a ReadOne operation to be called with",
+			r.Names.Camel,
+		))
+	}
+	found := false
+	for _, waiter := range r.sdkAPI.API.Waiters {
+		if waiter.Name != waiterCfg.Name {
+			continue
+		}
+		if waiter.OperationName != r.Ops.ReadOne.ExportedName {
+			panic(fmt.Sprintf(
+				"waiter %s of %s polls %s, not the ReadOne operation %s",
+				waiter.Name, r.Names.Camel, waiter.OperationName,
+				r.Ops.ReadOne.ExportedName,
+			))
+		}
+		found = true
+		break
+	}
+	if !found {
+		panic(fmt.Sprintf(
+			"waiter %s of %s is not a waiter of the %s API",
+			waiterCfg.Name, r.Names.Camel, r.sdkAPI.API.PackageName(),
+		))
+	}
+	res := *waiterCfg
+	if res.TimeoutSeconds == 0 {
+		res.TimeoutSeconds = 30
+	}
+	return &res
+}
+
+// CreateWaiter returns the aws-sdk-go waiter called after the resource is
+// created, or nil if none is configured.
+func (r *CRD) CreateWaiter() *ackgenconfig.WaiterConfig {
+	return r.Waiter(OpTypeCreate)
+}
+
+// UpdateWaiter returns the aws-sdk-go waiter called after the resource is
+// updated, or nil if none is configured.
+func (r *CRD) UpdateWaiter() *ackgenconfig.WaiterConfig {
+	return r.Waiter(OpTypeUpdate)
+}
+
+// DeleteWaiter returns the aws-sdk-go waiter called after the resource is
+// deleted, or nil if none is configured.
+func (r *CRD) DeleteWaiter() *ackgenconfig.WaiterConfig {
+	return r.Waiter(OpTypeDelete)
+}
+
+// HasWaiters returns true if aws-sdk-go waiters are called after any of the
+// operations mutating the resource.
+func (r *CRD) HasWaiters() bool {
+	return r.CreateWaiter() != nil || r.UpdateWaiter() != nil ||
+		r.DeleteWaiter() != nil
+}
+
+// CreateGracePeriod returns the post-create grace period configured for the
+// resource, with defaults applied, or nil if a resource not found after its
+// creation is created again right away.
+func (r *CRD) CreateGracePeriod() *ackgenconfig.CreateGracePeriodConfig {
+	graceCfg := r.cfg.GetCreateGracePeriodConfig(r.Names.Original)
+	if graceCfg == nil {
+		return nil
+	}
+	res := *graceCfg
+	if res.Seconds <= 0 {
+		res.Seconds = 30
+	}
+	if res.RequeueAfterSeconds <= 0 {
+		res.RequeueAfterSeconds = 5
+	}
+	return &res
+}
+
+// defaultOwnershipTagKey is the key of the ownership tag when no `tag_key` is
+// configured
+const defaultOwnershipTagKey = "services.k8s.aws/owner-uid"
+
+// Ownership returns the ownership tagging behavior configured for the
+// resource, with defaults applied, or nil if the resource is not tagged with
+// its owner.
+func (r *CRD) Ownership() *ackgenconfig.OwnershipConfig {
+	ownershipCfg := r.cfg.GetOwnershipConfig(r.Names.Original)
+	if ownershipCfg == nil {
+		return nil
+	}
+	if tagField, err := r.GetTagField(); err != nil || tagField == nil {
+		panic(fmt.Sprintf(
+			"ownership is configured for %s but the resource has no tag field",
+			r.Names.Original,
+		))
+	}
+	res := *ownershipCfg
+	if res.TagKey == "" {
+		res.TagKey = defaultOwnershipTagKey
+	}
+	return &res
+}
+
+// defaultReferenceWaitRequeueAfterSeconds is the delay before the references
+// of a resource waiting for a referenced resource to be synced are resolved
+// again when no `requeue_after_seconds` is configured
+const defaultReferenceWaitRequeueAfterSeconds = 30
+
+// ReferenceWait returns the behavior configured for the resource when a
+// referenced resource is not synced yet, with defaults applied, or nil if
+// none is configured.
+func (r *CRD) ReferenceWait() *ackgenconfig.ReferenceWaitConfig {
+	waitCfg := r.cfg.GetReferenceWaitConfig(r.Names.Original)
+	if waitCfg == nil {
+		return nil
+	}
+	if !r.HasReferenceFields() {
+		panic(fmt.Sprintf(
+			"reference_wait is configured for %s but the resource has no reference fields",
+			r.Names.Original,
+		))
+	}
+	res := *waitCfg
+	if res.RequeueAfterSeconds <= 0 {
+		res.RequeueAfterSeconds = defaultReferenceWaitRequeueAfterSeconds
+	}
+	return &res
+}
+
+// defaultLateInitializeBackoffSeconds is the delay before late initialization
+// is attempted again when no `min_backoff_seconds` is configured
+const defaultLateInitializeBackoffSeconds = 5
+
+// LateInitializeRetry returns the retry behavior of the late initialization
+// of the resource, aggregated from the `late_initialize` configs of its
+// fields with defaults applied, or nil if none of its late initialized fields
+// configures a backoff or max attempts, in which case late initialization is
+// requeued with the default fixed delay.
+func (r *CRD) LateInitializeRetry() *ackgenconfig.LateInitializeConfig {
+	lateInitConfigs := r.cfg.GetLateInitConfigs(r.Names.Original)
+	configured := false
+	for _, lateInitConfig := range lateInitConfigs {
+		if lateInitConfig.MinBackoffSeconds > 0 ||
+			lateInitConfig.MaxBackoffSeconds > 0 ||
+			lateInitConfig.MaxAttempts > 0 {
+			configured = true
+			break
+		}
+	}
+	if !configured {
+		return nil
+	}
+	res := &ackgenconfig.LateInitializeConfig{}
+	unlimitedAttempts := false
+	for _, lateInitConfig := range lateInitConfigs {
+		if lateInitConfig.MinBackoffSeconds > 0 &&
+			(res.MinBackoffSeconds == 0 || lateInitConfig.MinBackoffSeconds < res.MinBackoffSeconds) {
+			res.MinBackoffSeconds = lateInitConfig.MinBackoffSeconds
+		}
+		if lateInitConfig.MaxBackoffSeconds > res.MaxBackoffSeconds {
+			res.MaxBackoffSeconds = lateInitConfig.MaxBackoffSeconds
+		}
+		if lateInitConfig.MaxAttempts <= 0 {
+			unlimitedAttempts = true
+		} else if lateInitConfig.MaxAttempts > res.MaxAttempts {
+			res.MaxAttempts = lateInitConfig.MaxAttempts
+		}
+	}
+	if res.MinBackoffSeconds == 0 {
+		res.MinBackoffSeconds = defaultLateInitializeBackoffSeconds
+	}
+	if res.MaxBackoffSeconds < res.MinBackoffSeconds {
+		res.MaxBackoffSeconds = res.MinBackoffSeconds
+	}
+	if unlimitedAttempts {
+		res.MaxAttempts = 0
+	}
+	return res
+}
+
+// GetResourcePrintOrderByName returns the Printer Column order-by field name
+func (r *CRD) GetResourcePrintOrderByName() string {
+	orderBy := r.cfg.GetResourcePrintOrderByName(r.Names.Camel)
+	if orderBy == "" {
+		return "name"
+	}
+	return orderBy
+}
+
+// PrintAgeColumn returns whether the code generator should append 'Age'
+// kubebuilder:printcolumn comment marker
+func (r *CRD) PrintAgeColumn() bool {
+	return r.cfg.ResourceDisplaysAgeColumn(r.Names.Camel)
+}
+
+// PrintSyncedColumn returns whether the code generator should append 'Sync'
+// kubebuilder:printcolumn comment marker
+func (r *CRD) PrintSyncedColumn() bool {
+	return r.cfg.ResourceDisplaysSyncedColumn(r.Names.Camel)
+}
+
+func (r *CRD) addAdditionalPrinterColumns(additionalColumns []*ackgenconfig.AdditionalColumnConfig) {
+	for _, additionalColumn := range additionalColumns {
+		printerColumn := &PrinterColumn{}
+		printerColumn.Name = additionalColumn.Name
+		printerColumn.JSONPath = additionalColumn.JSONPath
+		printerColumn.Type = additionalColumn.Type
+		printerColumn.Format = additionalColumn.Format
 		printerColumn.Priority = additionalColumn.Priority
 		printerColumn.Index = additionalColumn.Index
 		r.additionalPrinterColumns = append(r.additionalPrinterColumns, printerColumn)
@@ -710,21 +1689,736 @@ func (r *CRD) ReconcileRequeuOnSuccessSeconds() int {
 	return r.cfg.GetReconcileRequeueOnSuccessSeconds(r.Names.Original)
 }
 
+// ReconcileResyncSeconds returns the default resync period, in seconds, of
+// the custom resource, or 0 if the controller-wide default applies.
+func (r *CRD) ReconcileResyncSeconds() int {
+	return r.cfg.GetReconcileResyncSeconds(r.Names.Original)
+}
+
+// ReconcileMaxConcurrentSyncs returns the default maximum number of
+// concurrent reconciles of the custom resource, or 0 if the controller-wide
+// default applies.
+func (r *CRD) ReconcileMaxConcurrentSyncs() int {
+	return r.cfg.GetReconcileMaxConcurrentSyncs(r.Names.Original)
+}
+
+// ReconcileRequeueOnSuccessByState returns the requeue delays keyed by state
+// configured for the custom resource, or nil if the resource is requeued
+// after the same delay whatever its state.
+func (r *CRD) ReconcileRequeueOnSuccessByState() *ackgenconfig.RequeueOnSuccessByStateConfig {
+	byStateCfg := r.cfg.GetReconcileRequeueOnSuccessByState(r.Names.Original)
+	if byStateCfg == nil {
+		return nil
+	}
+	if byStateCfg.Path == "" || len(byStateCfg.Seconds) == 0 {
+		panic(fmt.Sprintf(
+			"requeue_on_success_by_state for %s must have a path and at "+
+				"least one state",
+			r.Names.Original,
+		))
+	}
+	return byStateCfg
+}
+
 // CustomUpdateMethodName returns the name of the custom resourceManager method
 // for updating the resource state, if any has been specified in the generator
-// config
+// config, either with the resource's `update_operation` or with the
+// `custom_implementation` of its Update operation if it has
+// `skip_generation`.
 func (r *CRD) CustomUpdateMethodName() string {
-	return r.cfg.GetCustomUpdateMethodName(r.Names.Original)
+	if name := r.cfg.GetCustomUpdateMethodName(r.Names.Original); name != "" {
+		return name
+	}
+	if r.SkipsGeneration(r.Ops.Update) {
+		return r.cfg.GetCustomImplementation(r.Ops.Update)
+	}
+	return ""
 }
 
+// CustomFindMethodName returns the name of the custom resourceManager method
+// implementing sdkFind, either the resource's `read_operation` method or the
+// `custom_implementation` of its ReadOne operation if it has
+// `skip_generation`.
 func (r *CRD) CustomFindMethodName() string {
-	return r.cfg.GetCustomFindMethodName(r.Names.Original)
+	if name := r.cfg.GetCustomFindMethodName(r.Names.Original); name != "" {
+		return name
+	}
+	if r.SkipsGeneration(r.Ops.ReadOne) {
+		return r.cfg.GetCustomImplementation(r.Ops.ReadOne)
+	}
+	return ""
 }
 
 func (r *CRD) CustomDeleteMethodName() string {
 	return r.cfg.GetCustomDeleteMethodName(r.Names.Original)
 }
 
+// HasAuxiliaryResources returns true if the resource has auxiliary resources
+// that need to be cleaned up when the resource is deleted.
+func (r *CRD) HasAuxiliaryResources() bool {
+	for _, auxCfg := range r.cfg.GetAuxiliaryResources(r.Names.Original) {
+		if !auxCfg.Retain {
+			return true
+		}
+	}
+	return false
+}
+
+// IsObserveOnly returns true if the resource is only read from the backend
+// AWS service API, and never created, updated or deleted.
+func (r *CRD) IsObserveOnly() bool {
+	return r.cfg.ResourceIsObserveOnly(r.Names.Original)
+}
+
+// DeletionPolicy returns the default deletion policy of the resource, either
+// "delete" or "retain", see `deletion_policy`.
+func (r *CRD) DeletionPolicy() string {
+	switch policy := r.cfg.GetDeletionPolicy(r.Names.Original); policy {
+	case "", ackgenconfig.DeletionPolicyDelete:
+		return ackgenconfig.DeletionPolicyDelete
+	case ackgenconfig.DeletionPolicyRetain:
+		return policy
+	default:
+		panic(fmt.Sprintf(
+			"deletion_policy of resource %q must be either %q or %q, got %q",
+			r.Names.Original, ackgenconfig.DeletionPolicyDelete,
+			ackgenconfig.DeletionPolicyRetain, policy,
+		))
+	}
+}
+
+// IsAdoptionOnly returns true if the AWS resources of the resource can only
+// be adopted, and are never created in the backend AWS service API. It panics
+// if the resource has no operation to read, and therefore adopt, it with.
+func (r *CRD) IsAdoptionOnly() bool {
+	if !r.cfg.ResourceIsAdoptionOnly(r.Names.Original) {
+		return false
+	}
+	if r.CustomFindMethodName() == "" && r.Ops.ReadOne == nil &&
+		r.Ops.GetAttributes == nil && r.Ops.ReadMany == nil {
+		panic(fmt.Sprintf(
+			"adoption_only is set for %s which has no operation to read it",
+			r.Names.Camel,
+		))
+	}
+	return true
+}
+
+// ReadsAfterCreate returns true if the resource is read right after its
+// creation to fill in the Status fields not returned by the Create operation.
+// It panics if the resource has no operation to read it with.
+func (r *CRD) ReadsAfterCreate() bool {
+	if !r.cfg.ResourceReadsAfterCreate(r.Names.Original) {
+		return false
+	}
+	if r.CustomFindMethodName() == "" && r.Ops.ReadOne == nil &&
+		r.Ops.GetAttributes == nil && r.Ops.ReadMany == nil {
+		panic(fmt.Sprintf(
+			"read_after_create is set for %s which has no operation to read it",
+			r.Names.Camel,
+		))
+	}
+	return true
+}
+
+// Parent returns the ParentConfig of the resource, if it is a child resource
+// of another resource of the API.
+func (r *CRD) Parent() *ackgenconfig.ParentConfig {
+	return r.cfg.GetParentConfig(r.Names.Original)
+}
+
+// GetParentReferenceField returns the reference field, e.g. "RouteTableRef",
+// of the Spec field holding the identifier of the resource's parent. It
+// panics if the resource is not a child resource or if the field does not
+// reference the parent resource.
+func (r *CRD) GetParentReferenceField() *Field {
+	parentCfg := r.Parent()
+	if parentCfg == nil {
+		panic(fmt.Sprintf("resource %q is not a child resource", r.Names.Original))
+	}
+	f, found := r.Fields[names.New(parentCfg.ReferenceField).Camel]
+	if !found || r.SpecFields[f.Names.Original] != f {
+		panic(fmt.Sprintf(
+			"unable to find reference_field %q of the parent of resource %q",
+			parentCfg.ReferenceField, r.Names.Original,
+		))
+	}
+	if !f.HasReference() || f.ShapeRef.Shape.Type == "list" ||
+		f.FieldConfig.References.Resource != parentCfg.Resource ||
+		f.FieldConfig.References.ServiceName != "" {
+		panic(fmt.Sprintf(
+			"reference_field %q of resource %q must reference its parent resource %q",
+			parentCfg.ReferenceField, r.Names.Original, parentCfg.Resource,
+		))
+	}
+	return r.Fields[f.GetReferenceFieldName().Camel]
+}
+
+// ParentIsClusterScoped returns true if the resource is a child resource whose
+// parent resource's CRD is cluster-scoped, see `scope`.
+func (r *CRD) ParentIsClusterScoped() bool {
+	parentCfg := r.Parent()
+	return parentCfg != nil &&
+		r.cfg.GetResourceScope(parentCfg.Resource) == ackgenconfig.ResourceScopeCluster
+}
+
+// IsOwnedByParent returns true if the custom resource of a child resource is
+// made owned by the custom resource of its parent. Kubernetes does not allow
+// a namespaced owner for a cluster-scoped custom resource.
+func (r *CRD) IsOwnedByParent() bool {
+	return r.Parent() != nil && (!r.IsClusterScoped() || r.ParentIsClusterScoped())
+}
+
+// HasAttachments returns true if the resource has Spec fields read and
+// written with their own operations, see `attachments`.
+func (r *CRD) HasAttachments() bool {
+	return len(r.cfg.GetAttachments(r.Names.Original)) > 0
+}
+
+// GetAttachmentFieldPaths returns the paths, including the Spec prefix, of
+// the fields read and written with their own operations, see `attachments`.
+func (r *CRD) GetAttachmentFieldPaths() []string {
+	specPrefix := strings.TrimPrefix(r.cfg.PrefixConfig.SpecField, ".")
+	paths := []string{}
+	for _, attCfg := range r.cfg.GetAttachments(r.Names.Original) {
+		paths = append(paths, specPrefix+"."+attCfg.Field)
+	}
+	return paths
+}
+
+// HasPreDelete returns true if the resource must be prepared, e.g. disabled,
+// before its Delete operation is called, see `pre_delete`.
+func (r *CRD) HasPreDelete() bool {
+	return r.cfg.GetPreDeleteConfig(r.Names.Original) != nil
+}
+
+// HasForceDelete returns true if users can force the deletion of the
+// resource, see `force_delete`.
+func (r *CRD) HasForceDelete() bool {
+	return r.cfg.GetForceDeleteConfig(r.Names.Original) != nil
+}
+
+// ForceDeleteWithAnnotation returns true if the deletion of the resource is
+// forced when it has the `<API group>/force-delete` annotation set to "true".
+func (r *CRD) ForceDeleteWithAnnotation() bool {
+	forceCfg := r.cfg.GetForceDeleteConfig(r.Names.Original)
+	return forceCfg != nil && forceCfg.Annotation
+}
+
+// HasCollections returns true if the resource has list Spec fields whose
+// members are added and removed with their own operations, see
+// `collections`.
+func (r *CRD) HasCollections() bool {
+	return len(r.cfg.GetCollections(r.Names.Original)) > 0
+}
+
+// GetCollectionFieldPaths returns the paths, including the Spec prefix, of
+// the list fields whose members are added and removed with their own
+// operations, see `collections`.
+func (r *CRD) GetCollectionFieldPaths() []string {
+	specPrefix := strings.TrimPrefix(r.cfg.PrefixConfig.SpecField, ".")
+	paths := []string{}
+	for _, colCfg := range r.cfg.GetCollections(r.Names.Original) {
+		paths = append(paths, specPrefix+"."+colCfg.Field)
+	}
+	return paths
+}
+
+// GetSubAPIFieldPaths returns the paths, including the Spec prefix, of the
+// fields written with their own operations rather than the resource's Update
+// operation, i.e. the attachment and collection fields.
+func (r *CRD) GetSubAPIFieldPaths() []string {
+	return append(r.GetAttachmentFieldPaths(), r.GetCollectionFieldPaths()...)
+}
+
+// SensitiveFieldPaths returns a sorted slice of the dotted paths, including
+// the Spec or Status prefix, of the top-level fields that are configured with
+// `is_sensitive` or that contain a nested field configured with
+// `is_sensitive`. Nested fields are reported via their top-level field because
+// the differences recorded for a struct, list or map field embed the values
+// of all of its members.
+func (r *CRD) SensitiveFieldPaths() []string {
+	seen := map[string]bool{}
+	paths := []string{}
+	for fPath, field := range r.Fields {
+		if !field.IsSensitive() {
+			continue
+		}
+		topFieldName := strings.Split(fPath, ".")[0]
+		prefix := r.cfg.PrefixConfig.StatusField
+		for _, specField := range r.SpecFields {
+			if specField.Names.Camel == topFieldName {
+				prefix = r.cfg.PrefixConfig.SpecField
+				break
+			}
+		}
+		path := strings.TrimPrefix(prefix+"."+topFieldName, ".")
+		if !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// MigratedSpecFields returns the Spec fields, sorted by name, with a
+// `migration` config accepting the values stored before the field's Go type or
+// name changed.
+func (r *CRD) MigratedSpecFields() []*Field {
+	fields := []*Field{}
+	for _, fieldName := range r.SpecFieldNames() {
+		f := r.SpecFields[fieldName]
+		if f.FieldConfig != nil && f.FieldConfig.Migration != nil {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// SpecFieldMigrationsParseStrings returns true if any migrated Spec field is
+// converted from or to a string from or to another scalar type, e.g. a *string
+// field that was an *int64.
+func (r *CRD) SpecFieldMigrationsParseStrings() bool {
+	for _, f := range r.MigratedSpecFields() {
+		previousType := f.PreviousGoType()
+		if previousType == "" || previousType == f.GoType {
+			continue
+		}
+		if strings.HasPrefix(previousType, "[]") || strings.HasPrefix(f.GoType, "[]") {
+			continue
+		}
+		if previousType == "*string" || f.GoType == "*string" {
+			return true
+		}
+	}
+	return false
+}
+
+// addDeprecatedSpecFields adds, for each Spec field with a
+// `migration.previous_name` config, a deprecated Spec field of the field's
+// previous name and type. Without it, the Kubernetes API server would prune
+// the values stored under the previous name before the controller could move
+// them to the field.
+func (r *CRD) addDeprecatedSpecFields() {
+	for _, f := range r.MigratedSpecFields() {
+		migrationCfg := f.FieldConfig.Migration
+		if migrationCfg.PreviousName == "" {
+			continue
+		}
+		if _, found := r.SpecFields[migrationCfg.PreviousName]; found {
+			msg := fmt.Sprintf(
+				"previous_name %s of field %s.%s is the name of another Spec field",
+				migrationCfg.PreviousName, r.Names.Original, f.Names.Original,
+			)
+			panic(msg)
+		}
+		shapeRef := f.ShapeRef
+		if migrationCfg.PreviousType != "" {
+			shapeRef = r.sdkAPI.GetShapeRefFromType(migrationCfg.PreviousType)
+			if shapeRef == nil {
+				msg := fmt.Sprintf(
+					"unsupported previous_type %s of field %s.%s",
+					migrationCfg.PreviousType, r.Names.Original, f.Names.Original,
+				)
+				panic(msg)
+			}
+		}
+		deprecatedShapeRef := *shapeRef
+		deprecatedShapeRef.Documentation = fmt.Sprintf(
+			"// Deprecated: use %s instead. Values set in this field are moved to\n// %s when the resource is read.",
+			f.Names.Camel, f.Names.Camel,
+		)
+		r.AddSpecField(names.New(migrationCfg.PreviousName), &deprecatedShapeRef)
+	}
+}
+
+// IsSingleton returns true if the resource is an account- or region-wide
+// settings resource, i.e. it has a `singleton` config.
+func (r *CRD) IsSingleton() bool {
+	return r.cfg.ResourceIsSingleton(r.Names.Original)
+}
+
+// GetResourceMarker returns the `+kubebuilder:resource` marker of the CRD's
+// root type, holding its short names, categories and scope, or an empty
+// string if the CRD has none of them.
+func (r *CRD) GetResourceMarker() string {
+	args := []string{}
+	if len(r.ShortNames) > 0 {
+		args = append(args, "shortName="+strings.Join(r.ShortNames, ";"))
+	}
+	if len(r.Categories) > 0 {
+		args = append(args, "categories="+strings.Join(r.Categories, ";"))
+	}
+	if r.IsClusterScoped() {
+		args = append(args, "scope="+ackgenconfig.ResourceScopeCluster)
+	}
+	if len(args) == 0 {
+		return ""
+	}
+	return "// +kubebuilder:resource:" + strings.Join(args, ",")
+}
+
+// GetScaleMarker returns the `+kubebuilder:subresource:scale` marker of the
+// CRD's root type, configured with `scale`, or an empty string if the CRD has
+// no scale subresource.
+func (r *CRD) GetScaleMarker() string {
+	scaleCfg := r.cfg.GetScaleConfig(r.Names.Original)
+	if scaleCfg == nil {
+		return ""
+	}
+	args := []string{
+		"specpath=" + r.scaleFieldJSONPath(r.SpecFields, ".spec", scaleCfg.SpecField, "integer", "long"),
+		"statuspath=" + r.scaleFieldJSONPath(r.StatusFields, ".status", scaleCfg.StatusField, "integer", "long"),
+	}
+	if scaleCfg.SelectorField != "" {
+		args = append(args,
+			"selectorpath="+r.scaleFieldJSONPath(r.StatusFields, ".status", scaleCfg.SelectorField, "string"),
+		)
+	}
+	return "// +kubebuilder:subresource:scale:" + strings.Join(args, ",")
+}
+
+// scaleFieldJSONPath returns the JSONPath, relative to the custom resource, of
+// the field with the supplied path in the supplied top-level fields. It
+// panics if the field does not exist or is not of one of the supplied shape
+// types.
+func (r *CRD) scaleFieldJSONPath(
+	fields map[string]*Field,
+	jsonPath string,
+	path string,
+	shapeTypes ...string,
+) string {
+	var field *Field
+	fp := fieldpath.FromString(path)
+	for fp.Size() > 0 {
+		field = fields[fp.PopFront()]
+		if field == nil {
+			panic(fmt.Sprintf(
+				"scale field %s of resource %s does not exist in %s",
+				path, r.Names.Original, jsonPath,
+			))
+		}
+		jsonPath += "." + field.Names.CamelLower
+		fields = field.MemberFields
+	}
+	if field.ShapeRef == nil || !util.InStrings(field.ShapeRef.Shape.Type, shapeTypes) {
+		panic(fmt.Sprintf(
+			"scale field %s of resource %s must be of type %s",
+			path, r.Names.Original, strings.Join(shapeTypes, " or "),
+		))
+	}
+	return jsonPath
+}
+
+// GetAdditionalMarkers returns the additional kubebuilder markers of the
+// CRD's root type, configured with `markers`.
+func (r *CRD) GetAdditionalMarkers() []string {
+	return formatMarkers(
+		r.cfg.GetResourceMarkers(r.Names.Original),
+		"resource "+r.Names.Original,
+	)
+}
+
+// formatMarkers returns the supplied raw kubebuilder markers as Go comments,
+// accepting markers with or without their leading "//". It panics if a marker
+// does not start with '+', mentioning the supplied owner of the markers.
+func formatMarkers(rawMarkers []string, owner string) []string {
+	markers := []string{}
+	for _, marker := range rawMarkers {
+		marker = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(marker), "//"))
+		if !strings.HasPrefix(marker, "+") {
+			panic(fmt.Sprintf(
+				"invalid marker %q for %s, markers must start with '+'",
+				marker, owner,
+			))
+		}
+		markers = append(markers, "// "+marker)
+	}
+	return markers
+}
+
+// IsClusterScoped returns true if the resource's CRD is cluster-scoped, i.e.
+// it has a `scope: Cluster` config.
+func (r *CRD) IsClusterScoped() bool {
+	switch scope := r.cfg.GetResourceScope(r.Names.Original); scope {
+	case "", ackgenconfig.ResourceScopeNamespaced:
+		return false
+	case ackgenconfig.ResourceScopeCluster:
+		return true
+	default:
+		panic(fmt.Sprintf(
+			"unsupported scope %s for resource %s, must be %s or %s",
+			scope, r.Names.Original,
+			ackgenconfig.ResourceScopeNamespaced, ackgenconfig.ResourceScopeCluster,
+		))
+	}
+}
+
+// SingletonResetsOnDelete returns true if deleting the custom resource of a
+// singleton resource with no Delete operation resets its settings to their
+// default values by calling the Create operation.
+func (r *CRD) SingletonResetsOnDelete() bool {
+	singletonCfg := r.cfg.GetSingletonConfig(r.Names.Original)
+	if singletonCfg == nil || r.Ops.Delete != nil {
+		return false
+	}
+	switch singletonCfg.OnDelete {
+	case "", ackgenconfig.SingletonOnDeleteNoop:
+		return false
+	case ackgenconfig.SingletonOnDeleteReset:
+		if len(singletonCfg.ResetValues) == 0 {
+			msg := fmt.Sprintf(
+				"singleton resource %s resets on delete but has no reset_values",
+				r.Names.Original,
+			)
+			panic(msg)
+		}
+		return true
+	default:
+		msg := fmt.Sprintf(
+			"unknown singleton on_delete %q for resource %s: expected %q or %q",
+			singletonCfg.OnDelete, r.Names.Original,
+			ackgenconfig.SingletonOnDeleteNoop, ackgenconfig.SingletonOnDeleteReset,
+		)
+		panic(msg)
+	}
+}
+
+// LogsSDKPayloads returns true if the resource manager logs the payloads of
+// its AWS API calls, i.e. the resource has a `logging.log_sdk_payloads`
+// config.
+func (r *CRD) LogsSDKPayloads() bool {
+	loggingCfg := r.cfg.GetLoggingConfig(r.Names.Original)
+	return loggingCfg != nil && loggingCfg.LogSDKPayloads
+}
+
+// RedactsAllSDKPayloads returns true if the payloads of the resource
+// manager's AWS API calls are redacted as a whole when logged.
+func (r *CRD) RedactsAllSDKPayloads() bool {
+	loggingCfg := r.cfg.GetLoggingConfig(r.Names.Original)
+	return loggingCfg != nil && loggingCfg.RedactAll
+}
+
+// RedactedSDKMemberNames returns a sorted slice of the AWS API member names of
+// the resource's fields, at any depth, that are configured with `is_secret`
+// or `is_sensitive` or that the AWS API model marks as sensitive. The values of these members are redacted from logged
+// AWS API payloads.
+func (r *CRD) RedactedSDKMemberNames() []string {
+	seen := map[string]bool{}
+	memberNames := []string{}
+	for _, field := range r.Fields {
+		// Members the AWS API model marks as sensitive are redacted as well
+		sdkSensitive := field.ShapeRef != nil && field.ShapeRef.Shape != nil &&
+			field.ShapeRef.Shape.Sensitive
+		if !sdkSensitive && (field.FieldConfig == nil ||
+			!(field.FieldConfig.IsSecret || field.FieldConfig.IsSensitive)) {
+			continue
+		}
+		candidates := []string{field.Names.Original}
+		// Renamed fields are named differently in the AWS API payloads
+		for _, opType := range []OpType{OpTypeCreate, OpTypeGet, OpTypeUpdate, OpTypeDelete} {
+			for sdkName, crdName := range r.GetAllRenames(opType) {
+				if crdName == field.Names.Original {
+					candidates = append(candidates, sdkName)
+				}
+			}
+		}
+		for _, memberName := range candidates {
+			if !seen[memberName] {
+				seen[memberName] = true
+				memberNames = append(memberNames, memberName)
+			}
+		}
+	}
+	sort.Strings(memberNames)
+	return memberNames
+}
+
+// ReadManySourcedFields returns the Status fields, sorted by name, whose
+// values are sourced, via a `from` config, from the ReadMany operation's
+// Output shape while the resource is read with its ReadOne or GetAttributes
+// operation. Some AWS APIs only return some attributes of a resource, e.g.
+// counts or timestamps, in their List operation.
+func (r *CRD) ReadManySourcedFields() []*Field {
+	if r.Ops.ReadMany == nil || (r.Ops.ReadOne == nil && r.Ops.GetAttributes == nil) {
+		return nil
+	}
+	fieldNames := []string{}
+	for fieldName, f := range r.StatusFields {
+		if f.FieldConfig != nil && f.FieldConfig.From != nil &&
+			f.FieldConfig.From.Operation == r.Ops.ReadMany.ExportedName {
+			fieldNames = append(fieldNames, fieldName)
+		}
+	}
+	sort.Strings(fieldNames)
+	fields := make([]*Field, 0, len(fieldNames))
+	for _, fieldName := range fieldNames {
+		fields = append(fields, r.StatusFields[fieldName])
+	}
+	return fields
+}
+
+// HasSetCompareFields returns true if any of the resource's list fields are
+// compared with set semantics, i.e. have a `compare.is_set` FieldConfig.
+func (r *CRD) HasSetCompareFields() bool {
+	for _, fConfig := range r.cfg.GetFieldConfigs(r.Names.Original) {
+		if fConfig.Compare != nil && fConfig.Compare.IsSet {
+			return true
+		}
+	}
+	return false
+}
+
+// HasNilEqualsEmptyCompareFields returns true if any of the resource's slice or
+// map fields consider nil and empty collections equal, i.e. have a
+// `compare.nil_equals_empty` FieldConfig.
+func (r *CRD) HasNilEqualsEmptyCompareFields() bool {
+	for _, fConfig := range r.cfg.GetFieldConfigs(r.Names.Original) {
+		if fConfig.Compare != nil && fConfig.Compare.NilEqualsEmpty {
+			return true
+		}
+	}
+	return false
+}
+
+// HasListLimitFields returns true if any of the resource's list Status fields
+// is capped, i.e. has a `list_limit` FieldConfig.
+func (r *CRD) HasListLimitFields() bool {
+	for _, fConfig := range r.cfg.GetFieldConfigs(r.Names.Original) {
+		if fConfig.ListLimit != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTimestampStringFields returns true if any of the resource's Spec or
+// Status fields is a string timestamp of the AWS API parsed into a
+// metav1.Time, i.e. has a `timestamp` FieldConfig.
+func (r *CRD) HasTimestampStringFields() bool {
+	for _, f := range r.Fields {
+		if f.GetTimestampLayout() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasQuantityFields returns true if any of the resource's Spec or Status
+// fields is a size of the AWS API converted into a resource.Quantity, i.e. has
+// a `quantity` FieldConfig.
+func (r *CRD) HasQuantityFields() bool {
+	for _, f := range r.Fields {
+		if size, _ := f.GetQuantityUnitSize(); size != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasJSONValueFields returns true if any of the resource's fields, including
+// nested ones, is backed by an aws.JSONValue member of the AWS API and is
+// therefore a runtime.RawExtension.
+func (r *CRD) HasJSONValueFields() bool {
+	for _, f := range r.Fields {
+		if f.IsJSONValue() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasJSONCompareFields returns true if any of the resource's fields hold JSON
+// documents compared semantically, i.e. have a `compare.is_json` FieldConfig,
+// are attribute fields with a "json" `attribute_type` or are backed by an
+// aws.JSONValue member of the AWS API.
+func (r *CRD) HasJSONCompareFields() bool {
+	if r.HasJSONValueFields() {
+		return true
+	}
+	for _, fConfig := range r.cfg.GetFieldConfigs(r.Names.Original) {
+		if fConfig.Compare != nil && fConfig.Compare.IsJSON {
+			return true
+		}
+		if fConfig.IsAttribute && fConfig.AttributeType == ackgenconfig.AttributeTypeJSON {
+			return true
+		}
+	}
+	return false
+}
+
+// HasSensitiveFields returns true if any of the resource's fields are
+// configured with `is_sensitive`.
+func (r *CRD) HasSensitiveFields() bool {
+	return len(r.SensitiveFieldPaths()) > 0
+}
+
+// SecretOutputFields returns a slice, sorted by name, of the Status fields
+// whose values are written to a Kubernetes Secret.
+func (r *CRD) SecretOutputFields() []*Field {
+	fields := []*Field{}
+	for _, f := range r.StatusFields {
+		if f.WritesToSecret() {
+			fields = append(fields, f)
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Names.Camel < fields[j].Names.Camel
+	})
+	return fields
+}
+
+// FieldsFromARN returns a slice, sorted by name, of the fields whose values
+// can be parsed from the ARN of the resource.
+func (r *CRD) FieldsFromARN() []*Field {
+	fields := []*Field{}
+	for _, f := range r.Fields {
+		if f.GetFromARNPattern() != "" {
+			fields = append(fields, f)
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Names.Camel < fields[j].Names.Camel
+	})
+	return fields
+}
+
+// HasFieldsFromARN returns true if the values of any of the resource's fields
+// can be parsed from the ARN of the resource.
+func (r *CRD) HasFieldsFromARN() bool {
+	return len(r.FieldsFromARN()) > 0
+}
+
+// HasMiddleware returns true if the resource manager's calls to the AWS
+// service API are routed through a middleware chain.
+func (r *CRD) HasMiddleware() bool {
+	return len(r.cfg.GetMiddleware(r.Names.Original)) > 0
+}
+
+// ConditionsConfig returns the conditions management configured for the
+// resource, or nil if the resource's Status.Conditions are left as-is.
+func (r *CRD) ConditionsConfig() *ackgenconfig.ConditionsConfig {
+	return r.cfg.GetConditionsConfig(r.Names.Original)
+}
+
+// UsesBuiltinMiddleware returns true if the supplied builtin middleware is
+// part of the resource's middleware chain.
+func (r *CRD) UsesBuiltinMiddleware(name string) bool {
+	return r.BuiltinMiddleware(name) != nil
+}
+
+// BuiltinMiddleware returns the config of the supplied builtin middleware in
+// the resource's middleware chain, or nil if it is not part of the chain.
+func (r *CRD) BuiltinMiddleware(name string) *ackgenconfig.MiddlewareConfig {
+	for _, mwCfg := range r.cfg.GetMiddleware(r.Names.Original) {
+		if mwCfg.Builtin == name {
+			return mwCfg
+		}
+	}
+	return nil
+}
+
 // ListOpMatchFieldNames returns a slice of strings representing the field
 // names in the List operation's Output shape's element Shape that we should
 // check a corresponding value in the target Spec exists.
@@ -732,6 +2426,116 @@ func (r *CRD) ListOpMatchFieldNames() []string {
 	return r.cfg.GetListOpMatchFieldNames(r.Names.Original)
 }
 
+// readManyPaginationTokens lists the pairs of ReadMany Input and Output shape
+// members holding the token of the next page of results
+var readManyPaginationTokens = [][2]string{
+	{"NextToken", "NextToken"},
+	{"Marker", "NextMarker"},
+	{"Marker", "Marker"},
+	{"ContinuationToken", "NextContinuationToken"},
+}
+
+// readManyPageSizeMembers lists the ReadMany Input shape members holding the
+// maximum number of results returned by each call
+var readManyPageSizeMembers = []string{
+	"MaxResults",
+	"MaxRecords",
+	"MaxItems",
+	"Limit",
+}
+
+// ReadManyPaginationTokens returns the names of the members of the ReadMany
+// Input and Output shapes holding the token of the next page of results, or
+// empty strings if the ReadMany operation is not paginated.
+func (r *CRD) ReadManyPaginationTokens() (inputToken string, outputToken string) {
+	return r.PaginationTokens(r.Ops.ReadMany)
+}
+
+// PaginationTokens returns the names of the members of the supplied
+// operation's Input and Output shapes holding the token of the next page of
+// results, or empty strings if the operation is not paginated.
+func (r *CRD) PaginationTokens(
+	op *awssdkmodel.Operation,
+) (inputToken string, outputToken string) {
+	if op == nil || op.InputRef.Shape == nil || op.OutputRef.Shape == nil {
+		return "", ""
+	}
+	for _, tokens := range readManyPaginationTokens {
+		_, inInput := op.InputRef.Shape.MemberRefs[tokens[0]]
+		_, inOutput := op.OutputRef.Shape.MemberRefs[tokens[1]]
+		if inInput && inOutput {
+			return tokens[0], tokens[1]
+		}
+	}
+	return "", ""
+}
+
+// ReadManyPageSizeMemberName returns the name of the member of the ReadMany
+// Input shape holding the maximum number of results returned by each call, or
+// an empty string if there is none.
+func (r *CRD) ReadManyPageSizeMemberName() string {
+	op := r.Ops.ReadMany
+	if op == nil || op.InputRef.Shape == nil {
+		return ""
+	}
+	for _, memberName := range readManyPageSizeMembers {
+		if _, found := op.InputRef.Shape.MemberRefs[memberName]; found {
+			return memberName
+		}
+	}
+	return ""
+}
+
+// ListOpFilters returns the mappings of the resource's fields to the names of
+// the filters of the List operation.
+func (r *CRD) ListOpFilters() []ackgenconfig.ListFilterConfig {
+	return r.cfg.GetListOpFilters(r.Names.Original)
+}
+
+// ListOpPageSize returns the maximum number of results returned by each call
+// to the List operation, or 0 if the service default applies.
+func (r *CRD) ListOpPageSize() int {
+	return r.cfg.GetListOpPageSize(r.Names.Original)
+}
+
+// ListOpPredicates returns the conditions on the List operation's Output
+// shape's element Shape that must hold for an element to describe the
+// resource.
+func (r *CRD) ListOpPredicates() []ackgenconfig.ListPredicateConfig {
+	return r.cfg.GetListOpPredicates(r.Names.Original)
+}
+
+// defaultMatchTagKey is the key of the identity tag locating a resource in the
+// List operation's Output shape when no `key` is configured
+const defaultMatchTagKey = "services.k8s.aws/namespaced-name"
+
+// ListOpMatchTag returns the identity tag used to locate the resource in the
+// List operation's Output shape, with defaults applied, or nil if the
+// resource is not located by tag.
+func (r *CRD) ListOpMatchTag() *ackgenconfig.ListMatchTagConfig {
+	matchTagCfg := r.cfg.GetListOpMatchTag(r.Names.Original)
+	if matchTagCfg == nil {
+		return nil
+	}
+	if r.Ops.ReadMany == nil {
+		panic(fmt.Sprintf(
+			"list_operation.match_tag is configured for %s but the resource has no List operation",
+			r.Names.Original,
+		))
+	}
+	if tagField, err := r.GetTagField(); err != nil || tagField == nil {
+		panic(fmt.Sprintf(
+			"list_operation.match_tag is configured for %s but the resource has no tag field",
+			r.Names.Original,
+		))
+	}
+	res := *matchTagCfg
+	if res.Key == "" {
+		res.Key = defaultMatchTagKey
+	}
+	return &res
+}
+
 // GetAllRenames returns all the field renames observed in the generator config
 // for a given OpType.
 func (r *CRD) GetAllRenames(op OpType) map[string]string {
@@ -856,6 +2660,47 @@ func (r *CRD) ReferencedServiceNames() (serviceNames []string) {
 	return serviceNames
 }
 
+// DeltaFieldPaths returns the paths, starting with the Spec prefix, of the
+// Spec fields and nested struct members the generated delta can record a
+// difference for, deepest paths first.
+func (r *CRD) DeltaFieldPaths() []string {
+	specPrefix := strings.TrimPrefix(r.cfg.PrefixConfig.SpecField, ".")
+	paths := []string{}
+	var addPaths func(path string, shape *awssdkmodel.Shape, seen map[string]bool)
+	addPaths = func(path string, shape *awssdkmodel.Shape, seen map[string]bool) {
+		paths = append(paths, path)
+		if shape == nil || shape.Type != "structure" || seen[shape.ShapeName] {
+			return
+		}
+		seen[shape.ShapeName] = true
+		for _, memberName := range shape.MemberNames() {
+			memberNames := names.New(memberName)
+			addPaths(
+				path+"."+memberNames.Camel,
+				shape.MemberRefs[memberName].Shape,
+				seen,
+			)
+		}
+		delete(seen, shape.ShapeName)
+	}
+	for _, f := range r.SpecFields {
+		var shape *awssdkmodel.Shape
+		if f.ShapeRef != nil {
+			shape = f.ShapeRef.Shape
+		}
+		addPaths(specPrefix+"."+f.Names.Camel, shape, map[string]bool{})
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		di := strings.Count(paths[i], ".")
+		dj := strings.Count(paths[j], ".")
+		if di != dj {
+			return di > dj
+		}
+		return paths[i] < paths[j]
+	})
+	return paths
+}
+
 // SortedFieldNames returns the fieldNames of the CRD in a sorted
 // order.
 func (r *CRD) SortedFieldNames() []string {
@@ -892,5 +2737,6 @@ func NewCRD(
 		StatusFields:             map[string]*Field{},
 		Fields:                   map[string]*Field{},
 		ShortNames:               cfg.GetResourceShortNames(kind),
+		Categories:               cfg.GetResourceCategories(kind),
 	}
 }
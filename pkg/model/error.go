@@ -13,12 +13,86 @@
 
 package model
 
+import (
+	"fmt"
+	"sort"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+)
+
 // TerminalExceptionCodes returns terminal exception codes as
 // []string for custom resource
 func (r *CRD) TerminalExceptionCodes() []string {
 	return r.cfg.GetTerminalExceptionCodes(r.Names.Original)
 }
 
+// TerminalExceptionMessages returns the matchers of the terminal exception
+// messages for custom resource
+func (r *CRD) TerminalExceptionMessages() []ackgenconfig.TerminalMessageConfig {
+	return r.cfg.GetTerminalExceptionMessages(r.Names.Original)
+}
+
+// HasTerminalExceptionMessageRegexes returns true if any of the terminal
+// exception messages for custom resource is matched with a regular expression
+func (r *CRD) HasTerminalExceptionMessageRegexes() bool {
+	for _, matcher := range r.TerminalExceptionMessages() {
+		if matcher.MessageRegex != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteNotFoundCodes returns the exception codes returned by the Delete
+// operation of custom resource when it is already gone
+func (r *CRD) DeleteNotFoundCodes() []string {
+	return r.cfg.GetDeleteNotFoundCodes(r.Names.Original)
+}
+
+// DeleteInProgressCodes returns the exception codes returned by the Delete
+// operation of custom resource when it is already being deleted
+func (r *CRD) DeleteInProgressCodes() []string {
+	return r.cfg.GetDeleteInProgressCodes(r.Names.Original)
+}
+
+// ErrorCondition is the reason and message of the condition set for an aws
+// exception code
+type ErrorCondition struct {
+	// Code is the aws exception code
+	Code string
+	// Reason is the reason of the condition
+	Reason string
+	// Message is the human-friendly message of the condition, possibly empty
+	Message string
+}
+
+// ErrorConditions returns the reasons and messages of the conditions set for
+// aws exception codes for custom resource, sorted by exception code
+func (r *CRD) ErrorConditions() []ErrorCondition {
+	errConds := r.cfg.GetErrorConditions(r.Names.Original)
+	codes := make([]string, 0, len(errConds))
+	for code := range errConds {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	res := make([]ErrorCondition, 0, len(codes))
+	for _, code := range codes {
+		errCond := errConds[code]
+		if errCond.Reason == "" {
+			panic(fmt.Sprintf(
+				"error_conditions entry %q of resource %q must have a reason",
+				code, r.Names.Original,
+			))
+		}
+		res = append(res, ErrorCondition{
+			Code:    code,
+			Reason:  errCond.Reason,
+			Message: errCond.Message,
+		})
+	}
+	return res
+}
+
 // ExceptionCode returns the name of the resource's Exception code for the
 // Exception having the exception code. If the generator config has
 // instructions for overriding the name of an exception code for a resource for
@@ -15,6 +15,7 @@ package model
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/aws-controllers-k8s/pkg/names"
@@ -33,6 +34,28 @@ var simpleStringShapeRef *awssdkmodel.ShapeRef = &awssdkmodel.ShapeRef{
 	},
 }
 
+// simpleBoolShapeRef, simpleLongShapeRef and simpleDurationShapeRef are used
+// for attribute fields whose values are coerced with `attribute_type`. The
+// "duration" shape type does not exist in the AWS API models and is only used
+// to compare metav1.Duration values.
+var (
+	simpleBoolShapeRef *awssdkmodel.ShapeRef = &awssdkmodel.ShapeRef{
+		Shape: &awssdkmodel.Shape{
+			Type: "boolean",
+		},
+	}
+	simpleLongShapeRef *awssdkmodel.ShapeRef = &awssdkmodel.ShapeRef{
+		Shape: &awssdkmodel.Shape{
+			Type: "long",
+		},
+	}
+	simpleDurationShapeRef *awssdkmodel.ShapeRef = &awssdkmodel.ShapeRef{
+		Shape: &awssdkmodel.Shape{
+			Type: "duration",
+		},
+	}
+)
+
 // Field represents a single field in the CRD's Spec or Status objects. The
 // field may be a direct field of the Spec or Status object or may be a field
 // of a list or struct-type field of the Spec or Status object. We call these
@@ -175,6 +198,10 @@ func (f *Field) IsRequired() bool {
 	if f.FieldConfig != nil && f.FieldConfig.IsRequired != nil {
 		return *f.FieldConfig.IsRequired
 	}
+	if f.CRD.Ops.Create == nil {
+		// Observe-only resources without a Create operation
+		return false
+	}
 	// We need to look up the original member name in the input struct
 	// otherwise renamed fields will not be discovered as required.
 	originalMember := f.CRD.Config().GetOriginalMemberName(
@@ -209,8 +236,12 @@ func (f *Field) GetSetterConfig(opType OpType) *ackgenconfig.SetFieldConfig {
 }
 
 // GetFieldDocsConfig returns the field documentation configuration for the
-// current field if it exists, otherwise it returns nil.
+// current field if it exists, otherwise it returns nil. The `documentation`
+// FieldConfig takes precedence over the documentation config file.
 func (f *Field) GetFieldDocsConfig() *ackgenconfig.FieldDocsConfig {
+	if f.FieldConfig != nil && f.FieldConfig.Documentation != nil {
+		return f.FieldConfig.Documentation
+	}
 	resourceConfig, exists := f.CRD.docCfg.Resources[f.CRD.Names.Camel]
 	if !exists {
 		return nil
@@ -260,6 +291,324 @@ func (f *Field) HasReference() bool {
 	return f.FieldConfig != nil && f.FieldConfig.References != nil
 }
 
+// HasKMSKeyConfig returns true if the supplied field contains a KMS Key
+// identifier used for encryption at rest, i.e. has a 'KMSKeyConfig'.
+func (f *Field) HasKMSKeyConfig() bool {
+	return f.FieldConfig != nil && f.FieldConfig.KMSKey != nil
+}
+
+// GetFromARNPattern returns the regular expression used to parse the value of
+// the field from the ARN of the resource, or an empty string if the value of
+// the field cannot be derived from the ARN.
+func (f *Field) GetFromARNPattern() string {
+	if f.FieldConfig == nil || f.FieldConfig.FromARN == nil {
+		return ""
+	}
+	return f.FieldConfig.FromARN.Pattern
+}
+
+// IsSensitive returns true if the supplied field's value must be redacted
+// from debug logs and delta dumps.
+func (f *Field) IsSensitive() bool {
+	return f.FieldConfig != nil && f.FieldConfig.IsSensitive
+}
+
+// IsPassthrough returns true if the supplied field is a Spec field that is
+// never set from or into an SDK shape, i.e. has an `is_passthrough`
+// FieldConfig.
+func (f *Field) IsPassthrough() bool {
+	return f.FieldConfig != nil && f.FieldConfig.IsPassthrough
+}
+
+// WritesToSecret returns true if the supplied field's value, returned by the
+// AWS service, is written into a Kubernetes Secret, i.e. has a
+// `write_to_secret` FieldConfig.
+func (f *Field) WritesToSecret() bool {
+	return f.FieldConfig != nil && f.FieldConfig.WriteToSecret
+}
+
+// GetListLimitConfig returns the `list_limit` configuration of the Field, if
+// any.
+func (f *Field) GetListLimitConfig() *ackgenconfig.ListLimitConfig {
+	if f.FieldConfig == nil {
+		return nil
+	}
+	return f.FieldConfig.ListLimit
+}
+
+// transformUnits contains, keyed by unit, the dimension of the units supported
+// by `transform.unit` and their size in the dimension's smallest unit
+var transformUnits = map[string]struct {
+	dimension string
+	size      int64
+}{
+	"B":   {"bytes", 1},
+	"KB":  {"bytes", 1000},
+	"MB":  {"bytes", 1000 * 1000},
+	"GB":  {"bytes", 1000 * 1000 * 1000},
+	"TB":  {"bytes", 1000 * 1000 * 1000 * 1000},
+	"KiB": {"bytes", 1 << 10},
+	"MiB": {"bytes", 1 << 20},
+	"GiB": {"bytes", 1 << 30},
+	"TiB": {"bytes", 1 << 40},
+	"ms":  {"duration", 1},
+	"s":   {"duration", 1000},
+	"min": {"duration", 60 * 1000},
+	"h":   {"duration", 60 * 60 * 1000},
+	"d":   {"duration", 24 * 60 * 60 * 1000},
+}
+
+// GetTransformFactor returns the factor converting the Field's API value into
+// its CRD value, configured with `transform`: the CRD value is the API value
+// multiplied by the factor. The CRD's unit must not be coarser than the API's
+// unit, so that every API value has an exact CRD value and the two round-trip
+// without drift. Returns 1 if the Field's value is not transformed.
+func (f *Field) GetTransformFactor() int64 {
+	if f.FieldConfig == nil || f.FieldConfig.Transform == nil {
+		return 1
+	}
+	if f.ShapeRef == nil || f.ShapeRef.Shape == nil ||
+		(f.ShapeRef.Shape.Type != "integer" && f.ShapeRef.Shape.Type != "long") {
+		panic(fmt.Sprintf(
+			"transform is only supported for integer fields, but %s.%s is not",
+			f.CRD.Names.Original, f.Path,
+		))
+	}
+	transformCfg := f.FieldConfig.Transform
+	switch {
+	case transformCfg.Multiply > 0 && transformCfg.Unit == nil:
+		return transformCfg.Multiply
+	case transformCfg.Multiply == 0 && transformCfg.Unit != nil:
+		apiUnit, apiFound := transformUnits[transformCfg.Unit.API]
+		crdUnit, crdFound := transformUnits[transformCfg.Unit.CRD]
+		if !apiFound || !crdFound || apiUnit.dimension != crdUnit.dimension ||
+			apiUnit.size%crdUnit.size != 0 {
+			panic(fmt.Sprintf(
+				"unsupported transform of %s.%s from %s to %s: the CRD's unit "+
+					"must divide the API's unit",
+				f.CRD.Names.Original, f.Path,
+				transformCfg.Unit.API, transformCfg.Unit.CRD,
+			))
+		}
+		return apiUnit.size / crdUnit.size
+	}
+	panic(fmt.Sprintf(
+		"transform of %s.%s must set one of a positive multiply or unit",
+		f.CRD.Names.Original, f.Path,
+	))
+}
+
+// GetQuantityUnitSize returns the size, in bytes, of the unit of the Field's
+// value in the AWS API when the Field is a size configured with `quantity`,
+// or 0. binary is true for the binary units, whose quantities are formatted
+// with the binary SI suffixes (Ki, Mi, Gi...).
+func (f *Field) GetQuantityUnitSize() (size int64, binary bool) {
+	if f.FieldConfig == nil || f.FieldConfig.Quantity == nil {
+		return 0, false
+	}
+	if f.ShapeRef == nil || f.ShapeRef.Shape == nil ||
+		(f.ShapeRef.Shape.Type != "integer" && f.ShapeRef.Shape.Type != "long") {
+		panic(fmt.Sprintf(
+			"quantity is only supported for integer fields, but %s.%s is not",
+			f.CRD.Names.Original, f.Path,
+		))
+	}
+	unitName := f.FieldConfig.Quantity.Unit
+	unit, found := transformUnits[unitName]
+	if !found || unit.dimension != "bytes" {
+		panic(fmt.Sprintf(
+			"unsupported quantity unit %s for %s.%s",
+			unitName, f.CRD.Names.Original, f.Path,
+		))
+	}
+	return unit.size, unitName == "B" || strings.HasSuffix(unitName, "iB")
+}
+
+// IsTypedEnum returns true if the Field holds an enum string and is typed
+// with the Go string alias generated for the enum, see `typed_enums`.
+func (f *Field) IsTypedEnum() bool {
+	if f.ShapeRef == nil || f.ShapeRef.Shape == nil {
+		return false
+	}
+	return isTypedEnum(f.CRD, f.Path, f.ShapeRef.Shape)
+}
+
+// GetEnumValues returns the valid values of a Field typed with the Go string
+// alias of its enum, or nil.
+func (f *Field) GetEnumValues() []string {
+	if !f.IsTypedEnum() {
+		return nil
+	}
+	return f.ShapeRef.Shape.Enum
+}
+
+// GetEnumMarker returns the `+kubebuilder:validation:Enum` marker validating
+// the value of a Field typed with the Go string alias of its enum, or an
+// empty string. Values are quoted so that numeric looking values remain
+// strings. The marker is only emitted for Spec fields.
+func (f *Field) GetEnumMarker() string {
+	values := f.GetEnumValues()
+	if len(values) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return "// +kubebuilder:validation:Enum=" + strings.Join(quoted, ";")
+}
+
+// IsJSONValue returns true if the Field is backed by an aws.JSONValue member
+// of the AWS API, which holds an arbitrary JSON document. Such fields are
+// runtime.RawExtension in the CRD, and the unknown fields of the document are
+// preserved by the API server.
+func (f *Field) IsJSONValue() bool {
+	return f.ShapeRef != nil && f.ShapeRef.JSONValue
+}
+
+// GetServerSideApplyMarkers returns the `+listType`, `+listMapKey` and
+// `+mapType` markers instructing server-side apply how to merge the Field, as
+// configured with `server_side_apply`.
+func (f *Field) GetServerSideApplyMarkers() []string {
+	if f.FieldConfig == nil || f.FieldConfig.ServerSideApply == nil {
+		return nil
+	}
+	ssaCfg := f.FieldConfig.ServerSideApply
+	var shape *awssdkmodel.Shape
+	if f.ShapeRef != nil {
+		shape = f.ShapeRef.Shape
+	}
+	markers := []string{}
+	if ssaCfg.ListType != "" {
+		if shape == nil || shape.Type != "list" {
+			panic(fmt.Sprintf(
+				"server_side_apply.list_type is only supported for list fields, but %s.%s is not",
+				f.CRD.Names.Original, f.Path,
+			))
+		}
+		elemShape := shape.MemberRef.Shape
+		switch ssaCfg.ListType {
+		case "atomic":
+		case "set":
+			if elemShape.Type == "structure" {
+				panic(fmt.Sprintf(
+					"server_side_apply.list_type set is only supported for lists of scalars, but %s.%s is a list of structs",
+					f.CRD.Names.Original, f.Path,
+				))
+			}
+		case "map":
+			if elemShape.Type != "structure" || len(ssaCfg.ListMapKeys) == 0 {
+				panic(fmt.Sprintf(
+					"server_side_apply.list_type map requires a list of structs and list_map_keys, but %s.%s has neither",
+					f.CRD.Names.Original, f.Path,
+				))
+			}
+		default:
+			panic(fmt.Sprintf(
+				"unsupported server_side_apply.list_type %s for %s.%s",
+				ssaCfg.ListType, f.CRD.Names.Original, f.Path,
+			))
+		}
+		markers = append(markers, "// +listType="+ssaCfg.ListType)
+		if ssaCfg.ListType == "map" {
+			for _, key := range ssaCfg.ListMapKeys {
+				if _, found := elemShape.MemberRefs[key]; !found {
+					panic(fmt.Sprintf(
+						"list map key %s is not a member of the elements of %s.%s",
+						key, f.CRD.Names.Original, f.Path,
+					))
+				}
+				markers = append(markers, "// +listMapKey="+names.New(key).CamelLower)
+			}
+		}
+	}
+	if ssaCfg.MapType != "" {
+		if shape == nil || shape.Type != "map" {
+			panic(fmt.Sprintf(
+				"server_side_apply.map_type is only supported for map fields, but %s.%s is not",
+				f.CRD.Names.Original, f.Path,
+			))
+		}
+		if ssaCfg.MapType != "atomic" && ssaCfg.MapType != "granular" {
+			panic(fmt.Sprintf(
+				"unsupported server_side_apply.map_type %s for %s.%s",
+				ssaCfg.MapType, f.CRD.Names.Original, f.Path,
+			))
+		}
+		markers = append(markers, "// +mapType="+ssaCfg.MapType)
+	}
+	return markers
+}
+
+// GetMarkers returns the kubebuilder markers of the Field that are not
+// derived from its shape: the server-side apply markers followed by the
+// additional markers configured with `markers`.
+func (f *Field) GetMarkers() []string {
+	markers := f.GetServerSideApplyMarkers()
+	if f.FieldConfig == nil || len(f.FieldConfig.Markers) == 0 {
+		return markers
+	}
+	return append(markers, formatMarkers(
+		f.FieldConfig.Markers,
+		"field "+f.CRD.Names.Original+"."+f.Path,
+	)...)
+}
+
+// defaultTimestampLayout is the layout of the string timestamps configured
+// with `timestamp` that do not specify a layout. It is the same as
+// time.RFC3339.
+const defaultTimestampLayout = "2006-01-02T15:04:05Z07:00"
+
+// GetTimestampLayout returns the Go reference time layout of the Field's
+// string value in the AWS API when the Field is a timestamp configured with
+// `timestamp`, or an empty string.
+func (f *Field) GetTimestampLayout() string {
+	if f.FieldConfig == nil || f.FieldConfig.Timestamp == nil {
+		return ""
+	}
+	if f.ShapeRef == nil || f.ShapeRef.Shape == nil ||
+		f.ShapeRef.Shape.Type != "string" {
+		panic(fmt.Sprintf(
+			"timestamp is only supported for string fields, but %s.%s is not",
+			f.CRD.Names.Original, f.Path,
+		))
+	}
+	if f.FieldConfig.Timestamp.Layout != "" {
+		return f.FieldConfig.Timestamp.Layout
+	}
+	return defaultTimestampLayout
+}
+
+// PreviousGoType returns the Go type the Field had before its type changed,
+// configured with `migration.previous_type`, or an empty string. Scalar types
+// are returned as pointers, like the Go types of Spec fields.
+func (f *Field) PreviousGoType() string {
+	if f.FieldConfig == nil || f.FieldConfig.Migration == nil {
+		return ""
+	}
+	previousType := f.FieldConfig.Migration.PreviousType
+	if previousType == "" ||
+		strings.HasPrefix(previousType, "[]") ||
+		strings.HasPrefix(previousType, "map[") ||
+		strings.HasPrefix(previousType, "*") {
+		return previousType
+	}
+	return "*" + previousType
+}
+
+// GetSecretRefFieldName returns the name of the Spec field identifying the
+// Secret that a `write_to_secret` field's value is written to.
+func (f *Field) GetSecretRefFieldName() names.Names {
+	return names.New(f.Names.Original + "SecretRef")
+}
+
+// IsSecretRef returns true if the Field is a Spec field generated by ACK
+// code-generator to identify the Secret a `write_to_secret` field's value is
+// written to.
+func (f *Field) IsSecretRef() bool {
+	return f.ShapeRef == nil && f.GoType == "*ackv1alpha1.SecretKeyReference"
+}
+
 // IsReference returns true if the Field has type '*ackv1alpha1.AWSResourceReferenceWrapper'
 // or '[]*ackv1alpha1.AWSResourceReferenceWrapper'.
 // These fields are not part of aws-sdk-go model and they are generated by
@@ -318,6 +667,18 @@ func (f *Field) ReferencedServiceName() (referencedServiceName string) {
 	return referencedServiceName
 }
 
+// ReferencesClusterScopedResource returns true if the field references a
+// resource of the same service whose CRD is cluster-scoped, see `scope`.
+// Resources of other services are assumed to be namespaced.
+func (f *Field) ReferencesClusterScopedResource() bool {
+	if !f.HasReference() ||
+		f.ReferencedServiceName() != f.CRD.sdkAPI.API.PackageName() {
+		return false
+	}
+	return f.CRD.cfg.GetResourceScope(f.FieldConfig.References.Resource) ==
+		ackgenconfig.ResourceScopeCluster
+}
+
 // ReferencedResourceNamePlural returns the plural of referenced resource
 // when the field has a 'ReferencesConfig'
 // If the field does not have 'ReferencesConfig', empty string is returned
@@ -377,6 +738,25 @@ func NewReferenceField(
 	}
 }
 
+// NewSecretRefField returns a pointer to a new Field object of type
+// SecretKeyReference, identifying the Secret that the value of a
+// `write_to_secret` field is written to.
+func NewSecretRefField(
+	crd *CRD,
+	fieldNames names.Names,
+) *Field {
+	return &Field{
+		CRD:               crd,
+		Names:             fieldNames,
+		Path:              fieldNames.Original,
+		ShapeRef:          nil,
+		GoType:            "*ackv1alpha1.SecretKeyReference",
+		GoTypeElem:        "SecretKeyReference",
+		GoTypeWithPkgName: "*github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1.SecretKeyReference",
+		FieldConfig:       nil,
+	}
+}
+
 // NewField returns a pointer to a new Field object
 func NewField(
 	crd *CRD,
@@ -414,6 +794,18 @@ func newFieldRecurse(
 
 	if shape != nil {
 		gte, gt, gtwp = CleanGoType(crd.sdkAPI, crd.cfg, shape, cfg)
+		if isTypedEnum(crd, path, shape) {
+			gte = crd.sdkAPI.EnumTypeName(shapeRef.ShapeName, crd.cfg)
+			gt = "*" + gte
+			gtwp = gt
+		}
+		if shapeRef.JSONValue {
+			// aws.JSONValue members hold arbitrary JSON documents, see
+			// Field.IsJSONValue
+			gte = "runtime.RawExtension"
+			gt = "*" + gte
+			gtwp = gt
+		}
 		for {
 			// If the field is a slice or map of structs, we want to add
 			// MemberFields that describe the list or value struct elements so
@@ -475,10 +867,7 @@ func newFieldRecurse(
 			}
 		}
 	} else {
-		gte = "string"
-		gt = "*string"
-		gtwp = "*string"
-		shapeRef = simpleStringShapeRef
+		gte, gt, gtwp, shapeRef = attributeGoType(cfg)
 	}
 
 	return &Field{
@@ -493,3 +882,34 @@ func newFieldRecurse(
 		MemberFields:      memberFields,
 	}
 }
+
+// isTypedEnum returns true if the field of the supplied path and shape is a
+// top-level Spec or Status field typed with the Go string alias of its enum,
+// see `typed_enums`. The fields of nested structs and the elements of lists
+// and maps remain strings.
+func isTypedEnum(crd *CRD, path string, shape *awssdkmodel.Shape) bool {
+	return crd.cfg != nil && crd.cfg.TypedEnums &&
+		!strings.Contains(path, ".") &&
+		shape.Type == "string" && shape.IsEnum()
+}
+
+// attributeGoType returns the Go type element, Go type, Go type with package
+// name and shape of a field that has no shape, such as an attribute field,
+// according to its configured `attribute_type`.
+func attributeGoType(
+	cfg *ackgenconfig.FieldConfig,
+) (string, string, string, *awssdkmodel.ShapeRef) {
+	attrType := ""
+	if cfg != nil {
+		attrType = cfg.AttributeType
+	}
+	switch attrType {
+	case ackgenconfig.AttributeTypeBool:
+		return "bool", "*bool", "*bool", simpleBoolShapeRef
+	case ackgenconfig.AttributeTypeInt:
+		return "int64", "*int64", "*int64", simpleLongShapeRef
+	case ackgenconfig.AttributeTypeDuration:
+		return "metav1.Duration", "*metav1.Duration", "*metav1.Duration", simpleDurationShapeRef
+	}
+	return "string", "*string", "*string", simpleStringShapeRef
+}
@@ -73,6 +73,50 @@ func TestFieldDocumentation(t *testing.T) {
 
 }
 
+func TestFieldDocumentation_FieldConfig(t *testing.T) {
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile:     "generator-with-documentation.yaml",
+			DocumentationConfigFile: "documentation.yaml",
+		},
+	)
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Cluster", crds)
+	require.NotNil(crd)
+
+	appendField := crd.SpecFields["Version"]
+	require.NotNil(appendField)
+	require.Equal(
+		"// The desired Kubernetes version for your cluster. If you don't specify a value\n"+
+			"// here, the latest version available in Amazon EKS is used.\n"+
+			"//\n"+
+			"// Upgrades are performed one minor version at a time.",
+		appendField.GetDocumentation(),
+	)
+
+	// The documentation FieldConfig takes precedence over the documentation
+	// config file
+	overrideField := crd.Fields["RoleARN"]
+	require.NotNil(overrideField)
+	require.Equal(
+		"// The ARN of the IAM role used by the cluster control plane.",
+		overrideField.GetDocumentation(),
+	)
+
+	// Fields without a documentation FieldConfig still use the documentation
+	// config file
+	prependField := crd.Fields["ResourcesVPCConfig.SecurityGroupIDs"]
+	require.NotNil(prependField)
+	require.True(
+		strings.HasPrefix(prependField.GetDocumentation(), "// !!! Let's take it from the top"),
+	)
+}
+
 func TestMemberFields(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
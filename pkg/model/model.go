@@ -16,6 +16,9 @@ package model
 import (
 	"errors"
 	"fmt"
+	"path"
+	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -77,6 +80,8 @@ func (m *Model) MetaVars() templateset.MetaVars {
 		ClientInterfaceTypeName: m.ClientInterfaceTypeName(),
 		ClientStructTypeName:    m.ClientStructTypeName(),
 		CRDNames:                m.crdNames(),
+		NamespacedCRDNames:      m.scopedCRDNames(false),
+		ClusterScopedCRDNames:   m.scopedCRDNames(true),
 	}
 }
 
@@ -92,6 +97,21 @@ func (m *Model) crdNames() []string {
 	return crdConfigs
 }
 
+// scopedCRDNames returns the crd names, lowercased and in plural, of either
+// the cluster-scoped or the namespaced crds
+func (m *Model) scopedCRDNames(clusterScoped bool) []string {
+	var crdConfigs []string
+
+	crds, _ := m.GetCRDs()
+	for _, crd := range crds {
+		if crd.IsClusterScoped() == clusterScoped {
+			crdConfigs = append(crdConfigs, strings.ToLower(crd.Plural))
+		}
+	}
+
+	return crdConfigs
+}
+
 // GetCRDs returns a slice of `CRD` structs that describe the
 // top-level resources discovered by the code generator for an AWS service API
 func (m *Model) GetCRDs() ([]*CRD, error) {
@@ -110,7 +130,35 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 	getAttributesOps := (*opMap)[OpTypeGetAttributes]
 	setAttributesOps := (*opMap)[OpTypeSetAttributes]
 
+	// The Spec fields of a CRD are the members of its Create operation's
+	// Input shape. Observe-only resources without a Create operation take
+	// them from the operation reading the resource instead.
+	specOps := map[string]*awssdkmodel.Operation{}
 	for crdName, createOp := range createOps {
+		specOps[crdName] = createOp
+	}
+	for crdName, rConfig := range m.cfg.Resources {
+		if !rConfig.ObserveOnly || specOps[crdName] != nil ||
+			m.cfg.ResourceIsIgnored(crdName) {
+			continue
+		}
+		for _, readOps := range []map[string]*awssdkmodel.Operation{
+			readOneOps, getAttributesOps, readManyOps,
+		} {
+			if readOp, found := readOps[crdName]; found {
+				specOps[crdName] = readOp
+				break
+			}
+		}
+		if specOps[crdName] == nil {
+			panic(fmt.Sprintf(
+				"unable to find an operation reading observe-only resource %q",
+				crdName,
+			))
+		}
+	}
+
+	for crdName, createOp := range specOps {
 		if m.cfg.ResourceIsIgnored(crdName) {
 			continue
 		}
@@ -125,19 +173,36 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 			SetAttributes: setAttributesOps[crdName],
 		}
 		m.RemoveIgnoredOperations(&ops)
+		// Singleton resources are usually updated with the same Put operation
+		// that creates them
+		if m.cfg.ResourceIsSingleton(crdName) && ops.Update == nil {
+			ops.Update = ops.Create
+		}
 		crd := NewCRD(m.SDKAPI, m.cfg, m.docCfg, crdNames, ops)
 
 		// OK, begin to gather the CRDFields that will go into the Spec struct.
 		// These fields are those members of the Create operation's Input
-		// Shape.
+		// Shape, or of the shape configured in place of it.
+		inputShapeOverride, outputShapeOverride := m.cfg.GetShapeOverrides(createOp)
 		inputShape := createOp.InputRef.Shape
+		var err error
+		if inputShapeOverride != "" {
+			if inputShape, err = m.overrideShape(createOp, inputShapeOverride); err != nil {
+				return nil, err
+			}
+		}
 		if inputShape == nil {
 			return nil, ErrNilShapePointer
 		}
+		idempotencyTokenMember := crd.GetIdempotencyTokenMember(createOp)
 		for memberName, memberShapeRef := range inputShape.MemberRefs {
 			if memberShapeRef.Shape == nil {
 				return nil, ErrNilShapePointer
 			}
+			// The generated idempotency token is not a field of the resource
+			if memberName == idempotencyTokenMember {
+				continue
+			}
 			// Handles field renames, if applicable
 			fieldName := m.cfg.GetResourceFieldName(
 				crd.Names.Original,
@@ -146,7 +211,9 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 			)
 			memberNames := names.New(fieldName)
 			if memberName == "Attributes" && m.cfg.ResourceContainsAttributesMap(crdName) {
-				crd.UnpackAttributes()
+				if err := crd.UnpackAttributes(); err != nil {
+					return nil, err
+				}
 				continue
 			}
 			crd.AddSpecField(memberNames, memberShapeRef)
@@ -168,25 +235,18 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 				continue
 			}
 
-			var found bool
 			var memberShapeRef *awssdkmodel.ShapeRef
 
 			if fieldConfig.From != nil {
-				from := fieldConfig.From
-				memberShapeRef, found = m.SDKAPI.GetInputShapeRef(
-					from.Operation, from.Path,
+				memberShapeRef = m.sourceFieldShapeRef(
+					"Spec", targetFieldName, fieldConfig.From,
+					m.SDKAPI.GetInputShapeRef,
 				)
-				if !found {
-					// This is a compile-time failure, just bomb out...
-					msg := fmt.Sprintf(
-						"unknown additional Spec field with Op: %s and Path: %s",
-						from.Operation, from.Path,
-					)
-					panic(msg)
-				}
 			} else if fieldConfig.CustomField != nil {
 				customField := fieldConfig.CustomField
-				if customField.ListOf != "" {
+				if customField.StructOf != "" {
+					memberShapeRef = m.SDKAPI.GetCustomStructRef(customField.StructOf)
+				} else if customField.ListOf != "" {
 					memberShapeRef = m.SDKAPI.GetCustomShapeRef(customField.ListOf)
 				} else {
 					memberShapeRef = m.SDKAPI.GetCustomShapeRef(customField.MapOf)
@@ -229,8 +289,17 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 		// Now process the fields that will go into the Status struct. We want
 		// fields that are in the Create operation's Output Shape but that are
 		// not in the Input Shape.
-		outputShape, err := crd.GetOutputShape(createOp)
-		if err != nil {
+		var outputShape *awssdkmodel.Shape
+		if outputShapeOverride != "" {
+			if outputShape, err = m.overrideShape(createOp, outputShapeOverride); err != nil {
+				return nil, err
+			}
+			if wrapperFieldPath := crd.GetOutputWrapperFieldPath(createOp); wrapperFieldPath != nil {
+				if outputShape, err = crd.getWrapperOutputShape(outputShape, *wrapperFieldPath); err != nil {
+					return nil, err
+				}
+			}
+		} else if outputShape, err = crd.GetOutputShape(createOp); err != nil {
 			return nil, err
 		}
 		if outputShape.UsedAsOutput && len(outputShape.MemberRefs) == 1 {
@@ -281,25 +350,23 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 				continue
 			}
 
-			var found bool
 			var memberShapeRef *awssdkmodel.ShapeRef
 
-			if fieldConfig.From != nil {
-				from := fieldConfig.From
-				memberShapeRef, found = m.SDKAPI.GetOutputShapeRef(
-					from.Operation, from.Path,
+			if fieldConfig.From.IsAttribute() {
+				m.checkAttributeSourcedField(crd, targetFieldName, fieldConfig)
+				// The field's Go type is determined by its attribute_type
+				crd.AddStatusField(names.New(targetFieldName), nil)
+				continue
+			} else if fieldConfig.From != nil {
+				memberShapeRef = m.sourceFieldShapeRef(
+					"Status", targetFieldName, fieldConfig.From,
+					m.SDKAPI.GetOutputShapeRef,
 				)
-				if !found {
-					// This is a compile-time failure, just bomb out...
-					msg := fmt.Sprintf(
-						"unknown additional Status field with Op: %s and Path: %s",
-						from.Operation, from.Path,
-					)
-					panic(msg)
-				}
 			} else if fieldConfig.CustomField != nil {
 				customField := fieldConfig.CustomField
-				if customField.ListOf != "" {
+				if customField.StructOf != "" {
+					memberShapeRef = m.SDKAPI.GetCustomStructRef(customField.StructOf)
+				} else if customField.ListOf != "" {
 					memberShapeRef = m.SDKAPI.GetCustomShapeRef(customField.ListOf)
 				} else {
 					memberShapeRef = m.SDKAPI.GetCustomShapeRef(customField.MapOf)
@@ -341,8 +408,15 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 		// Now add the additional printer columns that have been defined explicitly
 		// in additional_columns
 		crd.addAdditionalPrinterColumns(m.cfg.GetAdditionalColumns(crdName))
+		// and the standard columns enabled for the resource
+		crd.addDefaultPrinterColumns()
 		// Process the custom nested fields
 		crd.addCustomNestedFields(customNestedFields)
+		// Keep the previous names of renamed Spec fields in the schema
+		crd.addDeprecatedSpecFields()
+		if err := crd.checkProcessOutputCustomMethods(); err != nil {
+			return nil, err
+		}
 		crds = append(crds, crd)
 	}
 	sort.Slice(crds, func(i, j int) bool {
@@ -356,6 +430,116 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 	return crds, nil
 }
 
+// sourceFieldShapeRef returns the ShapeRef of the member a field with a
+// `from` config takes its Go type from: the one at the path of the first
+// source whose operation exists in the API model. Sources whose operation does
+// not exist are skipped, which lets a field prefer an operation only some
+// versions of the API have.
+func (m *Model) sourceFieldShapeRef(
+	// "Spec" or "Status"
+	fieldKind string,
+	fieldName string,
+	from *ackgenconfig.SourceFieldConfig,
+	getShapeRef func(opID string, path string) (*awssdkmodel.ShapeRef, bool),
+) *awssdkmodel.ShapeRef {
+	var memberShapeRef *awssdkmodel.ShapeRef
+	for _, source := range from.Sources() {
+		if _, found := m.SDKAPI.API.Operations[source.Operation]; !found && from.HasFallbacks() {
+			continue
+		}
+		shapeRef, found := getShapeRef(source.Operation, source.Path)
+		if !found {
+			// This is a compile-time failure, just bomb out...
+			msg := fmt.Sprintf(
+				"unknown additional %s field with Op: %s and Path: %s",
+				fieldKind, source.Operation, source.Path,
+			)
+			panic(msg)
+		}
+		if memberShapeRef == nil {
+			memberShapeRef = shapeRef
+		} else if shapeRef.Shape.Type != memberShapeRef.Shape.Type ||
+			(shapeRef.Shape.Type == "structure" && shapeRef.Shape.ShapeName != memberShapeRef.Shape.ShapeName) {
+			msg := fmt.Sprintf(
+				"source with Op: %s and Path: %s of %s field %s has a different type than the preferred source",
+				source.Operation, source.Path, fieldKind, fieldName,
+			)
+			panic(msg)
+		}
+	}
+	if memberShapeRef == nil {
+		msg := fmt.Sprintf(
+			"none of the operations %s field %s is sourced from exist",
+			fieldKind, fieldName,
+		)
+		panic(msg)
+	}
+	return memberShapeRef
+}
+
+// overrideShape returns the structure shape with the supplied name, configured
+// in place of one of the payload shapes of the supplied operation.
+func (m *Model) overrideShape(
+	op *awssdkmodel.Operation,
+	shapeName string,
+) (*awssdkmodel.Shape, error) {
+	shape, found := m.SDKAPI.API.Shapes[shapeName]
+	if !found {
+		return nil, fmt.Errorf(
+			"shape %s configured in place of a shape of operation %s not found in API",
+			shapeName, op.ExportedName,
+		)
+	}
+	if shape.Type != "structure" {
+		return nil, fmt.Errorf(
+			"shape %s configured in place of a shape of operation %s is not a structure",
+			shapeName, op.ExportedName,
+		)
+	}
+	return shape, nil
+}
+
+// checkAttributeSourcedField panics if the supplied Status field, sourced
+// from a key of an attributes map, is not sourced from the resource's
+// GetAttributes operation or has an unsupported `attribute_type`.
+func (m *Model) checkAttributeSourcedField(
+	crd *CRD,
+	fieldName string,
+	fieldConfig *ackgenconfig.FieldConfig,
+) {
+	from := fieldConfig.From
+	if from.HasFallbacks() {
+		panic(fmt.Sprintf(
+			"Status field %s sourced from attribute %s cannot have fallback sources",
+			fieldName, from.Attribute,
+		))
+	}
+	if crd.Ops.GetAttributes == nil || crd.Ops.GetAttributes.ExportedName != from.Operation {
+		panic(fmt.Sprintf(
+			"Status field %s is sourced from attribute %s of %s, which is not "+
+				"the GetAttributes operation of resource %s",
+			fieldName, from.Attribute, from.Operation, crd.Names.Original,
+		))
+	}
+	shapeRef, found := m.SDKAPI.GetOutputShapeRef(from.Operation, from.AttributesPath())
+	if !found || shapeRef.Shape.Type != "map" ||
+		shapeRef.Shape.ValueRef.Shape.Type != "string" {
+		panic(fmt.Sprintf(
+			"Status field %s is sourced from %s.%s, which is not a map of strings",
+			fieldName, from.Operation, from.AttributesPath(),
+		))
+	}
+	switch fieldConfig.AttributeType {
+	case "", ackgenconfig.AttributeTypeBool, ackgenconfig.AttributeTypeInt,
+		ackgenconfig.AttributeTypeDuration, ackgenconfig.AttributeTypeJSON:
+	default:
+		panic(fmt.Sprintf(
+			"unsupported attribute_type %q for field %s of resource %s",
+			fieldConfig.AttributeType, fieldName, crd.Names.Original,
+		))
+	}
+}
+
 // RemoveIgnoredOperations updates Ops argument by setting those
 // operations to nil that are configured to be ignored in generator config for
 // the AWS service
@@ -421,9 +605,9 @@ func (m *Model) GetTypeDefs() ([]*TypeDef, error) {
 			continue
 		}
 		tdefNames := names.New(shapeName)
-		if m.SDKAPI.HasConflictingTypeName(shapeName, m.cfg) {
-			tdefNames.Camel += ConflictingNameSuffix
-			trenames[shapeName] = tdefNames.Camel
+		if typeName := m.SDKAPI.StructTypeName(shapeName, m.cfg); typeName != tdefNames.Camel {
+			tdefNames.Camel = typeName
+			trenames[shapeName] = typeName
 		}
 
 		attrs := map[string]*Attr{}
@@ -434,22 +618,31 @@ func (m *Model) GetTypeDefs() ([]*TypeDef, error) {
 				continue
 			}
 			gt := m.getShapeCleanGoType(memberShape)
+			if memberRef.JSONValue {
+				gt = "*runtime.RawExtension"
+			}
 			attrs[memberName] = NewAttr(memberNames, gt, memberShape)
+			if m.cfg != nil && m.cfg.InferNestedRequiredFields {
+				attrs[memberName].IsRequired = util.InStrings(memberName, shape.Required)
+			}
 		}
 		if len(attrs) == 0 {
 			// Just ignore these...
 			continue
 		}
 		tdefs = append(tdefs, &TypeDef{
-			Shape: shape,
-			Names: tdefNames,
-			Attrs: attrs,
+			Shape:   shape,
+			Names:   tdefNames,
+			Attrs:   attrs,
+			IsUnion: m.SDKAPI.IsUnion(shapeName),
 		})
 	}
 	sort.Slice(tdefs, func(i, j int) bool {
 		return tdefs[i].Names.Camel < tdefs[j].Names.Camel
 	})
-	m.processNestedFieldTypeDefs(tdefs)
+	if err := m.processNestedFieldTypeDefs(tdefs); err != nil {
+		return nil, err
+	}
 	m.typeDefs = tdefs
 	m.typeRenames = trenames
 	return tdefs, nil
@@ -475,12 +668,7 @@ func (m *Model) getShapeCleanGoType(shape *awssdkmodel.Shape) string {
 		// fields in a DBProxy CRD... we need to ensure the type names don't
 		// conflict. Also, the name of the Go type in the generated code is
 		// Camel-cased and normalized, so we use that as the Go type
-		goType := shape.GoType()
-		typeNames := names.New(goType)
-		if m.SDKAPI.HasConflictingTypeName(goType, m.cfg) {
-			typeNames.Camel += ConflictingNameSuffix
-		}
-		return "*" + typeNames.Camel
+		return "*" + m.SDKAPI.StructTypeName(shape.GoTypeElem(), m.cfg)
 	default:
 		return shape.GoType()
 	}
@@ -488,13 +676,19 @@ func (m *Model) getShapeCleanGoType(shape *awssdkmodel.Shape) string {
 
 // processNestedFieldTypeDefs updates the supplied TypeDef structs' if a nested
 // field has been configured with a type overriding FieldConfig -- such as
-// FieldConfig.IsSecret.
+// FieldConfig.IsSecret. It returns an error when the configuration of a nested
+// field conflicts with the configuration of another field sharing its TypeDef.
 func (m *Model) processNestedFieldTypeDefs(
 	tdefs []*TypeDef,
-) {
+) error {
 	crds, _ := m.GetCRDs()
 	for _, crd := range crds {
 		for fieldPath, field := range crd.Fields {
+			if field.FieldConfig != nil && field.FieldConfig.ServerSideApply != nil {
+				if err := requireListMapKeyAttributes(crds, field, tdefs); err != nil {
+					return err
+				}
+			}
 			if !strings.Contains(fieldPath, ".") {
 				// top-level fields have already had their structure
 				// transformed during the CRD.AddSpecField and
@@ -509,7 +703,7 @@ func (m *Model) processNestedFieldTypeDefs(
 				// treat this field differently.
 				continue
 			}
-			if field.FieldConfig.IsSecret {
+			if field.FieldConfig.IsSecret || field.FieldConfig.IsConfigMap {
 				// Find the TypeDef that was created for the *containing*
 				// secret field struct. For example, assume the nested field
 				// path `Users..Password`, we'd want to find the TypeDef that
@@ -517,14 +711,29 @@ func (m *Model) processNestedFieldTypeDefs(
 				// struct)
 				replaceSecretAttrGoType(crd, field, tdefs)
 			}
+			if field.FieldConfig.IsRequired != nil {
+				if err := setTypeDefAttributeRequired(crds, crd, fieldPath, *field.FieldConfig.IsRequired, tdefs); err != nil {
+					return err
+				}
+			}
 			if field.FieldConfig.References != nil {
+				// Either the field or its reference field may be set
+				if err := setTypeDefAttributeRequired(crds, crd, fieldPath, false, tdefs); err != nil {
+					return err
+				}
 				updateTypeDefAttributeWithReference(crd, fieldPath, tdefs)
 			}
 			if field.FieldConfig.GoTag != nil {
 				setTypeDefAttributeGoTag(crd, fieldPath, field, tdefs)
 			}
+			if field.FieldConfig.ServerSideApply != nil || len(field.FieldConfig.Markers) > 0 {
+				if err := setTypeDefAttributeMarkers(crds, crd, fieldPath, field, tdefs); err != nil {
+					return err
+				}
+			}
 		}
 	}
+	return nil
 }
 
 // getAttributeFromPath extracts the parent TypeDef and the target attribute for
@@ -656,6 +865,172 @@ func setTypeDefAttributeGoTag(crd *CRD, fieldPath string, f *Field, tdefs []*Typ
 	}
 }
 
+// setTypeDefAttributeRequired overrides whether the attribute represented by
+// fieldPath of nested field is required.
+//
+// The TypeDef is the single Go type of every field using its shape. It
+// returns an error when another field of the same type would require the
+// attribute differently.
+func setTypeDefAttributeRequired(crds []*CRD, crd *CRD, fieldPath string, required bool, tdefs []*TypeDef) error {
+	parentTypeDef, fieldAttr := getAttributeFromPath(crd, fieldPath, tdefs)
+	if fieldAttr == nil {
+		return nil
+	}
+	for _, use := range typeDefUses(crds, parentTypeDef) {
+		member, found := use.MemberFields[fieldAttr.Names.Camel]
+		if !found {
+			continue
+		}
+		member = configuredField(member)
+		if isNestedAttributeRequired(member, parentTypeDef, fieldAttr) == required {
+			continue
+		}
+		return fmt.Errorf(
+			"field %s.%s and field %s.%s share the %s type but require "+
+				"the attribute differently. Configure is_required the same "+
+				"way for both fields",
+			crd.Names.Original, fieldPath,
+			use.CRD.Names.Original, member.Path, parentTypeDef.Names.Camel,
+		)
+	}
+	fieldAttr.IsRequired = required
+	return nil
+}
+
+// isNestedAttributeRequired returns whether the supplied nested field requires
+// its attribute of the TypeDef containing it: as configured with
+// `is_required`, never for a field with a reference, or else as inferred from
+// the TypeDef's shape with `infer_nested_required_fields`.
+func isNestedAttributeRequired(f *Field, td *TypeDef, attr *Attr) bool {
+	if f.FieldConfig != nil {
+		if f.FieldConfig.IsRequired != nil {
+			return *f.FieldConfig.IsRequired
+		}
+		if f.FieldConfig.References != nil {
+			return false
+		}
+	}
+	return f.CRD.cfg != nil && f.CRD.cfg.InferNestedRequiredFields &&
+		util.InStrings(attr.Names.Original, td.Shape.Required)
+}
+
+// setTypeDefAttributeMarkers sets the server-side apply and additional markers
+// of the attribute represented by fieldPath of nested field.
+//
+// The TypeDef is the single Go type of every field using its shape, so the
+// markers apply to all of them. It returns an error when another field of the
+// same type configures different markers for the attribute.
+func setTypeDefAttributeMarkers(crds []*CRD, crd *CRD, fieldPath string, f *Field, tdefs []*TypeDef) error {
+	parentTypeDef, fieldAttr := getAttributeFromPath(crd, fieldPath, tdefs)
+	if fieldAttr == nil {
+		return nil
+	}
+	markers := f.GetMarkers()
+	for _, use := range typeDefUses(crds, parentTypeDef) {
+		member, found := use.MemberFields[fieldAttr.Names.Camel]
+		if !found {
+			continue
+		}
+		member = configuredField(member)
+		if slices.Equal(member.GetMarkers(), markers) {
+			continue
+		}
+		return fmt.Errorf(
+			"field %s.%s and field %s.%s share the %s type but configure "+
+				"different markers. Configure the same markers for both fields",
+			crd.Names.Original, fieldPath,
+			use.CRD.Names.Original, member.Path, parentTypeDef.Names.Camel,
+		)
+	}
+	fieldAttr.Markers = markers
+	return nil
+}
+
+// requireListMapKeyAttributes marks the list map keys of a list field with a
+// "map" server-side apply list type as required attributes of the TypeDef of
+// the list elements, since Kubernetes rejects CRDs with optional list map
+// keys.
+//
+// It returns an error when the TypeDef is also the type of a field that does
+// not use the same list map keys, since the keys would become required for
+// that field too.
+func requireListMapKeyAttributes(crds []*CRD, f *Field, tdefs []*TypeDef) error {
+	ssaCfg := f.FieldConfig.ServerSideApply
+	if ssaCfg.ListType != "map" {
+		return nil
+	}
+	for _, td := range tdefs {
+		if td.Names.Camel != f.GoTypeElem {
+			continue
+		}
+		for _, use := range typeDefUses(crds, td) {
+			use = configuredField(use)
+			useCfg := use.FieldConfig
+			if use.ShapeRef.Shape.Type == "list" &&
+				useCfg != nil && useCfg.ServerSideApply != nil &&
+				useCfg.ServerSideApply.ListType == "map" &&
+				slices.Equal(useCfg.ServerSideApply.ListMapKeys, ssaCfg.ListMapKeys) {
+				continue
+			}
+			return fmt.Errorf(
+				"field %s.%s and field %s.%s share the %s type, so the list "+
+					"map keys %v would be required for both. Configure the "+
+					"same server_side_apply list map keys for both fields",
+				f.CRD.Names.Original, f.Path, use.CRD.Names.Original,
+				use.Path, td.Names.Camel, ssaCfg.ListMapKeys,
+			)
+		}
+		for _, key := range ssaCfg.ListMapKeys {
+			if attr, found := td.Attrs[key]; found {
+				attr.IsRequired = true
+			}
+		}
+	}
+	return nil
+}
+
+// configuredField returns the CRD field configured for the path of the
+// supplied field, since the member fields of a struct field are only
+// configured when their path matches the case of the generator config.
+func configuredField(f *Field) *Field {
+	for fPath, cf := range f.CRD.Fields {
+		if strings.EqualFold(fPath, f.Path) {
+			return cf
+		}
+	}
+	return f
+}
+
+// typeDefUses returns the Spec and Status fields of all the supplied CRDs,
+// including nested member fields, whose type is the supplied TypeDef or a list
+// or map of it.
+func typeDefUses(crds []*CRD, td *TypeDef) []*Field {
+	uses := []*Field{}
+	var collect func(fields map[string]*Field)
+	collect = func(fields map[string]*Field) {
+		for _, f := range fields {
+			if f.ShapeRef != nil && f.ShapeRef.Shape != nil {
+				shape := f.ShapeRef.Shape
+				switch shape.Type {
+				case "list":
+					shape = shape.MemberRef.Shape
+				case "map":
+					shape = shape.ValueRef.Shape
+				}
+				if shape != nil && shape.ShapeName == td.Shape.ShapeName {
+					uses = append(uses, f)
+				}
+			}
+			collect(f.MemberFields)
+		}
+	}
+	for _, crd := range crds {
+		collect(crd.SpecFields)
+		collect(crd.StatusFields)
+	}
+	return uses
+}
+
 // updateTypeDefAttributeWithReference adds a new AWSResourceReference attribute
 // for the corresponding attribute represented by fieldPath of nested field
 func updateTypeDefAttributeWithReference(crd *CRD, fieldPath string, tdefs []*TypeDef) {
@@ -688,8 +1063,8 @@ func addReferenceAttribute(td *TypeDef, attr *Attr) {
 	td.Attrs[refAttrName.Original] = refAttr
 }
 
-// replaceSecretAttrGoType replaces a nested field Attr's GoType with
-// `*ackv1alpha1.SecretKeyReference`.
+// replaceSecretAttrGoType replaces a nested field Attr's GoType with the
+// field's `*ackv1alpha1.SecretKeyReference` or `*ConfigMapKeyReference`.
 func replaceSecretAttrGoType(
 	crd *CRD,
 	field *Field,
@@ -792,7 +1167,7 @@ func (m *Model) processTopLevelField(
 	crd *CRD,
 	field *Field,
 ) {
-	if field.ShapeRef == nil && !field.IsReference() && (field.FieldConfig == nil || !field.FieldConfig.IsAttribute) {
+	if field.ShapeRef == nil && !field.IsReference() && !field.IsSecretRef() && (field.FieldConfig == nil || !field.FieldConfig.IsAttribute) {
 		fmt.Printf(
 			"WARNING: Field %s:%s has nil ShapeRef and is not defined as an Attribute-based Field!\n",
 			crd.Names.Original,
@@ -899,11 +1274,7 @@ func (m *Model) GetEnumDefs() ([]*EnumDef, error) {
 			continue
 		}
 		enumNames := names.New(shapeName)
-		// Handle name conflicts with top-level CRD.Spec or CRD.Status
-		// types
-		if m.SDKAPI.HasConflictingTypeName(shapeName, m.cfg) {
-			enumNames.Camel += ConflictingNameSuffix
-		}
+		enumNames.Camel = m.SDKAPI.EnumTypeName(shapeName, m.cfg)
 		edef, err := NewEnumDef(enumNames, shape.Enum)
 		if err != nil {
 			return nil, err
@@ -937,6 +1308,10 @@ func (m *Model) ApplyShapeIgnoreRules() {
 		}
 	}
 	for _, fieldpath := range m.cfg.Ignore.FieldPaths {
+		if isFieldPathPattern(fieldpath) {
+			m.ignoreFieldPathPattern(fieldpath)
+			continue
+		}
 		fp := ackfp.FromString(fieldpath)
 		sn := fp.At(0)
 		if shape, found := m.SDKAPI.API.Shapes[sn]; !found {
@@ -963,6 +1338,142 @@ func (m *Model) ApplyShapeIgnoreRules() {
 	}
 }
 
+// isFieldPathPattern returns true if the supplied ignored field path contains
+// wildcards, e.g. "*.KMSKeyId" or "Instance.Rules[*].Internal*".
+func isFieldPathPattern(fieldpath string) bool {
+	return strings.ContainsAny(fieldpath, "*?[")
+}
+
+// ignoreFieldPathPattern removes from the API shapes the members matching the
+// supplied ignored field path pattern. Each element of the pattern, including
+// the leading shape name, is matched case-insensitively with the syntax of
+// path.Match, e.g. "*" matches any shape or member name and "Internal*" any
+// name starting with "Internal". List elements and map values are traversed
+// implicitly and may be denoted with a "[*]" suffix, e.g. "Rules[*]".
+func (m *Model) ignoreFieldPathPattern(pattern string) {
+	parts := strings.Split(pattern, ".")
+	for i, part := range parts {
+		parts[i] = strings.ToLower(strings.TrimSuffix(part, "[*]"))
+	}
+	if len(parts) < 2 {
+		msg := fmt.Sprintf(
+			"config's Ignore.FieldPaths pattern %s must refer to a shape member",
+			pattern,
+		)
+		panic(msg)
+	}
+
+	// Collect the shapes containing the matching members before removing any,
+	// so that removals do not change what the pattern matches
+	type ignoredMember struct {
+		parent     *awssdkmodel.Shape
+		memberName string
+	}
+	ignored := []ignoredMember{}
+	for _, shapeName := range m.SDKAPI.API.ShapeNames() {
+		if !fieldPathPartMatches(parts[0], shapeName) {
+			continue
+		}
+		parents := []*awssdkmodel.Shape{m.SDKAPI.API.Shapes[shapeName]}
+		for _, part := range parts[1 : len(parts)-1] {
+			children := []*awssdkmodel.Shape{}
+			for _, parent := range parents {
+				for _, memberName := range parent.MemberNames() {
+					if !fieldPathPartMatches(part, memberName) {
+						continue
+					}
+					if child := elementShape(parent.MemberRefs[memberName].Shape); child != nil {
+						children = append(children, child)
+					}
+				}
+			}
+			parents = children
+		}
+		for _, parent := range parents {
+			for _, memberName := range parent.MemberNames() {
+				if fieldPathPartMatches(parts[len(parts)-1], memberName) {
+					ignored = append(ignored, ignoredMember{parent, memberName})
+				}
+			}
+		}
+	}
+	if len(ignored) == 0 {
+		msg := fmt.Sprintf(
+			"config's Ignore.FieldPaths pattern %s does not match any shape member",
+			pattern,
+		)
+		panic(msg)
+	}
+	for _, member := range ignored {
+		delete(member.parent.MemberRefs, member.memberName)
+	}
+}
+
+// fieldPathPartMatches returns true if the supplied lowercased element of an
+// ignored field path pattern matches the supplied shape or member name.
+func fieldPathPartMatches(pattern string, name string) bool {
+	matched, err := path.Match(pattern, strings.ToLower(name))
+	if err != nil {
+		msg := fmt.Sprintf(
+			"invalid config's Ignore.FieldPaths pattern element %s: %v",
+			pattern, err,
+		)
+		panic(msg)
+	}
+	return matched
+}
+
+// elementShape returns the shape of the elements of the supplied list shape,
+// or of the values of the supplied map shape, or the supplied shape itself.
+func elementShape(shape *awssdkmodel.Shape) *awssdkmodel.Shape {
+	for shape != nil {
+		switch shape.Type {
+		case "list":
+			shape = shape.MemberRef.Shape
+		case "map":
+			shape = shape.ValueRef.Shape
+		default:
+			return shape
+		}
+	}
+	return shape
+}
+
+// ApplyKMSKeyReferences sets the ReferencesConfig of every field configured
+// with a KMSKeyConfig, so that a companion reference field to a kms-controller
+// Key resource is generated for it. Explicitly configured ReferencesConfig
+// are left untouched. The resource and field configs are copied before being
+// changed, since the supplied generator config may be shared with other
+// models.
+func (m *Model) ApplyKMSKeyReferences() {
+	if m.cfg == nil {
+		return
+	}
+	resources := make(map[string]ackgenconfig.ResourceConfig, len(m.cfg.Resources))
+	for rName, rConfig := range m.cfg.Resources {
+		var fields map[string]*ackgenconfig.FieldConfig
+		for fName, fConfig := range rConfig.Fields {
+			if fConfig == nil || fConfig.KMSKey == nil || fConfig.References != nil {
+				continue
+			}
+			if fields == nil {
+				fields = make(map[string]*ackgenconfig.FieldConfig, len(rConfig.Fields))
+				for name, c := range rConfig.Fields {
+					fields[name] = c
+				}
+			}
+			fCopy := *fConfig
+			fCopy.References = fConfig.KMSKey.ReferencesConfig()
+			fields[fName] = &fCopy
+		}
+		if fields != nil {
+			rConfig.Fields = fields
+		}
+		resources[rName] = rConfig
+	}
+	m.cfg.Resources = resources
+}
+
 // GetConfig returns the configuration option used to define the current
 // generator.
 func (m *Model) GetConfig() *ackgenconfig.Config {
@@ -983,6 +1494,56 @@ func (m *Model) APIGroup() string {
 	return fmt.Sprintf("%s.%s", name, suffix)
 }
 
+// HealthCheck returns the AWS API call made by the controller's readiness
+// check, with defaults applied, or nil if no `health_check` is configured.
+// Returns an error if the configured operation does not exist or requires
+// input.
+func (m *Model) HealthCheck() (*ackgenconfig.HealthCheckConfig, error) {
+	if m.cfg == nil || m.cfg.HealthCheck == nil {
+		return nil, nil
+	}
+	res := *m.cfg.HealthCheck
+	op, found := m.SDKAPI.API.Operations[res.Operation]
+	if !found {
+		return nil, fmt.Errorf(
+			"health_check operation %q not found in API", res.Operation,
+		)
+	}
+	if op.InputRef.Shape != nil && len(op.InputRef.Shape.Required) > 0 {
+		return nil, fmt.Errorf(
+			"health_check operation %s requires input members %s",
+			res.Operation, strings.Join(op.InputRef.Shape.Required, ", "),
+		)
+	}
+	if res.TimeoutSeconds <= 0 {
+		res.TimeoutSeconds = 5
+	}
+	if res.IntervalSeconds <= 0 {
+		res.IntervalSeconds = 60
+	}
+	return &res, nil
+}
+
+// envVarNameRegexp matches valid environment variable names
+var envVarNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Endpoint returns the endpoint options the service's API clients are
+// constructed with, or nil if the clients use the runtime's defaults. An
+// error is returned if the configured environment variable name is invalid.
+func (m *Model) Endpoint() (*ackgenconfig.EndpointConfig, error) {
+	if m.cfg == nil || m.cfg.Endpoint == nil {
+		return nil, nil
+	}
+	res := *m.cfg.Endpoint
+	if res.URLEnvVar != "" && !envVarNameRegexp.MatchString(res.URLEnvVar) {
+		return nil, fmt.Errorf(
+			"endpoint url_env_var %q is not a valid environment variable name",
+			res.URLEnvVar,
+		)
+	}
+	return &res, nil
+}
+
 // ClientInterfaceTypeName returns the name of the aws-sdk-go primary API
 // interface type name.
 func (m *Model) ClientInterfaceTypeName() string {
@@ -1019,5 +1580,6 @@ func New(
 		docCfg:             &docCfg,
 	}
 	m.ApplyShapeIgnoreRules()
+	m.ApplyKMSKeyReferences()
 	return m, nil
 }
@@ -251,6 +251,34 @@ func TestAPIGatewayV2_WithReference(t *testing.T) {
 	assert.Equal(2, len(referencedServiceNames))
 }
 
+func TestAPIGatewayV2_WithReferenceWait(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "apigatewayv2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-reference-wait.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	integrationCrd := getCRDByName("Integration", crds)
+	require.NotNil(integrationCrd)
+
+	waitCfg := integrationCrd.ReferenceWait()
+	require.NotNil(waitCfg)
+	assert.Equal(10, waitCfg.RequeueAfterSeconds)
+
+	// Route has no reference fields to wait for
+	routeCrd := getCRDByName("Route", crds)
+	require.NotNil(routeCrd)
+	assert.Panics(func() { routeCrd.ReferenceWait() })
+
+	apiCrd := getCRDByName("Api", crds)
+	require.NotNil(apiCrd)
+	assert.Nil(apiCrd.ReferenceWait())
+}
+
 func TestAPIGatewayV2_WithNestedReference(t *testing.T) {
 	_ = assert.New(t)
 	require := require.New(t)
@@ -279,3 +307,31 @@ func TestAPIGatewayV2_WithNestedReference(t *testing.T) {
 	assert.Equal(t, "IssuerRef", issuerRefAttr.Names.Camel)
 	assert.Equal(t, "*ackv1alpha1.AWSResourceReferenceWrapper", issuerRefAttr.GoType)
 }
+
+func TestAPIGatewayV2_SkipGeneration(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "apigatewayv2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-skip-generation.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Api", crds)
+	require.NotNil(crd)
+
+	assert.True(crd.SkipsGeneration(crd.Ops.Create))
+	assert.True(crd.SkipsGeneration(crd.Ops.Delete))
+	assert.Equal("customFindApi", crd.CustomFindMethodName())
+	// Without skip_generation, the custom implementation is called before
+	// the generated code
+	assert.False(crd.SkipsGeneration(crd.Ops.Update))
+	assert.Equal("", crd.CustomUpdateMethodName())
+
+	routeCrd := getCRDByName("Route", crds)
+	require.NotNil(routeCrd)
+	assert.False(routeCrd.SkipsGeneration(routeCrd.Ops.Create))
+	assert.Panics(func() { routeCrd.CustomFindMethodName() })
+}
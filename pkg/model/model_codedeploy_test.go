@@ -128,3 +128,39 @@ func TestCodeDeploy_Deployment(t *testing.T) {
 	}
 	assert.Equal(expPrinterColNames, gotPrinterColNames)
 }
+
+func TestCodeDeploy_Deployment_PrinterColumnOverrides(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "codedeploy",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-printer-column-overrides.yaml",
+		},
+	)
+
+	crd := testutil.GetCRDByName(t, g, "Deployment")
+	require.NotNil(crd)
+
+	gotPrinterCols := crd.AdditionalPrinterColumns()
+	require.Len(gotPrinterCols, 4)
+
+	assert.Equal("Application", gotPrinterCols[0].Name)
+	assert.Equal("string", gotPrinterCols[0].Type)
+	assert.Equal(".spec.applicationName", gotPrinterCols[0].JSONPath)
+
+	// Boolean fields are printed as boolean columns
+	assert.Equal("IgnoreStopFailures", gotPrinterCols[1].Name)
+	assert.Equal("boolean", gotPrinterCols[1].Type)
+	assert.Equal(".spec.ignoreApplicationStopFailures", gotPrinterCols[1].JSONPath)
+
+	// Struct fields can be printed by pointing the column into the struct
+	assert.Equal("AutoRollback", gotPrinterCols[2].Name)
+	assert.Equal("boolean", gotPrinterCols[2].Type)
+	assert.Equal(".spec.autoRollbackConfiguration.enabled", gotPrinterCols[2].JSONPath)
+
+	assert.Equal("ID", gotPrinterCols[3].Name)
+	assert.Equal("string", gotPrinterCols[3].Type)
+	assert.Equal("byte", gotPrinterCols[3].Format)
+	assert.Equal(".status.deploymentID", gotPrinterCols[3].JSONPath)
+}
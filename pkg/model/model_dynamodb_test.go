@@ -102,3 +102,44 @@ func TestDynamoDB_Table(t *testing.T) {
 	}
 	assert.Equal(expSpecFieldCamel, attrCamelNames(specFields))
 }
+
+func TestDynamoDB_Table_DeletionPolicy(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "dynamodb")
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+	crd := getCRDByName("Table", crds)
+	require.NotNil(crd)
+	assert.Equal("delete", crd.DeletionPolicy())
+
+	g = testutil.NewModelForServiceWithOptions(t, "dynamodb",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-deletion-policy.yaml",
+		})
+	crds, err = g.GetCRDs()
+	require.Nil(err)
+	crd = getCRDByName("Table", crds)
+	require.NotNil(crd)
+	assert.Equal("retain", crd.DeletionPolicy())
+}
+
+func TestDynamoDB_Table_DeleteCodes(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "dynamodb",
+		&testutil.TestingModelOptions{
+			GeneratorConfigFile: "generator-with-delete-codes.yaml",
+		})
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+	crd := getCRDByName("Table", crds)
+	require.NotNil(crd)
+	assert.Equal(
+		[]string{"ResourceNotFoundException", "TableNotFoundException"},
+		crd.DeleteNotFoundCodes(),
+	)
+	assert.Equal([]string{"ResourceInUseException"}, crd.DeleteInProgressCodes())
+}
@@ -336,3 +336,56 @@ func TestEC2_Instance_WrapperOutput(t *testing.T) {
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
 }
+
+func TestEC2_Route_ChildResource(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ec2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-child-resources.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	parent := getCRDByName("RouteTable", crds)
+	require.NotNil(parent)
+	assert.Nil(parent.Parent())
+	// The Routes field is managed by the Route child resource
+	assert.NotContains(parent.SpecFields, "Routes")
+	assert.NotContains(parent.StatusFields, "Routes")
+
+	child := getCRDByName("Route", crds)
+	require.NotNil(child)
+	require.NotNil(child.Parent())
+	assert.Equal("RouteTable", child.Parent().Resource)
+	assert.Equal("CreateRoute", child.Ops.Create.ExportedName)
+	assert.Equal("ReplaceRoute", child.Ops.Update.ExportedName)
+	assert.Equal("DeleteRoute", child.Ops.Delete.ExportedName)
+
+	refField := child.GetParentReferenceField()
+	require.NotNil(refField)
+	assert.Equal("RouteTableRef", refField.Path)
+}
+
+func TestEC2_Instance_ReconcileDefaults(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ec2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-reconcile-defaults.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Instance", crds)
+	require.NotNil(crd)
+	assert.Equal(3600, crd.ReconcileResyncSeconds())
+	assert.Equal(2, crd.ReconcileMaxConcurrentSyncs())
+
+	crd = getCRDByName("SecurityGroup", crds)
+	require.NotNil(crd)
+	assert.Equal(0, crd.ReconcileResyncSeconds())
+	assert.Equal(0, crd.ReconcileMaxConcurrentSyncs())
+}
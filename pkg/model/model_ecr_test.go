@@ -19,6 +19,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -144,3 +146,475 @@ func TestECRRepository(t *testing.T) {
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
 }
+
+func TestECRRepository_IgnoredFieldPatterns(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-ignored-field-patterns.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// "*.ImageTagMutability" matches the member of any shape
+	assert.NotContains(crd.SpecFields, "ImageTagMutability")
+	assert.NotContains(crd.StatusFields, "ImageTagMutability")
+	assert.Contains(crd.SpecFields, "ImageScanningConfiguration")
+
+	// List elements are traversed and member names matched by prefix
+	tagsField := crd.SpecFields["Tags"]
+	require.NotNil(tagsField)
+	tagShape := tagsField.ShapeRef.Shape.MemberRef.Shape
+	assert.Contains(tagShape.MemberRefs, "Key")
+	assert.NotContains(tagShape.MemberRefs, "Value")
+}
+
+func TestECRRepository_ConditionsConfig(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-conditions.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	condCfg := crd.ConditionsConfig()
+	require.NotNil(condCfg)
+	assert.Equal(10, condCfg.MaxCount)
+	assert.Equal(86400, condCfg.PruneStaleAfterSeconds)
+
+	g = testutil.NewModelForService(t, "ecr")
+	crd = testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.Nil(crd.ConditionsConfig())
+}
+
+func TestECRRepository_AdoptionRetry(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-adoption-retry.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	retryCfg := crd.AdoptionRetry()
+	require.NotNil(retryCfg)
+	assert.Equal(3, retryCfg.MaxAttempts)
+	// Unset values are defaulted
+	assert.Equal(1, retryCfg.InitialBackoffSeconds)
+	assert.Equal(30, retryCfg.MaxBackoffSeconds)
+	assert.Contains(retryCfg.RetryableErrorCodes, "ThrottlingException")
+
+	g = testutil.NewModelForService(t, "ecr")
+	crd = testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.Nil(crd.AdoptionRetry())
+}
+
+func TestECRRepository_ProcessOutputCustomMethodName(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-process-output.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	assert.Equal("normalizeCreateRepositoryOutput", crd.ProcessOutputCustomMethodName(crd.Ops.Create))
+	assert.Equal("normalizeDescribeRepositoriesOutput", crd.ProcessOutputCustomMethodName(crd.Ops.ReadMany))
+	assert.Empty(crd.ProcessOutputCustomMethodName(crd.Ops.Delete))
+	assert.Empty(crd.ProcessOutputCustomMethodName(nil))
+
+	// The Output shape of the Delete operation is not read
+	g = testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-process-output.yaml",
+	})
+	g.GetConfig().Operations["DeleteRepository"] = ackgenconfig.OperationConfig{
+		ProcessOutputCustomMethodName: "normalizeDeleteRepositoryOutput",
+	}
+	_, err := g.GetCRDs()
+	if assert.NotNil(err) {
+		assert.Contains(err.Error(), "process_output_custom_method_name")
+	}
+}
+
+func TestECRRepository_LateInitializeRetry(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-late-initialize-retry.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// The smallest min backoff, largest max backoff and largest max attempts
+	// of the late initialized fields are used
+	retryCfg := crd.LateInitializeRetry()
+	require.NotNil(retryCfg)
+	assert.Equal(2, retryCfg.MinBackoffSeconds)
+	assert.Equal(60, retryCfg.MaxBackoffSeconds)
+	assert.Equal(5, retryCfg.MaxAttempts)
+
+	// Unset values are defaulted and a field without max_attempts retries
+	// until late initialization completes
+	g = testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-late-initialize.yaml",
+	})
+	crd = testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	retryCfg = crd.LateInitializeRetry()
+	require.NotNil(retryCfg)
+	assert.Equal(5, retryCfg.MinBackoffSeconds)
+	assert.Equal(5, retryCfg.MaxBackoffSeconds)
+	assert.Equal(0, retryCfg.MaxAttempts)
+
+	// Late initialized fields without backoff or max attempts keep the
+	// default fixed delay
+	resCfg := crd.Config().Resources["Repository"]
+	resCfg.Fields["ImageTagMutability"].LateInitialize = &ackgenconfig.LateInitializeConfig{}
+	assert.Nil(crd.LateInitializeRetry())
+
+	g = testutil.NewModelForService(t, "ecr")
+	crd = testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.Nil(crd.LateInitializeRetry())
+}
+
+func TestECRRepository_DefaultPrinterColumns(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-default-columns.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// The Repository has no state field, so only the ARN column is added
+	cols := crd.AdditionalPrinterColumns()
+	require.Len(cols, 1)
+	assert.Equal("ARN", cols[0].Name)
+	assert.True(crd.PrintSyncedColumn())
+	assert.True(crd.PrintAgeColumn())
+}
+
+func TestECRRepository_Ownership(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-ownership.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	ownershipCfg := crd.Ownership()
+	require.NotNil(ownershipCfg)
+	assert.Equal("services.k8s.aws/owner-uid", ownershipCfg.TagKey)
+
+	g = testutil.NewModelForService(t, "ecr")
+	crd = testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.Nil(crd.Ownership())
+}
+
+func TestECRRepository_DefaultTags(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-default-tags.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// The resource's default tags override the service's
+	assert.Equal(
+		map[string]string{
+			"cluster":   "%K8S_CLUSTER_NAME%",
+			"namespace": "%K8S_NAMESPACE%",
+			"team":      "registry",
+		},
+		crd.GetDefaultTags(),
+	)
+
+	crd.Config().DefaultTags["aws:team"] = "platform"
+	assert.Panics(func() {
+		crd.GetDefaultTags()
+	})
+
+	g = testutil.NewModelForService(t, "ecr")
+	crd = testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.Nil(crd.GetDefaultTags())
+}
+
+func TestECR_ImageTagMutabilitySetting_Singleton(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-singleton.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "ImageTagMutabilitySetting")
+	require.NotNil(crd)
+
+	assert.True(crd.IsSingleton())
+	assert.True(crd.SingletonResetsOnDelete())
+	require.NotNil(crd.Ops.Create)
+	assert.Equal("PutImageTagMutability", crd.Ops.Create.ExportedName)
+	// The Put operation is also used to update the singleton
+	assert.Equal(crd.Ops.Create, crd.Ops.Update)
+	assert.Nil(crd.Ops.Delete)
+
+	crd = testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.False(crd.IsSingleton())
+	assert.False(crd.SingletonResetsOnDelete())
+}
+
+func TestECR_HealthCheck(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-health-check.yaml",
+	})
+
+	healthCheck, err := g.HealthCheck()
+	require.Nil(err)
+	require.NotNil(healthCheck)
+	assert.Equal("DescribeRepositories", healthCheck.Operation)
+	assert.Equal(10, healthCheck.TimeoutSeconds)
+	// Unset values are defaulted
+	assert.Equal(60, healthCheck.IntervalSeconds)
+
+	// DescribeImages requires a repository name
+	g.GetConfig().HealthCheck.Operation = "DescribeImages"
+	_, err = g.HealthCheck()
+	assert.NotNil(err)
+
+	g.GetConfig().HealthCheck.Operation = "NoSuchOperation"
+	_, err = g.HealthCheck()
+	assert.NotNil(err)
+
+	g = testutil.NewModelForService(t, "ecr")
+	healthCheck, err = g.HealthCheck()
+	require.Nil(err)
+	assert.Nil(healthCheck)
+}
+
+func TestECR_Endpoint(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-endpoint.yaml",
+	})
+
+	endpoint, err := g.Endpoint()
+	require.Nil(err)
+	require.NotNil(endpoint)
+	assert.True(endpoint.UseFIPS)
+	assert.False(endpoint.UseDualStack)
+	assert.Equal("ECR_ENDPOINT_URL", endpoint.URLEnvVar)
+
+	g.GetConfig().Endpoint.URLEnvVar = "ECR-ENDPOINT-URL"
+	_, err = g.Endpoint()
+	assert.NotNil(err)
+
+	g = testutil.NewModelForService(t, "ecr")
+	endpoint, err = g.Endpoint()
+	require.Nil(err)
+	assert.Nil(endpoint)
+}
+
+func TestECRRepository_TypedEnums(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-typed-enums.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Repository", crds)
+	require.NotNil(crd)
+
+	f := crd.SpecFields["ImageTagMutability"]
+	require.NotNil(f)
+	assert.True(f.IsTypedEnum())
+	assert.Equal("*ImageTagMutability", f.GoType)
+	assert.Equal([]string{"MUTABLE", "IMMUTABLE"}, f.GetEnumValues())
+	assert.Equal(
+		`// +kubebuilder:validation:Enum="MUTABLE";"IMMUTABLE"`,
+		f.GetEnumMarker(),
+	)
+
+	g = testutil.NewModelForService(t, "ecr")
+	crds, err = g.GetCRDs()
+	require.Nil(err)
+
+	crd = getCRDByName("Repository", crds)
+	require.NotNil(crd)
+	f = crd.SpecFields["ImageTagMutability"]
+	assert.False(f.IsTypedEnum())
+	assert.Equal("*string", f.GoType)
+	assert.Empty(f.GetEnumMarker())
+}
+
+func TestECRRepository_ServerSideApplyListMap(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-server-side-apply.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	assert.Equal(
+		[]string{"// +listType=map", "// +listMapKey=key"},
+		crd.SpecFields["Tags"].GetServerSideApplyMarkers(),
+	)
+
+	tds, err := g.GetTypeDefs()
+	require.Nil(err)
+
+	var tagTD *model.TypeDef
+	for _, td := range tds {
+		if td.Names.Camel == "Tag" {
+			tagTD = td
+		}
+	}
+	require.NotNil(tagTD)
+	// Kubernetes requires list map keys to be set
+	assert.True(tagTD.GetAttribute("Key").IsRequired)
+	assert.False(tagTD.GetAttribute("Value").IsRequired)
+}
+
+func TestECRRepository_ResourceMarkers(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-resource-markers.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	assert.Equal(
+		"// +kubebuilder:resource:shortName=repo;repos,categories=aws;ecr",
+		crd.GetResourceMarker(),
+	)
+	assert.Equal(
+		[]string{
+			"// +kubebuilder:deprecatedversion",
+			`// +kubebuilder:metadata:labels="team=registry"`,
+		},
+		crd.GetAdditionalMarkers(),
+	)
+	assert.Equal(
+		[]string{"// +kubebuilder:validation:MinLength=2"},
+		crd.SpecFields["RepositoryName"].GetMarkers(),
+	)
+
+	tds, err := g.GetTypeDefs()
+	require.Nil(err)
+
+	var scanTD *model.TypeDef
+	for _, td := range tds {
+		if td.Names.Camel == "ImageScanningConfiguration" {
+			scanTD = td
+		}
+	}
+	require.NotNil(scanTD)
+	assert.Equal(
+		[]string{"// +kubebuilder:default=true"},
+		scanTD.GetAttribute("ScanOnPush").Markers,
+	)
+
+	g = testutil.NewModelForService(t, "ecr")
+	crd = testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.Empty(crd.GetResourceMarker())
+	assert.Empty(crd.GetAdditionalMarkers())
+	assert.Empty(crd.SpecFields["RepositoryName"].GetMarkers())
+}
+
+func TestECRRepository_ErrorConditions(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-error-conditions.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	assert.Equal(
+		[]model.ErrorCondition{
+			{
+				Code:   "InvalidParameterException",
+				Reason: "InvalidParameter",
+			},
+			{
+				Code:    "LimitExceededException",
+				Reason:  "RepositoryQuotaExceeded",
+				Message: "The repository quota of the account is reached, request a quota increase",
+			},
+		},
+		crd.ErrorConditions(),
+	)
+
+	g = testutil.NewModelForService(t, "ecr")
+	crd = testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.Empty(crd.ErrorConditions())
+}
+
+func TestECRRepository_TypeNames(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-type-names.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	field := crd.SpecFields["ImageScanningConfiguration"]
+	require.NotNil(field)
+	assert.Equal("*ScanningConfiguration", field.GoType)
+	assert.Equal("ScanningConfiguration", crd.TypeRenames()["ImageScanningConfiguration"])
+
+	tdefs, err := g.GetTypeDefs()
+	require.Nil(err)
+	tdefNames := []string{}
+	for _, tdef := range tdefs {
+		tdefNames = append(tdefNames, tdef.Names.Camel)
+	}
+	assert.Contains(tdefNames, "ScanningConfiguration")
+	assert.NotContains(tdefNames, "ImageScanningConfiguration")
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
 	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
@@ -55,3 +56,91 @@ func TestEKS_WithNestedReference(t *testing.T) {
 	assert.Equal("SecurityGroupRefs", securityGroupRefsAttr.Names.Camel)
 	assert.Equal("[]*ackv1alpha1.AWSResourceReferenceWrapper", securityGroupRefsAttr.GoType)
 }
+
+func TestEKS_DefaultPrinterColumns(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-default-columns.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Cluster")
+	require.NotNil(crd)
+
+	cols := crd.AdditionalPrinterColumns()
+	require.Len(cols, 2)
+	assert.Equal("ARN", cols[0].Name)
+	assert.Equal(".status.ackResourceMetadata.arn", cols[0].JSONPath)
+	assert.Equal(1, cols[0].Priority)
+	assert.Equal("Status", cols[1].Name)
+	assert.Equal(".status.status", cols[1].JSONPath)
+	assert.Equal(0, cols[1].Priority)
+	assert.True(crd.PrintSyncedColumn())
+	// The Age column is suppressed
+	assert.False(crd.PrintAgeColumn())
+
+	crd = testutil.GetCRDByName(t, g, "FargateProfile")
+	require.NotNil(crd)
+
+	// The ARN and Synced columns are suppressed
+	cols = crd.AdditionalPrinterColumns()
+	require.Len(cols, 1)
+	assert.Equal("Status", cols[0].Name)
+	assert.False(crd.PrintSyncedColumn())
+	assert.True(crd.PrintAgeColumn())
+}
+
+func TestEKS_ServerSideApplyMarkers(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-server-side-apply.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Cluster")
+	require.NotNil(crd)
+
+	assert.Equal(
+		[]string{"// +mapType=granular"},
+		crd.SpecFields["Tags"].GetServerSideApplyMarkers(),
+	)
+	assert.Empty(crd.SpecFields["Version"].GetServerSideApplyMarkers())
+
+	tds, err := g.GetTypeDefs()
+	require.Nil(err)
+
+	var vpcConfigRequestTD *model.TypeDef
+	for _, td := range tds {
+		if td != nil && strings.EqualFold(td.Names.Original, "vpcConfigRequest") {
+			vpcConfigRequestTD = td
+			break
+		}
+	}
+	require.NotNil(vpcConfigRequestTD)
+	subnetIdsAttr := vpcConfigRequestTD.GetAttribute("SubnetIds")
+	require.NotNil(subnetIdsAttr)
+	assert.Equal([]string{"// +listType=set"}, subnetIdsAttr.Markers)
+	securityGroupIdsAttr := vpcConfigRequestTD.GetAttribute("SecurityGroupIds")
+	require.NotNil(securityGroupIdsAttr)
+	assert.Empty(securityGroupIdsAttr.Markers)
+}
+
+func TestEKS_Cluster_UpdateOperationProcessOutput(t *testing.T) {
+	assert := assert.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "eks", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-update-operations.yaml",
+	})
+
+	// The Output shapes of update operations are not read
+	g.GetConfig().Operations = map[string]ackgenconfig.OperationConfig{}
+	g.GetConfig().Operations["UpdateClusterVersion"] = ackgenconfig.OperationConfig{
+		ProcessOutputCustomMethodName: "normalizeUpdateClusterVersionOutput",
+	}
+	_, err := g.GetCRDs()
+	if assert.NotNil(err) {
+		assert.Contains(err.Error(), "process_output_custom_method_name")
+	}
+}
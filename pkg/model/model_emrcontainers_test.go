@@ -17,7 +17,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -30,3 +32,35 @@ func TestEMRContainers_JobRun(t *testing.T) {
 
 	assert.Panics(func() { g.GetCRDs() })
 }
+
+func TestEMRContainers_UnionTypeDefs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "emrcontainers", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-unions.yaml",
+	})
+
+	tdefs, err := g.GetTypeDefs()
+	require.Nil(err)
+
+	var containerInfo, containerProvider *model.TypeDef
+	for _, tdef := range tdefs {
+		switch tdef.Names.Camel {
+		case "ContainerInfo":
+			containerInfo = tdef
+		case "ContainerProvider":
+			containerProvider = tdef
+		}
+	}
+	require.NotNil(containerInfo)
+	require.NotNil(containerProvider)
+
+	assert.True(containerInfo.IsUnion)
+	assert.Equal(
+		`// +kubebuilder:validation:XValidation:rule="[has(self.eksInfo)].filter(x, x).size() == 1",message="exactly one of eksInfo must be set"`,
+		containerInfo.GetUnionValidationMarker(),
+	)
+	assert.False(containerProvider.IsUnion)
+	assert.Empty(containerProvider.GetUnionValidationMarker())
+}
@@ -0,0 +1,147 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	 http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestIAM_ClusterScopedResource(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-cluster-scope.yaml",
+	})
+
+	role := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(role)
+	assert.True(role.IsClusterScoped())
+
+	user := testutil.GetCRDByName(t, g, "User")
+	require.NotNil(user)
+	assert.False(user.IsClusterScoped())
+	assert.Equal("// +kubebuilder:resource:scope=Cluster", role.GetResourceMarker())
+	assert.Empty(user.GetResourceMarker())
+
+	metaVars := g.MetaVars()
+	assert.Equal([]string{"roles", "users"}, metaVars.CRDNames)
+	assert.Equal([]string{"users"}, metaVars.NamespacedCRDNames)
+	assert.Equal([]string{"roles"}, metaVars.ClusterScopedCRDNames)
+}
+
+func TestIAM_ObserveOnlyResources(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-observe-only.yaml",
+	})
+
+	role := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(role)
+	assert.True(role.IsObserveOnly())
+	require.NotNil(role.Ops.Create)
+	assert.Contains(role.SpecFields, "RoleName")
+
+	// ServerCertificate has no Create operation, its Spec is the Input shape
+	// of its ReadOne operation
+	cert := testutil.GetCRDByName(t, g, "ServerCertificate")
+	require.NotNil(cert)
+	assert.True(cert.IsObserveOnly())
+	assert.Nil(cert.Ops.Create)
+	require.NotNil(cert.Ops.ReadOne)
+	assert.Equal("GetServerCertificate", cert.Ops.ReadOne.ExportedName)
+	assert.Equal([]string{"ServerCertificateName"}, attrCamelNames(cert.SpecFields))
+	assert.Equal(
+		[]string{"CertificateBody", "CertificateChain", "ServerCertificateMetadata", "Tags"},
+		attrCamelNames(cert.StatusFields),
+	)
+	assert.False(cert.SpecFields["ServerCertificateName"].IsRequired())
+	// The ServerCertificate shape is renamed not to conflict with the CRD
+	tds, err := g.GetTypeDefs()
+	require.Nil(err)
+	var certTD *model.TypeDef
+	for _, td := range tds {
+		if td.Names.Original == "ServerCertificate" {
+			certTD = td
+		}
+	}
+	require.NotNil(certTD)
+	assert.Equal("ServerCertificate_SDK", certTD.Names.Camel)
+	// The tags of observe-only resources are never written
+	tagField, err := cert.GetTagField()
+	require.Nil(err)
+	assert.Nil(tagField)
+}
+
+func TestIAM_AdoptionOnlyResource(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-adoption-only.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+	assert.True(crd.IsAdoptionOnly())
+	assert.False(crd.IsObserveOnly())
+	// The Spec still comes from the Create operation's Input shape
+	require.NotNil(crd.Ops.Create)
+	assert.Contains(crd.SpecFields, "AssumeRolePolicyDocument")
+}
+
+func TestIAM_AdoptionOnlyResource_Unreadable(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-unreadable-adoption-only.yaml",
+	})
+
+	// ServiceLinkedRole has no operation to read, and therefore adopt, it
+	crd := testutil.GetCRDByName(t, g, "ServiceLinkedRole")
+	require.NotNil(crd)
+	assert.Panics(func() { crd.IsAdoptionOnly() })
+}
+
+func TestIAM_Role_CreateGracePeriod(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "iam", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-create-grace-period.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+
+	graceCfg := crd.CreateGracePeriod()
+	require.NotNil(graceCfg)
+	assert.Equal(60, graceCfg.Seconds)
+	// Unset values are defaulted
+	assert.Equal(5, graceCfg.RequeueAfterSeconds)
+
+	g = testutil.NewModelForService(t, "iam")
+	crd = testutil.GetCRDByName(t, g, "Role")
+	require.NotNil(crd)
+	assert.Nil(crd.CreateGracePeriod())
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -178,3 +179,70 @@ func TestLambda_customNestedFields_Status_Depth3(t *testing.T) {
 	assert.Contains(ErrorField.MemberFields, "New")
 	assert.Contains(ErrorField.ShapeRef.Shape.MemberRefs, "New")
 }
+
+func TestLambda_Function_InferNestedRequiredFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-nested-required-fields.yaml",
+	})
+
+	tds, err := g.GetTypeDefs()
+	require.Nil(err)
+
+	// The FileSystemConfig shape requires both Arn and LocalMountPath, but
+	// the generator config overrides the latter
+	var fsConfigTD, environmentTD *model.TypeDef
+	for _, td := range tds {
+		switch td.Names.Camel {
+		case "FileSystemConfig":
+			fsConfigTD = td
+		case "Environment":
+			environmentTD = td
+		}
+	}
+	require.NotNil(fsConfigTD)
+	require.NotNil(environmentTD)
+	assert.True(fsConfigTD.GetAttribute("Arn").IsRequired)
+	assert.False(fsConfigTD.GetAttribute("LocalMountPath").IsRequired)
+	assert.False(environmentTD.GetAttribute("Variables").IsRequired)
+
+	// Required members are only inferred when configured to
+	g = testutil.NewModelForService(t, "lambda")
+	tds, err = g.GetTypeDefs()
+	require.Nil(err)
+	for _, td := range tds {
+		if td.Names.Camel == "FileSystemConfig" {
+			assert.False(td.GetAttribute("Arn").IsRequired)
+		}
+	}
+}
+
+func TestLambda_Function_OutputShapeOverride(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-shape-overrides.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+
+	// The Status fields are determined from the GetFunction Output shape
+	// instead of the FunctionConfiguration shape returned by CreateFunction
+	assert.Contains(crd.StatusFields, "Concurrency")
+	assert.Contains(crd.StatusFields, "Configuration")
+	assert.NotContains(crd.StatusFields, "State")
+
+	// The configured shape must exist in the API model
+	g = testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-shape-overrides.yaml",
+	})
+	opConfig := g.GetConfig().Operations["CreateFunction"]
+	opConfig.OutputShape = "NoSuchShape"
+	g.GetConfig().Operations["CreateFunction"] = opConfig
+	_, err := g.GetCRDs()
+	assert.NotNil(err)
+}
@@ -22,3 +22,25 @@ func TestUserPasswords_IsSecret(t *testing.T) {
 	assert.Equal("SecretKeyReference", crd.SpecFields["AuthenticationMode"].MemberFields["Passwords"].GoTypeElem)
 	assert.Equal("[]*ackv1alpha1.SecretKeyReference", crd.SpecFields["AuthenticationMode"].MemberFields["Passwords"].GoTypeWithPkgName)
 }
+
+func TestCluster_ScaleSubresource(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "memorydb", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-scale.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Cluster")
+	require.NotNil(crd)
+
+	assert.Equal(
+		"// +kubebuilder:subresource:scale:specpath=.spec.numShards,statuspath=.status.numberOfShards",
+		crd.GetScaleMarker(),
+	)
+
+	g = testutil.NewModelForService(t, "memorydb")
+	crd = testutil.GetCRDByName(t, g, "User")
+	require.NotNil(crd)
+	assert.Empty(crd.GetScaleMarker())
+}
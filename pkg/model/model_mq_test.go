@@ -65,3 +65,25 @@ func TestMQ_GetOutputShapeGoType(t *testing.T) {
 	otype := crd.GetOutputShapeGoType(crd.Ops.Create)
 	assert.Equal(exp, otype)
 }
+
+func TestMQ_Broker_Logging(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "mq", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-logging.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Broker")
+	require.NotNil(crd)
+
+	assert.True(crd.LogsSDKPayloads())
+	assert.False(crd.RedactsAllSDKPayloads())
+	// Secret and sensitive nested fields are redacted by member name
+	assert.Equal([]string{"Password", "Username"}, crd.RedactedSDKMemberNames())
+
+	g = testutil.NewModelForService(t, "mq")
+	crd = testutil.GetCRDByName(t, g, "Broker")
+	require.NotNil(crd)
+	assert.False(crd.LogsSDKPayloads())
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -174,3 +176,93 @@ func TestRDS_DBInstance(t *testing.T) {
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
 }
+
+func TestRDS_DBInstance_SensitiveFields(t *testing.T) {
+	assert := assert.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-sensitive-fields.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+
+	assert.True(crd.Fields["MasterUserPassword"].IsSensitive())
+	assert.True(crd.Fields["Endpoint.Address"].IsSensitive())
+	assert.False(crd.Fields["Endpoint.Port"].IsSensitive())
+
+	assert.True(crd.HasSensitiveFields())
+	assert.Equal(
+		[]string{"Spec.MasterUserPassword", "Status.Endpoint"},
+		crd.SensitiveFieldPaths(),
+	)
+}
+
+func TestRDS_ServerSideApplyListMap_SharedTypeDef(t *testing.T) {
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-shared-server-side-apply.yaml",
+	})
+
+	// The list map key would also become required in the Tag elements of
+	// the Tags fields of the other resources
+	_, err := g.GetTypeDefs()
+	require.Error(err)
+	require.Contains(err.Error(), "share the Tag type")
+}
+
+func TestRDS_RequiredNestedField_SharedTypeDef(t *testing.T) {
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-shared-required-fields.yaml",
+	})
+
+	// Key would also become required in the Tag elements of the Tags fields
+	// of the other resources
+	_, err := g.GetTypeDefs()
+	require.Error(err)
+	require.Contains(err.Error(), "require the attribute differently")
+}
+
+func TestRDS_DBInstance_KMSKeyReferences_SharedConfig(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-kms-key.yaml",
+	})
+	fConfig := &ackgenconfig.FieldConfig{
+		KMSKey: &ackgenconfig.KMSKeyConfig{},
+	}
+	cfg := ackgenconfig.Config{
+		Resources: map[string]ackgenconfig.ResourceConfig{
+			"DBInstance": {
+				Fields: map[string]*ackgenconfig.FieldConfig{
+					"KmsKeyId": fConfig,
+				},
+			},
+		},
+	}
+
+	// Models generated from the same config each get the companion
+	// reference field once, without changing the shared config
+	for i := 0; i < 2; i++ {
+		m, err := ackmodel.New(g.SDKAPI, "rds", "v1alpha1", cfg, ackgenconfig.DocumentationConfig{})
+		require.Nil(err)
+		assert.Nil(fConfig.References)
+
+		crds, err := m.GetCRDs()
+		require.Nil(err)
+		crd := getCRDByName("DBInstance", crds)
+		require.NotNil(crd)
+		refFields := 0
+		for _, f := range crd.SpecFields {
+			if f.Names.Camel == "KMSKeyRef" {
+				refFields++
+			}
+		}
+		assert.Equal(1, refFields)
+		require.NotNil(crd.SpecFields["KmsKeyId"].FieldConfig.References)
+	}
+}
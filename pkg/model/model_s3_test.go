@@ -100,3 +100,63 @@ func TestS3_Bucket(t *testing.T) {
 		assert.NotNil(testutil.GetTypeDefByName(t, g, typeDef))
 	}
 }
+
+func TestS3_Bucket_DeltaFieldPaths(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "s3")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Bucket", crds)
+	require.NotNil(crd)
+
+	paths := crd.DeltaFieldPaths()
+	assert.Contains(paths, "Spec.Name")
+	assert.Contains(paths, "Spec.CreateBucketConfiguration")
+	assert.Contains(paths, "Spec.CreateBucketConfiguration.LocationConstraint")
+	assert.NotContains(paths, "Status.Location")
+
+	// Nested members come before the structs containing them so that the
+	// deepest field path containing a difference is found first
+	indexOf := func(path string) int {
+		for i, p := range paths {
+			if p == path {
+				return i
+			}
+		}
+		return -1
+	}
+	assert.Less(
+		indexOf("Spec.CreateBucketConfiguration.LocationConstraint"),
+		indexOf("Spec.CreateBucketConfiguration"),
+	)
+}
+
+func TestS3_BucketPolicy_Upsert(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "s3", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-upsert.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "BucketPolicy")
+	require.NotNil(crd)
+	assert.True(crd.IsUpsert())
+	assert.False(crd.OmitUnchangedFieldsOnUpdate())
+
+	// PutBucketPolicy both creates and updates the bucket policy
+	require.NotNil(crd.Ops.Create)
+	require.NotNil(crd.Ops.Update)
+	assert.Equal("PutBucketPolicy", crd.Ops.Create.ExportedName)
+	assert.Equal("PutBucketPolicy", crd.Ops.Update.ExportedName)
+	assert.Equal("GetBucketPolicy", crd.Ops.ReadOne.ExportedName)
+	assert.Equal("DeleteBucketPolicy", crd.Ops.Delete.ExportedName)
+	assert.Equal(
+		[]string{"Bucket", "ConfirmRemoveSelfBucketAccess", "Policy"},
+		attrCamelNames(crd.SpecFields),
+	)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -226,3 +227,41 @@ func TestSageMaker_RequeueOnSuccessSeconds_Default(t *testing.T) {
 	assert.Equal(0, crd.ReconcileRequeuOnSuccessSeconds())
 
 }
+
+func TestSageMaker_FlowDefinition_JSONValueFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sagemaker", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-json-values.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "FlowDefinition")
+	require.NotNil(crd)
+	assert.True(crd.HasJSONValueFields())
+	assert.True(crd.HasJSONCompareFields())
+
+	// HumanLoopActivationConditionsConfig.HumanLoopActivationConditions is
+	// an aws.JSONValue member of the AWS API
+	f := crd.Fields["HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions"]
+	require.NotNil(f)
+	assert.True(f.IsJSONValue())
+	assert.Equal("*runtime.RawExtension", f.GoType)
+	assert.False(crd.Fields["RoleARN"].IsJSONValue())
+
+	tdefs, err := g.GetTypeDefs()
+	require.Nil(err)
+
+	var conditionsConfig *model.TypeDef
+	for _, tdef := range tdefs {
+		if tdef.Names.Camel == "HumanLoopActivationConditionsConfig" {
+			conditionsConfig = tdef
+		}
+	}
+	require.NotNil(conditionsConfig)
+
+	attr := conditionsConfig.Attrs["HumanLoopActivationConditions"]
+	require.NotNil(attr)
+	assert.True(attr.IsJSONValue())
+	assert.Equal("*runtime.RawExtension", attr.GoType)
+}
@@ -86,3 +86,34 @@ func TestSQS_Queue(t *testing.T) {
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
 }
+
+func TestSQS_Queue_InvalidAttributeType(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "sqs", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-typed-attributes.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Queue", crds)
+	require.NotNil(crd)
+
+	fConfig := crd.Config().Resources["Queue"].Fields["MaximumMessageSize"]
+	require.NotNil(fConfig)
+
+	fConfig.AttributeType = "float"
+	assert.EqualError(
+		crd.UnpackAttributes(),
+		`unsupported attribute_type "float" for field MaximumMessageSize of resource Queue`,
+	)
+
+	fConfig.AttributeType = "int"
+	fConfig.IsAttribute = false
+	assert.EqualError(
+		crd.UnpackAttributes(),
+		"field MaximumMessageSize of resource Queue has an attribute_type but is not an attribute",
+	)
+}
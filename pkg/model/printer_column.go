@@ -17,6 +17,8 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
 )
 
 // PrinterColumn represents a single field in the CRD's Spec or Status objects
@@ -24,6 +26,7 @@ type PrinterColumn struct {
 	CRD      *CRD
 	Name     string
 	Type     string
+	Format   string
 	Priority int
 	JSONPath string
 	Index    int
@@ -97,13 +100,26 @@ func (r *CRD) AdditionalPrinterColumns() []*PrinterColumn {
 	return r.additionalPrinterColumns
 }
 
+// printableColumnTypes contains the OpenAPI types that printer columns may
+// have, as defined by
+// https://github.com/OAI/OpenAPI-Specification/blob/master/versions/2.0.md#data-types
+// plus the "date" type supported by kubectl.
+var printableColumnTypes = []string{
+	"string", "boolean", "integer", "number", "date",
+}
+
 // addPrintableColumn adds an entry to the list of additional printer columns
-// using the given path and field types.
+// using the given path and field types. The column's type and path may be
+// overridden by the field's print configuration.
 func (r *CRD) addPrintableColumn(
 	field *Field,
 	jsonPath string,
 ) {
+	printCfg := field.FieldConfig.Print
 	fieldColumnType := field.GoTypeElem
+	if field.IsTypedEnum() {
+		fieldColumnType = "string"
+	}
 
 	// Printable columns must be primitives supported by the OpenAPI list of data
 	// types as defined by
@@ -111,6 +127,7 @@ func (r *CRD) addPrintableColumn(
 	// This maps Go type to OpenAPI type.
 	acceptableColumnMaps := map[string]string{
 		"string":      "string",
+		"bool":        "boolean",
 		"boolean":     "boolean",
 		"int":         "integer",
 		"int8":        "integer",
@@ -126,10 +143,21 @@ func (r *CRD) addPrintableColumn(
 		"float32":     "number",
 		"float64":     "number",
 		"metav1.Time": "date",
+		// resource.Quantity is serialized as a string, e.g. "10Gi"
+		"k8sresource.Quantity": "string",
 	}
 	printColumnType, exists := acceptableColumnMaps[fieldColumnType]
 
-	if !exists {
+	if printCfg.Type != "" {
+		if !util.InStrings(printCfg.Type, printableColumnTypes) {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! Unknown printer column type %s for the field %s. Must be one of %s.",
+				printCfg.Type, field.Names.Camel, strings.Join(printableColumnTypes, ", "),
+			)
+			panic(msg)
+		}
+		printColumnType = printCfg.Type
+	} else if !exists {
 		msg := fmt.Sprintf(
 			"GENERATION FAILURE! Unable to generate a printer column for the field %s that has type %s.",
 			field.Names.Camel, fieldColumnType,
@@ -137,18 +165,30 @@ func (r *CRD) addPrintableColumn(
 		panic(msg)
 	}
 
+	if printCfg.JSONPath != "" {
+		if !strings.HasPrefix(printCfg.JSONPath, ".") {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! Printer column JSONPath %s for the field %s must start with '.'.",
+				printCfg.JSONPath, field.Names.Camel,
+			)
+			panic(msg)
+		}
+		jsonPath = printCfg.JSONPath
+	}
+
 	name := field.Names.Camel
-	if field.FieldConfig.Print.Name != "" {
-		name = field.FieldConfig.Print.Name
+	if printCfg.Name != "" {
+		name = printCfg.Name
 	}
 
 	column := &PrinterColumn{
 		CRD:      r,
 		Name:     name,
 		Type:     printColumnType,
-		Priority: field.FieldConfig.Print.Priority,
+		Format:   printCfg.Format,
+		Priority: printCfg.Priority,
 		JSONPath: jsonPath,
-		Index:    field.FieldConfig.Print.Index,
+		Index:    printCfg.Index,
 	}
 	r.additionalPrinterColumns = append(r.additionalPrinterColumns, column)
 }
@@ -176,3 +216,83 @@ func (r *CRD) addStatusPrintableColumn(
 		fmt.Sprintf("%s.%s", ".status", field.Names.CamelLower),
 	)
 }
+
+// addDefaultPrinterColumns adds the standard columns of an ACK resource, i.e.
+// its ARN and state, that the resource's print configuration enables to the
+// list of additional printer columns. The state column is skipped when the
+// resource has no state field. The Synced and Age columns are added by the
+// CRD template, after these columns.
+func (r *CRD) addDefaultPrinterColumns() {
+	if r.cfg.IncludeACKMetadata && r.cfg.ResourceDisplaysARNColumn(r.Names.Camel) {
+		r.additionalPrinterColumns = append(r.additionalPrinterColumns, &PrinterColumn{
+			CRD:      r,
+			Name:     "ARN",
+			Type:     "string",
+			Priority: 1,
+			JSONPath: ".status.ackResourceMetadata.arn",
+		})
+	}
+	if r.cfg.ResourceDisplaysStateColumn(r.Names.Camel) {
+		field := r.stateField()
+		if field == nil {
+			return
+		}
+		if field.FieldConfig != nil && field.FieldConfig.Print != nil {
+			// The field is already printed
+			return
+		}
+		r.additionalPrinterColumns = append(r.additionalPrinterColumns, &PrinterColumn{
+			CRD:      r,
+			Name:     field.Names.Camel,
+			Type:     "string",
+			JSONPath: fmt.Sprintf("%s.%s", ".status", field.Names.CamelLower),
+		})
+	}
+}
+
+// stateField returns the Status field holding the state of the resource,
+// either the one named in the resource's print configuration or the first
+// of the "Status", "State", "{Resource}Status" and "{Resource}State" string
+// Status fields found, or nil if the resource has no such field.
+func (r *CRD) stateField() *Field {
+	candidates := []string{
+		"Status",
+		"State",
+		r.Names.Original + "Status",
+		r.Names.Original + "State",
+	}
+	stateFieldName := ""
+	if resConfig := r.cfg.GetResourceConfig(r.Names.Original); resConfig != nil &&
+		resConfig.Print != nil {
+		stateFieldName = resConfig.Print.StateFieldName
+	}
+	if stateFieldName != "" {
+		candidates = []string{stateFieldName}
+	}
+	for _, candidate := range candidates {
+		for _, field := range r.StatusFields {
+			if !strings.EqualFold(field.Names.Original, candidate) &&
+				!strings.EqualFold(field.Names.Camel, candidate) {
+				continue
+			}
+			if field.GoTypeElem == "string" {
+				return field
+			}
+			if stateFieldName != "" {
+				msg := fmt.Sprintf(
+					"GENERATION FAILURE! State field %s of %s has type %s, expected string.",
+					field.Names.Camel, r.Names.Original, field.GoTypeElem,
+				)
+				panic(msg)
+			}
+		}
+	}
+	if stateFieldName != "" {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! Unable to find state field %s among Status fields of %s.",
+			stateFieldName, r.Names.Original,
+		)
+		panic(msg)
+	}
+	return nil
+}
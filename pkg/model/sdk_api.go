@@ -15,6 +15,7 @@ package model
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws-controllers-k8s/pkg/names"
@@ -50,6 +51,13 @@ type SDKAPI struct {
 	API            *awssdkmodel.API
 	APIGroupSuffix string
 	CustomShapes   []*CustomShape
+	// ModelPath is the path to the api-2.json file the API was loaded from
+	ModelPath string
+	// UnionShapeNames contains the names of the structure shapes modeled as
+	// unions, of which exactly one member must be set. aws-sdk-go's API
+	// model does not record unions, so they are read from the api-2.json
+	// file.
+	UnionShapeNames map[string]struct{}
 	// A map of operation type and resource name to
 	// aws-sdk-go/private/model/api.Operation structs
 	opMap *OperationMap
@@ -120,6 +128,25 @@ func (a *SDKAPI) GetOperationMap(cfg *ackgenconfig.Config) *OperationMap {
 			}
 		}
 	}
+
+	// Upsert operations are both the Create and Update operations of their
+	// resource, overriding any inferred or configured operation.
+	for resName, rCfg := range cfg.Resources {
+		if rCfg.UpsertOperation == "" {
+			continue
+		}
+		op, found := a.API.Operations[rCfg.UpsertOperation]
+		if !found {
+			panic("upsert_operation " + rCfg.UpsertOperation + " of resource " +
+				resName + " in generator.yaml does not exist.")
+		}
+		for _, opType := range []OpType{OpTypeCreate, OpTypeUpdate} {
+			if _, found := opMap[opType]; !found {
+				opMap[opType] = map[string]*awssdkmodel.Operation{}
+			}
+			opMap[opType][resName] = op
+		}
+	}
 	a.opMap = &opMap
 	return &opMap
 }
@@ -200,6 +227,22 @@ func (a *SDKAPI) GetCustomShapeRef(shapeName string) *awssdkmodel.ShapeRef {
 	return a.getCustomMapRef(shapeName)
 }
 
+// GetCustomStructRef returns a ShapeRef for the supplied structure shape,
+// either a custom shape defined in the generator config or a shape of the API
+// model. Returns nil if there is no such structure shape.
+func (a *SDKAPI) GetCustomStructRef(shapeName string) *awssdkmodel.ShapeRef {
+	shape, found := a.API.Shapes[shapeName]
+	if !found || shape.Type != "structure" {
+		return nil
+	}
+	return &awssdkmodel.ShapeRef{
+		API:           a.API,
+		Shape:         shape,
+		Documentation: shape.Documentation,
+		ShapeName:     shapeName,
+	}
+}
+
 // getCustomListRef finds a ShapeRef for a supplied custom list field
 func (a *SDKAPI) getCustomListRef(memberShapeName string) *awssdkmodel.ShapeRef {
 	for _, shape := range a.CustomShapes {
@@ -261,11 +304,22 @@ func (a *SDKAPI) CRDNames(cfg *ackgenconfig.Config) []names.Names {
 		}
 		crdNames = append(crdNames, names.New(crdName))
 	}
+	if cfg != nil {
+		// Observe-only resources may have no Create operation
+		for crdName, rConfig := range cfg.Resources {
+			if !rConfig.ObserveOnly || createOps[crdName] != nil ||
+				cfg.ResourceIsIgnored(crdName) {
+				continue
+			}
+			crdNames = append(crdNames, names.New(crdName))
+		}
+	}
 	return crdNames
 }
 
 // GetTypeRenames returns a map of original type name to renamed name (some
-// type definition names conflict with generated names)
+// type definition names conflict with generated names, collide with the names
+// of other types or are chosen in the generator config)
 func (a *SDKAPI) GetTypeRenames(cfg *ackgenconfig.Config) map[string]string {
 	if a.typeRenames != nil {
 		return a.typeRenames
@@ -275,6 +329,7 @@ func (a *SDKAPI) GetTypeRenames(cfg *ackgenconfig.Config) map[string]string {
 
 	payloads := a.GetPayloads()
 
+	shapeNames := []string{}
 	for shapeName, shape := range a.API.Shapes {
 		if util.InStrings(shapeName, payloads) {
 			// Payloads are not type defs
@@ -287,16 +342,73 @@ func (a *SDKAPI) GetTypeRenames(cfg *ackgenconfig.Config) map[string]string {
 			// Neither are exceptions
 			continue
 		}
-		tdefNames := names.New(shapeName)
+		shapeNames = append(shapeNames, shapeName)
+	}
+	// Sort the shape names to disambiguate colliding names deterministically
+	sort.Strings(shapeNames)
+
+	// Names chosen in the generator config take precedence over the names
+	// derived from the shape names
+	taken := map[string]bool{}
+	if cfg != nil {
+		for shapeName, typeName := range cfg.TypeNames {
+			if taken[typeName] {
+				panic(fmt.Sprintf("type name %q is chosen for more than one "+
+					"shape, including %q", typeName, shapeName))
+			}
+			taken[typeName] = true
+			trenames[shapeName] = typeName
+		}
+	}
+	defaultNames := map[string]string{}
+	isDefaultName := map[string]bool{}
+	for _, shapeName := range shapeNames {
+		if _, chosen := trenames[shapeName]; chosen {
+			continue
+		}
+		typeName := names.New(shapeName).Camel
 		if a.HasConflictingTypeName(shapeName, cfg) {
-			tdefNames.Camel += ConflictingNameSuffix
-			trenames[shapeName] = tdefNames.Camel
+			typeName += ConflictingNameSuffix
+		}
+		defaultNames[shapeName] = typeName
+		isDefaultName[typeName] = true
+	}
+	for _, shapeName := range shapeNames {
+		typeName, found := defaultNames[shapeName]
+		if !found {
+			continue
+		}
+		if taken[typeName] {
+			// The Camel-cased names of distinct shapes collide, e.g.
+			// `VpcConfig` and `VPCConfig`
+			baseName := typeName
+			for suffix := 2; taken[typeName] || isDefaultName[typeName]; suffix++ {
+				typeName = fmt.Sprintf("%s%d", baseName, suffix)
+			}
+		}
+		taken[typeName] = true
+		if typeName != names.New(shapeName).Camel {
+			trenames[shapeName] = typeName
 		}
 	}
 	a.typeRenames = trenames
 	return trenames
 }
 
+// StructTypeName returns the name of the Go type generated for the supplied
+// structure shape, handling name conflicts with top-level CRD types, name
+// collisions with other shapes and the `type_names` generator config.
+func (a *SDKAPI) StructTypeName(shapeName string, cfg *ackgenconfig.Config) string {
+	if typeName, renamed := a.GetTypeRenames(cfg)[shapeName]; renamed {
+		return typeName
+	}
+	typeName := names.New(shapeName).Camel
+	if a.HasConflictingTypeName(shapeName, cfg) {
+		typeName += ConflictingNameSuffix
+	}
+	return typeName
+}
+
 // HasConflictingTypeName returns true if the supplied type name will conflict
 // with any generated type in the service's API package
 func (a *SDKAPI) HasConflictingTypeName(typeName string, cfg *ackgenconfig.Config) bool {
@@ -322,6 +434,24 @@ func (a *SDKAPI) HasConflictingTypeName(typeName string, cfg *ackgenconfig.Confi
 		util.InStrings(cleanTypeName, crdListResourceNames)
 }
 
+// EnumTypeName returns the name of the Go string alias generated for the
+// supplied enum shape, handling name conflicts with top-level CRD.Spec or
+// CRD.Status types.
+func (a *SDKAPI) EnumTypeName(shapeName string, cfg *ackgenconfig.Config) string {
+	typeName := names.New(shapeName).Camel
+	if a.HasConflictingTypeName(shapeName, cfg) {
+		typeName += ConflictingNameSuffix
+	}
+	return typeName
+}
+
+// IsUnion returns true if the supplied structure shape is modeled as a union,
+// of which exactly one member must be set.
+func (a *SDKAPI) IsUnion(shapeName string) bool {
+	_, found := a.UnionShapeNames[shapeName]
+	return found
+}
+
 // ServiceID returns the exact `metadata.serviceId` attribute for the AWS
 // service APi's api-2.json file.
 // This MAY NOT MATCH the AWS SDK Go package used by the service. For example:
@@ -443,6 +573,16 @@ func NewCustomListShape(shape *awssdkmodel.Shape, ref *awssdkmodel.ShapeRef, mem
 	}
 }
 
+// NewCustomStructShape creates a custom shape object for a new structure.
+func NewCustomStructShape(shape *awssdkmodel.Shape, ref *awssdkmodel.ShapeRef) *CustomShape {
+	return &CustomShape{
+		Shape:           shape,
+		ShapeRef:        ref,
+		MemberShapeName: nil,
+		ValueShapeName:  nil,
+	}
+}
+
 // NewCustomMapShape creates a custom shape object for a new map.
 func NewCustomMapShape(shape *awssdkmodel.Shape, ref *awssdkmodel.ShapeRef, valueShapeName string) *CustomShape {
 	return &CustomShape{
@@ -16,11 +16,30 @@ package model
 import (
 	"fmt"
 	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
 )
 
 // GetTagFieldName returns the name of field containing AWS tags. The default
 // name is "Tags". If no tag field is found inside the CRD, an error is returned
 func (r *CRD) GetTagFieldName() (string, error) {
+	tagFieldPath := r.tagFieldPath()
+	// verify that the tagFieldPath exists inside CRD
+	for fName, field := range r.Fields {
+		if strings.EqualFold(field.Path, tagFieldPath) {
+			return fName, nil
+		}
+	}
+	// If the tagFieldPath did not exist in CRD, return an error
+	return "", fmt.Errorf("tag field path %s does not exist inside %s"+
+		" crd", tagFieldPath, r.Names.Original)
+}
+
+// tagFieldPath returns the configured path of the tag field, or the default
+// "Tags" path.
+func (r *CRD) tagFieldPath() string {
 	tagFieldPath := "Tags" // default tag field path
 
 	// If there is an explicit tag field path mentioned inside generator config,
@@ -32,21 +51,14 @@ func (r *CRD) GetTagFieldName() (string, error) {
 			}
 		}
 	}
-	// verify that the tagFieldPath exists inside CRD
-	for fName, field := range r.Fields {
-		if strings.EqualFold(field.Path, tagFieldPath) {
-			return fName, nil
-		}
-	}
-	// If the tagFieldPath did not exist in CRD, return an error
-	return "", fmt.Errorf("tag field path %s does not exist inside %s"+
-		" crd", tagFieldPath, r.Names.Original)
+	return tagFieldPath
 }
 
 // GetTagField return the model.Field representing the Tag field for CRD. If no
 // such field is found an error is returned.
 func (r *CRD) GetTagField() (*Field, error) {
-	if r.cfg.TagsAreIgnored(r.Names.Original) {
+	if r.cfg.TagsAreIgnored(r.Names.Original) || r.IsObserveOnly() {
+		// The tags of observe-only resources are never written
 		return nil, nil
 	}
 	tagFieldName, err := r.GetTagFieldName()
@@ -119,3 +131,421 @@ func isListOfStruct(f *Field) bool {
 	}
 	return false
 }
+
+// GetDefaultTags returns the tags, keyed by tag key, added to the tags of the
+// resource, see `default_tags`, or nil if there are none. It panics if the
+// resource has no tag field or if a tag key is empty or uses the reserved
+// "aws:" prefix.
+func (r *CRD) GetDefaultTags() map[string]string {
+	defaultTags := r.cfg.GetDefaultTags(r.Names.Original)
+	if len(defaultTags) == 0 {
+		return nil
+	}
+	if tagField, err := r.GetTagField(); err != nil || tagField == nil {
+		panic(fmt.Sprintf(
+			"default_tags are configured for %s but the resource has no tag field",
+			r.Names.Original,
+		))
+	}
+	for k := range defaultTags {
+		if k == "" || strings.HasPrefix(strings.ToLower(k), "aws:") {
+			panic(fmt.Sprintf(
+				"invalid default tag key %q of %s: keys must be non-empty and "+
+					"must not use the reserved aws: prefix",
+				k, r.Names.Original,
+			))
+		}
+	}
+	return defaultTags
+}
+
+// awsTagKeyPrefix is the prefix of the keys of the tags managed by AWS, which
+// users can neither set nor remove
+const awsTagKeyPrefix = "aws:"
+
+// GetIgnoredTagKeyPrefixes returns the prefixes of the keys of the tags
+// ignored when comparing and updating the tags of the resource: the "aws:"
+// prefix followed by the prefixes of `tags.ignore_key_prefixes`. It returns
+// nil when no prefix is configured and panics if the resource has no tag
+// field or if a prefix is empty.
+func (r *CRD) GetIgnoredTagKeyPrefixes() []string {
+	configured := r.cfg.GetTagIgnoreKeyPrefixes(r.Names.Original)
+	if len(configured) == 0 {
+		return nil
+	}
+	if tagField, err := r.GetTagField(); err != nil || tagField == nil {
+		panic(fmt.Sprintf(
+			"tags.ignore_key_prefixes is configured for %s but the resource has no tag field",
+			r.Names.Original,
+		))
+	}
+	prefixes := []string{awsTagKeyPrefix}
+	for _, prefix := range configured {
+		if prefix == "" {
+			panic(fmt.Sprintf(
+				"tags.ignore_key_prefixes of %s contains an empty prefix",
+				r.Names.Original,
+			))
+		}
+		if !util.InStrings(prefix, prefixes) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// TagSync describes the generated code updating the tags of a resource with
+// the tagging operations of its service, see `tags.sync`.
+type TagSync struct {
+	// TagOp is the operation adding tags to the AWS resource
+	TagOp *awssdkmodel.Operation
+	// UntagOp is the operation removing tags from the AWS resource. It is
+	// TagOp for services adding and removing tags in a single call.
+	UntagOp *awssdkmodel.Operation
+	// ResourceMember is the name of the member of the tagging operations'
+	// Input shapes identifying the AWS resource
+	ResourceMember string
+	// ResourceFieldPath is the path of the field of the resource holding the
+	// value of ResourceMember
+	ResourceFieldPath string
+	// TagsMember is the name of the member of TagOp's Input shape holding
+	// the tags to add
+	TagsMember string
+	// TagKeysMember is the name of the member of UntagOp's Input shape
+	// holding the keys of the tags to remove
+	TagKeysMember string
+	// FieldPath is the path, including the Spec prefix, of the tag field of
+	// the resource
+	FieldPath string
+	// AfterCreate is true when the tags are added with TagOp once the
+	// resource is created instead of being passed to the Create operation
+	AfterCreate bool
+	// ListOp is the operation reading the tags of the AWS resource when the
+	// resource is read, or nil if the operation reading the resource returns
+	// its tags
+	ListOp *awssdkmodel.Operation
+	// ListTagsMember is the name of the member of ListOp's Output shape
+	// holding the tags of the AWS resource
+	ListTagsMember string
+}
+
+// GetTagSync returns how the generated code updates the tags of the resource
+// with the tagging operations of its service, with defaults applied, or nil
+// if the generated code does not update its tags. It panics if the resource
+// has no top-level Spec tag field, if the tagging operations or their members
+// do not exist or if the tags of the AWS resource are neither returned by the
+// operation reading the resource nor read with a list operation.
+func (r *CRD) GetTagSync() *TagSync {
+	syncCfg := r.cfg.GetTagSyncConfig(r.Names.Original)
+	if syncCfg == nil {
+		return nil
+	}
+	tagField, err := r.GetTagField()
+	if err != nil || tagField == nil || strings.Contains(tagField.Path, ".") ||
+		r.SpecFields[tagField.Names.Original] != tagField {
+		panic(fmt.Sprintf(
+			"tags.sync is configured for %s but the resource has no top-level Spec tag field",
+			r.Names.Original,
+		))
+	}
+	specPrefix := strings.TrimPrefix(r.cfg.PrefixConfig.SpecField, ".")
+	res := &TagSync{
+		ResourceMember:    syncCfg.ResourceMember,
+		ResourceFieldPath: syncCfg.ResourceField,
+		TagsMember:        syncCfg.TagsMember,
+		TagKeysMember:     syncCfg.TagKeysMember,
+		FieldPath:         specPrefix + "." + tagField.Path,
+		AfterCreate:       syncCfg.AfterCreate,
+		ListTagsMember:    syncCfg.ListTagsMember,
+	}
+	tagOpID := syncCfg.TagOperation
+	if tagOpID == "" {
+		tagOpID = "TagResource"
+	}
+	untagOpID := syncCfg.UntagOperation
+	if untagOpID == "" {
+		untagOpID = "UntagResource"
+	}
+	if res.ResourceFieldPath == "" {
+		res.ResourceFieldPath = "Status.ACKResourceMetadata.ARN"
+	}
+	if res.TagsMember == "" {
+		res.TagsMember = "Tags"
+	}
+	if res.TagKeysMember == "" {
+		res.TagKeysMember = "TagKeys"
+	}
+	res.TagOp = r.GetOperation(tagOpID)
+	res.UntagOp = r.GetOperation(untagOpID)
+	if res.TagOp == nil || res.UntagOp == nil {
+		panic(fmt.Sprintf(
+			"unable to find tagging operations %q and %q of resource %q",
+			tagOpID, untagOpID, r.Names.Original,
+		))
+	}
+	tagInput := res.TagOp.InputRef.Shape
+	untagInput := res.UntagOp.InputRef.Shape
+	if res.ResourceMember == "" {
+		for _, memberName := range tagInput.Required {
+			if memberName == res.TagsMember {
+				continue
+			}
+			if res.ResourceMember != "" {
+				panic(fmt.Sprintf(
+					"tagging operation %q of resource %q has several required "+
+						"members, set tags.sync.resource_member",
+					tagOpID, r.Names.Original,
+				))
+			}
+			res.ResourceMember = memberName
+		}
+	}
+	if _, found := tagInput.MemberRefs[res.TagsMember]; !found {
+		panic(fmt.Sprintf(
+			"unable to find member %q of tag operation %q of resource %q",
+			res.TagsMember, tagOpID, r.Names.Original,
+		))
+	}
+	keysRef, found := untagInput.MemberRefs[res.TagKeysMember]
+	if !found || keysRef.Shape.Type != "list" ||
+		keysRef.Shape.MemberRef.Shape.Type != "string" {
+		panic(fmt.Sprintf(
+			"member %q of untag operation %q of resource %q must be a list "+
+				"of strings",
+			res.TagKeysMember, untagOpID, r.Names.Original,
+		))
+	}
+	for _, inputShape := range []*awssdkmodel.Shape{tagInput, untagInput} {
+		if _, found := inputShape.MemberRefs[res.ResourceMember]; !found {
+			panic(fmt.Sprintf(
+				"unable to find resource member %q of tagging operations "+
+					"%q and %q of resource %q",
+				res.ResourceMember, tagOpID, untagOpID, r.Names.Original,
+			))
+		}
+	}
+	if syncCfg.ListOperation == "" {
+		// Without the latest tags, the tags removed from the resource would
+		// never be untagged and the tags would be added again on every
+		// resync
+		if !r.readOutputHasMember(tagField.Names.Original) {
+			panic(fmt.Sprintf(
+				"tags.sync is configured for %s but the operation reading the "+
+					"resource does not return its tags, set tags.sync.list_operation",
+				r.Names.Original,
+			))
+		}
+		return res
+	}
+	res.ListOp = r.GetOperation(syncCfg.ListOperation)
+	if res.ListOp == nil {
+		panic(fmt.Sprintf(
+			"unable to find list tags operation %q of resource %q",
+			syncCfg.ListOperation, r.Names.Original,
+		))
+	}
+	if _, found := res.ListOp.InputRef.Shape.MemberRefs[res.ResourceMember]; !found {
+		panic(fmt.Sprintf(
+			"unable to find resource member %q of list tags operation %q of "+
+				"resource %q",
+			res.ResourceMember, syncCfg.ListOperation, r.Names.Original,
+		))
+	}
+	if res.ListTagsMember == "" {
+		res.ListTagsMember = "Tags"
+	}
+	listTagsRef, found := res.ListOp.OutputRef.Shape.MemberRefs[res.ListTagsMember]
+	if !found || (listTagsRef.Shape.Type != tagField.ShapeRef.Shape.Type &&
+		!r.GetTagConversion().Converts(tagField, listTagsRef.Shape)) {
+		panic(fmt.Sprintf(
+			"member %q of list tags operation %q of resource %q must have "+
+				"the representation of the tag field",
+			res.ListTagsMember, syncCfg.ListOperation, r.Names.Original,
+		))
+	}
+	return res
+}
+
+// readOutputHasMember returns true if the resource read by the ReadOne, or
+// else ReadMany, operation has a member with the supplied name.
+func (r *CRD) readOutputHasMember(memberName string) bool {
+	op := r.Ops.ReadOne
+	if op == nil {
+		op = r.Ops.ReadMany
+	}
+	if op == nil {
+		return false
+	}
+	shape, err := r.GetOutputShape(op)
+	if err != nil {
+		return false
+	}
+	if op == r.Ops.ReadMany && r.GetOutputWrapperFieldPath(op) == nil {
+		// The resource is the element of the Output shape's list member
+		for _, memberRef := range shape.MemberRefs {
+			if memberRef.Shape.Type == "list" {
+				shape = memberRef.Shape
+				break
+			}
+		}
+	}
+	if shape.Type == "list" {
+		shape = shape.MemberRef.Shape
+	}
+	_, found := shape.MemberRefs[memberName]
+	return found
+}
+
+// TagsAfterCreate returns true if the tags of the resource are added with the
+// tag operation once the resource is created instead of being passed to its
+// Create operation, see `tags.sync.after_create`.
+func (r *CRD) TagsAfterCreate() bool {
+	tagSync := r.GetTagSync()
+	return tagSync != nil && tagSync.AfterCreate
+}
+
+// ReadsTags returns true if the tags of the AWS resource are read with the
+// list operation of `tags.sync` when the resource is read.
+func (r *CRD) ReadsTags() bool {
+	tagSync := r.GetTagSync()
+	return tagSync != nil && tagSync.ListOp != nil
+}
+
+// IsTagField returns true if the supplied field is the tag field of the
+// resource.
+func (r *CRD) IsTagField(f *Field) bool {
+	tagField, err := r.GetTagField()
+	return err == nil && tagField != nil && tagField == f
+}
+
+// TagConversion describes the conversion of the tags between the tag field of
+// a resource and the payloads of its API representing them the other way, see
+// `tags.representation`.
+type TagConversion struct {
+	// Field is the tag field of the resource
+	Field *Field
+	// SDKShape is the shape of the tags in the payloads of the API
+	SDKShape *awssdkmodel.Shape
+	// SDKKeyMemberName is the name of the key member of the API's tag
+	// struct when SDKShape is a list
+	SDKKeyMemberName string
+	// SDKValueMemberName is the name of the value member of the API's tag
+	// struct when SDKShape is a list
+	SDKValueMemberName string
+}
+
+// GetTagConversion returns the conversion of the tags between the tag field
+// of the resource and the payloads of its API, or nil if the tag field has
+// the representation of the API.
+func (r *CRD) GetTagConversion() *TagConversion {
+	if r.tagSDKShape == nil {
+		return nil
+	}
+	tagField, err := r.GetTagField()
+	if err != nil || tagField == nil {
+		return nil
+	}
+	res := &TagConversion{Field: tagField, SDKShape: r.tagSDKShape}
+	if r.tagSDKShape.Type == "list" {
+		res.SDKKeyMemberName, res.SDKValueMemberName = r.GetTagStructMemberNames(
+			r.tagSDKShape.MemberRef.Shape,
+		)
+	}
+	return res
+}
+
+// Converts returns whether the tags are converted between the supplied field
+// and a payload member of the supplied shape.
+func (c *TagConversion) Converts(f *Field, shape *awssdkmodel.Shape) bool {
+	return c != nil && f == c.Field && shape != nil &&
+		shape.Type == c.SDKShape.Type
+}
+
+// GetTagStructMemberNames returns the names of the key and value members of
+// the supplied struct shape of a tag, the configured `key_name` and
+// `value_name` if the shape has such members, or else Key and Value. It
+// panics if the shape has no such members.
+func (r *CRD) GetTagStructMemberNames(
+	shape *awssdkmodel.Shape,
+) (string, string) {
+	keyMemberName := "Key"
+	valueMemberName := "Value"
+	if resConfig := r.cfg.GetResourceConfig(r.Names.Original); resConfig != nil {
+		if tagConfig := resConfig.TagConfig; tagConfig != nil {
+			if name := tagConfig.KeyMemberName; name != nil && shape.MemberRefs[*name] != nil {
+				keyMemberName = *name
+			}
+			if name := tagConfig.ValueMemberName; name != nil && shape.MemberRefs[*name] != nil {
+				valueMemberName = *name
+			}
+		}
+	}
+	if shape.MemberRefs[keyMemberName] == nil || shape.MemberRefs[valueMemberName] == nil {
+		panic(fmt.Sprintf(
+			"tag shape %q of resource %q has no key and value members",
+			shape.ShapeName, r.Names.Original,
+		))
+	}
+	return keyMemberName, valueMemberName
+}
+
+// tagFieldShapeRef returns the ShapeRef of the Spec field with the supplied
+// path. If the field is the tag field and `tags.representation` configures
+// another representation than the one of the supplied ShapeRef of the API,
+// the ShapeRef of the configured representation is returned and the shape of
+// the API is recorded for the conversion helpers, see GetTagConversion.
+func (r *CRD) tagFieldShapeRef(
+	fPath string,
+	shapeRef *awssdkmodel.ShapeRef,
+) *awssdkmodel.ShapeRef {
+	representation := r.cfg.GetTagRepresentation(r.Names.Original)
+	if representation == "" || r.cfg.TagsAreIgnored(r.Names.Original) ||
+		!strings.EqualFold(fPath, r.tagFieldPath()) ||
+		shapeRef.Shape == nil || shapeRef.Shape.Type == representation {
+		return shapeRef
+	}
+	sdkShape := shapeRef.Shape
+	switch {
+	case sdkShape.Type == "map" && sdkShape.ValueRef.Shape.Type == "string":
+	case sdkShape.Type == "list" && sdkShape.MemberRef.Shape.Type == "structure":
+		// Panics if the API's tag struct has no key and value members
+		r.GetTagStructMemberNames(sdkShape.MemberRef.Shape)
+	default:
+		panic(fmt.Sprintf(
+			"unable to convert the tags of %s: the API's tags must be a map "+
+				"of strings or a list of structs",
+			r.Names.Original,
+		))
+	}
+	var res *awssdkmodel.ShapeRef
+	switch representation {
+	case "map":
+		res = r.sdkAPI.GetShapeRefFromType("map[string]*string")
+	case "list":
+		structName := r.cfg.GetTagStructName(r.Names.Original)
+		tagStructRef := r.sdkAPI.GetCustomStructRef(structName)
+		if tagStructRef == nil {
+			panic(fmt.Sprintf(
+				"tags.representation of %s is list but the API has no %s "+
+					"struct, set tags.struct_name or define it in custom_shapes",
+				r.Names.Original, structName,
+			))
+		}
+		// Panics if the Tag struct has no key and value members
+		r.GetTagStructMemberNames(tagStructRef.Shape)
+		res = &awssdkmodel.ShapeRef{
+			Shape: &awssdkmodel.Shape{
+				Type:      "list",
+				MemberRef: *tagStructRef,
+			},
+		}
+	default:
+		panic(fmt.Sprintf(
+			"invalid tags.representation %q of %s, must be map or list",
+			representation, r.Names.Original,
+		))
+	}
+	res.Documentation = shapeRef.Documentation
+	r.tagSDKShape = sdkShape
+	return res
+}
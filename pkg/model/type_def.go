@@ -14,6 +14,8 @@
 package model
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws-controllers-k8s/pkg/names"
@@ -26,6 +28,35 @@ type TypeDef struct {
 	Names names.Names
 	Attrs map[string]*Attr
 	Shape *awssdkmodel.Shape
+	// IsUnion is true when the struct is modeled as a union by the AWS API,
+	// i.e. exactly one of its attributes must be set
+	IsUnion bool
+}
+
+// GetUnionValidationMarker returns the `+kubebuilder:validation:XValidation`
+// marker of a union struct, rejecting values that do not set exactly one of
+// its attributes, or an empty string.
+//
+// Output will look something like this:
+//
+//	// +kubebuilder:validation:XValidation:rule="[has(self.eksInfo)].filter(x, x).size() == 1",message="exactly one of eksInfo must be set"
+func (td *TypeDef) GetUnionValidationMarker() string {
+	if !td.IsUnion || len(td.Attrs) == 0 {
+		return ""
+	}
+	jsonNames := make([]string, 0, len(td.Attrs))
+	for _, attr := range td.Attrs {
+		jsonNames = append(jsonNames, attr.Names.CamelLower)
+	}
+	sort.Strings(jsonNames)
+	hasChecks := make([]string, len(jsonNames))
+	for i, jsonName := range jsonNames {
+		hasChecks[i] = "has(self." + jsonName + ")"
+	}
+	return fmt.Sprintf(
+		"// +kubebuilder:validation:XValidation:rule=\"[%s].filter(x, x).size() == 1\",message=\"exactly one of %s must be set\"",
+		strings.Join(hasChecks, ", "), strings.Join(jsonNames, ", "),
+	)
 }
 
 // GetAttribute returns the Attribute with name "attrName".
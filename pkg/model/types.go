@@ -40,20 +40,21 @@ func CleanGoType(
 	gtwp := shape.GoTypeWithPkgName()
 	// Normalize the type names for structs and list elements
 	if shape.Type == "structure" {
-		cleanNames := names.New(gte)
-		gte = cleanNames.Camel
-		if api.HasConflictingTypeName(gte, cfg) {
-			gte += "_SDK"
-		}
+		gte = api.StructTypeName(gte, cfg)
 		gt = "*" + gte
 	} else if shape.Type == "list" {
 		// If it's a list type, where the element is a structure, we need to
 		// set the GoType to the cleaned-up Camel-cased name
 		mgte, mgt, mgtwp := CleanGoType(api, cfg, shape.MemberRef.Shape, fieldCfg)
-		cleanNames := names.New(mgte)
-		gte = cleanNames.Camel
-		if api.HasConflictingTypeName(mgte, cfg) {
-			gte += "_SDK"
+		if shape.MemberRef.Shape.Type == "structure" {
+			// The element type name is already cleaned up
+			gte = mgte
+		} else {
+			cleanNames := names.New(mgte)
+			gte = cleanNames.Camel
+			if api.HasConflictingTypeName(mgte, cfg) {
+				gte += "_SDK"
+			}
 		}
 
 		gt = "[]" + mgt
@@ -64,11 +65,31 @@ func CleanGoType(
 		gtwp = "*metav1.Time"
 		gte = "metav1.Time"
 		gt = "*metav1.Time"
-	} else if fieldCfg != nil && fieldCfg.IsSecret {
+	} else if shape.Type == "string" && fieldCfg != nil && fieldCfg.Timestamp != nil {
+		// string timestamps are parsed into a metav1.Time, see
+		// Field.GetTimestampLayout
+		gtwp = "*metav1.Time"
+		gte = "metav1.Time"
+		gt = "*metav1.Time"
+	} else if (shape.Type == "integer" || shape.Type == "long") &&
+		fieldCfg != nil && fieldCfg.Quantity != nil {
+		// sizes are converted into a resource.Quantity, see
+		// Field.GetQuantityUnitSize
+		gtwp = "*k8sresource.Quantity"
+		gte = "k8sresource.Quantity"
+		gt = "*k8sresource.Quantity"
+	} else if fieldCfg != nil && (fieldCfg.IsSecret || fieldCfg.WriteToSecret) {
 		gt = "*ackv1alpha1.SecretKeyReference"
 		gte = "SecretKeyReference"
 		gtwp = "*ackv1alpha1.SecretKeyReference"
 		return gte, gt, gtwp
+	} else if fieldCfg != nil && fieldCfg.IsConfigMap {
+		// ConfigMapKeyReference is generated into the apis package, see
+		// Config.HasConfigMapFields
+		gt = "*ConfigMapKeyReference"
+		gte = "ConfigMapKeyReference"
+		gtwp = "*ConfigMapKeyReference"
+		return gte, gt, gtwp
 	}
 
 	// Replace the type part of the full type-with-package-name with the
@@ -3,8 +3,11 @@ package model_test
 import (
 	"testing"
 
-	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
 	"github.com/stretchr/testify/assert"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 )
 
 func TestReplacePkgName(t *testing.T) {
@@ -77,3 +80,31 @@ func TestReplacePkgName(t *testing.T) {
 		assert.Equal(tc.want, result)
 	}
 }
+
+func TestGetTypeRenames_CollidingShapeNames(t *testing.T) {
+	assert := assert.New(t)
+
+	newAPI := func() *model.SDKAPI {
+		return model.NewSDKAPI(&awssdkmodel.API{
+			Shapes: map[string]*awssdkmodel.Shape{
+				"VpcConfig": {ShapeName: "VpcConfig", Type: "structure"},
+				"VPCConfig": {ShapeName: "VPCConfig", Type: "structure"},
+			},
+		}, "")
+	}
+
+	// The first shape, in shape name order, keeps the Camel-cased name
+	cfg := &ackgenconfig.Config{}
+	api := newAPI()
+	assert.Equal(map[string]string{"VpcConfig": "VPCConfig2"}, api.GetTypeRenames(cfg))
+	assert.Equal("VPCConfig", api.StructTypeName("VPCConfig", cfg))
+	assert.Equal("VPCConfig2", api.StructTypeName("VpcConfig", cfg))
+
+	// Names chosen in the generator config take precedence
+	cfg = &ackgenconfig.Config{
+		TypeNames: map[string]string{"VPCConfig": "VPCConfigRequest"},
+	}
+	api = newAPI()
+	assert.Equal("VPCConfigRequest", api.StructTypeName("VPCConfig", cfg))
+	assert.Equal("VPCConfig", api.StructTypeName("VpcConfig", cfg))
+}
@@ -16,56 +16,177 @@ package sdk
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
 
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
 	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
 )
 
 var (
 	ErrMemberShapeNotFound = errors.New("base shape not found")
+	ErrCustomShapeConflict = errors.New("custom shape name conflicts with an existing shape")
 )
 
 const (
-	ShapeNameTemplateList = "%sList"
-	ShapeNameTemplateMap  = "%sMap"
-	ShapeNameTemplateKey  = "%sKey"
+	ShapeNameTemplateList   = "%sList"
+	ShapeNameTemplateMap    = "%sMap"
+	ShapeNameTemplateKey    = "%sKey"
+	ShapeNameTemplateMember = "%s%s"
 )
 
 type customShapeInjector struct {
 	sdkAPI *ackmodel.SDKAPI
+	// pendingLists contains, keyed by shape name, the member type of the custom
+	// list shapes not injected yet
+	pendingLists map[string]string
+	// pendingMaps contains, keyed by shape name, the value type of the custom
+	// map shapes not injected yet
+	pendingMaps map[string]string
+	// injected contains, keyed by shape name, the custom list and map shapes
+	// already injected
+	injected map[string]*ackmodel.CustomShape
 }
 
 // InjectCustomShapes will create custom shapes for each of the spec and status
 // fields that contain CustomFieldConfig values. It will append these values
 // into the list of shapes in the API and update the list of custom shapes in
 // the SDKAPI object.
+//
+// The member of a custom list, and the value of a custom map, may be an SDK
+// shape, a custom structure shape, another custom list or map shape, e.g.
+// RuleOverrideList, or a list or map type of those, e.g. []RuleOverride.
 func (h *Helper) InjectCustomShapes(sdkapi *ackmodel.SDKAPI) error {
-	injector := customShapeInjector{sdkapi}
-
-	for _, memberShape := range h.cfg.GetCustomMapFieldMembers() {
-		customShape, err := injector.newMap(memberShape)
-		if err != nil {
-			return err
-		}
+	injector := customShapeInjector{
+		sdkAPI:       sdkapi,
+		pendingLists: map[string]string{},
+		pendingMaps:  map[string]string{},
+		injected:     map[string]*ackmodel.CustomShape{},
+	}
 
-		sdkapi.API.Shapes[customShape.Shape.ShapeName] = customShape.Shape
+	// Custom structure shapes are injected first, so that custom list and map
+	// fields can use them too
+	customStructs, err := injector.newStructs(h.cfg.CustomShapes)
+	if err != nil {
+		return err
+	}
+	for _, customShape := range customStructs {
 		sdkapi.CustomShapes = append(sdkapi.CustomShapes, customShape)
 	}
 
-	for _, memberShape := range h.cfg.GetCustomListFieldMembers() {
-		customShape, err := injector.newList(memberShape)
-		if err != nil {
+	// Custom list and map shapes may refer to each other regardless of the
+	// order they are injected in, so they are all known before injecting any
+	mapMembers := h.cfg.GetCustomMapFieldMembers()
+	for _, valueShapeName := range mapMembers {
+		shapeName := fmt.Sprintf(ShapeNameTemplateMap, customShapeName(valueShapeName))
+		injector.pendingMaps[shapeName] = valueShapeName
+	}
+	listMembers := h.cfg.GetCustomListFieldMembers()
+	for _, memberShapeName := range listMembers {
+		shapeName := fmt.Sprintf(ShapeNameTemplateList, customShapeName(memberShapeName))
+		injector.pendingLists[shapeName] = memberShapeName
+	}
+
+	for _, valueShapeName := range mapMembers {
+		if _, err := injector.injectMap(valueShapeName); err != nil {
+			return err
+		}
+	}
+	for _, memberShapeName := range listMembers {
+		if _, err := injector.injectList(memberShapeName); err != nil {
 			return err
 		}
-
-		sdkapi.API.Shapes[customShape.Shape.ShapeName] = customShape.Shape
-		sdkapi.CustomShapes = append(sdkapi.CustomShapes, customShape)
 	}
 
 	return nil
 }
 
+// customShapeName returns the name of the shape of the supplied type: the
+// type itself for a shape name, or a name made of the element's shape name for
+// a list or map type, e.g. RuleOverrideList for []RuleOverride.
+func customShapeName(typeName string) string {
+	typeName = strings.TrimPrefix(typeName, "*")
+	switch {
+	case strings.HasPrefix(typeName, "[]"):
+		return fmt.Sprintf(ShapeNameTemplateList, customShapeName(typeName[2:]))
+	case strings.HasPrefix(typeName, "map[string]"):
+		return fmt.Sprintf(ShapeNameTemplateMap, customShapeName(typeName[11:]))
+	}
+	return typeName
+}
+
+// injectList creates, if not done yet, the custom list shape of the supplied
+// member type and registers it into the API shapes and the custom shapes.
+func (i *customShapeInjector) injectList(memberShapeName string) (*ackmodel.CustomShape, error) {
+	shapeName := fmt.Sprintf(ShapeNameTemplateList, customShapeName(memberShapeName))
+	if customShape, found := i.injected[shapeName]; found {
+		return customShape, nil
+	}
+	// Removing the pending shape first stops a list from being its own member
+	delete(i.pendingLists, shapeName)
+	customShape, err := i.newList(memberShapeName)
+	if err != nil {
+		return nil, err
+	}
+	i.register(customShape)
+	return customShape, nil
+}
+
+// injectMap creates, if not done yet, the custom map shape of the supplied
+// value type and registers it into the API shapes and the custom shapes.
+func (i *customShapeInjector) injectMap(valueShapeName string) (*ackmodel.CustomShape, error) {
+	shapeName := fmt.Sprintf(ShapeNameTemplateMap, customShapeName(valueShapeName))
+	if customShape, found := i.injected[shapeName]; found {
+		return customShape, nil
+	}
+	// Removing the pending shape first stops a map from being its own value
+	delete(i.pendingMaps, shapeName)
+	customShape, err := i.newMap(valueShapeName)
+	if err != nil {
+		return nil, err
+	}
+	i.register(customShape)
+	return customShape, nil
+}
+
+// register adds the supplied custom list or map shape to the API shapes and
+// the custom shapes.
+func (i *customShapeInjector) register(customShape *ackmodel.CustomShape) {
+	shapeName := customShape.Shape.ShapeName
+	i.injected[shapeName] = customShape
+	i.sdkAPI.API.Shapes[shapeName] = customShape.Shape
+	i.sdkAPI.CustomShapes = append(i.sdkAPI.CustomShapes, customShape)
+}
+
+// elementShape returns the shape of the supplied list member or map value
+// type, injecting first the custom list or map shape it refers to when that
+// shape was not injected yet.
+func (i *customShapeInjector) elementShape(typeName string) (*awssdkmodel.Shape, error) {
+	typeName = strings.TrimPrefix(typeName, "*")
+	if shape, exists := i.sdkAPI.API.Shapes[typeName]; exists {
+		return shape, nil
+	}
+	var customShape *ackmodel.CustomShape
+	var err error
+	if strings.HasPrefix(typeName, "[]") {
+		customShape, err = i.injectList(typeName[2:])
+	} else if strings.HasPrefix(typeName, "map[string]") {
+		customShape, err = i.injectMap(typeName[11:])
+	} else if memberShapeName, found := i.pendingLists[typeName]; found {
+		customShape, err = i.injectList(memberShapeName)
+	} else if valueShapeName, found := i.pendingMaps[typeName]; found {
+		customShape, err = i.injectMap(valueShapeName)
+	} else {
+		return nil, fmt.Errorf("%w: %s", ErrMemberShapeNotFound, typeName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return customShape.Shape, nil
+}
+
 // createShapeRefForMember creates a minimal ShapeRef type to encapsulate a
 // shape.
 func (i *customShapeInjector) createShapeRefForMember(shape *awssdkmodel.Shape) *awssdkmodel.ShapeRef {
@@ -87,16 +208,16 @@ func (i *customShapeInjector) createKeyShape(shapeName string) *awssdkmodel.Shap
 	}
 }
 
-// newMap loads a shape given its name and creates a custom shape that is a
-// map with strings as keys and that shape as the value.
+// newMap loads a shape given its name, or type, and creates a custom shape
+// that is a map with strings as keys and that shape as the value.
 func (i *customShapeInjector) newMap(valueShapeName string) (*ackmodel.CustomShape, error) {
-	valueShape, exists := i.sdkAPI.API.Shapes[valueShapeName]
-	if !exists {
-		return nil, ErrMemberShapeNotFound
+	valueShape, err := i.elementShape(valueShapeName)
+	if err != nil {
+		return nil, err
 	}
 	valueShapeRef := i.createShapeRefForMember(valueShape)
 
-	shapeName := fmt.Sprintf(ShapeNameTemplateMap, valueShape.ShapeName)
+	shapeName := fmt.Sprintf(ShapeNameTemplateMap, customShapeName(valueShapeName))
 	documentation := ""
 
 	keyShape := i.createKeyShape(shapeName)
@@ -122,16 +243,16 @@ func (i *customShapeInjector) newMap(valueShapeName string) (*ackmodel.CustomSha
 	return ackmodel.NewCustomMapShape(shape, shapeRef, valueShapeName), nil
 }
 
-// newList loads a shape given its name and creates a custom shape that is a
-// list of that shape.
+// newList loads a shape given its name, or type, and creates a custom shape
+// that is a list of that shape.
 func (i *customShapeInjector) newList(memberShapeName string) (*ackmodel.CustomShape, error) {
-	memberShape, exists := i.sdkAPI.API.Shapes[memberShapeName]
-	if !exists {
-		return nil, ErrMemberShapeNotFound
+	memberShape, err := i.elementShape(memberShapeName)
+	if err != nil {
+		return nil, err
 	}
 	memberShapeRef := i.createShapeRefForMember(memberShape)
 
-	shapeName := fmt.Sprintf(ShapeNameTemplateList, memberShape.ShapeName)
+	shapeName := fmt.Sprintf(ShapeNameTemplateList, customShapeName(memberShapeName))
 	documentation := ""
 
 	shape := &awssdkmodel.Shape{
@@ -152,3 +273,102 @@ func (i *customShapeInjector) newList(memberShapeName string) (*ackmodel.CustomS
 
 	return ackmodel.NewCustomListShape(shape, shapeRef, memberShapeName), nil
 }
+
+// newStructs creates the custom structure shapes defined in the generator
+// config and registers them, along with the shapes of their scalar, list and
+// map members, into the API shapes. All the structure shapes are registered
+// before their members are resolved so that custom shapes can refer to each
+// other.
+func (i *customShapeInjector) newStructs(
+	shapeConfigs map[string]*ackgenconfig.CustomShapeConfig,
+) ([]*ackmodel.CustomShape, error) {
+	shapeNames := make([]string, 0, len(shapeConfigs))
+	for shapeName := range shapeConfigs {
+		shapeNames = append(shapeNames, shapeName)
+	}
+	sort.Strings(shapeNames)
+
+	customShapes := []*ackmodel.CustomShape{}
+	for _, shapeName := range shapeNames {
+		if _, exists := i.sdkAPI.API.Shapes[shapeName]; exists {
+			return nil, fmt.Errorf("%w: %s", ErrCustomShapeConflict, shapeName)
+		}
+		shape := &awssdkmodel.Shape{
+			API:           i.sdkAPI.API,
+			ShapeName:     shapeName,
+			Documentation: shapeConfigs[shapeName].Documentation,
+			MemberRefs:    map[string]*awssdkmodel.ShapeRef{},
+			Type:          "structure",
+		}
+		i.sdkAPI.API.Shapes[shapeName] = shape
+		customShapes = append(
+			customShapes,
+			ackmodel.NewCustomStructShape(shape, i.createShapeRefForMember(shape)),
+		)
+	}
+	for _, customShape := range customShapes {
+		shape := customShape.Shape
+		for memberName, typeName := range shapeConfigs[shape.ShapeName].Members {
+			memberShape, err := i.newMemberShape(
+				fmt.Sprintf(ShapeNameTemplateMember, shape.ShapeName, memberName),
+				typeName,
+			)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"member %s of custom shape %s: %w",
+					memberName, shape.ShapeName, err,
+				)
+			}
+			shape.MemberRefs[memberName] = i.createShapeRefForMember(memberShape)
+		}
+	}
+	return customShapes, nil
+}
+
+// newMemberShape returns the shape of a custom structure's member with the
+// supplied type: the shape of that name in the API shapes or, for Go types,
+// a new shape registered into the API shapes under the supplied shape name.
+func (i *customShapeInjector) newMemberShape(
+	shapeName string,
+	typeName string,
+) (*awssdkmodel.Shape, error) {
+	typeName = strings.TrimPrefix(typeName, "*")
+	if shape, exists := i.sdkAPI.API.Shapes[typeName]; exists {
+		return shape, nil
+	}
+	shape := &awssdkmodel.Shape{
+		API:       i.sdkAPI.API,
+		ShapeName: shapeName,
+	}
+	switch {
+	case strings.HasPrefix(typeName, "[]"):
+		memberShape, err := i.newMemberShape(
+			fmt.Sprintf(ShapeNameTemplateMember, shapeName, "Member"),
+			typeName[2:],
+		)
+		if err != nil {
+			return nil, err
+		}
+		shape.Type = "list"
+		shape.MemberRef = *i.createShapeRefForMember(memberShape)
+	case strings.HasPrefix(typeName, "map[string]"):
+		valueShape, err := i.newMemberShape(
+			fmt.Sprintf(ShapeNameTemplateMember, shapeName, "Value"),
+			typeName[11:],
+		)
+		if err != nil {
+			return nil, err
+		}
+		shape.Type = "map"
+		shape.KeyRef = *i.createShapeRefForMember(i.createKeyShape(shapeName))
+		shape.ValueRef = *i.createShapeRefForMember(valueShape)
+	default:
+		sdkType, found := ackmodel.GoTypeToSDKShapeType[typeName]
+		if !found {
+			return nil, fmt.Errorf("%w: %s", ErrMemberShapeNotFound, typeName)
+		}
+		shape.Type = sdkType
+	}
+	i.sdkAPI.API.Shapes[shapeName] = shape
+	return shape, nil
+}
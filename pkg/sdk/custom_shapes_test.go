@@ -131,3 +131,135 @@ func TestCustomMapField(t *testing.T) {
 	_, exists = api.API.Shapes[shapeRef.ShapeName]
 	assert.True(exists)
 }
+
+func TestCustomStructField(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	cfg := config.Config{
+		CustomShapes: map[string]*config.CustomShapeConfig{
+			"SimpleLocation": {
+				Documentation: "A simplified object location",
+				Members: map[string]string{
+					"Bucket":    "string",
+					"Key":       "*string",
+					"Versions":  "[]*int64",
+					"Tag":       "Tag",
+					"Locations": "map[string]*SimpleLocationList",
+				},
+			},
+			"SimpleLocationList": {
+				Members: map[string]string{
+					"Items": "[]*string",
+				},
+			},
+		},
+		Resources: map[string]config.ResourceConfig{
+			"Bucket": {
+				Fields: map[string]*config.FieldConfig{
+					"Location": {
+						CustomField: &config.CustomFieldConfig{
+							StructOf: "SimpleLocation",
+						},
+					},
+				},
+			},
+		},
+	}
+	api := s3SDKAPI(t, cfg)
+
+	// Assert custom shape was registered into API shapes
+	shapeRef := api.GetCustomStructRef("SimpleLocation")
+	require.NotNil(shapeRef)
+	shape := shapeRef.Shape
+	assert.Equal("structure", shape.Type)
+	assert.Equal("A simplified object location", shape.Documentation)
+	require.Len(shape.MemberRefs, 5)
+
+	// Assert scalar, list and map members were given shapes
+	assert.Equal("string", shape.MemberRefs["Bucket"].Shape.Type)
+	assert.Equal("SimpleLocationBucket", shape.MemberRefs["Bucket"].Shape.ShapeName)
+	assert.Equal("string", shape.MemberRefs["Key"].Shape.Type)
+	assert.Equal("list", shape.MemberRefs["Versions"].Shape.Type)
+	assert.Equal("integer", shape.MemberRefs["Versions"].Shape.MemberRef.Shape.Type)
+	assert.Equal("map", shape.MemberRefs["Locations"].Shape.Type)
+	assert.Equal("string", shape.MemberRefs["Locations"].Shape.KeyRef.Shape.Type)
+
+	// Assert members refer to existing and other custom shapes
+	assert.Equal(api.API.Shapes["Tag"], shape.MemberRefs["Tag"].Shape)
+	assert.Equal(
+		api.API.Shapes["SimpleLocationList"],
+		shape.MemberRefs["Locations"].Shape.ValueRef.Shape,
+	)
+
+	// Assert an unknown member type is an error
+	cfg.CustomShapes["SimpleLocation"].Members["Unknown"] = "NoSuchShape"
+	sdkHelper := sdk.NewHelper(filepath.Clean("../testdata"), cfg)
+	_, err := sdkHelper.API("s3")
+	assert.ErrorIs(err, sdk.ErrMemberShapeNotFound)
+}
+
+func TestCustomNestedListAndMapFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	cfg := config.Config{
+		CustomShapes: map[string]*config.CustomShapeConfig{
+			"RuleOverride": {
+				Members: map[string]string{
+					"Name": "string",
+				},
+			},
+		},
+		Resources: map[string]config.ResourceConfig{
+			"Bucket": {
+				Fields: map[string]*config.FieldConfig{
+					"RuleOverrides": {
+						CustomField: &config.CustomFieldConfig{
+							ListOf: "RuleOverride",
+						},
+					},
+					// Refers to the shape of the RuleOverrides field, injected
+					// after the custom map shapes
+					"RuleOverridesByName": {
+						CustomField: &config.CustomFieldConfig{
+							MapOf: "RuleOverrideList",
+						},
+					},
+					"RuleOverrideMatrix": {
+						CustomField: &config.CustomFieldConfig{
+							ListOf: "[]RuleOverride",
+						},
+					},
+				},
+			},
+		},
+	}
+	api := s3SDKAPI(t, cfg)
+
+	listRef := api.GetCustomShapeRef("RuleOverride")
+	require.NotNil(listRef)
+	assert.Equal("RuleOverrideList", listRef.ShapeName)
+	assert.Equal(api.API.Shapes["RuleOverride"], listRef.Shape.MemberRef.Shape)
+
+	// Assert the custom map's value is the shape of the custom list field
+	mapRef := api.GetCustomShapeRef("RuleOverrideList")
+	require.NotNil(mapRef)
+	assert.Equal("RuleOverrideListMap", mapRef.ShapeName)
+	assert.Equal(listRef.Shape, mapRef.Shape.ValueRef.Shape)
+
+	// Assert the list type member was given the custom list shape
+	matrixRef := api.GetCustomShapeRef("[]RuleOverride")
+	require.NotNil(matrixRef)
+	assert.Equal("RuleOverrideListList", matrixRef.ShapeName)
+	assert.Equal(listRef.Shape, matrixRef.Shape.MemberRef.Shape)
+
+	// Assert every custom list and map shape was injected once
+	count := 0
+	for _, customShape := range api.CustomShapes {
+		if customShape.Shape.ShapeName == "RuleOverrideList" {
+			count++
+		}
+	}
+	assert.Equal(1, count)
+}
@@ -14,6 +14,7 @@
 package sdk
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -56,6 +57,9 @@ type Helper struct {
 	loader         *awssdkmodel.Loader
 	// Default is set by `FirstAPIVersion`
 	apiVersion string
+	// modelDir is the directory holding the model files when they are read
+	// from a local directory instead of the models/apis tree of basePath
+	modelDir string
 }
 
 // NewHelper returns a new SDKHelper object
@@ -93,6 +97,13 @@ func (h *Helper) WithAPIVersion(apiVersion string) {
 	h.apiVersion = apiVersion
 }
 
+// WithModelDir instructs the helper to read the API model (api-2.json) and
+// documentation (docs-2.json) files directly from the supplied directory,
+// whatever the service model name and API version.
+func (h *Helper) WithModelDir(modelDir string) {
+	h.modelDir = modelDir
+}
+
 // API returns the aws-sdk-go API model for a supplied service model name.
 func (h *Helper) API(serviceModelName string) (*model.SDKAPI, error) {
 	modelPath, _, err := h.ModelAndDocsPath(serviceModelName)
@@ -114,19 +125,56 @@ func (h *Helper) API(serviceModelName string) (*model.SDKAPI, error) {
 		// unexported map variable...
 		_ = api.ServicePackageDoc()
 		sdkapi := model.NewSDKAPI(api, h.APIGroupSuffix)
+		sdkapi.ModelPath = modelPath
+		sdkapi.UnionShapeNames, err = unionShapeNames(modelPath)
+		if err != nil {
+			return nil, err
+		}
 
-		h.InjectCustomShapes(sdkapi)
+		if err := h.InjectCustomShapes(sdkapi); err != nil {
+			return nil, err
+		}
 
 		return sdkapi, nil
 	}
 	return nil, ErrServiceNotFound
 }
 
+// unionShapeNames returns the names of the shapes of the supplied API model
+// file that are modeled as unions. aws-sdk-go's model loader drops the
+// "union" trait of shapes, which is why the file is read again.
+func unionShapeNames(modelPath string) (map[string]struct{}, error) {
+	b, err := ioutil.ReadFile(modelPath)
+	if err != nil {
+		return nil, err
+	}
+	var apiModel struct {
+		Shapes map[string]struct {
+			Union bool `json:"union"`
+		} `json:"shapes"`
+	}
+	if err = json.Unmarshal(b, &apiModel); err != nil {
+		return nil, fmt.Errorf("cannot parse API model %s: %w", modelPath, err)
+	}
+	unions := map[string]struct{}{}
+	for shapeName, shape := range apiModel.Shapes {
+		if shape.Union {
+			unions[shapeName] = struct{}{}
+		}
+	}
+	return unions, nil
+}
+
 // ModelAndDocsPath returns two string paths to the supplied service's API and
 // doc JSON files
 func (h *Helper) ModelAndDocsPath(
 	serviceModelName string,
 ) (string, string, error) {
+	if h.modelDir != "" {
+		modelPath := filepath.Join(h.modelDir, "api-2.json")
+		docsPath := filepath.Join(h.modelDir, "docs-2.json")
+		return modelPath, docsPath, nil
+	}
 	if h.apiVersion == "" {
 		apiVersion, err := h.FirstAPIVersion(serviceModelName)
 		if err != nil {
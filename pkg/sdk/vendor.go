@@ -0,0 +1,211 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/config"
+)
+
+const (
+	// VendoredModelsDir is the directory, relative to the service
+	// controller's repository, where the pinned API model is stored. It uses
+	// the same layout as aws-sdk-go, i.e. models/apis/{model}/{api_version}.
+	VendoredModelsDir  = "sdk-models"
+	sdkRawContentURL   = "https://raw.githubusercontent.com/aws/aws-sdk-go"
+	apiModelFileName   = "api-2.json"
+	docsModelFileName  = "docs-2.json"
+	checksumAlgoPrefix = "sha256:"
+)
+
+var (
+	ErrInvalidModelSource = errors.New(
+		"model source must specify an api_version and one of git_ref or url",
+	)
+	ErrChecksumMismatch = errors.New(
+		"API model checksum does not match the pinned checksum",
+	)
+	ErrLocalModelNotFound = errors.New(
+		"no API model file found in local model directory",
+	)
+)
+
+// VendoredModelsPath returns the path of the directory the pinned API model
+// is stored in for the supplied service controller repository path.
+func VendoredModelsPath(controllerRepoPath string) string {
+	return filepath.Join(controllerRepoPath, VendoredModelsDir)
+}
+
+// LocalModelDir returns the path of the local directory holding the API model
+// files to generate from: the supplied path if not empty, otherwise the local
+// path of the model source, relative to the service controller repository
+// path. It returns an empty string if no local model directory is configured.
+func LocalModelDir(
+	src *ackgenconfig.ModelSourceConfig,
+	path string,
+	controllerRepoPath string,
+) string {
+	if path != "" {
+		return path
+	}
+	if src == nil || src.LocalPath == "" {
+		return ""
+	}
+	if filepath.IsAbs(src.LocalPath) {
+		return src.LocalPath
+	}
+	return filepath.Join(controllerRepoPath, src.LocalPath)
+}
+
+// VerifyLocalModel returns an error if the supplied local model directory
+// does not hold an API model file.
+func VerifyLocalModel(modelDir string) error {
+	fi, err := os.Stat(filepath.Join(modelDir, apiModelFileName))
+	if err != nil || fi.IsDir() {
+		return fmt.Errorf("%w: %s", ErrLocalModelNotFound, modelDir)
+	}
+	return nil
+}
+
+// vendoredModelDir returns the path of the directory holding the model files
+// of the supplied service model name.
+func vendoredModelDir(
+	modelsPath string,
+	src *ackgenconfig.ModelSourceConfig,
+	modelName string,
+) string {
+	return filepath.Join(modelsPath, "models", "apis", modelName, src.APIVersion)
+}
+
+// modelSourceURLs returns the URLs of the API model and documentation files
+// of the supplied service model name. The documentation URL is empty if the
+// model source does not have one.
+func modelSourceURLs(
+	src *ackgenconfig.ModelSourceConfig,
+	modelName string,
+) (string, string, error) {
+	if src == nil || src.APIVersion == "" {
+		return "", "", ErrInvalidModelSource
+	}
+	if src.URL != "" {
+		return src.URL, src.DocsURL, nil
+	}
+	if src.GitRef == "" {
+		return "", "", ErrInvalidModelSource
+	}
+	base := fmt.Sprintf(
+		"%s/%s/models/apis/%s/%s",
+		sdkRawContentURL, src.GitRef, modelName, src.APIVersion,
+	)
+	return base + "/" + apiModelFileName, base + "/" + docsModelFileName, nil
+}
+
+// FetchModel downloads the API model files of the supplied service model name
+// from the pinned model source, verifies the API model checksum and stores
+// the files under modelsPath. It returns the checksum of the API model file.
+func FetchModel(
+	ctx context.Context,
+	src *ackgenconfig.ModelSourceConfig,
+	modelName string,
+	modelsPath string,
+) (string, error) {
+	apiURL, docsURL, err := modelSourceURLs(src, modelName)
+	if err != nil {
+		return "", err
+	}
+	apiModel, err := download(ctx, apiURL)
+	if err != nil {
+		return "", err
+	}
+	sum := Checksum(apiModel)
+	if src.Checksum != "" && src.Checksum != sum {
+		return "", fmt.Errorf("%w: expected %s, got %s", ErrChecksumMismatch, src.Checksum, sum)
+	}
+	var docsModel []byte
+	if docsURL != "" {
+		if docsModel, err = download(ctx, docsURL); err != nil {
+			return "", err
+		}
+	}
+
+	modelDir := vendoredModelDir(modelsPath, src, modelName)
+	if err = os.MkdirAll(modelDir, os.ModePerm); err != nil {
+		return "", err
+	}
+	if err = os.WriteFile(filepath.Join(modelDir, apiModelFileName), apiModel, 0644); err != nil {
+		return "", err
+	}
+	if docsModel != nil {
+		if err = os.WriteFile(filepath.Join(modelDir, docsModelFileName), docsModel, 0644); err != nil {
+			return "", err
+		}
+	}
+	return sum, nil
+}
+
+// VerifyVendoredModel returns an error if the API model of the supplied
+// service model name stored under modelsPath does not match the checksum
+// pinned in the model source.
+func VerifyVendoredModel(
+	src *ackgenconfig.ModelSourceConfig,
+	modelName string,
+	modelsPath string,
+) error {
+	if src == nil || src.Checksum == "" {
+		return nil
+	}
+	apiModel, err := os.ReadFile(
+		filepath.Join(vendoredModelDir(modelsPath, src, modelName), apiModelFileName),
+	)
+	if err != nil {
+		return err
+	}
+	if sum := Checksum(apiModel); sum != src.Checksum {
+		return fmt.Errorf("%w: expected %s, got %s", ErrChecksumMismatch, src.Checksum, sum)
+	}
+	return nil
+}
+
+// Checksum returns the checksum of the supplied model file contents in the
+// "sha256:<hex digest>" format used by the model source configuration.
+func Checksum(data []byte) string {
+	digest := sha256.Sum256(data)
+	return checksumAlgoPrefix + hex.EncodeToString(digest[:])
+}
+
+// download returns the contents found at the supplied URL
+func download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot download %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
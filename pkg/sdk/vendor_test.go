@@ -0,0 +1,98 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	 http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package sdk_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	config "github.com/aws-controllers-k8s/code-generator/pkg/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/sdk"
+)
+
+func TestFetchModel(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	apiModel := []byte(`{"metadata":{"apiVersion":"2015-09-21"}}`)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(apiModel)
+	}))
+	defer srv.Close()
+
+	modelsPath := sdk.VendoredModelsPath(t.TempDir())
+	src := &config.ModelSourceConfig{
+		URL:        srv.URL + "/api-2.json",
+		APIVersion: "2015-09-21",
+		Checksum:   sdk.Checksum(apiModel),
+	}
+	sum, err := sdk.FetchModel(context.Background(), src, "ecr", modelsPath)
+	require.Nil(err)
+	assert.Equal(src.Checksum, sum)
+
+	got, err := os.ReadFile(filepath.Join(modelsPath, "models", "apis", "ecr", "2015-09-21", "api-2.json"))
+	require.Nil(err)
+	assert.Equal(apiModel, got)
+	assert.Nil(sdk.VerifyVendoredModel(src, "ecr", modelsPath))
+
+	src.Checksum = sdk.Checksum([]byte("{}"))
+	assert.ErrorIs(sdk.VerifyVendoredModel(src, "ecr", modelsPath), sdk.ErrChecksumMismatch)
+	_, err = sdk.FetchModel(context.Background(), src, "ecr", modelsPath)
+	assert.ErrorIs(err, sdk.ErrChecksumMismatch)
+}
+
+func TestFetchModel_InvalidSource(t *testing.T) {
+	_, err := sdk.FetchModel(
+		context.Background(),
+		&config.ModelSourceConfig{APIVersion: "2015-09-21"},
+		"ecr", t.TempDir(),
+	)
+	assert.ErrorIs(t, err, sdk.ErrInvalidModelSource)
+}
+
+func TestLocalModelDir(t *testing.T) {
+	assert := assert.New(t)
+
+	src := &config.ModelSourceConfig{LocalPath: "api-models/ecr"}
+	assert.Equal("", sdk.LocalModelDir(nil, "", "/controller"))
+	assert.Equal("/controller/api-models/ecr", sdk.LocalModelDir(src, "", "/controller"))
+	assert.Equal("/models/ecr", sdk.LocalModelDir(src, "/models/ecr", "/controller"))
+
+	src.LocalPath = "/models/ecr"
+	assert.Equal("/models/ecr", sdk.LocalModelDir(src, "", "/controller"))
+}
+
+func TestHelper_WithModelDir(t *testing.T) {
+	require := require.New(t)
+
+	modelDir := filepath.Clean("../testdata/models/apis/lambda/0000-00-00")
+	require.Nil(sdk.VerifyLocalModel(modelDir))
+	assert.ErrorIs(t, sdk.VerifyLocalModel(t.TempDir()), sdk.ErrLocalModelNotFound)
+
+	// The model files are read from the local directory whatever the model
+	// name
+	sdkHelper := sdk.NewHelper(t.TempDir(), config.Config{})
+	sdkHelper.WithModelDir(modelDir)
+	sdkAPI, err := sdkHelper.API("some-unreleased-service")
+	require.Nil(err)
+	require.NotNil(sdkAPI)
+	assert.Equal(t, filepath.Join(modelDir, "api-2.json"), sdkAPI.ModelPath)
+}
@@ -3,6 +3,12 @@
 package {{ .APIVersion }}
 
 import (
+{{- if .CRD.MigratedSpecFields }}
+	"encoding/json"
+{{- if .CRD.SpecFieldMigrationsParseStrings }}
+	"strconv"
+{{- end }}
+{{- end }}
 {{- if .CRD.TypeImports }}
 {{- range $packagePath, $alias := .CRD.TypeImports }}
     {{ if $alias }}{{ $alias }} {{ end }}"{{ $packagePath }}"
@@ -21,10 +27,28 @@ type {{ .CRD.Kind }}Spec struct {
 {{ end -}}
 {{- if and ($field.IsRequired) (not $field.HasReference) -}}
     // +kubebuilder:validation:Required
+{{ end -}}
+{{- if $field.GetEnumMarker -}}
+    {{ $field.GetEnumMarker }}
+{{ end -}}
+{{- if $field.IsJSONValue -}}
+    // +kubebuilder:pruning:PreserveUnknownFields
+{{ end -}}
+{{- range $marker := $field.GetMarkers -}}
+    {{ $marker }}
 {{ end -}}
     {{ $field.Names.Camel }} {{ $field.GoType }} {{ $field.GetGoTag }}
 {{- end }}
 }
+{{- if .CRD.MigratedSpecFields }}
+
+// UnmarshalJSON unmarshals a {{ .CRD.Kind }}Spec, accepting the values stored
+// before the type or name of some of its fields changed.
+func (in *{{ .CRD.Kind }}Spec) UnmarshalJSON(b []byte) error {
+{{ GoCodeMigrateSpecFields .CRD "in" "b" 1 }}
+	return nil
+}
+{{- end }}
 
 // {{ .CRD.Kind }}Status defines the observed state of {{ .CRD.Kind }}
 type {{ .CRD.Kind }}Status struct {
@@ -44,6 +68,12 @@ type {{ .CRD.Kind }}Status struct {
 	{{ $field.GetDocumentation }}
 	{{- end }}
 	// +kubebuilder:validation:Optional
+	{{- if $field.IsJSONValue }}
+	// +kubebuilder:pruning:PreserveUnknownFields
+	{{- end }}
+	{{- range $marker := $field.GetMarkers }}
+	{{ $marker }}
+	{{- end }}
 	{{ $field.Names.Camel }} {{ $field.GoType }} {{ $field.GetGoTag }}
 {{- end }}
 }
@@ -51,8 +81,11 @@ type {{ .CRD.Kind }}Status struct {
 // {{ .CRD.Kind }} is the Schema for the {{ .CRD.Plural }} API
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+{{- if .CRD.GetScaleMarker }}
+{{ .CRD.GetScaleMarker }}
+{{- end }}
 {{- range $column := .CRD.AdditionalPrinterColumns }}
-// +kubebuilder:printcolumn:name="{{$column.Name}}",type={{$column.Type}},priority={{$column.Priority}},JSONPath=`{{$column.JSONPath}}`
+// +kubebuilder:printcolumn:name="{{$column.Name}}",type={{$column.Type}},{{ if $column.Format }}format={{$column.Format}},{{ end }}priority={{$column.Priority}},JSONPath=`{{$column.JSONPath}}`
 {{- end }}
 {{- if .CRD.PrintSyncedColumn }}
 // +kubebuilder:printcolumn:name="Synced",type="string",priority=0,JSONPath=".status.conditions[?(@.type==\"ACK.ResourceSynced\")].status"
@@ -60,8 +93,11 @@ type {{ .CRD.Kind }}Status struct {
 {{- if .CRD.PrintAgeColumn }}
 // +kubebuilder:printcolumn:name="Age",type="date",priority=0,JSONPath=".metadata.creationTimestamp"
 {{- end }}
-{{- if .CRD.ShortNames }}
-// +kubebuilder:resource:shortName={{ Join .CRD.ShortNames ";" }}
+{{- if .CRD.GetResourceMarker }}
+{{ .CRD.GetResourceMarker }}
+{{- end }}
+{{- range $marker := .CRD.GetAdditionalMarkers }}
+{{ $marker }}
 {{- end }}
 type {{ .CRD.Kind }} struct {
 	metav1.TypeMeta   `json:",inline"`
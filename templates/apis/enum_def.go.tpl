@@ -7,3 +7,14 @@ const (
 {{- end }}
 )
 {{- end -}}
+
+{{- define "enum_values" -}}
+// {{ .Names.Camel }}_Values returns all elements of the {{ .Names.Camel }} enum
+func {{ .Names.Camel }}_Values() []string {
+	return []string{
+{{- range $val := .Values }}
+		string({{ $.Names.Camel }}_{{ $val.Clean }}),
+{{- end }}
+	}
+}
+{{- end -}}
@@ -4,4 +4,8 @@ package {{ .APIVersion }}
 {{- range $enumDef := .EnumDefs }}
 
 {{ template "enum_def" $enumDef }}
+{{- if $.TypedEnums }}
+
+{{ template "enum_values" $enumDef }}
+{{- end }}
 {{- end -}}
@@ -2,11 +2,23 @@
 {{- if .Shape.Documentation }}
 {{ .Shape.Documentation }}
 {{- end }}
+{{- if .GetUnionValidationMarker }}
+{{ .GetUnionValidationMarker }}
+{{- end }}
 type {{ .Names.Camel }} struct {
 {{- range $attrName, $attr := .Attrs }}
 	{{- if $attr.Shape.Documentation }}
 	{{ $attr.Shape.Documentation }}
 	{{- end }}
+	{{- if $attr.IsRequired }}
+	// +kubebuilder:validation:Required
+	{{- end }}
+	{{- range $marker := $attr.Markers }}
+	{{ $marker }}
+	{{- end }}
+	{{- if $attr.IsJSONValue }}
+	// +kubebuilder:pruning:PreserveUnknownFields
+	{{- end }}
 	{{ $attr.Names.Camel }} {{ $attr.GoType }} {{ $attr.GetGoTag }}
 {{- end }}
 }
@@ -6,15 +6,32 @@ import (
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	"github.com/aws/aws-sdk-go/aws"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // Hack to avoid import errors during build...
 var (
 	_ = &metav1.Time{}
 	_ = &aws.JSONValue{}
+	_ = &runtime.RawExtension{}
 	_ = ackv1alpha1.AWSAccountID("")
 )
 {{- range $typeDef := .TypeDefs }}
 
 {{ template "type_def" $typeDef }}
+{{- end }}
+{{- if .HasConfigMapFields }}
+
+// ConfigMapKeyReference identifies the key of a ConfigMap the value of a field
+// is read from
+type ConfigMapKeyReference struct {
+	// Name is the name of the ConfigMap
+	Name string `json:"name"`
+	// Namespace is the namespace of the ConfigMap, defaulting to the namespace
+	// of the resource
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Key is the key of the ConfigMap the value is read from
+	Key string `json:"key"`
+}
 {{- end -}}
@@ -0,0 +1,81 @@
+{{ template "boilerplate" }}
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServicePackageName }}"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/{{ .ServicePackageName }}/{{ .ServicePackageName }}iface"
+{{- if .Endpoint }}
+
+	svcresource "github.com/aws-controllers-k8s/{{ .ControllerName }}-controller/pkg/resource"
+{{- end }}
+)
+
+const (
+	// awsHealthCheckTimeout is the maximum duration of the AWS API call made
+	// by the readiness check
+	awsHealthCheckTimeout = {{ .HealthCheck.TimeoutSeconds }} * time.Second
+	// awsHealthCheckInterval is the duration the result of the AWS API call
+	// is reused for by subsequent readiness checks
+	awsHealthCheckInterval = {{ .HealthCheck.IntervalSeconds }} * time.Second
+)
+
+// awsHealthChecker checks that the controller's credentials are valid and that
+// the {{ .ServicePackageName }} API endpoint is reachable by calling the
+// {{ .HealthCheck.Operation }} operation.
+type awsHealthChecker struct {
+	sdkapi    svcsdkapi.{{ .ClientInterfaceTypeName }}
+	mu        sync.Mutex
+	checkedAt time.Time
+	lastErr   error
+}
+
+// newAWSHealthChecker returns an awsHealthChecker calling the API in the
+// controller's default region and endpoint
+func newAWSHealthChecker(ackCfg ackcfg.Config) (*awsHealthChecker, error) {
+	sessCfg := aws.NewConfig().WithRegion(ackCfg.Region)
+	if ackCfg.EndpointURL != "" {
+		sessCfg = sessCfg.WithEndpoint(ackCfg.EndpointURL)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *sessCfg,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, err
+	}
+{{- if .Endpoint }}
+	return &awsHealthChecker{sdkapi: svcsdk.New(sess, svcresource.SDKClientConfig())}, nil
+{{- else }}
+	return &awsHealthChecker{sdkapi: svcsdk.New(sess)}, nil
+{{- end }}
+}
+
+// Check implements sigs.k8s.io/controller-runtime/pkg/healthz.Checker. It
+// returns the error of the last {{ .HealthCheck.Operation }} call, made at most
+// once per awsHealthCheckInterval.
+func (c *awsHealthChecker) Check(req *http.Request) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.checkedAt.IsZero() && time.Since(c.checkedAt) < awsHealthCheckInterval {
+		return c.lastErr
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), awsHealthCheckTimeout)
+	defer cancel()
+	_, err := c.sdkapi.{{ .HealthCheck.Operation }}WithContext(ctx, &svcsdk.{{ .HealthCheck.Operation }}Input{})
+	if err != nil {
+		err = fmt.Errorf("unable to call {{ .ServicePackageName }} {{ .HealthCheck.Operation }}: %w", err)
+	}
+	c.checkedAt = time.Now()
+	c.lastErr = err
+	return err
+}
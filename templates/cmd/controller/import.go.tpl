@@ -0,0 +1,235 @@
+{{ template "boilerplate" }}
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	flag "github.com/spf13/pflag"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/yaml"
+
+	svcresource "github.com/aws-controllers-k8s/{{ .ControllerName }}-controller/pkg/resource"
+)
+
+const importUsage = `Usage: controller import KIND IDENTIFIER [flags]
+
+Reads a single existing AWS resource using the same field mapping as the
+controller and prints a Kubernetes manifest for it on stdout.
+
+Supported kinds: %s
+
+Flags:
+`
+
+// runImport implements the `import` command, which calls ReadOne for the
+// resource of the supplied kind with the supplied identifier and prints the
+// resulting custom resource as a YAML manifest, ready to be applied. It
+// returns the process exit code.
+func runImport(args []string) int {
+	factories := map[string]acktypes.AWSResourceManagerFactory{}
+	kinds := []string{}
+	for _, mf := range svcresource.GetManagerFactories() {
+		kind := mf.ResourceDescriptor().GroupVersionKind().Kind
+		factories[strings.ToLower(kind)] = mf
+		kinds = append(kinds, kind)
+	}
+
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, importUsage, strings.Join(kinds, ", "))
+		fs.PrintDefaults()
+	}
+	region := fs.String("region", "", "AWS Region the resource lives in")
+	accountID := fs.String("account-id", "", "AWS Account ID owning the resource")
+	endpointURL := fs.String("endpoint-url", "", "Override the AWS service API endpoint URL")
+	name := fs.String("name", "", "Name of the generated custom resource. Defaults to the last segment of the identifier, converted into a DNS-1123 subdomain")
+	namespace := fs.String("namespace", "", "Namespace of the generated custom resource")
+	additionalKeys := fs.StringToString("key", map[string]string{}, "Additional key used to identify the resource, as key=value")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return 2
+	}
+	mf, found := factories[strings.ToLower(fs.Arg(0))]
+	if !found {
+		fmt.Fprintf(os.Stderr, "unknown kind %q, expected one of: %s\n", fs.Arg(0), strings.Join(kinds, ", "))
+		return 2
+	}
+	identifier := fs.Arg(1)
+
+	sessCfg := aws.NewConfig()
+	if *region != "" {
+		sessCfg = sessCfg.WithRegion(*region)
+	}
+	if *endpointURL != "" {
+		sessCfg = sessCfg.WithEndpoint(*endpointURL)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *sessCfg,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create AWS session: %v\n", err)
+		return 1
+	}
+
+	rm, err := mf.ManagerFor(
+		ackcfg.Config{}, ctrlrt.Log, ackmetrics.NewMetrics(awsServiceAlias),
+		importReconciler{}, sess,
+		ackv1alpha1.AWSAccountID(*accountID),
+		ackv1alpha1.AWSRegion(aws.StringValue(sess.Config.Region)),
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create resource manager: %v\n", err)
+		return 1
+	}
+
+	rd := mf.ResourceDescriptor()
+	res := rd.ResourceFromRuntimeObject(rd.EmptyRuntimeObject())
+	if err = res.SetIdentifiers(&ackv1alpha1.AWSIdentifiers{
+		NameOrID:       identifier,
+		AdditionalKeys: *additionalKeys,
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to set resource identifiers: %v\n", err)
+		return 1
+	}
+	latest, err := rm.ReadOne(context.Background(), res)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to read %s %q: %v\n", rd.GroupVersionKind().Kind, identifier, err)
+		return 1
+	}
+
+	if ci, ok := latest.(compositeIdentifier); ok && ci.CompositeIdentifier() != "" {
+		// The identifier read from the resource is in the canonical form
+		// accepted by SetIdentifiers, whatever the user supplied
+		identifier = ci.CompositeIdentifier()
+	}
+	manifest, err := importManifest(latest, rd, *name, *namespace, identifier)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to render manifest: %v\n", err)
+		return 1
+	}
+	fmt.Print(string(manifest))
+	return 0
+}
+
+// compositeIdentifier is implemented by the resources identified by a tuple of
+// fields instead of an ARN or a single name
+type compositeIdentifier interface {
+	// CompositeIdentifier returns the values of the primary key fields of the
+	// resource, joined by their separator
+	CompositeIdentifier() string
+}
+
+// importManifest returns the YAML manifest for the supplied resource, keeping
+// only the type information, name, namespace and Spec so that it can be
+// applied as-is.
+func importManifest(
+	res acktypes.AWSResource,
+	rd acktypes.AWSResourceDescriptor,
+	name string,
+	namespace string,
+	identifier string,
+) ([]byte, error) {
+	obj, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(res.RuntimeObject())
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = importName(identifier)
+	}
+	if errs := k8svalidation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return nil, fmt.Errorf(
+			"invalid name %q, set a valid one with --name: %s",
+			name, strings.Join(errs, ", "),
+		)
+	}
+	metadata := map[string]interface{}{
+		"name": name,
+	}
+	if namespace != "" {
+		metadata["namespace"] = namespace
+	}
+	gvk := rd.GroupVersionKind()
+	manifest := map[string]interface{}{
+		"apiVersion": gvk.GroupVersion().String(),
+		"kind":       gvk.Kind,
+		"metadata":   metadata,
+		"spec":       obj["spec"],
+	}
+	return yaml.Marshal(manifest)
+}
+
+// importNameInvalidChars matches the runs of characters that cannot appear in
+// a DNS-1123 subdomain
+var importNameInvalidChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// importName returns the default name of the custom resource imported with
+// the supplied identifier: the segment of the identifier following its last
+// ':' or '/', lower-cased and with the characters not allowed in a DNS-1123
+// subdomain replaced with '-'. For instance, the ARN
+// "arn:aws:iam::111122223333:role/My_Role" gives "my-role" and the composite
+// identifier "111122223333|my_repo" gives "111122223333-my-repo".
+func importName(identifier string) string {
+	name := identifier
+	if i := strings.LastIndexAny(strings.TrimRight(name, ":/"), ":/"); i >= 0 {
+		name = name[i+1:]
+	}
+	name = importNameInvalidChars.ReplaceAllString(strings.ToLower(name), "-")
+	name = strings.Trim(name, "-.")
+	if len(name) > k8svalidation.DNS1123SubdomainMaxLength {
+		name = strings.TrimRight(name[:k8svalidation.DNS1123SubdomainMaxLength], "-.")
+	}
+	return name
+}
+
+// errImportNoCluster is returned by the importReconciler
+var errImportNoCluster = errors.New("the import command does not access a Kubernetes cluster")
+
+// importReconciler is the Reconciler of the resource managers of the import
+// command. The command runs outside of a Kubernetes cluster, so the
+// importReconciler neither reconciles resources nor reads or writes Secrets.
+type importReconciler struct{}
+
+// Reconcile implements acktypes.Reconciler
+func (importReconciler) Reconcile(
+	context.Context,
+	ctrlrt.Request,
+) (ctrlrt.Result, error) {
+	return ctrlrt.Result{}, errImportNoCluster
+}
+
+// SecretValueFromReference implements acktypes.Reconciler
+func (importReconciler) SecretValueFromReference(
+	context.Context,
+	*ackv1alpha1.SecretKeyReference,
+) (string, error) {
+	return "", errImportNoCluster
+}
+
+// WriteToSecret implements acktypes.Reconciler
+func (importReconciler) WriteToSecret(
+	context.Context,
+	string,
+	string,
+	string,
+	string,
+) error {
+	return errImportNoCluster
+}
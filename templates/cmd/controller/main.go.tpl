@@ -3,7 +3,13 @@
 package main
 
 import (
+{{- if or .ResourceResyncSeconds .ResourceMaxConcurrentSyncs }}
+	"fmt"
+{{- end }}
 	"os"
+{{- if or .ResourceResyncSeconds .ResourceMaxConcurrentSyncs }}
+	"strings"
+{{- end }}
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
@@ -65,10 +71,17 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(runImport(os.Args[2:]))
+	}
+
 	var ackCfg ackcfg.Config
 	ackCfg.BindFlags()
 	flag.Parse()
 	ackCfg.SetupLogger()
+{{- if or .ResourceResyncSeconds .ResourceMaxConcurrentSyncs }}
+	setResourceReconcileDefaults(&ackCfg)
+{{- end }}
 
 	managerFactories := svcresource.GetManagerFactories()
 	resourceGVKs := make([]schema.GroupVersionKind, 0, len(managerFactories))
@@ -135,6 +148,10 @@ func main() {
 		os.Exit(1)
 	}
 
+{{- if .UsesAPIReader }}
+	svcresource.SetAPIReader(mgr.GetAPIReader())
+{{- end }}
+
 	stopChan := ctrlrt.SetupSignalHandler()
 
 	setupLog.Info(
@@ -190,6 +207,23 @@ func main() {
 		)
 		os.Exit(1)
 	}
+{{- if .HealthCheck }}
+	awsChecker, err := newAWSHealthChecker(ackCfg)
+	if err != nil {
+		setupLog.Error(
+			err, "unable to create AWS session for ready check",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+	if err = mgr.AddReadyzCheck("aws", awsChecker.Check); err != nil {
+		setupLog.Error(
+			err, "unable to set up AWS ready check",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+{{- end }}
 
 	setupLog.Info(
 		"starting manager",
@@ -203,3 +237,54 @@ func main() {
 		os.Exit(1)
 	}
 }
+{{- if or .ResourceResyncSeconds .ResourceMaxConcurrentSyncs }}
+
+var (
+	// resourceResyncSeconds contains the default resync period, in seconds,
+	// of resources, keyed by resource kind
+	resourceResyncSeconds = map[string]int{
+{{- range $kind, $seconds := .ResourceResyncSeconds }}
+		"{{ $kind }}": {{ $seconds }},
+{{- end }}
+	}
+	// resourceMaxConcurrentSyncs contains the default maximum number of
+	// concurrent reconciles of resources, keyed by resource kind
+	resourceMaxConcurrentSyncs = map[string]int{
+{{- range $kind, $syncs := .ResourceMaxConcurrentSyncs }}
+		"{{ $kind }}": {{ $syncs }},
+{{- end }}
+	}
+)
+
+// setResourceReconcileDefaults adds the default resync period and maximum
+// number of concurrent reconciles of resources to the supplied configuration,
+// unless they are set by the --reconcile-resource-resync-seconds and
+// --reconcile-resource-max-concurrent-syncs flags.
+func setResourceReconcileDefaults(cfg *ackcfg.Config) {
+	cfg.ReconcileResourceResyncSeconds = withResourceDefaults(
+		cfg.ReconcileResourceResyncSeconds, resourceResyncSeconds,
+	)
+	cfg.ReconcileResourceMaxConcurrency = withResourceDefaults(
+		cfg.ReconcileResourceMaxConcurrency, resourceMaxConcurrentSyncs,
+	)
+}
+
+// withResourceDefaults returns the supplied "kind=value" flag arguments along
+// with an argument for each default value whose resource kind is not set by
+// the flag arguments.
+func withResourceDefaults(flagArgs []string, defaults map[string]int) []string {
+	for kind, value := range defaults {
+		found := false
+		for _, flagArg := range flagArgs {
+			if strings.EqualFold(strings.SplitN(flagArg, "=", 2)[0], kind) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			flagArgs = append(flagArgs, fmt.Sprintf("%s=%d", kind, value))
+		}
+	}
+	return flagArgs
+}
+{{- end }}
@@ -111,6 +111,8 @@ spec:
           value: {{ "{{ .Values.log.level | quote }}" }}
         - name: ACK_RESOURCE_TAGS
           value: {{ "{{ join \",\" .Values.resourceTags | quote }}" }}
+        - name: ACK_CLUSTER_NAME
+          value: {{ "{{ .Values.clusterName | quote }}" }}
 {{ "{{- if gt (int .Values.reconcile.defaultResyncPeriod) 0 }}" }}
         - name: RECONCILE_DEFAULT_RESYNC_SECONDS
           value: {{ "{{ .Values.reconcile.defaultResyncPeriod | quote }}" }}
@@ -116,6 +116,12 @@ resourceTags:
   - services.k8s.aws/controller-version=%CONTROLLER_SERVICE%-%CONTROLLER_VERSION%
   - services.k8s.aws/namespace=%K8S_NAMESPACE%
 
+# The name of the Kubernetes cluster, expanded in the values of the resource
+# tags configured in the controller's generator.yaml `default_tags` that refer
+# to %K8S_CLUSTER_NAME%. Resources with such tags fail to reconcile while it is
+# empty.
+clusterName: ""
+
 # Set to "retain" to keep all AWS resources intact even after the K8s resources
 # have been deleted. By default, the ACK controller will delete the AWS resource
 # before the K8s resource is removed.
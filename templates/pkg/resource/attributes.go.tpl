@@ -0,0 +1,119 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Hack to avoid import errors during build...
+var (
+	_ = &bytes.Buffer{}
+	_ = &json.RawMessage{}
+	_ = strconv.Itoa
+	_ = time.Second
+	_ = &metav1.Duration{}
+)
+
+// The functions below convert the string values of the AWS API's Attributes
+// map to and from the types of the {{ .CRD.Kind }} attribute fields configured
+// with `attribute_type`. Values the AWS service returns that cannot be parsed
+// leave the field unset.
+{{- if .CRD.HasAttributeType "bool" }}
+
+// attributeToBool returns the boolean value of the supplied attribute
+func attributeToBool(v *string) *bool {
+	if v == nil {
+		return nil
+	}
+	b, err := strconv.ParseBool(*v)
+	if err != nil {
+		return nil
+	}
+	return &b
+}
+
+// attributeFromBool returns the attribute value of the supplied boolean
+func attributeFromBool(v *bool) *string {
+	if v == nil {
+		return nil
+	}
+	s := strconv.FormatBool(*v)
+	return &s
+}
+{{- end }}
+{{- if .CRD.HasAttributeType "int" }}
+
+// attributeToInt returns the integer value of the supplied attribute
+func attributeToInt(v *string) *int64 {
+	if v == nil {
+		return nil
+	}
+	i, err := strconv.ParseInt(*v, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &i
+}
+
+// attributeFromInt returns the attribute value of the supplied integer
+func attributeFromInt(v *int64) *string {
+	if v == nil {
+		return nil
+	}
+	s := strconv.FormatInt(*v, 10)
+	return &s
+}
+{{- end }}
+{{- if .CRD.HasAttributeType "duration" }}
+
+// attributeToDuration returns the duration of the supplied attribute, which
+// holds a number of seconds
+func attributeToDuration(v *string) *metav1.Duration {
+	if v == nil {
+		return nil
+	}
+	seconds, err := strconv.ParseInt(*v, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &metav1.Duration{Duration: time.Duration(seconds) * time.Second}
+}
+
+// attributeFromDuration returns the attribute value, a number of seconds, of
+// the supplied duration. Fractions of seconds are truncated.
+func attributeFromDuration(v *metav1.Duration) *string {
+	if v == nil {
+		return nil
+	}
+	s := strconv.FormatInt(int64(v.Duration/time.Second), 10)
+	return &s
+}
+{{- end }}
+{{- if .CRD.HasAttributeType "json" }}
+
+// attributeToJSON returns the compacted JSON document held by the supplied
+// attribute. Values that are not valid JSON documents are returned as-is.
+func attributeToJSON(v *string) *string {
+	if v == nil {
+		return nil
+	}
+	buf := &bytes.Buffer{}
+	if err := json.Compact(buf, []byte(*v)); err != nil {
+		return v
+	}
+	s := buf.String()
+	return &s
+}
+
+// attributeFromJSON returns the attribute value of the supplied JSON
+// document, compacted.
+func attributeFromJSON(v *string) *string {
+	return attributeToJSON(v)
+}
+{{- end }}
@@ -0,0 +1,166 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"sort"
+{{- if .CRD.ConditionsConfig.PruneStaleAfterSeconds }}
+	"time"
+{{- end }}
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+{{- if .CRD.ConditionsConfig.PruneStaleAfterSeconds }}
+	corev1 "k8s.io/api/core/v1"
+{{- end }}
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ControllerName }}-controller/apis/{{ .APIVersion }}"
+)
+
+// ackConditionTypes contains the ACK condition types, in the order they
+// appear in the resource's Status. They are never pruned.
+var ackConditionTypes = []ackv1alpha1.ConditionType{
+	ackv1alpha1.ConditionTypeAdopted,
+{{- if .CRD.AdoptionRetry }}
+	conditionTypeAdopting,
+{{- end }}
+	ackv1alpha1.ConditionTypeResourceSynced,
+	ackv1alpha1.ConditionTypeTerminal,
+	ackv1alpha1.ConditionTypeRecoverable,
+	ackv1alpha1.ConditionTypeAdvisory,
+	ackv1alpha1.ConditionTypeLateInitialized,
+	ackv1alpha1.ConditionTypeReferencesResolved,
+}
+{{- with .CRD.ConditionsConfig }}
+{{- if .MaxCount }}
+
+// maxConditions is the maximum number of conditions kept in the resource's
+// Status
+const maxConditions = {{ .MaxCount }}
+{{- end }}
+{{- if .PruneStaleAfterSeconds }}
+
+// staleConditionAge is the duration after which a custom condition that is
+// not True, and has not transitioned since, is pruned
+const staleConditionAge = {{ .PruneStaleAfterSeconds }} * time.Second
+{{- end }}
+{{- end }}
+
+// ackConditionRank returns the position of the supplied condition type in
+// ackConditionTypes, or -1 if it is a custom condition type.
+func ackConditionRank(conditionType ackv1alpha1.ConditionType) int {
+	for i, ct := range ackConditionTypes {
+		if ct == conditionType {
+			return i
+		}
+	}
+	return -1
+}
+
+// normalizeConditions deduplicates, orders and prunes the supplied resource's
+// Status.Conditions. It returns true if the conditions were changed.
+func normalizeConditions(
+	ko *svcapitypes.{{ .CRD.Names.Camel }},
+) bool {
+	// Deduplicate by type, keeping the last condition of each type
+	byType := map[ackv1alpha1.ConditionType]*ackv1alpha1.Condition{}
+	for _, condition := range ko.Status.Conditions {
+		if condition != nil {
+			byType[condition.Type] = condition
+		}
+	}
+	conditions := make([]*ackv1alpha1.Condition, 0, len(byType))
+	for _, condition := range byType {
+{{- if .CRD.ConditionsConfig.PruneStaleAfterSeconds }}
+		if isStaleCondition(condition) {
+			continue
+		}
+{{- end }}
+		conditions = append(conditions, condition)
+	}
+	sortConditions(conditions)
+{{- if .CRD.ConditionsConfig.MaxCount }}
+	conditions = pruneOldestConditions(conditions, maxConditions)
+{{- end }}
+
+	changed := len(conditions) != len(ko.Status.Conditions)
+	for i := 0; !changed && i < len(conditions); i++ {
+		changed = conditions[i] != ko.Status.Conditions[i]
+	}
+	ko.Status.Conditions = conditions
+	return changed
+}
+
+// sortConditions orders the supplied conditions with the ACK condition types
+// first, followed by the custom condition types sorted by name.
+func sortConditions(conditions []*ackv1alpha1.Condition) {
+	sort.SliceStable(conditions, func(i, j int) bool {
+		ri := ackConditionRank(conditions[i].Type)
+		rj := ackConditionRank(conditions[j].Type)
+		switch {
+		case ri >= 0 && rj >= 0:
+			return ri < rj
+		case ri >= 0 || rj >= 0:
+			return ri >= 0
+		default:
+			return conditions[i].Type < conditions[j].Type
+		}
+	})
+}
+{{- if .CRD.ConditionsConfig.PruneStaleAfterSeconds }}
+
+// isStaleCondition returns true if the supplied condition is a custom
+// condition that is not True and has not transitioned for longer than
+// staleConditionAge.
+func isStaleCondition(condition *ackv1alpha1.Condition) bool {
+	if ackConditionRank(condition.Type) >= 0 {
+		return false
+	}
+	if condition.Status == corev1.ConditionTrue {
+		return false
+	}
+	if condition.LastTransitionTime == nil {
+		return false
+	}
+	return time.Since(condition.LastTransitionTime.Time) > staleConditionAge
+}
+{{- end }}
+{{- if .CRD.ConditionsConfig.MaxCount }}
+
+// pruneOldestConditions removes, from the supplied sorted conditions, the
+// custom conditions with the oldest last transition time until at most max
+// conditions remain. The order of the remaining conditions is preserved.
+func pruneOldestConditions(
+	conditions []*ackv1alpha1.Condition,
+	max int,
+) []*ackv1alpha1.Condition {
+	if len(conditions) <= max {
+		return conditions
+	}
+	custom := []*ackv1alpha1.Condition{}
+	for _, condition := range conditions {
+		if ackConditionRank(condition.Type) < 0 {
+			custom = append(custom, condition)
+		}
+	}
+	// Conditions without a last transition time are considered the oldest
+	sort.SliceStable(custom, func(i, j int) bool {
+		ti := custom[i].LastTransitionTime
+		tj := custom[j].LastTransitionTime
+		if ti == nil || tj == nil {
+			return ti == nil && tj != nil
+		}
+		return ti.Before(tj)
+	})
+	pruned := map[*ackv1alpha1.Condition]bool{}
+	for i := 0; i < len(custom) && len(conditions)-len(pruned) > max; i++ {
+		pruned[custom[i]] = true
+	}
+	kept := make([]*ackv1alpha1.Condition, 0, len(conditions)-len(pruned))
+	for _, condition := range conditions {
+		if !pruned[condition] {
+			kept = append(kept, condition)
+		}
+	}
+	return kept
+}
+{{- end }}
@@ -4,16 +4,23 @@ package {{ .CRD.Names.Snake }}
 
 import (
 	"bytes"
+{{- if .CRD.HasJSONCompareFields }}
+	"encoding/json"
+	"net/url"
+{{- end }}
 	"reflect"
+	"strings"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	acktags "github.com/aws-controllers-k8s/runtime/pkg/tags"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
 )
 
 // Hack to avoid import errors during build...
 var (
 	_ = &bytes.Buffer{}
 	_ = &reflect.Method{}
+	_ = strings.EqualFold
 	_ = &acktags.Tags{}
 )
 
@@ -34,8 +41,289 @@ func newResourceDelta(
 {{ $hookCode }}
 {{- end }}
 {{ GoCodeCompare .CRD "delta" "a.ko" "b.ko" 1}}
+{{- if .CRD.HasCompareIgnoreWhen }}
+{{ GoCodeCompareIgnoreWhen .CRD "delta" "a.ko" 1 -}}
+{{- end }}
 {{- if $hookCode := Hook .CRD "delta_post_compare" }}
 {{ $hookCode }}
+{{- end }}
+{{- if .CRD.HasSensitiveFields }}
+	redactSensitiveDifferences(delta)
 {{- end }}
 	return delta
 }
+
+// DifferenceReason describes how a field differs between the desired and
+// latest states of a resource
+type DifferenceReason string
+
+const (
+	// DifferenceReasonAdded means the field is only set in the desired state
+	DifferenceReasonAdded DifferenceReason = "Added"
+	// DifferenceReasonRemoved means the field is only set in the latest state
+	DifferenceReasonRemoved DifferenceReason = "Removed"
+	// DifferenceReasonChanged means the field is set to different values in
+	// the desired and latest states
+	DifferenceReasonChanged DifferenceReason = "Changed"
+)
+
+// FieldDifference is a single difference found when comparing the desired
+// and latest states of a {{ .CRD.Kind }} resource.
+type FieldDifference struct {
+	// Path is the path of the field that differs, e.g. "Spec.Name"
+	Path string
+	// Desired is the value of the field in the desired state
+	Desired interface{}
+	// Latest is the value of the field in the latest state
+	Latest interface{}
+	// Reason describes how the field differs
+	Reason DifferenceReason
+}
+
+// ResourceDifferences returns the fields that differ between the supplied
+// desired and latest {{ .CRD.Kind }} resources, compared with the same logic
+// the resource manager uses to decide whether to update the resource.
+func ResourceDifferences(
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+) []FieldDifference {
+	var a, b *resource
+	if desired != nil {
+		a = desired.(*resource)
+	}
+	if latest != nil {
+		b = latest.(*resource)
+	}
+	return DifferencesFromDelta(newResourceDelta(a, b))
+}
+
+// DifferencesFromDelta returns the differences recorded in the supplied
+// delta, where A is the desired state and B the latest state, as
+// FieldDifference entries.
+func DifferencesFromDelta(delta *ackcompare.Delta) []FieldDifference {
+	if delta == nil {
+		return nil
+	}
+	diffs := make([]FieldDifference, 0, len(delta.Differences))
+	for _, diff := range delta.Differences {
+		reason := DifferenceReasonChanged
+		if isNilValue(diff.B) {
+			reason = DifferenceReasonAdded
+		} else if isNilValue(diff.A) {
+			reason = DifferenceReasonRemoved
+		}
+		diffs = append(diffs, FieldDifference{
+			Path:    differencePath(diff.Path),
+			Desired: diff.A,
+			Latest:  diff.B,
+			Reason:  reason,
+		})
+	}
+	return diffs
+}
+
+// deltaFieldPaths contains the paths of the fields newResourceDelta can record
+// a difference for, deepest paths first
+var deltaFieldPaths = []string{
+{{- range $path := .CRD.DeltaFieldPaths }}
+	"{{ $path }}",
+{{- end }}
+}
+
+// differencePath returns the dotted path, e.g. "Spec.Name", of the deepest
+// known field containing the supplied difference path, or "" for differences
+// covering the whole resource or recorded at an unknown path, e.g. by hooks.
+func differencePath(path ackcompare.Path) string {
+	for _, fieldPath := range deltaFieldPaths {
+		if path.Contains(fieldPath) {
+			return fieldPath
+		}
+	}
+	return ""
+}
+
+// isNilValue returns true if the supplied value is nil or a nil pointer,
+// slice or map.
+func isNilValue(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+{{- if .CRD.HasCompareIgnoreWhen }}
+
+// ignoreDifferencesAt removes from the supplied delta the differences found
+// at or below the supplied field path
+func ignoreDifferencesAt(delta *ackcompare.Delta, path string) {
+	diffs := make([]*ackcompare.Difference, 0, len(delta.Differences))
+	for _, diff := range delta.Differences {
+		if !diff.Path.Contains(path) {
+			diffs = append(diffs, diff)
+		}
+	}
+	delta.Differences = diffs
+}
+{{- end }}
+{{- if .CRD.HasJSONCompareFields }}
+
+// equalJSON returns true if the supplied strings hold semantically equal JSON
+// documents, regardless of whitespace and the order of object keys. Documents
+// returned URL-encoded by the AWS service are decoded first. Strings that do
+// not hold JSON documents are compared as-is.
+func equalJSON(a, b string) bool {
+	if a == b {
+		return true
+	}
+	aDoc, aOK := decodeJSON(a)
+	bDoc, bOK := decodeJSON(b)
+	if !aOK || !bOK {
+		return false
+	}
+	return reflect.DeepEqual(aDoc, bDoc)
+}
+
+// decodeJSON returns the value of the JSON document held by the supplied
+// string, URL-decoding it first if needed.
+func decodeJSON(s string) (interface{}, bool) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(s), &doc); err == nil {
+		return doc, true
+	}
+	unescaped, err := url.QueryUnescape(s)
+	if err != nil {
+		return nil, false
+	}
+	if err := json.Unmarshal([]byte(unescaped), &doc); err != nil {
+		return nil, false
+	}
+	return doc, true
+}
+{{- end }}
+{{- if .CRD.HasSetCompareFields }}
+
+// equalIgnoringOrder returns true if the supplied slices contain deeply equal
+// elements, regardless of their order.
+func equalIgnoringOrder(a, b interface{}) bool {
+	av := reflect.ValueOf(a)
+	bv := reflect.ValueOf(b)
+	if av.Len() != bv.Len() {
+		return false
+	}
+	matched := make([]bool, bv.Len())
+	for i := 0; i < av.Len(); i++ {
+		found := false
+		for j := 0; j < bv.Len(); j++ {
+			if !matched[j] && reflect.DeepEqual(av.Index(i).Interface(), bv.Index(j).Interface()) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+{{- end }}
+{{- if .CRD.HasNilEqualsEmptyCompareFields }}
+
+// equalNilAsEmpty returns true if the supplied values are deeply equal,
+// considering nil and empty slices and maps, at any depth, equal.
+func equalNilAsEmpty(a, b interface{}) bool {
+	return deepEqualNilAsEmpty(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+// deepEqualNilAsEmpty implements equalNilAsEmpty on reflected values.
+func deepEqualNilAsEmpty(a, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !deepEqualNilAsEmpty(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for _, key := range a.MapKeys() {
+			bVal := b.MapIndex(key)
+			if !bVal.IsValid() || !deepEqualNilAsEmpty(a.MapIndex(key), bVal) {
+				return false
+			}
+		}
+		return true
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return deepEqualNilAsEmpty(a.Elem(), b.Elem())
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			// Structs with unexported fields, e.g. time.Time, are compared
+			// as a whole
+			if a.Type().Field(i).PkgPath != "" {
+				return reflect.DeepEqual(a.Interface(), b.Interface())
+			}
+			if !deepEqualNilAsEmpty(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+{{- end }}
+{{- if .CRD.HasSensitiveFields }}
+
+// sensitiveFieldPaths contains the paths of the fields whose values must never
+// appear in delta dumps or debug logs
+var sensitiveFieldPaths = []string{
+{{- range $path := .CRD.SensitiveFieldPaths }}
+	"{{ $path }}",
+{{- end }}
+}
+
+// redactedValue replaces the values of sensitive fields in delta differences
+const redactedValue = "<redacted>"
+
+// redactSensitiveDifferences replaces the values recorded in the supplied
+// delta for any difference found at or below a sensitive field path, as well
+// as for any difference covering the whole resource. Nil values are kept, so
+// that added and removed fields are still told apart from changed ones.
+func redactSensitiveDifferences(delta *ackcompare.Delta) {
+	for _, diff := range delta.Differences {
+		redact := diff.Path.Contains("")
+		for _, path := range sensitiveFieldPaths {
+			if diff.Path.Contains(path) {
+				redact = true
+				break
+			}
+		}
+		if redact {
+			if !isNilValue(diff.A) {
+				diff.A = redactedValue
+			}
+			if !isNilValue(diff.B) {
+				diff.B = redactedValue
+			}
+		}
+	}
+}
+{{- end }}
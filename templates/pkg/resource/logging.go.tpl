@@ -0,0 +1,89 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+	"encoding/json"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+)
+
+// Hack to avoid import errors during build...
+var (
+	_ = json.Marshal
+)
+
+// redactedPayloadValue replaces the redacted values of logged AWS API
+// payloads
+const redactedPayloadValue = "<redacted>"
+
+// logSDKPayload logs, at debug level, the supplied request ("input") or
+// response ("output") payload of the AWS API operation opName, with the
+// values of secret and sensitive members redacted.
+func logSDKPayload(
+	ctx context.Context,
+	opName string,
+	kind string,
+	payload interface{},
+) {
+	rlog := ackrtlog.FromContext(ctx)
+	rlog.Debug("sdk payload", "operation", opName, kind, redactSDKPayload(payload))
+}
+{{- if .CRD.RedactsAllSDKPayloads }}
+
+// redactSDKPayload returns the supplied AWS API payload redacted as a whole
+func redactSDKPayload(payload interface{}) string {
+	return redactedPayloadValue
+}
+{{- else }}
+
+// redactedSDKMemberNames contains the names of the AWS API payload members,
+// at any depth, whose values are never logged
+var redactedSDKMemberNames = map[string]bool{
+{{- range $memberName := .CRD.RedactedSDKMemberNames }}
+	"{{ $memberName }}": true,
+{{- end }}
+}
+
+// redactSDKPayload returns the JSON representation of the supplied AWS API
+// payload, with the values of secret and sensitive members redacted. The
+// payload is redacted as a whole if it cannot be represented as JSON.
+func redactSDKPayload(payload interface{}) string {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return redactedPayloadValue
+	}
+	var doc interface{}
+	if err = json.Unmarshal(raw, &doc); err != nil {
+		return redactedPayloadValue
+	}
+	redacted, err := json.Marshal(redactSDKValue(doc))
+	if err != nil {
+		return redactedPayloadValue
+	}
+	return string(redacted)
+}
+
+// redactSDKValue replaces, in place, the values of the secret and sensitive
+// members found in the supplied decoded JSON value
+func redactSDKValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if redactedSDKMemberNames[key] {
+				if val != nil {
+					v[key] = redactedPayloadValue
+				}
+				continue
+			}
+			v[key] = redactSDKValue(val)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactSDKValue(val)
+		}
+	}
+	return v
+}
+{{- end }}
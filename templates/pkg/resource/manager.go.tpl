@@ -4,7 +4,23 @@ package {{ .CRD.Names.Snake }}
 
 import (
 	"context"
+{{- if .CRD.AdoptionRetry }}
+	"encoding/json"
+{{- end }}
+{{- if or .CRD.Ownership .CRD.CreateGracePeriod }}
+	"errors"
+{{- end }}
 	"fmt"
+{{- if .CRD.GetDefaultTags }}
+	"os"
+{{- end }}
+	"strconv"
+{{- if .CRD.GetDefaultTags }}
+	"strings"
+{{- end }}
+{{- if or (.CRD.UsesBuiltinMiddleware "caching") .CRD.AdoptionRetry }}
+	"sync"
+{{- end }}
 	"time"
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
@@ -21,15 +37,28 @@ import (
 	ackutil "github.com/aws-controllers-k8s/runtime/pkg/util"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/go-logr/logr"
+{{- if .CRD.UsesBuiltinMiddleware "metrics" }}
+	"github.com/prometheus/client_golang/prometheus"
+{{- end }}
+{{- if .CRD.UsesBuiltinMiddleware "rate_limiting" }}
+	"golang.org/x/time/rate"
+{{- end }}
 	corev1 "k8s.io/api/core/v1"
+{{- if .CRD.UsesBuiltinMiddleware "metrics" }}
+	ctrlrtmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+{{- end }}
 	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServicePackageName }}"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/{{ .ServicePackageName }}/{{ .ServicePackageName }}iface"
 
 	svcapitypes "github.com/aws-controllers-k8s/{{ .ControllerName }}-controller/apis/{{ .APIVersion }}"
+{{- if or .CRD.Config.Endpoint (eq .CRD.DeletionPolicy "retain") .CRD.HasConfigMapFields }}
+	svcresource "github.com/aws-controllers-k8s/{{ .ControllerName }}-controller/pkg/resource"
+{{- end }}
 )
 
 var (
 	_ = ackutil.InStrings
+	_ = strconv.Itoa
 	_ = acktags.NewTags()
 	_ = ackrt.MissingImageTagValue
 	_ = svcapitypes.{{ .CRD.Kind }}{}
@@ -89,15 +118,365 @@ func (rm *resourceManager) ReadOne(
 		// Should never happen... if it does, it's buggy code.
 		panic("resource manager's ReadOne() method received resource with nil CR object")
 	}
+{{- if .CRD.AdoptionRetry }}
+{{- if .CRD.HasMiddleware }}
+	find := sdkFindFunc(rm.withMiddleware("find", rm.sdkFind))
+{{- else }}
+	var find sdkFindFunc = rm.sdkFind
+{{- end }}
+	if isAdopting(r) {
+		find = rm.withAdoptionRetries(find)
+	}
+	observed, err := find(ctx, r)
+{{- else if .CRD.HasMiddleware }}
+	observed, err := rm.withMiddleware("find", rm.sdkFind)(ctx, r)
+{{- else }}
 	observed, err := rm.sdkFind(ctx, r)
+{{- end }}
+{{- if .CRD.CreateGracePeriod }}
+	if err == ackerr.NotFound && withinCreateGracePeriod(r) {
+		return rm.onError(r, ackrequeue.NeededAfter(
+			errors.New("resource not found yet after its creation"),
+			createGracePeriodRequeueAfter,
+		))
+	}
+{{- end }}
 	if err != nil {
 		if observed != nil {
 			return rm.onError(observed, err)
 		}
 		return rm.onError(r, err)
 	}
+{{- if .CRD.HasAttachments }}
+	if err := rm.sdkFindAttachments(ctx, observed); err != nil {
+		return rm.onError(observed, err)
+	}
+{{- end }}
+{{- if .CRD.HasCollections }}
+	if err := rm.sdkFindCollections(ctx, observed); err != nil {
+		return rm.onError(observed, err)
+	}
+{{- end }}
+{{- if .CRD.Ownership }}
+	if err := checkOwnership(r, observed); err != nil {
+		return rm.onError(observed, err)
+	}
+{{- end }}
+{{- if .CRD.TerminalStates }}
+	if observed.ko.GetDeletionTimestamp().IsZero() {
+		if err := terminalStateError(observed); err != nil {
+			return rm.onError(observed, err)
+		}
+	}
+{{- end }}
 	return rm.onSuccess(observed)
 }
+{{- if .CRD.TerminalStates }}
+
+// terminalStateError returns a terminal error if the supplied resource is in
+// a state it cannot recover from, nil otherwise
+func terminalStateError(r *resource) error {
+{{ GoCodeTerminalState .CRD "r" 1 }}	return nil
+}
+{{- end }}
+{{- with .CRD.Ownership }}
+{{- $tagField := $.CRD.GetTagField }}
+
+const (
+	// ownershipTagKey is the key of the tag recording the UID of the custom
+	// resource that owns the AWS resource
+	ownershipTagKey = "{{ .TagKey }}"
+	// claimOwnershipAnnotation is the annotation that, set to "true" on a
+	// custom resource, lets it take ownership of an AWS resource owned by
+	// another custom resource, e.g. after adopting it
+	claimOwnershipAnnotation = "{{ $.APIGroup }}/claim-ownership"
+)
+
+// conditionTypeOwnershipConflict is the type of the condition set on a
+// resource whose AWS resource is owned by another custom resource
+const conditionTypeOwnershipConflict ackv1alpha1.ConditionType = "ACK.OwnershipConflict"
+
+// checkOwnership returns a terminal error, and sets the ACK.OwnershipConflict
+// condition of the observed resource, if the AWS resource carries the
+// ownership tag of another custom resource than the desired one.
+func checkOwnership(desired *resource, observed *resource) error {
+	if desired.ko.UID == "" ||
+		desired.ko.Annotations[claimOwnershipAnnotation] == "true" {
+		removeOwnershipConflictCondition(observed)
+		return nil
+	}
+	var observedTags {{ if eq "list" $tagField.ShapeRef.Shape.Type }}[]*svcapitypes.{{ $tagField.GoTypeElem }}{{ else }}{{ $tagField.GoType }}{{ end }}
+{{- $nilCheck := CheckNilFieldPath $tagField "observed.ko.Spec" }}
+{{- if not (eq $nilCheck "") }}
+	if !({{ $nilCheck }}) {
+		observedTags = observed.ko.Spec.{{ $tagField.Path }}
+	}
+{{- else }}
+	observedTags = observed.ko.Spec.{{ $tagField.Path }}
+{{- end }}
+	owner, found := ToACKTags(observedTags)[ownershipTagKey]
+	if !found || owner == string(desired.ko.UID) {
+		removeOwnershipConflictCondition(observed)
+		return nil
+	}
+	msg := fmt.Sprintf(
+		"AWS resource is owned by another custom resource with UID %s. "+
+			"Set the %s annotation to \"true\" to take ownership of it",
+		owner, claimOwnershipAnnotation,
+	)
+	for _, condition := range observed.ko.Status.Conditions {
+		if condition.Type == conditionTypeOwnershipConflict {
+			condition.Status = corev1.ConditionTrue
+			condition.Message = &msg
+			return ackerr.NewTerminalError(errors.New(msg))
+		}
+	}
+	observed.ko.Status.Conditions = append(observed.ko.Status.Conditions, &ackv1alpha1.Condition{
+		Type:    conditionTypeOwnershipConflict,
+		Status:  corev1.ConditionTrue,
+		Message: &msg,
+	})
+	return ackerr.NewTerminalError(errors.New(msg))
+}
+
+// removeOwnershipConflictCondition removes the ACK.OwnershipConflict
+// condition of the supplied resource, if any
+func removeOwnershipConflictCondition(r *resource) {
+	conditions := r.ko.Status.Conditions[:0]
+	for _, condition := range r.ko.Status.Conditions {
+		if condition.Type != conditionTypeOwnershipConflict {
+			conditions = append(conditions, condition)
+		}
+	}
+	r.ko.Status.Conditions = conditions
+}
+{{- end }}
+{{- with .CRD.GetDefaultTags }}
+
+// configuredDefaultTags are the tags, see `default_tags`, added to the tags
+// of the resource in addition to the controller's default tags. Their values
+// are expanded like the values of the controller's default tags.
+var configuredDefaultTags = map[string]string{
+{{- range $k, $v := . }}
+	{{ printf "%q" $k }}: {{ printf "%q" $v }},
+{{- end }}
+}
+
+// clusterNameTagFormat is expanded in the values of configuredDefaultTags to
+// the value of the ACK_CLUSTER_NAME environment variable
+const clusterNameTagFormat = "%K8S_CLUSTER_NAME%"
+
+// getDefaultTags returns the expanded configuredDefaultTags of the supplied
+// resource. It returns a terminal error if one of them refers to
+// clusterNameTagFormat while ACK_CLUSTER_NAME is empty, as it is when the
+// clusterName value of the controller's helm chart is not set, rather than
+// tagging the AWS resource with an empty cluster name.
+func getDefaultTags(
+	r *resource,
+	md acktypes.ServiceControllerMetadata,
+) (acktags.Tags, error) {
+	clusterName := os.Getenv("ACK_CLUSTER_NAME")
+	tags := acktags.NewTags()
+	for k, v := range configuredDefaultTags {
+		if clusterName == "" && strings.Contains(v, clusterNameTagFormat) {
+			return nil, ackerr.NewTerminalError(fmt.Errorf(
+				"default tag %q refers to %s but ACK_CLUSTER_NAME is not set", k, clusterNameTagFormat,
+			))
+		}
+		for tagFormat, resolveTagFormat := range ackrt.ACKResourceTagFormats {
+			v = strings.ReplaceAll(v, tagFormat, resolveTagFormat(r.ko, md))
+		}
+		tags[k] = strings.ReplaceAll(v, clusterNameTagFormat, clusterName)
+	}
+	return tags, nil
+}
+{{- end }}
+{{- with .CRD.ListOpMatchTag }}
+
+// identityTagKey is the key of the tag, holding the {{ if $.CRD.IsClusterScoped }}name{{ else }}namespace and name{{ end }} of the
+// custom resource, by which the AWS resource is located in the results of the
+// {{ $.CRD.Ops.ReadMany.ExportedName }} operation
+const identityTagKey = "{{ .Key }}"
+{{- end }}
+
+{{- with .CRD.CreateGracePeriod }}
+
+const (
+	// createdAtAnnotation is the annotation recording the time at which the
+	// resource was created in the AWS service
+	createdAtAnnotation = "{{ $.APIGroup }}/created-at"
+	// createGracePeriod is the period after the creation of the resource
+	// during which the resource not being found is tolerated
+	createGracePeriod = {{ .Seconds }} * time.Second
+	// createGracePeriodRequeueAfter is the delay after which a resource not
+	// found within the grace period is requeued
+	createGracePeriodRequeueAfter = {{ .RequeueAfterSeconds }} * time.Second
+)
+
+// setCreatedAt records the supplied time of creation on the resource
+func setCreatedAt(r *resource, createdAt time.Time) {
+	annotations := r.ko.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[createdAtAnnotation] = createdAt.UTC().Format(time.RFC3339)
+	r.ko.SetAnnotations(annotations)
+}
+
+// withinCreateGracePeriod returns true if the supplied resource was created
+// less than createGracePeriod ago. The AWS service may not find such a
+// resource yet, which must not make the reconciler create it again.
+func withinCreateGracePeriod(r *resource) bool {
+	createdAt, err := time.Parse(
+		time.RFC3339, r.ko.GetAnnotations()[createdAtAnnotation],
+	)
+	if err != nil {
+		return false
+	}
+	return time.Since(createdAt) < createGracePeriod
+}
+{{- end }}
+
+{{- with .CRD.AdoptionRetry }}
+
+// sdkFindFunc is the signature of the resource manager's sdkFind method
+type sdkFindFunc func(ctx context.Context, r *resource) (*resource, error)
+
+const (
+	// adoptionMaxAttempts is the maximum number of reads of a resource being
+	// adopted
+	adoptionMaxAttempts = {{ .MaxAttempts }}
+	// adoptionInitialBackoff is the wait before the first retry of the read
+	// of a resource being adopted
+	adoptionInitialBackoff = {{ .InitialBackoffSeconds }} * time.Second
+	// adoptionMaxBackoff is the maximum wait between two reads of a resource
+	// being adopted
+	adoptionMaxBackoff = {{ .MaxBackoffSeconds }} * time.Second
+)
+
+// isAdopting returns true if the supplied resource is being adopted, i.e. it
+// was built from its AWS identifiers and does not exist in Kubernetes yet.
+func isAdopting(r *resource) bool {
+	return r.ko.UID == ""
+}
+
+// isAdoptionRetryable returns true if the supplied error, returned while
+// reading a resource being adopted, is transient: the resource is not found
+// yet or the request was throttled.
+func isAdoptionRetryable(err error) bool {
+	if err == ackerr.NotFound {
+		return true
+	}
+	awsErr, ok := ackerr.AWSError(err)
+	if !ok {
+		return false
+	}
+	switch awsErr.Code() {
+	case {{ range $x, $code := .RetryableErrorCodes -}}{{ if ne ($x) (0) }},
+		{{ end }}"{{ $code }}"{{ end }}:
+		return true
+	default:
+		return false
+	}
+}
+
+// conditionTypeAdopting is the type of the condition set on a resource whose
+// adoption is waiting for a read of the resource to succeed
+const conditionTypeAdopting ackv1alpha1.ConditionType = "ACK.Adopting"
+
+var (
+	adoptionAttemptsMu sync.Mutex
+	// adoptionAttempts is a map, keyed by the account, region and identifiers
+	// of a resource being adopted, of the number of failed reads of the
+	// resource across reconciles
+	adoptionAttempts = map[string]int{}
+)
+
+// withAdoptionRetries returns the supplied sdkFindFunc wrapped with retries,
+// with exponential backoff, of transient errors. Each retry is a requeue of
+// the adoption, during which the resource carries a True ACK.Adopting
+// condition. When the retries are exhausted, the condition becomes False and
+// the adoption keeps being requeued after adoptionMaxBackoff instead of
+// failing.
+func (rm *resourceManager) withAdoptionRetries(
+	next sdkFindFunc,
+) sdkFindFunc {
+	return func(ctx context.Context, r *resource) (*resource, error) {
+		rlog := ackrtlog.FromContext(ctx)
+		identifiers, err := json.Marshal(r.ko)
+		if err != nil {
+			return nil, err
+		}
+		key := fmt.Sprintf("%s/%s/%s", rm.awsAccountID, rm.awsRegion, identifiers)
+		observed, err := next(ctx, r)
+		if err == nil || !isAdoptionRetryable(err) {
+			adoptionAttemptsMu.Lock()
+			delete(adoptionAttempts, key)
+			adoptionAttemptsMu.Unlock()
+			return observed, err
+		}
+		adoptionAttemptsMu.Lock()
+		adoptionAttempts[key]++
+		attempt := adoptionAttempts[key]
+		adoptionAttemptsMu.Unlock()
+		if observed == nil {
+			observed = &resource{r.ko.DeepCopy()}
+		}
+		if attempt >= adoptionMaxAttempts {
+			msg := fmt.Sprintf(
+				"unable to read resource after %d attempts: %s",
+				attempt, err,
+			)
+			setAdoptingCondition(observed, corev1.ConditionFalse, msg)
+			return observed, ackrequeue.NeededAfter(
+				fmt.Errorf(
+					"unable to read resource after %d attempts: %w",
+					attempt, err,
+				),
+				adoptionMaxBackoff,
+			)
+		}
+		backoff := adoptionInitialBackoff << (attempt - 1)
+		if backoff > adoptionMaxBackoff || backoff <= 0 {
+			backoff = adoptionMaxBackoff
+		}
+		rlog.Debug(
+			"retrying read of resource being adopted",
+			"attempt", attempt,
+			"backoff", backoff.String(),
+			"error", err.Error(),
+		)
+		msg := fmt.Sprintf(
+			"retrying read of resource after attempt %d of %d: %s",
+			attempt, adoptionMaxAttempts, err,
+		)
+		setAdoptingCondition(observed, corev1.ConditionTrue, msg)
+		return observed, ackrequeue.NeededAfter(err, backoff)
+	}
+}
+
+// setAdoptingCondition sets the ACK.Adopting condition of the supplied
+// resource
+func setAdoptingCondition(
+	r *resource,
+	status corev1.ConditionStatus,
+	message string,
+) {
+	for _, condition := range r.ko.Status.Conditions {
+		if condition.Type == conditionTypeAdopting {
+			condition.Status = status
+			condition.Message = &message
+			return
+		}
+	}
+	r.ko.Status.Conditions = append(r.ko.Status.Conditions, &ackv1alpha1.Condition{
+		Type:    conditionTypeAdopting,
+		Status:  status,
+		Message: &message,
+	})
+}
+
+{{- end }}
 
 // Create attempts to create the supplied AWSResource in the backend AWS
 // service API, returning an AWSResource representing the newly-created
@@ -111,13 +490,30 @@ func (rm *resourceManager) Create(
 		// Should never happen... if it does, it's buggy code.
 		panic("resource manager's Create() method received resource with nil CR object")
 	}
+{{- if .CRD.HasMiddleware }}
+	created, err := rm.withMiddleware("create", rm.sdkCreate)(ctx, r)
+{{- else }}
 	created, err := rm.sdkCreate(ctx, r)
+{{- end }}
 	if err != nil {
 	    if created != nil {
 	        return rm.onError(created, err)
 	    }
 		return rm.onError(r, err)
 	}
+{{- if .CRD.CreateGracePeriod }}
+	setCreatedAt(created, time.Now())
+{{- end }}
+{{- if .CRD.HasAttachments }}
+	if err := rm.sdkPutAttachments(ctx, created, nil); err != nil {
+		return rm.onError(created, err)
+	}
+{{- end }}
+{{- if .CRD.HasCollections }}
+	if err := rm.sdkSyncCollections(ctx, created, nil, nil); err != nil {
+		return rm.onError(created, err)
+	}
+{{- end }}
 	return rm.onSuccess(created)
 }
 
@@ -141,7 +537,58 @@ func (rm *resourceManager) Update(
 		// Should never happen... if it does, it's buggy code.
 		panic("resource manager's Update() method received resource with nil CR object")
 	}
+	rlog := ackrtlog.FromContext(ctx)
+	for _, diff := range DifferencesFromDelta(delta) {
+		rlog.Debug(
+			"desired state differs from latest state",
+			"field", diff.Path, "reason", diff.Reason,
+		)
+	}
+{{- if or .CRD.HasAttachments .CRD.HasCollections }}
+	// Attachments and collections are written with their own operations, the
+	// resource is only updated when other fields differ.
+	updated := &resource{desired.ko.DeepCopy()}
+	var err error
+	if delta.DifferentExcept({{ range $x, $path := .CRD.GetSubAPIFieldPaths }}{{ if ne $x 0 }}, {{ end }}"{{ $path }}"{{ end }}) {
+{{- if .CRD.HasMiddleware }}
+		updated, err = rm.withMiddleware("update", func(
+			ctx context.Context,
+			desired *resource,
+		) (*resource, error) {
+			return rm.sdkUpdate(ctx, desired, latest, delta)
+		})(ctx, desired)
+{{- else }}
+		updated, err = rm.sdkUpdate(ctx, desired, latest, delta)
+{{- end }}
+		if err != nil {
+			if updated != nil {
+				return rm.onError(updated, err)
+			}
+			return rm.onError(latest, err)
+		}
+	}
+{{- if .CRD.HasAttachments }}
+	if err := rm.sdkPutAttachments(ctx, updated, delta); err != nil {
+		return rm.onError(updated, err)
+	}
+{{- end }}
+{{- if .CRD.HasCollections }}
+	if err := rm.sdkSyncCollections(ctx, updated, latest, delta); err != nil {
+		return rm.onError(updated, err)
+	}
+{{- end }}
+	return rm.onSuccess(updated)
+{{- else }}
+{{- if .CRD.HasMiddleware }}
+	updated, err := rm.withMiddleware("update", func(
+		ctx context.Context,
+		desired *resource,
+	) (*resource, error) {
+		return rm.sdkUpdate(ctx, desired, latest, delta)
+	})(ctx, desired)
+{{- else }}
 	updated, err := rm.sdkUpdate(ctx, desired, latest, delta)
+{{- end }}
 	if err != nil {
 	    if updated != nil {
 	        return rm.onError(updated, err)
@@ -149,6 +596,7 @@ func (rm *resourceManager) Update(
 		return rm.onError(latest, err)
 	}
 	return rm.onSuccess(updated)
+{{- end }}
 }
 
 // Delete attempts to destroy the supplied AWSResource in the backend AWS
@@ -163,7 +611,28 @@ func (rm *resourceManager) Delete(
 		// Should never happen... if it does, it's buggy code.
 		panic("resource manager's Update() method received resource with nil CR object")
 	}
+{{- if eq .CRD.DeletionPolicy "retain" }}
+	// {{ .CRD.Names.Camel }} resources are retained by default: the AWS resource
+	// is only deleted when the deletion policy annotation of the custom
+	// resource, or else of its namespace, is set to "delete".
+	policy, found := r.ko.GetAnnotations()[ackv1alpha1.AnnotationDeletionPolicy]
+	if !found {
+		var err error
+		if policy, err = svcresource.NamespaceDeletionPolicy(ctx, r.ko.Namespace); err != nil {
+			return rm.onError(r, err)
+		}
+	}
+	if policy != string(ackv1alpha1.DeletionPolicyDelete) {
+		rlog := ackrtlog.FromContext(ctx)
+		rlog.Info("AWS resource will not be deleted - deletion policy set to retain")
+		return nil, nil
+	}
+{{- end }}
+{{- if .CRD.HasMiddleware }}
+	observed, err := rm.withMiddleware("delete", rm.sdkDelete)(ctx, r)
+{{- else }}
 	observed, err := rm.sdkDelete(ctx, r)
+{{- end }}
 	if err != nil {
 		if observed != nil {
 			return rm.onError(observed, err)
@@ -173,6 +642,198 @@ func (rm *resourceManager) Delete(
 
 	return rm.onSuccess(observed)
 }
+{{- if .CRD.HasMiddleware }}
+
+// sdkOperation is a call to the backend AWS service API for a single
+// resource, as performed by the resource manager's sdkFind, sdkCreate,
+// sdkUpdate and sdkDelete methods.
+type sdkOperation func(ctx context.Context, r *resource) (*resource, error)
+
+// middleware wraps the sdkOperation named opName ("find", "create", "update"
+// or "delete") with cross-cutting behavior.
+type middleware func(opName string, next sdkOperation) sdkOperation
+
+// middlewareChain returns the middleware configured for the resource, the
+// first being the outermost.
+func (rm *resourceManager) middlewareChain() []middleware {
+	return []middleware{
+{{ GoCodeMiddlewareChain .CRD 2 }}	}
+}
+
+// withMiddleware returns the supplied sdkOperation wrapped by all the
+// middleware in the resource's middleware chain.
+func (rm *resourceManager) withMiddleware(
+	opName string,
+	op sdkOperation,
+) sdkOperation {
+	chain := rm.middlewareChain()
+	for i := len(chain) - 1; i >= 0; i-- {
+		op = chain[i](opName, op)
+	}
+	return op
+}
+{{- if .CRD.UsesBuiltinMiddleware "logging" }}
+
+// loggingMiddleware traces the entry into and exit from each operation in the
+// resource logger.
+func (rm *resourceManager) loggingMiddleware(
+	opName string,
+	next sdkOperation,
+) sdkOperation {
+	return func(ctx context.Context, r *resource) (*resource, error) {
+		rlog := ackrtlog.FromContext(ctx)
+		exit := rlog.Trace("rm.middleware." + opName)
+		res, err := next(ctx, r)
+		exit(err)
+		return res, err
+	}
+}
+{{- end }}
+{{- if .CRD.UsesBuiltinMiddleware "metrics" }}
+
+// operationDuration observes the duration of the resource manager's
+// operations, by service, kind, operation and outcome. The histogram is shared
+// by the resource managers of all the kinds of the controller.
+var operationDuration = func() *prometheus.HistogramVec {
+	hv := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "ack_resource_manager_operation_duration_seconds",
+			Help: "Duration of the resource manager's operations against the backend AWS service API",
+		},
+		[]string{"service", "kind", "op", "result"},
+	)
+	if err := ctrlrtmetrics.Registry.Register(hv); err != nil {
+		are, ok := err.(prometheus.AlreadyRegisteredError)
+		if !ok {
+			panic(err)
+		}
+		hv = are.ExistingCollector.(*prometheus.HistogramVec)
+	}
+	return hv
+}()
+
+// metricsMiddleware observes the duration of each operation in the
+// operationDuration histogram.
+func (rm *resourceManager) metricsMiddleware(
+	opName string,
+	next sdkOperation,
+) sdkOperation {
+	return func(ctx context.Context, r *resource) (*resource, error) {
+		start := time.Now()
+		res, err := next(ctx, r)
+		result := "success"
+		if err != nil {
+			result = "error"
+		}
+		operationDuration.WithLabelValues(
+			"{{ .ControllerName }}", "{{ .CRD.Kind }}", opName, result,
+		).Observe(time.Since(start).Seconds())
+		return res, err
+	}
+}
+{{- end }}
+{{- with .CRD.BuiltinMiddleware "rate_limiting" }}
+
+// rateLimiter limits the operations of all the {{ $.CRD.Kind }} resources
+var rateLimiter = rate.NewLimiter(rate.Limit({{ .GetRequestsPerSecond }}), {{ .GetBurst }})
+
+// rateLimitingMiddleware waits for the rateLimiter to allow each operation.
+func (rm *resourceManager) rateLimitingMiddleware(
+	opName string,
+	next sdkOperation,
+) sdkOperation {
+	return func(ctx context.Context, r *resource) (*resource, error) {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		return next(ctx, r)
+	}
+}
+{{- end }}
+{{- with .CRD.BuiltinMiddleware "caching" }}
+
+// findCacheTTL is the duration for which the result of a find is reused
+const findCacheTTL = {{ .GetTTLSeconds }} * time.Second
+
+// findCacheEntry is the resource observed by a find for a generation of the
+// custom resource
+type findCacheEntry struct {
+	generation int64
+	expiresAt  time.Time
+	observed   *resource
+}
+
+var (
+	findCacheMu sync.Mutex
+	// findCache is a map, keyed by the account, region, namespace and name of
+	// the custom resource, of the result of its last find
+	findCache = map[string]findCacheEntry{}
+)
+
+// cachingMiddleware reuses the resource returned by a successful find for
+// findCacheTTL, as long as the generation of the custom resource does not
+// change. Any other operation discards the cached resource.
+func (rm *resourceManager) cachingMiddleware(
+	opName string,
+	next sdkOperation,
+) sdkOperation {
+	return func(ctx context.Context, r *resource) (*resource, error) {
+		key := fmt.Sprintf(
+			"%s/%s/%s/%s",
+			rm.awsAccountID, rm.awsRegion, r.ko.GetNamespace(), r.ko.GetName(),
+		)
+		if opName != "find" {
+			res, err := next(ctx, r)
+			findCacheMu.Lock()
+			delete(findCache, key)
+			findCacheMu.Unlock()
+			return res, err
+		}
+		findCacheMu.Lock()
+		entry, found := findCache[key]
+		findCacheMu.Unlock()
+		if found && entry.generation == r.ko.GetGeneration() &&
+			time.Now().Before(entry.expiresAt) {
+			return &resource{entry.observed.ko.DeepCopy()}, nil
+		}
+		observed, err := next(ctx, r)
+		if err != nil {
+			return observed, err
+		}
+		findCacheMu.Lock()
+		findCache[key] = findCacheEntry{
+			generation: r.ko.GetGeneration(),
+			expiresAt:  time.Now().Add(findCacheTTL),
+			observed:   &resource{observed.ko.DeepCopy()},
+		}
+		findCacheMu.Unlock()
+		return observed, nil
+	}
+}
+{{- end }}
+{{- end }}
+{{- if .CRD.HasConfigMapFields }}
+
+// configMapValueFromReference returns the value of the key of the ConfigMap
+// identified by the supplied reference. The ConfigMap is looked up in the
+// namespace of the resource when the reference has no namespace.
+func (rm *resourceManager) configMapValueFromReference(
+	ctx context.Context,
+	ref *svcapitypes.ConfigMapKeyReference,
+) (string, error) {
+	if ref == nil {
+		return "", nil
+	}
+	namespace := ref.Namespace
+	if namespace == "" {
+		// The reconciler stores the namespace of the resource in the context
+		if ctxNamespace, ok := ctx.Value("resourceNamespace").(string); ok {
+			namespace = ctxNamespace
+		}
+	}
+	return svcresource.ConfigMapValue(ctx, namespace, ref.Name, ref.Key)
+}
+{{- end }}
 
 // ARNFromName returns an AWS Resource Name from a given string name. This
 // is useful for constructing ARNs for APIs that require ARNs in their
@@ -222,13 +883,37 @@ func (rm *resourceManager) LateInitialize(
 	lateInitializedRes := rm.lateInitializeFromReadOneOutput(observed, latestCopy)
 	incompleteInitialization := rm.incompleteLateInitialization(lateInitializedRes)
 	if incompleteInitialization {
+{{- if .CRD.LateInitializeRetry }}
+		attempts := lateInitializationAttempts(lateInitializedRes) + 1
+{{- if .CRD.LateInitializeRetry.MaxAttempts }}
+		if attempts > lateInitializeMaxAttempts {
+			// Stop requeuing and leave the condition with LateInitialized=False
+			lateInitConditionMessage = fmt.Sprintf("Late initialization did not complete after %d attempts", lateInitializeMaxAttempts)
+			lateInitConditionReason = "Late Initialization Failure"
+			ackcondition.SetLateInitialized(lateInitializedRes, corev1.ConditionFalse, &lateInitConditionMessage, &lateInitConditionReason)
+			return lateInitializedRes, nil
+		}
+{{- end }}
+		setLateInitializationAttempts(lateInitializedRes, attempts)
+		backoff := lateInitializationBackoff(attempts)
+		// Add the condition with LateInitialized=False
+		lateInitConditionMessage = fmt.Sprintf("Late initialization did not complete, requeuing with delay of %d seconds", int(backoff.Seconds()))
+		lateInitConditionReason = "Delayed Late Initialization"
+		ackcondition.SetLateInitialized(lateInitializedRes, corev1.ConditionFalse, &lateInitConditionMessage, &lateInitConditionReason)
+		ackcondition.SetSynced(lateInitializedRes, corev1.ConditionFalse, nil, nil)
+		return lateInitializedRes, ackrequeue.NeededAfter(nil, backoff)
+{{- else }}
 		// Add the condition with LateInitialized=False
 		lateInitConditionMessage = "Late initialization did not complete, requeuing with delay of 5 seconds"
 		lateInitConditionReason = "Delayed Late Initialization"
 		ackcondition.SetLateInitialized(lateInitializedRes, corev1.ConditionFalse, &lateInitConditionMessage, &lateInitConditionReason)
 		ackcondition.SetSynced(lateInitializedRes, corev1.ConditionFalse, nil, nil)
 		return lateInitializedRes, ackrequeue.NeededAfter(nil, time.Duration(5)*time.Second)
+{{- end }}
 	}
+{{- if .CRD.LateInitializeRetry }}
+	setLateInitializationAttempts(lateInitializedRes, 0)
+{{- end }}
 	// Set LateInitialized condition to True
 	lateInitConditionMessage = "Late initialization successful"
 	lateInitConditionReason = "Late initialization successful"
@@ -236,6 +921,74 @@ func (rm *resourceManager) LateInitialize(
 	return lateInitializedRes, nil
 }
 
+{{- with .CRD.LateInitializeRetry }}
+{{- if .MaxAttempts }}
+
+// lateInitializeMaxAttempts is the number of unsuccessful late initialization
+// attempts after which late initialization is no longer requeued
+const lateInitializeMaxAttempts = {{ .MaxAttempts }}
+{{- end }}
+
+const (
+	// lateInitializeMinBackoff is the delay before late initialization is
+	// attempted again after the first unsuccessful attempt
+	lateInitializeMinBackoff = {{ .MinBackoffSeconds }} * time.Second
+	// lateInitializeMaxBackoff is the maximum delay before late
+	// initialization is attempted again
+	lateInitializeMaxBackoff = {{ .MaxBackoffSeconds }} * time.Second
+)
+{{- end }}
+{{- if .CRD.LateInitializeRetry }}
+
+// lateInitializationAttemptsAnnotation is the annotation recording the number
+// of consecutive unsuccessful late initialization attempts of a resource
+const lateInitializationAttemptsAnnotation = "{{ .APIGroup }}/late-initialization-attempts"
+
+// lateInitializationAttempts returns the number of consecutive unsuccessful
+// late initialization attempts recorded on the supplied resource
+func lateInitializationAttempts(res acktypes.AWSResource) int {
+	attempts, err := strconv.Atoi(res.MetaObject().GetAnnotations()[lateInitializationAttemptsAnnotation])
+	if err != nil {
+		return 0
+	}
+	return attempts
+}
+
+// setLateInitializationAttempts records the number of consecutive
+// unsuccessful late initialization attempts on the supplied resource. The
+// annotation is removed when attempts is zero.
+func setLateInitializationAttempts(res acktypes.AWSResource, attempts int) {
+	annotations := res.MetaObject().GetAnnotations()
+	if attempts == 0 {
+		if _, found := annotations[lateInitializationAttemptsAnnotation]; found {
+			delete(annotations, lateInitializationAttemptsAnnotation)
+			res.MetaObject().SetAnnotations(annotations)
+		}
+		return
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[lateInitializationAttemptsAnnotation] = strconv.Itoa(attempts)
+	res.MetaObject().SetAnnotations(annotations)
+}
+
+// lateInitializationBackoff returns the delay before late initialization is
+// attempted again after the supplied number of unsuccessful attempts. The
+// delay doubles with every attempt, from lateInitializeMinBackoff up to
+// lateInitializeMaxBackoff.
+func lateInitializationBackoff(attempts int) time.Duration {
+	backoff := lateInitializeMinBackoff
+	for i := 1; i < attempts && backoff < lateInitializeMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > lateInitializeMaxBackoff {
+		backoff = lateInitializeMaxBackoff
+	}
+	return backoff
+}
+{{- end }}
+
 // incompleteLateInitialization return true if there are fields which were supposed to be
 // late initialized but are not. If all the fields are late initialized, false is returned
 func (rm *resourceManager) incompleteLateInitialization(
@@ -292,6 +1045,13 @@ func (rm *resourceManager) EnsureTags(
 		panic("resource manager's EnsureTags method received resource with nil CR object")
 	}
 	defaultTags := ackrt.GetDefaultTags(&rm.cfg, r.ko, md)
+{{- if .CRD.GetDefaultTags }}
+	configuredTags, err := getDefaultTags(r, md)
+	if err != nil {
+		return err
+	}
+	defaultTags = acktags.Merge(defaultTags, configuredTags)
+{{- end }}
 	var existingTags {{ $tagFieldGoType }}
 {{ $nilCheck := CheckNilFieldPath $tagField "r.ko.Spec" -}}
 {{ if not (eq $nilCheck "") -}}
@@ -305,6 +1065,18 @@ func (rm *resourceManager) EnsureTags(
 {{ end -}}
 	resourceTags := ToACKTags(existingTags)
 	tags := acktags.Merge(resourceTags, defaultTags)
+{{- if .CRD.Ownership }}
+	if r.ko.UID != "" {
+		tags[ownershipTagKey] = string(r.ko.UID)
+	}
+{{- end }}
+{{- if .CRD.ListOpMatchTag }}
+{{- if .CRD.IsClusterScoped }}
+	tags[identityTagKey] = r.ko.Name
+{{- else }}
+	tags[identityTagKey] = r.ko.Namespace + "/" + r.ko.Name
+{{- end }}
+{{- end }}
 {{ GoCodeInitializeNestedStructField .CRD "r.ko" $tagField "svcapitypes" 1 -}}
 	r.ko.Spec.{{ $tagField.Path }} = FromACKTags(tags)
 {{- end }}
@@ -331,7 +1103,11 @@ func newResourceManager(
 		awsAccountID: id,
 		awsRegion: region,
 		sess:		 sess,
+{{- if .CRD.Config.Endpoint }}
+		sdkapi:	   svcsdk.New(sess, svcresource.SDKClientConfig()),
+{{- else }}
 		sdkapi:	   svcsdk.New(sess),
+{{- end }}
 	}, nil
 }
 
@@ -5,9 +5,18 @@ package {{ .CRD.Names.Snake }}
 import (
 	"context"
 {{ if .CRD.HasReferenceFields -}}
+{{ if .CRD.ReferenceWait -}}
+	"errors"
+{{ end -}}
 	"fmt"
+{{ if .CRD.ReferenceWait -}}
+	"time"
+{{ end -}}
 
 	corev1 "k8s.io/api/core/v1"
+{{ if .CRD.IsOwnedByParent -}}
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+{{ end -}}
 	"k8s.io/apimachinery/pkg/types"
 {{ end -}}
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -15,6 +24,9 @@ import (
 {{ if .CRD.HasReferenceFields -}}
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+{{ if .CRD.ReferenceWait -}}
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+{{ end -}}
 {{ end -}}
 	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
 {{ $servicePackageName := .ServicePackageName -}}
@@ -80,6 +92,9 @@ func (rm *resourceManager) ResolveReferences(
 	{{ range $fieldName, $field := .CRD.Fields -}}
 	{{ if $field.HasReference -}}
 	if fieldHasReferences, err := rm.resolveReferenceFor{{ $field.FieldPathWithUnderscore }}(ctx, apiReader, ko); err != nil {
+{{- if $.CRD.ReferenceWait }}
+		err = waitForReferencedResource(ko, err)
+{{- end }}
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
@@ -87,6 +102,19 @@ func (rm *resourceManager) ResolveReferences(
 	
 	{{ end -}}
 	{{ end -}}
+	{{ range $fieldName, $field := .CRD.Fields -}}
+	{{ if $field.HasKMSKeyConfig -}}
+{{ GoCodeSetKMSKeyDefault $field "ko" 1 }}
+	{{- end }}
+	{{- end }}
+{{- if .CRD.IsOwnedByParent }}
+	if err := setParentOwnerReference(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, resourceHasReferences, err
+	}
+{{- end }}
+{{- if .CRD.ReferenceWait }}
+	removeReferenceNotSyncedCondition(ko)
+{{- end }}
 {{- if $hookCode := Hook .CRD "references_post_resolve" }}
 {{ $hookCode }}
 {{- end }}
@@ -94,6 +122,57 @@ func (rm *resourceManager) ResolveReferences(
 {{ end -}}
 }
 
+{{- with .CRD.ReferenceWait }}
+
+// conditionTypeReferenceNotSynced is the type of the condition set on a
+// resource waiting for a referenced resource to be synced
+const conditionTypeReferenceNotSynced ackv1alpha1.ConditionType = "ACK.ReferenceNotSynced"
+
+// referenceNotSyncedRequeueAfter is the wait before the references of a
+// resource waiting for a referenced resource to be synced are resolved again
+const referenceNotSyncedRequeueAfter = {{ .RequeueAfterSeconds }} * time.Second
+
+// waitForReferencedResource sets the ACK.ReferenceNotSynced condition, naming
+// the blocking resource, of the supplied resource and returns an error
+// requeueing it after referenceNotSyncedRequeueAfter if the supplied error
+// reports that a referenced resource is not synced yet. Other errors are
+// returned as is.
+func waitForReferencedResource(
+	ko *svcapitypes.{{ $.CRD.Names.Camel }},
+	err error,
+) error {
+	if !errors.Is(err, ackerr.ResourceReferenceNotSynced) {
+		return err
+	}
+	msg := fmt.Sprintf("waiting for referenced resource to be synced: %s", err)
+	for _, condition := range ko.Status.Conditions {
+		if condition.Type == conditionTypeReferenceNotSynced {
+			condition.Status = corev1.ConditionTrue
+			condition.Message = &msg
+			return ackrequeue.NeededAfter(err, referenceNotSyncedRequeueAfter)
+		}
+	}
+	ko.Status.Conditions = append(ko.Status.Conditions, &ackv1alpha1.Condition{
+		Type:    conditionTypeReferenceNotSynced,
+		Status:  corev1.ConditionTrue,
+		Message: &msg,
+	})
+	return ackrequeue.NeededAfter(err, referenceNotSyncedRequeueAfter)
+}
+
+// removeReferenceNotSyncedCondition removes the ACK.ReferenceNotSynced
+// condition of the supplied resource, if any
+func removeReferenceNotSyncedCondition(ko *svcapitypes.{{ $.CRD.Names.Camel }}) {
+	conditions := ko.Status.Conditions[:0]
+	for _, condition := range ko.Status.Conditions {
+		if condition.Type != conditionTypeReferenceNotSynced {
+			conditions = append(conditions, condition)
+		}
+	}
+	ko.Status.Conditions = conditions
+}
+{{- end }}
+
 // validateReferenceFields validates the reference field and corresponding
 // identifier field.
 func validateReferenceFields(ko *svcapitypes.{{ .CRD.Names.Camel }}) error {
@@ -101,10 +180,67 @@ func validateReferenceFields(ko *svcapitypes.{{ .CRD.Names.Camel }}) error {
 {{ if $field.HasReference }}
 {{ GoCodeReferencesValidation $field "ko" 1 -}}
 {{ end -}}
+{{ if $field.HasKMSKeyConfig }}
+{{ GoCodeKMSKeyValidation $field "ko" 1 -}}
+{{ end -}}
 {{ end -}}
 	return nil
 }
 
+{{- if .CRD.IsOwnedByParent }}
+{{- $parentRefField := .CRD.GetParentReferenceField }}
+{{- $parentResource := .CRD.Parent.Resource }}
+
+// setParentOwnerReference makes the {{ $parentResource }} custom resource referenced from
+// {{ $parentRefField.Path }} the owner of the supplied custom resource, so that it is
+// garbage collected along with its parent. Parents referenced by their AWS
+// identifier, or from another namespace, cannot own the custom resource.
+func setParentOwnerReference(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.{{ .CRD.Names.Camel }},
+) error {
+	if ko.Spec.{{ $parentRefField.Path }} == nil || ko.Spec.{{ $parentRefField.Path }}.From == nil {
+		return nil
+	}
+	arr := ko.Spec.{{ $parentRefField.Path }}.From
+{{- if .CRD.ParentIsClusterScoped }}
+	if arr.Name == nil || *arr.Name == "" {
+		return nil
+	}
+	parent := &svcapitypes.{{ $parentResource }}{}
+	// {{ $parentResource }} is cluster-scoped
+	namespacedName := types.NamespacedName{
+		Name: *arr.Name,
+	}
+{{- else }}
+	if arr.Name == nil || *arr.Name == "" ||
+		(arr.Namespace != nil && *arr.Namespace != "" && *arr.Namespace != ko.ObjectMeta.GetNamespace()) {
+		return nil
+	}
+	parent := &svcapitypes.{{ $parentResource }}{}
+	namespacedName := types.NamespacedName{
+		Namespace: ko.ObjectMeta.GetNamespace(),
+		Name:      *arr.Name,
+	}
+{{- end }}
+	if err := apiReader.Get(ctx, namespacedName, parent); err != nil {
+		return err
+	}
+	for _, ownerRef := range ko.ObjectMeta.OwnerReferences {
+		if ownerRef.UID == parent.UID {
+			return nil
+		}
+	}
+	ko.ObjectMeta.OwnerReferences = append(ko.ObjectMeta.OwnerReferences, metav1.OwnerReference{
+		APIVersion: svcapitypes.GroupVersion.String(),
+		Kind:       "{{ $parentResource }}",
+		Name:       parent.Name,
+		UID:        parent.UID,
+	})
+	return nil
+}
+{{- end }}
 {{- $getReferencedResourceStateResources := (Nil) -}}
 
 {{ range $fieldName, $field := .CRD.Fields -}}
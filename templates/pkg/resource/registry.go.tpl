@@ -3,8 +3,33 @@
 package resource
 
 import (
+{{- if .GeneratorConfig.UsesAPIReader }}
+	"context"
+{{- end }}
+{{- if .GeneratorConfig.HasConfigMapFields }}
+	"fmt"
+{{- end }}
+{{- with .GeneratorConfig.Endpoint }}
+{{- if .URLEnvVar }}
+	"os"
+
+{{- end }}
+{{- end }}
+{{- if .GeneratorConfig.HasRetainedResources }}
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+{{- end }}
 	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
 	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+{{- with .GeneratorConfig.Endpoint }}
+	"github.com/aws/aws-sdk-go/aws"
+{{- if or .UseFIPS .UseDualStack }}
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+{{- end }}
+{{- end }}
+{{- if .GeneratorConfig.UsesAPIReader }}
+	corev1 "k8s.io/api/core/v1"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+{{- end }}
 )
 
 // +kubebuilder:rbac:groups=services.k8s.aws,resources=adoptedresources,verbs=get;list;watch;create;update;patch;delete
@@ -30,3 +55,78 @@ func GetManagerFactories() []acktypes.AWSResourceManagerFactory {
 func RegisterManagerFactory(f acktypes.AWSResourceManagerFactory) {
 	reg.RegisterResourceManagerFactory(f)
 }
+{{- if .GeneratorConfig.UsesAPIReader }}
+
+// apiReader reads the namespaces of the resources retained by default and the
+// ConfigMaps the fields of the resources refer to
+var apiReader ctrlrtclient.Reader
+
+// SetAPIReader sets the reader the resource managers read Kubernetes objects
+// with
+func SetAPIReader(r ctrlrtclient.Reader) {
+	apiReader = r
+}
+{{- end }}
+{{- if .GeneratorConfig.HasConfigMapFields }}
+
+// ConfigMapValue returns the value of the supplied key of the ConfigMap with
+// the supplied namespace and name
+func ConfigMapValue(
+	ctx context.Context,
+	namespace string,
+	name string,
+	key string,
+) (string, error) {
+	if apiReader == nil {
+		return "", fmt.Errorf("cannot read ConfigMap %s/%s, no API reader is set", namespace, name)
+	}
+	cm := &corev1.ConfigMap{}
+	if err := apiReader.Get(ctx, ctrlrtclient.ObjectKey{Namespace: namespace, Name: name}, cm); err != nil {
+		return "", err
+	}
+	if value, ok := cm.Data[key]; ok {
+		return value, nil
+	}
+	return "", fmt.Errorf("key %q not found in ConfigMap %s/%s", key, namespace, name)
+}
+{{- end }}
+{{- if .GeneratorConfig.HasRetainedResources }}
+
+// NamespaceDeletionPolicy returns the value of the
+// `{{ .ControllerName }}.services.k8s.aws/deletion-policy` annotation of the supplied
+// namespace, or an empty string if the namespace has none
+func NamespaceDeletionPolicy(
+	ctx context.Context,
+	namespace string,
+) (string, error) {
+	if apiReader == nil || namespace == "" {
+		return "", nil
+	}
+	ns := &corev1.Namespace{}
+	if err := apiReader.Get(ctx, ctrlrtclient.ObjectKey{Name: namespace}, ns); err != nil {
+		return "", err
+	}
+	return ns.GetAnnotations()["{{ .ControllerName }}."+ackv1alpha1.AnnotationDeletionPolicy], nil
+}
+{{- end }}
+{{- with .GeneratorConfig.Endpoint }}
+
+// SDKClientConfig returns the configuration the {{ $.ServicePackageName }} API clients of the
+// controller are constructed with, customizing the endpoint they send their
+// requests to
+func SDKClientConfig() *aws.Config {
+	cfg := aws.NewConfig()
+{{- if .UseFIPS }}
+	cfg.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+{{- end }}
+{{- if .UseDualStack }}
+	cfg.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+{{- end }}
+{{- if .URLEnvVar }}
+	if endpointURL := os.Getenv("{{ .URLEnvVar }}"); endpointURL != "" {
+		cfg = cfg.WithEndpoint(endpointURL)
+	}
+{{- end }}
+	return cfg
+}
+{{- end }}
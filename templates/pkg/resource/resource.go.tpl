@@ -3,6 +3,15 @@
 package {{ .CRD.Names.Snake }}
 
 import (
+{{- if or .CRD.HasCompositePrimaryKey .CRD.HasFieldsFromARN }}
+	"fmt"
+{{- end }}
+{{- if .CRD.HasFieldsFromARN }}
+	"regexp"
+{{- end }}
+{{- if .CRD.HasCompositePrimaryKey }}
+	"strings"
+{{- end }}
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
 	ackerrors "github.com/aws-controllers-k8s/runtime/pkg/errors"
@@ -76,11 +85,24 @@ func (r *resource) SetIdentifiers(identifier *ackv1alpha1.AWSIdentifiers) error
 {{ $hookCode }}
 {{- end }}
 {{- GoCodeSetResourceIdentifiers .CRD "identifier" "r.ko" 1}}
+{{- if .CRD.HasFieldsFromARN }}{{ GoCodeSetResourceIdentifiersFromARN .CRD "identifier" "r.ko" 1 -}}
+{{- end }}
 {{- if $hookCode := Hook .CRD "post_set_resource_identifiers" }}
 {{ $hookCode }}
 {{- end }}
 	return nil
 }
+{{- if .CRD.HasCompositePrimaryKey }}
+
+// CompositeIdentifier returns the identifier of the resource made of the
+// values, in order, of its primary key fields separated by
+// "{{ .CRD.PrimaryKeySeparator }}". This identifier is accepted as the NameOrID
+// of the resource when it is adopted. An empty string is returned while any
+// primary key field is not set.
+func (r *resource) CompositeIdentifier() string {
+{{ GoCodeCompositeIdentifier .CRD "r.ko" 1 -}}
+}
+{{- end }}
 
 // DeepCopy will return a copy of the resource
 func (r *resource) DeepCopy() acktypes.AWSResource {
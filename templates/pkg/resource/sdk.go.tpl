@@ -4,10 +4,25 @@ package {{ .CRD.Names.Snake }}
 
 import (
 	"context"
+{{- if .CRD.HasJSONValueFields }}
+	"encoding/json"
+{{- end }}
 	"errors"
 	"fmt"
 	"reflect"
+{{- if .CRD.HasTerminalExceptionMessageRegexes }}
+	"regexp"
+{{- end }}
+{{- if or .CRD.HasListLimitFields .CRD.GetTagSync }}
+	"sort"
+{{- end }}
+{{- if .CRD.CountsCreations }}
+	"strconv"
+{{- end }}
 	"strings"
+{{- if or .CRD.HasTimestampStringFields .CRD.HasPreDelete .CRD.DeleteInProgressCodes .CRD.HasWaiters .CRD.HasOperationTimeouts .CRD.HasOperationRetries .CRD.HasUpdateOperations .CRD.ReconcileRequeueOnSuccessByState }}
+	"time"
+{{- end }}
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
@@ -18,7 +33,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServicePackageName }}"
 	corev1 "k8s.io/api/core/v1"
+{{- if .CRD.HasQuantityFields }}
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+{{- end }}
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+{{- if .CRD.HasJSONValueFields }}
+	"k8s.io/apimachinery/pkg/runtime"
+{{- end }}
 
 	svcapitypes "github.com/aws-controllers-k8s/{{.ControllerName }}-controller/apis/{{ .APIVersion }}"
 )
@@ -36,11 +57,20 @@ var (
 	_ = &reflect.Value{}
 	_ = fmt.Sprintf("")
 	_ = &ackrequeue.NoRequeue{}
+{{- if .CRD.HasListLimitFields }}
+	_ = sort.SliceStable
+{{- end }}
+{{- if .CRD.HasJSONValueFields }}
+	_ = json.Marshal
+	_ = &runtime.RawExtension{}
+{{- end }}
 )
 
 // sdkFind returns SDK-specific information about a supplied resource
 {{ if .CRD.CustomFindMethodName }}
 	{{- template "sdk_find_custom" . }}
+{{- else if .CRD.ListOpMatchTag }}
+	{{- template "sdk_find_read_many" . }}
 {{- else if .CRD.Ops.ReadOne }}
 	{{- template "sdk_find_read_one" . }}
 {{- else if .CRD.Ops.GetAttributes }}
@@ -63,6 +93,25 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+{{- if .CRD.IsObserveOnly }}
+	// {{ .CRD.Names.Camel }} is observe-only and is never created in the
+	// backend AWS service API
+	return nil, ackerr.NewTerminalError(errors.New(
+		"observe-only resource does not exist in the backend AWS service API",
+	))
+}
+{{- else if .CRD.IsAdoptionOnly }}
+	// {{ .CRD.Names.Camel }} can only be adopted and is never created in the
+	// backend AWS service API
+	return nil, ackerr.NewTerminalError(errors.New(
+		"resource can only be adopted, use an AdoptedResource to adopt the " +
+			"existing AWS resource",
+	))
+}
+{{- else if .CRD.SkipsGeneration .CRD.Ops.Create }}
+	return rm.{{ .CRD.GetCustomImplementation .CRD.Ops.Create }}(ctx, desired)
+}
+{{- else }}
 
 {{- if $hookCode := Hook .CRD "sdk_create_pre_build_request" }}
 {{ $hookCode }}
@@ -82,21 +131,38 @@ func (rm *resourceManager) sdkCreate(
 {{- end }}
 
 	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.Create }}; _ = resp;
-	resp, err = rm.sdkapi.{{ .CRD.Ops.Create.ExportedName }}WithContext(ctx, input)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.Create.ExportedName }}", "input", input)
+{{- end }}
+{{ GoCodeSDKCall .CRD .CRD.Ops.Create "resp, err =" "input" 1 }}
 {{- if $hookCode := Hook .CRD "sdk_create_post_request" }}
 {{ $hookCode }}
 {{- end }}
 	rm.metrics.RecordAPICall("CREATE", "{{ .CRD.Ops.Create.ExportedName }}", err)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.Create.ExportedName }}", "output", resp)
+{{- end }}
 	if err != nil {
 		return nil, err
 	}
+{{- if $processOutputMethod := .CRD.ProcessOutputCustomMethodName .CRD.Ops.Create }}
+	if err = rm.{{ $processOutputMethod }}(ctx, desired, resp); err != nil {
+		return nil, err
+	}
+{{- end }}
 	// Merge in the information we read from the API call above to the copy of
 	// the original Kubernetes object we passed to the function
 	ko := desired.ko.DeepCopy()
+{{- if .CRD.CountsCreations }}
+	incrementCreateCount(ko)
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_create_pre_set_output" }}
 {{ $hookCode }}
 {{- end }}
 {{ GoCodeSetCreateOutput .CRD "resp" "ko" 1 }}
+{{- if .CRD.SecretOutputFields }}
+{{ GoCodeWriteSecretOutputs .CRD "resp" "ko" 1 }}
+{{- end }}
 	rm.setStatusDefaults(ko)
 {{- if $setOutputCustomMethodName := .CRD.SetOutputCustomMethodName .CRD.Ops.Create }}
 	// custom set output from response
@@ -108,8 +174,50 @@ func (rm *resourceManager) sdkCreate(
 {{- if $hookCode := Hook .CRD "sdk_create_post_set_output" }}
 {{ $hookCode }}
 {{- end }}
+{{- if .CRD.TagsAfterCreate }}
+	// The tags are not passed to {{ .CRD.Ops.Create.ExportedName }}, add them
+	// now that the resource exists
+	if err = rm.syncTags(ctx, &resource{ko}, &resource{&svcapitypes.{{ .CRD.Names.Camel }}{}}); err != nil {
+		// Return the created resource so its identifiers are persisted
+		return &resource{ko}, err
+	}
+{{- end }}
+{{- if or .CRD.ReadsAfterCreate .CRD.CreateWaiter .CRD.ReconcileRequeueOnSuccessByState }}
+	created = &resource{ko}
+{{- if .CRD.CreateWaiter }}
+	// Wait for the resource to be available
+{{ GoCodeWaitUntil .CRD "create" "created" 1 -}}
+{{- end }}
+{{- if .CRD.ReadsAfterCreate }}
+	// Read the resource right after its creation to fill in the Status fields
+	// not returned by the Create operation
+	observed, err := rm.sdkFind(ctx, created)
+	if err == nil {
+		created.SetStatus(observed)
+	} else if err != ackerr.NotFound {
+		return created, err
+	}
+	// The resource may not be readable right after its creation, in which case
+	// its Status is filled in by the next read of the reconciliation
+{{- end }}
+{{- if .CRD.ReconcileRequeueOnSuccessByState }}
+	return created, requeueOnSuccessByState(created)
+{{- else }}
+	return created, nil
+{{- end }}
+{{- else }}
 	return &resource{ko}, nil
+{{- end }}
 }
+{{- if .CRD.ReconcileRequeueOnSuccessByState }}
+
+// requeueOnSuccessByState returns a request to requeue the supplied created or
+// updated resource after the delay configured for its observed state, see
+// `requeue_on_success_by_state`, or nil if none is configured
+func requeueOnSuccessByState(r *resource) error {
+{{ GoCodeRequeueOnSuccessByState .CRD "r" 1 }}	return nil
+}
+{{- end }}
 
 // newCreateRequestPayload returns an SDK-specific struct for the HTTP request
 // payload of the Create API call for the resource
@@ -121,15 +229,22 @@ func (rm *resourceManager) newCreateRequestPayload(
 {{ GoCodeSetCreateInput .CRD "r.ko" "res" 1 }}
 	return res, nil
 }
+{{- end }}
 
 // sdkUpdate patches the supplied resource in the backend AWS service API and
 // returns a new resource with updated fields.
-{{ if .CRD.CustomUpdateMethodName }}
+{{ if .CRD.IsObserveOnly }}
+	{{- template "sdk_update_observe_only" . }}
+{{- else if .CRD.CustomUpdateMethodName }}
 	{{- template "sdk_update_custom" . }}
 {{- else if .CRD.Ops.Update }}
 	{{- template "sdk_update" . }}
+{{- else if .CRD.HasUpdateOperations }}
+	{{- template "sdk_update_operations" . }}
 {{- else if .CRD.Ops.SetAttributes }}
 	{{- template "sdk_update_set_attributes" . }}
+{{- else if .CRD.GetTagSync }}
+	{{- template "sdk_update_tags" . }}
 {{- else }}
 	{{- template "sdk_update_not_implemented" . }}
 {{- end }}
@@ -145,9 +260,30 @@ func (rm *resourceManager) sdkDelete(
 		exit(err)
 	}()
 
-{{- if .CRD.CustomDeleteMethodName }}
+{{- if .CRD.IsObserveOnly }}
+	// {{ .CRD.Names.Camel }} is observe-only and is never deleted in the
+	// backend AWS service API
+	return nil, nil
+{{- else if .CRD.CustomDeleteMethodName }}
 	{{- template "sdk_delete_custom" . }}
+{{- else if .CRD.SkipsGeneration .CRD.Ops.Delete }}
+{{- if .CRD.HasAuxiliaryResources }}
+	if err = rm.deleteAuxiliaryResources(ctx, r); err != nil {
+		return nil, err
+	}
+{{- end }}
+	return nil, rm.{{ .CRD.GetCustomImplementation .CRD.Ops.Delete }}(ctx, r)
 {{- else if .CRD.Ops.Delete }}
+{{- if .CRD.HasPreDelete }}
+	if err = rm.sdkPreDelete(ctx, r); err != nil {
+		return r, err
+	}
+{{- end }}
+{{- if .CRD.HasAuxiliaryResources }}
+	if err = rm.deleteAuxiliaryResources(ctx, r); err != nil {
+		return nil, err
+	}
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_delete_pre_build_request" }}
 {{ $hookCode }}
 {{- end }}
@@ -164,18 +300,73 @@ func (rm *resourceManager) sdkDelete(
 {{ $hookCode }}
 {{- end }}
 	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.Delete }}; _ = resp;
-	resp, err = rm.sdkapi.{{ .CRD.Ops.Delete.ExportedName }}WithContext(ctx, input)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.Delete.ExportedName }}", "input", input)
+{{- end }}
+{{ GoCodeSDKCall .CRD .CRD.Ops.Delete "resp, err =" "input" 1 }}
 	rm.metrics.RecordAPICall("DELETE", "{{ .CRD.Ops.Delete.ExportedName }}", err)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.Delete.ExportedName }}", "output", resp)
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_delete_post_request" }}
 {{ $hookCode }}
+{{- end }}
+{{- if or .CRD.DeleteNotFoundCodes .CRD.DeleteInProgressCodes }}
+	if awsErr, ok := ackerr.AWSError(err); ok {
+		switch awsErr.Code() {
+{{- if .CRD.DeleteNotFoundCodes }}
+		case {{ range $x, $code := .CRD.DeleteNotFoundCodes -}}{{ if ne ($x) (0) }}, {{ end }}"{{ $code }}"{{ end }}:
+			// The resource is already gone
+			err = nil
+{{- end }}
+{{- if .CRD.DeleteInProgressCodes }}
+		case {{ range $x, $code := .CRD.DeleteInProgressCodes -}}{{ if ne ($x) (0) }}, {{ end }}"{{ $code }}"{{ end }}:
+			// The resource is already being deleted, requeue until it is gone
+			return r, ackrequeue.NeededAfter(nil, deleteInProgressRequeueAfter)
+{{- end }}
+		}
+	}
+{{- end }}
+{{- if .CRD.DeleteWaiter }}
+	if err != nil {
+		return nil, err
+	}
+	// Wait for the resource to be gone
+{{ GoCodeWaitUntil .CRD "delete" "r" 1 -}}
+{{- end }}
+	return nil, err
+{{- else if .CRD.SingletonResetsOnDelete }}
+	// Singleton settings are never deleted, only reset to their defaults
+	input, err := rm.newCreateRequestPayload(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+{{ GoCodeSetSingletonResetInput .CRD "input" 1 }}
+	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.Create }}; _ = resp;
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.Create.ExportedName }}", "input", input)
+{{- end }}
+{{ GoCodeSDKCall .CRD .CRD.Ops.Create "resp, err =" "input" 1 }}
+	rm.metrics.RecordAPICall("DELETE", "{{ .CRD.Ops.Create.ExportedName }}", err)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.Create.ExportedName }}", "output", resp)
 {{- end }}
 	return nil, err
+{{- else if .CRD.IsSingleton }}
+	// Singleton settings are never deleted and are left as they are
+	return nil, nil
 {{- else }}
 	// TODO(jaypipes): Figure this out...
 	return nil, nil
 {{ end }}
 }
 
+{{ if .CRD.DeleteInProgressCodes -}}
+// deleteInProgressRequeueAfter is the duration after which the deletion of a
+// resource that is already being deleted is requeued
+const deleteInProgressRequeueAfter = 10 * time.Second
+
+{{ end -}}
 {{ if .CRD.Ops.Delete -}}
 // newDeleteRequestPayload returns an SDK-specific struct for the HTTP request
 // payload of the Delete API call for the resource
@@ -184,8 +375,17 @@ func (rm *resourceManager) newDeleteRequestPayload(
 ) (*svcsdk.{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }}, error) {
 	res := &svcsdk.{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }}{}
 {{ GoCodeSetDeleteInput .CRD "r.ko" "res" 1 }}
+{{- if .CRD.HasForceDelete }}
+{{ GoCodeSetForceDeleteInput .CRD "r" "res" 1 }}
+{{- end }}
 	return res, nil
 }
+{{- if .CRD.ForceDeleteWithAnnotation }}
+
+// forceDeleteAnnotation is the annotation that, set to "true" on a resource,
+// forces its deletion
+const forceDeleteAnnotation = "{{ .APIGroup }}/force-delete"
+{{- end }}
 {{- end }}
 
 // setStatusDefaults sets default properties into supplied custom resource
@@ -247,12 +447,18 @@ func (rm *resourceManager) updateConditions (
 			errorMessage = awsErr.Error()
 		}
 		terminalCondition.Status = corev1.ConditionTrue
+{{- if .CRD.ErrorConditions }}
+		terminalCondition.Reason, errorMessage = awsErrorCondition(err, errorMessage)
+{{- end }}
 		terminalCondition.Message = &errorMessage
 	} else {
 		// Clear the terminal condition if no longer present
 		if terminalCondition != nil {
 			terminalCondition.Status = corev1.ConditionFalse
 			terminalCondition.Message = nil
+{{- if .CRD.ErrorConditions }}
+			terminalCondition.Reason = nil
+{{- end }}
 		}
 		// Handling Recoverable Conditions
 		if err != nil {
@@ -269,10 +475,16 @@ func (rm *resourceManager) updateConditions (
 			if awsErr != nil {
 				errorMessage = awsErr.Error()
 			}
+{{- if .CRD.ErrorConditions }}
+			recoverableCondition.Reason, errorMessage = awsErrorCondition(err, errorMessage)
+{{- end }}
 			recoverableCondition.Message = &errorMessage
 		} else if recoverableCondition != nil {
 			recoverableCondition.Status = corev1.ConditionFalse
 			recoverableCondition.Message = nil
+{{- if .CRD.ErrorConditions }}
+			recoverableCondition.Reason = nil
+{{- end }}
 		}
 	}
 
@@ -292,9 +504,23 @@ func (rm *resourceManager) updateConditions (
 {{- if $updateConditionsCustomMethodName := .CRD.UpdateConditionsCustomMethodName }}
 	// custom update conditions
 	customUpdate := rm.{{ $updateConditionsCustomMethodName }}(ko, r, err)
+{{- if .CRD.ConditionsConfig }}
+	// deduplicate, order and prune conditions
+	normalized := normalizeConditions(ko)
+	if terminalCondition != nil || recoverableCondition != nil || syncCondition != nil || customUpdate || normalized {
+		return &resource{ko}, true // updated
+	}
+{{- else }}
 	if terminalCondition != nil || recoverableCondition != nil || syncCondition != nil || customUpdate {
 		return &resource{ko}, true // updated
 	}
+{{- end }}
+{{- else if .CRD.ConditionsConfig }}
+	// deduplicate, order and prune conditions
+	normalized := normalizeConditions(ko)
+	if terminalCondition != nil || recoverableCondition != nil || syncCondition != nil || normalized {
+		return &resource{ko}, true // updated
+	}
 {{- else }}
 	if terminalCondition != nil || recoverableCondition != nil || syncCondition != nil {
 		return &resource{ko}, true // updated
@@ -303,11 +529,43 @@ func (rm *resourceManager) updateConditions (
 	return nil, false // not updated
 }
 
+{{ if .CRD.ErrorConditions -}}
+// awsErrorConditions maps the AWS error codes to the reason and message of
+// the condition set when the resource manager fails with that error
+var awsErrorConditions = map[string]struct {
+	reason  string
+	message string
+}{
+{{- range $errCond := .CRD.ErrorConditions }}
+	{{ printf "%q" $errCond.Code }}: { {{- printf "%q" $errCond.Reason }}, {{ printf "%q" $errCond.Message -}} },
+{{- end }}
+}
+
+// awsErrorCondition returns the reason and message of the condition set for
+// the supplied error when its AWS error code is mapped in awsErrorConditions,
+// and a nil reason and the supplied message otherwise.
+func awsErrorCondition(err error, errorMessage string) (*string, string) {
+	awsErr, ok := ackerr.AWSError(err)
+	if !ok {
+		return nil, errorMessage
+	}
+	errCond, found := awsErrorConditions[awsErr.Code()]
+	if !found {
+		return nil, errorMessage
+	}
+	reason := errCond.reason
+	if errCond.message == "" {
+		return &reason, errorMessage
+	}
+	return &reason, fmt.Sprintf("%s: %s", errCond.message, errorMessage)
+}
+
+{{ end -}}
 // terminalAWSError returns awserr, true; if the supplied error is an aws Error type
 // and if the exception indicates that it is a Terminal exception
 // 'Terminal' exception are specified in generator configuration
 func (rm *resourceManager) terminalAWSError(err error) bool {
-{{- if .CRD.TerminalExceptionCodes }}
+{{- if or .CRD.TerminalExceptionCodes .CRD.TerminalExceptionMessages }}
 	if err == nil {
 		return false
 	}
@@ -315,18 +573,345 @@ func (rm *resourceManager) terminalAWSError(err error) bool {
 	if !ok {
 		return false
 	}
+{{- if .CRD.TerminalExceptionCodes }}
 	switch awsErr.Code() {
 	case {{ range $x, $terminalCode := .CRD.TerminalExceptionCodes -}}{{ if ne ($x) (0) }},
 		{{ end }} "{{ $terminalCode }}"{{ end }}:
 		return true
-	default:
-		return false
 	}
+{{- end }}
+{{ GoCodeCheckTerminalExceptionMessages .CRD 1 }}	return false
 {{- else }}
 	// No terminal_errors specified for this resource in generator config
 	return false
 {{- end }}
 }
+{{- if .CRD.HasTerminalExceptionMessageRegexes }}
+
+// Compiled message_regex of the terminal_messages of the resource
+var (
+{{ GoCodeTerminalExceptionMessageRegexes .CRD 1 }})
+{{- end }}
+
+{{- if .CRD.HasAuxiliaryResources }}
+
+// deleteAuxiliaryResources cleans up the auxiliary AWS resources provisioned
+// alongside the supplied resource, so they are not orphaned once the resource
+// is deleted. It is called before the resource is deleted, so that a failed
+// clean up is retried while the resource still exists.
+func (rm *resourceManager) deleteAuxiliaryResources(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.deleteAuxiliaryResources")
+	defer func() {
+		exit(err)
+	}()
+{{ GoCodeDeleteAuxiliaryResources .CRD "r" 1 }}
+	return nil
+}
+{{- end }}
+
+{{- if .CRD.HasUpdateOperations }}
+
+// updateOperationsRequeueAfter is the delay after which a resource is
+// requeued to call its next additional update operation
+const updateOperationsRequeueAfter = 5 * time.Second
+
+// updateWithOperations calls the first additional update operation, see
+// `update_operations`, of the fields of the supplied resource that differ.
+func (rm *resourceManager) updateWithOperations(
+	ctx context.Context,
+	desired *resource,
+	delta *ackcompare.Delta,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.updateWithOperations")
+	defer func() {
+		exit(err)
+	}()
+{{ GoCodeUpdateWithOperations .CRD "desired" 1 }}
+	return nil
+}
+{{- range $op := .CRD.GetUpdateOperations }}
+
+// updateWith{{ $op.ExportedName }} calls {{ $op.ExportedName }} to update the changed
+// fields of the supplied resource it updates.
+func (rm *resourceManager) updateWith{{ $op.ExportedName }}(
+	ctx context.Context,
+	r *resource,
+	delta *ackcompare.Delta,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.updateWith{{ $op.ExportedName }}")
+	defer func() {
+		exit(err)
+	}()
+	input := &svcsdk.{{ $op.InputRef.Shape.ShapeName }}{}
+{{ GoCodeSetUpdateOperationInput $.CRD $op "r.ko" "input" 1 }}
+{{ GoCodeSDKCall $.CRD $op "_, err =" "input" 1 }}
+	rm.metrics.RecordAPICall("UPDATE", "{{ $op.ExportedName }}", err)
+	return err
+}
+{{- end }}
+{{- end }}
+
+{{- if .CRD.GetTagSync }}
+
+// syncTags updates the tags of the supplied resource with the tagging
+// operations of the service, see `tags.sync`, adding the desired tags missing
+// from the latest resource and removing the latest tags missing from the
+// desired resource.
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer func() {
+		exit(err)
+	}()
+{{ GoCodeSyncTags .CRD "desired" "latest" 1 }}
+	return nil
+}
+{{- end }}
+
+{{- if .CRD.ReadsTags }}
+
+// readTags sets the tags of the supplied resource to the tags of the AWS
+// resource, which the operation reading the resource does not return, see
+// `tags.sync.list_operation`.
+func (rm *resourceManager) readTags(
+	ctx context.Context,
+	ko *svcapitypes.{{ .CRD.Names.Camel }},
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.readTags")
+	defer func() {
+		exit(err)
+	}()
+{{ GoCodeReadTags .CRD "ko" 1 }}
+	return nil
+}
+{{- end }}
+
+{{- if .CRD.CountsCreations }}
+
+// createCountAnnotation is the annotation counting the times the resource was
+// created in the AWS service. It is part of the idempotency token of the
+// Create operation, so that a resource deleted outside of the controller is
+// created anew rather than matched with the deleted one.
+const createCountAnnotation = "{{ .APIGroup }}/create-count"
+
+// incrementCreateCount records a creation of the supplied resource in the AWS
+// service
+func incrementCreateCount(ko *svcapitypes.{{ .CRD.Names.Camel }}) {
+	annotations := ko.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	count, _ := strconv.Atoi(annotations[createCountAnnotation])
+	annotations[createCountAnnotation] = strconv.Itoa(count + 1)
+	ko.SetAnnotations(annotations)
+}
+{{- end }}
+
+{{- if .CRD.HasReadOneOperations }}
+
+// findWithReadOneOperations reads the supplied resource with the first of its
+// additional ReadOne operations, see `read_one_operations`, whose required
+// fields are set, returning NotFound if there is none.
+func (rm *resourceManager) findWithReadOneOperations(
+	ctx context.Context,
+	r *resource,
+) (*resource, error) {
+{{- range $op := .CRD.GetReadOneOperations }}
+	if !rm.requiredFieldsMissingFrom{{ $op.ExportedName }}Input(r) {
+		return rm.findWith{{ $op.ExportedName }}(ctx, r)
+	}
+{{- end }}
+	return nil, ackerr.NotFound
+}
+{{- range $op := .CRD.GetReadOneOperations }}
+
+// requiredFieldsMissingFrom{{ $op.ExportedName }}Input returns true if there are
+// any fields required to call {{ $op.ExportedName }} that are not present in the
+// resource's Spec or Status
+func (rm *resourceManager) requiredFieldsMissingFrom{{ $op.ExportedName }}Input(
+	r *resource,
+) bool {
+{{ GoCodeRequiredFieldsMissingFromReadOneOperationInput $.CRD $op "r.ko" 1 }}
+}
+
+// findWith{{ $op.ExportedName }} reads the supplied resource with {{ $op.ExportedName }}.
+func (rm *resourceManager) findWith{{ $op.ExportedName }}(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.findWith{{ $op.ExportedName }}")
+	defer func() {
+		exit(err)
+	}()
+	input := &svcsdk.{{ $op.InputRef.Shape.ShapeName }}{}
+{{ GoCodeSetReadOneOperationInput $.CRD $op "r.ko" "input" 1 }}
+{{- if $hookCode := Hook $.CRD "sdk_read_one_post_build_request" }}
+{{ $hookCode }}
+{{- end }}
+	var resp {{ $.CRD.GetOutputShapeGoType $op }}
+{{- if $.CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ $op.ExportedName }}", "input", input)
+{{- end }}
+{{ GoCodeSDKCall $.CRD $op "resp, err =" "input" 1 }}
+{{- if $hookCode := Hook $.CRD "sdk_read_one_post_request" }}
+{{ $hookCode }}
+{{- end }}
+	rm.metrics.RecordAPICall("READ_ONE", "{{ $op.ExportedName }}", err)
+{{- if $.CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ $op.ExportedName }}", "output", resp)
+{{- end }}
+	if err != nil {
+		if reqErr, ok := ackerr.AWSRequestFailure(err); ok && reqErr.StatusCode() == 404 {
+			return nil, ackerr.NotFound
+		}
+		if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == "{{ ResourceExceptionCode $.CRD 404 }}" {{ GoCodeSetExceptionMessageCheck $.CRD 404 }}{
+			return nil, ackerr.NotFound
+		}
+		return nil, err
+	}
+{{- if $processOutputMethod := $.CRD.ProcessOutputCustomMethodName $op }}
+	if err = rm.{{ $processOutputMethod }}(ctx, r, resp); err != nil {
+		return nil, err
+	}
+{{- end }}
+
+	ko := r.ko.DeepCopy()
+{{- if $hookCode := Hook $.CRD "sdk_read_one_pre_set_output" }}
+{{ $hookCode }}
+{{- end }}
+{{ GoCodeSetReadOneOperationOutput $.CRD $op "resp" "ko" 1 }}
+{{- if $.CRD.ReadManySourcedFields }}
+	if err = rm.setReadManySourcedFields(ctx, ko); err != nil {
+		return nil, err
+	}
+{{- end }}
+{{- if $.CRD.AttributeSourcedFields }}
+	if err = rm.setAttributeSourcedFields(ctx, ko); err != nil {
+		return nil, err
+	}
+{{- end }}
+{{- if $.CRD.ReadsTags }}
+	if err = rm.readTags(ctx, ko); err != nil {
+		return nil, err
+	}
+{{- end }}
+	rm.setStatusDefaults(ko)
+{{- if $hookCode := Hook $.CRD "sdk_read_one_post_set_output" }}
+{{ $hookCode }}
+{{- end }}
+	return &resource{ko}, nil
+}
+{{- end }}
+{{- end }}
+
+{{- if .CRD.HasPreDelete }}
+
+// preDeleteRequeueAfter is the duration after which the deletion of a
+// resource that is not yet ready to be deleted is requeued
+const preDeleteRequeueAfter = 10 * time.Second
+
+// sdkPreDelete prepares the supplied resource for its deletion, e.g. by
+// disabling it, returning a requeue error until the resource can be deleted.
+func (rm *resourceManager) sdkPreDelete(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.sdkPreDelete")
+	defer func() {
+		exit(err)
+	}()
+{{ GoCodePreDelete .CRD "r" 1 }}
+	return nil
+}
+{{- end }}
+{{- if .CRD.HasAttachments }}
+
+// sdkFindAttachments reads the attachments of the supplied resource, i.e. the
+// Spec fields read and written with their own operations of the AWS API, into
+// the resource.
+func (rm *resourceManager) sdkFindAttachments(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.sdkFindAttachments")
+	defer func() {
+		exit(err)
+	}()
+{{ GoCodeFindAttachments .CRD "r" 1 }}
+	return nil
+}
+
+// sdkPutAttachments writes the attachments of the supplied resource, i.e. the
+// Spec fields read and written with their own operations of the AWS API. With
+// a nil delta every set attachment is written, otherwise only the attachments
+// that differ.
+func (rm *resourceManager) sdkPutAttachments(
+	ctx context.Context,
+	r *resource,
+	delta *ackcompare.Delta,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.sdkPutAttachments")
+	defer func() {
+		exit(err)
+	}()
+{{ GoCodePutAttachments .CRD "r" 1 }}
+	return nil
+}
+{{- end }}
+
+{{- if .CRD.HasCollections }}
+
+// sdkFindCollections reads the collections of the supplied resource, i.e. the
+// list Spec fields whose members are added and removed with their own
+// operations of the AWS API, into the resource.
+func (rm *resourceManager) sdkFindCollections(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.sdkFindCollections")
+	defer func() {
+		exit(err)
+	}()
+{{ GoCodeFindCollections .CRD "r" 1 }}
+	return nil
+}
+
+// sdkSyncCollections adds the members of the collections of the supplied
+// desired resource missing from the latest observed resource, and removes
+// the members missing from the desired resource. With a nil delta, and a nil
+// latest resource, every member is added, otherwise only the collections
+// that differ are synced.
+func (rm *resourceManager) sdkSyncCollections(
+	ctx context.Context,
+	r *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.sdkSyncCollections")
+	defer func() {
+		exit(err)
+	}()
+{{ GoCodeSyncCollections .CRD "r" "latest" 1 }}
+	return nil
+}
+{{- end }}
 
 {{- if .CRD.HasImmutableFieldChanges }}
 // getImmutableFieldChanges returns list of immutable fields from the
@@ -348,3 +933,81 @@ func (rm *resourceManager) getImmutableFieldChanges(
 {{- if $hookCode := Hook .CRD "sdk_file_end" }}
 {{ $hookCode }}
 {{- end }}
+{{- if .CRD.ReadManySourcedFields }}
+
+// setReadManySourcedFields sets the fields of the supplied resource whose
+// values are only returned by the {{ .CRD.Ops.ReadMany.ExportedName }} operation. The fields are
+// cleared when the resource is not found in the results.
+func (rm *resourceManager) setReadManySourcedFields(
+	ctx context.Context,
+	ko *svcapitypes.{{ .CRD.Names.Camel }},
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.setReadManySourcedFields")
+	defer func() {
+		exit(err)
+	}()
+	input, err := rm.newReadManySourcedFieldsRequestPayload(&resource{ko})
+	if err != nil {
+		return err
+	}
+{{ GoCodeSetReadManySourcedFields .CRD "input" "ko" 1 }}
+	return nil
+}
+
+// newReadManySourcedFieldsRequestPayload returns SDK-specific struct for the
+// HTTP request payload of the List API call setting the fields of the
+// resource only returned by that call
+func (rm *resourceManager) newReadManySourcedFieldsRequestPayload(
+	r *resource,
+) (*svcsdk.{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}, error) {
+	res := &svcsdk.{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}{}
+{{ GoCodeSetReadManyInput .CRD "r.ko" "res" 1 }}
+	return res, nil
+}
+{{- end }}
+{{- if and .CRD.AttributeSourcedFields .CRD.Ops.ReadOne }}
+
+// setAttributeSourcedFields sets the fields of the supplied resource whose
+// values are only returned in the attributes map of the
+// {{ .CRD.Ops.GetAttributes.ExportedName }} operation.
+func (rm *resourceManager) setAttributeSourcedFields(
+	ctx context.Context,
+	ko *svcapitypes.{{ .CRD.Names.Camel }},
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.setAttributeSourcedFields")
+	defer func() {
+		exit(err)
+	}()
+	input, err := rm.newAttributeSourcedFieldsRequestPayload(&resource{ko})
+	if err != nil {
+		return err
+	}
+	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.GetAttributes }}
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.GetAttributes.ExportedName }}", "input", input)
+{{- end }}
+{{ GoCodeSDKCall .CRD .CRD.Ops.GetAttributes "resp, err =" "input" 1 }}
+	rm.metrics.RecordAPICall("GET_ATTRIBUTES", "{{ .CRD.Ops.GetAttributes.ExportedName }}", err)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.GetAttributes.ExportedName }}", "output", resp)
+{{- end }}
+	if err != nil {
+		return err
+	}
+{{ GoCodeSetAttributeSourcedFields .CRD "resp" "ko" 1 }}
+	return nil
+}
+
+// newAttributeSourcedFieldsRequestPayload returns SDK-specific struct for the
+// HTTP request payload of the GetAttributes API call setting the fields of
+// the resource only returned in its attributes map
+func (rm *resourceManager) newAttributeSourcedFieldsRequestPayload(
+	r *resource,
+) (*svcsdk.{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }}, error) {
+	res := &svcsdk.{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }}{}
+{{ GoCodeGetAttributesSetInput .CRD "r.ko" "res" 1 }}
+	return res, nil
+}
+{{- end }}
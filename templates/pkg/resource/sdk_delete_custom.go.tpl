@@ -1,3 +1,8 @@
 {{- define "sdk_delete_custom" }}
+{{- if .CRD.HasAuxiliaryResources }}
+	if err = rm.deleteAuxiliaryResources(ctx, r); err != nil {
+		return nil, err
+	}
+{{- end }}
 	return rm.{{ .CRD.CustomDeleteMethodName }}(ctx, r)
-{{- end }}
\ No newline at end of file
+{{- end }}
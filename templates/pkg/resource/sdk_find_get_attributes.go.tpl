@@ -27,24 +27,48 @@ func (rm *resourceManager) sdkFind(
 {{ $hookCode }}
 {{- end }}
 	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.GetAttributes }}
-	resp, err = rm.sdkapi.{{ .CRD.Ops.GetAttributes.ExportedName }}WithContext(ctx, input)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.GetAttributes.ExportedName }}", "input", input)
+{{- end }}
+{{ GoCodeSDKCall .CRD .CRD.Ops.GetAttributes "resp, err =" "input" 1 }}
 {{- if $hookCode := Hook .CRD "sdk_get_attributes_post_request" }}
 {{ $hookCode }}
 {{- end }}
 	rm.metrics.RecordAPICall("GET_ATTRIBUTES", "{{ .CRD.Ops.GetAttributes.ExportedName }}", err)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.GetAttributes.ExportedName }}", "output", resp)
+{{- end }}
 	if err != nil {
 		if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == "{{ ResourceExceptionCode .CRD 404 }}" {{ GoCodeSetExceptionMessageCheck .CRD 404 }}{
 			return nil, ackerr.NotFound
 		}
 		return nil, err
 	}
+{{- if $processOutputMethod := .CRD.ProcessOutputCustomMethodName .CRD.Ops.GetAttributes }}
+	if err = rm.{{ $processOutputMethod }}(ctx, r, resp); err != nil {
+		return nil, err
+	}
+{{- end }}
 
 	// Merge in the information we read from the API call above to the copy of
 	// the original Kubernetes object we passed to the function
 	ko := r.ko.DeepCopy()
 {{ GoCodeGetAttributesSetOutput .CRD "resp" "ko" 1 }}
+{{- if .CRD.AttributeSourcedFields }}
+{{ GoCodeSetAttributeSourcedFields .CRD "resp" "ko" 1 }}
+{{- end }}
+{{- if .CRD.ReadManySourcedFields }}
+	if err = rm.setReadManySourcedFields(ctx, ko); err != nil {
+		return nil, err
+	}
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_get_attributes_pre_set_output" }}
 {{ $hookCode }}
+{{- end }}
+{{- if .CRD.ReadsTags }}
+	if err = rm.readTags(ctx, ko); err != nil {
+		return nil, err
+	}
 {{- end }}
 	rm.setStatusDefaults(ko)
 {{- if $hookCode := Hook .CRD "sdk_get_attributes_post_set_output" }}
@@ -27,18 +27,32 @@ func (rm *resourceManager) sdkFind(
 {{ $hookCode }}
 {{- end }}
 	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.ReadMany }}
-	resp, err = rm.sdkapi.{{ .CRD.Ops.ReadMany.ExportedName }}WithContext(ctx, input)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.ReadMany.ExportedName }}", "input", input)
+{{- end }}
+{{ GoCodeSDKCall .CRD .CRD.Ops.ReadMany "resp, err =" "input" 1 }}
 {{- if $hookCode := Hook .CRD "sdk_read_many_post_request" }}
 {{ $hookCode }}
 {{- end }}
 	rm.metrics.RecordAPICall("READ_MANY", "{{ .CRD.Ops.ReadMany.ExportedName }}", err)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.ReadMany.ExportedName }}", "output", resp)
+{{- end }}
 	if err != nil {
 		if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == "{{ ResourceExceptionCode .CRD 404 }}" {{ GoCodeSetExceptionMessageCheck .CRD 404 }}{
 			return nil, ackerr.NotFound
 		}
 		return nil, err
 	}
-
+{{ if $paginationCode := GoCodeReadManyPagination .CRD "input" "resp" 1 }}
+	// Read the pages of results following the first one, so that a resource
+	// beyond the first page is not considered missing
+{{ $paginationCode }}{{ end }}
+{{- if $processOutputMethod := .CRD.ProcessOutputCustomMethodName .CRD.Ops.ReadMany }}
+	if err = rm.{{ $processOutputMethod }}(ctx, r, resp); err != nil {
+		return nil, err
+	}
+{{- end }}
 	// Merge in the information we read from the API call above to the copy of
 	// the original Kubernetes object we passed to the function
 	ko := r.ko.DeepCopy()
@@ -46,6 +60,11 @@ func (rm *resourceManager) sdkFind(
 {{ $hookCode }}
 {{- end }}
 {{ GoCodeSetReadManyOutput .CRD "resp" "ko" 1 }}
+{{- if .CRD.ReadsTags }}
+	if err = rm.readTags(ctx, ko); err != nil {
+		return nil, err
+	}
+{{- end }}
 	rm.setStatusDefaults(ko)
 {{- if $setOutputCustomMethodName := .CRD.SetOutputCustomMethodName .CRD.Ops.ReadMany }}
 	// custom set output from response
@@ -80,6 +99,12 @@ func (rm *resourceManager) newListRequestPayload(
 ) (*svcsdk.{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}, error) {
 	res := &svcsdk.{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}{}
 {{ GoCodeSetReadManyInput .CRD "r.ko" "res" 1 }}
+{{- if $filtersCode := GoCodeSetReadManyFilters .CRD "r.ko" "res" 1 }}
+{{ $filtersCode -}}
+{{- end }}
+{{- if $pageSizeCode := GoCodeReadManyPageSize .CRD "res" 1 }}
+{{ $pageSizeCode -}}
+{{- end }}
 	return res, nil
 }
 {{- end -}}
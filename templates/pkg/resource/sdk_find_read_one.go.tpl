@@ -16,7 +16,11 @@ func (rm *resourceManager) sdkFind(
 	// not created yet. Return NotFound here to indicate to callers that the
 	// resource isn't yet created.
 	if rm.requiredFieldsMissingFromReadOneInput(r) {
+{{- if .CRD.HasReadOneOperations }}
+		return rm.findWithReadOneOperations(ctx, r)
+{{- else }}
 		return nil, ackerr.NotFound
+{{- end }}
 	}
 
 	input, err := rm.newDescribeRequestPayload(r)
@@ -28,11 +32,17 @@ func (rm *resourceManager) sdkFind(
 {{- end }}
 
 	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.ReadOne }}
-	resp, err = rm.sdkapi.{{ .CRD.Ops.ReadOne.ExportedName }}WithContext(ctx, input)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.ReadOne.ExportedName }}", "input", input)
+{{- end }}
+{{ GoCodeSDKCall .CRD .CRD.Ops.ReadOne "resp, err =" "input" 1 }}
 {{- if $hookCode := Hook .CRD "sdk_read_one_post_request" }}
 {{ $hookCode }}
 {{- end }}
 	rm.metrics.RecordAPICall("READ_ONE", "{{ .CRD.Ops.ReadOne.ExportedName }}", err)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.ReadOne.ExportedName }}", "output", resp)
+{{- end }}
 	if err != nil {
 		if reqErr, ok := ackerr.AWSRequestFailure(err); ok && reqErr.StatusCode() == 404 {
 			return nil, ackerr.NotFound
@@ -42,6 +52,11 @@ func (rm *resourceManager) sdkFind(
 		}
 		return nil, err
 	}
+{{- if $processOutputMethod := .CRD.ProcessOutputCustomMethodName .CRD.Ops.ReadOne }}
+	if err = rm.{{ $processOutputMethod }}(ctx, r, resp); err != nil {
+		return nil, err
+	}
+{{- end }}
 
 	// Merge in the information we read from the API call above to the copy of
 	// the original Kubernetes object we passed to the function
@@ -50,6 +65,21 @@ func (rm *resourceManager) sdkFind(
 {{ $hookCode }}
 {{- end }}
 {{ GoCodeSetReadOneOutput .CRD "resp" "ko" 1 }}
+{{- if .CRD.ReadManySourcedFields }}
+	if err = rm.setReadManySourcedFields(ctx, ko); err != nil {
+		return nil, err
+	}
+{{- end }}
+{{- if .CRD.AttributeSourcedFields }}
+	if err = rm.setAttributeSourcedFields(ctx, ko); err != nil {
+		return nil, err
+	}
+{{- end }}
+{{- if .CRD.ReadsTags }}
+	if err = rm.readTags(ctx, ko); err != nil {
+		return nil, err
+	}
+{{- end }}
 	rm.setStatusDefaults(ko)
 {{- if $setOutputCustomMethodName := .CRD.SetOutputCustomMethodName .CRD.Ops.ReadOne }}
 	// custom set output from response
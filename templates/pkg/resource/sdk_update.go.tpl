@@ -16,6 +16,28 @@ func (rm *resourceManager) sdkUpdate(
         return nil, ackerr.NewTerminalError(fmt.Errorf(msg))
     }
 {{- end }}
+{{- with .CRD.GetTagSync }}
+	if delta.DifferentAt("{{ .FieldPath }}") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+{{- end }}
+{{- if .CRD.HasUpdateOperations }}
+	if err = rm.updateWithOperations(ctx, desired, delta); err != nil {
+		return nil, err
+	}
+	if !delta.DifferentExcept({{ range $x, $path := .CRD.GetUpdateOperationFieldPaths "" }}{{ if ne $x 0 }}, {{ end }}"{{ $path }}"{{ end }}{{ with .CRD.GetTagSync }}, "{{ .FieldPath }}"{{ end }}) {
+		// Only fields updated by the additional update operations or the
+		// tagging operations changed
+		return &resource{desired.ko.DeepCopy()}, nil
+	}
+{{- else if .CRD.GetTagSync }}
+	if !delta.DifferentExcept("{{ .CRD.GetTagSync.FieldPath }}") {
+		// Only the tags, updated by the tagging operations, changed
+		return &resource{desired.ko.DeepCopy()}, nil
+	}
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_update_pre_build_request" }}
 {{ $hookCode }}
 {{- end }}
@@ -25,7 +47,28 @@ func (rm *resourceManager) sdkUpdate(
 		return updated, err
 	}
 {{- end }}
+{{- if .CRD.HasDeferredUpdateFields }}
+	// Updates of fields depending on other changed fields are deferred to a
+	// later reconcile
+	requested, deferredFields := rm.deferDependentUpdates(desired, latest, delta)
+	if len(deferredFields) > 0 &&
+		strings.Join(pendingUpdates(desired), ",") == strings.Join(deferredFields, ",") {
+		// The updates the deferred fields depend on were requested by a
+		// previous reconcile of this generation of the resource and are not
+		// complete yet
+		msg := fmt.Sprintf(
+			"waiting for dependent updates before updating: %s",
+			strings.Join(deferredFields, ", "),
+		)
+		updated = &resource{desired.ko.DeepCopy()}
+		ackcondition.SetSynced(updated, corev1.ConditionFalse, &msg, nil)
+		return updated, ackrequeue.Needed(errors.New(msg))
+	}
+	delta = newResourceDelta(requested, latest)
+	input, err := rm.newUpdateRequestPayload(ctx, requested, delta)
+{{- else }}
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
+{{- end }}
 	if err != nil {
 		return nil, err
 	}
@@ -34,14 +77,25 @@ func (rm *resourceManager) sdkUpdate(
 {{- end }}
 
 	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.Update }}; _ = resp;
-	resp, err = rm.sdkapi.{{ .CRD.Ops.Update.ExportedName }}WithContext(ctx, input)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.Update.ExportedName }}", "input", input)
+{{- end }}
+{{ GoCodeSDKCall .CRD .CRD.Ops.Update "resp, err =" "input" 1 }}
 {{- if $hookCode := Hook .CRD "sdk_update_post_request" }}
 {{ $hookCode }}
 {{- end }}
 	rm.metrics.RecordAPICall("UPDATE", "{{ .CRD.Ops.Update.ExportedName }}", err)
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.Update.ExportedName }}", "output", resp)
+{{- end }}
 	if err != nil {
 		return nil, err
 	}
+{{- if $processOutputMethod := .CRD.ProcessOutputCustomMethodName .CRD.Ops.Update }}
+	if err = rm.{{ $processOutputMethod }}(ctx, desired, resp); err != nil {
+		return nil, err
+	}
+{{- end }}
 	// Merge in the information we read from the API call above to the copy of
 	// the original Kubernetes object we passed to the function
 	ko := desired.ko.DeepCopy()
@@ -60,8 +114,90 @@ func (rm *resourceManager) sdkUpdate(
 {{- if $hookCode := Hook .CRD "sdk_update_post_set_output" }}
 {{ $hookCode }}
 {{- end }}
+{{- if .CRD.HasDeferredUpdateFields }}
+	setPendingUpdates(ko, deferredFields)
+	if len(deferredFields) > 0 {
+		msg := fmt.Sprintf(
+			"waiting for dependent updates before updating: %s",
+			strings.Join(deferredFields, ", "),
+		)
+		updated = &resource{ko}
+		ackcondition.SetSynced(updated, corev1.ConditionFalse, &msg, nil)
+		return updated, ackrequeue.Needed(errors.New(msg))
+	}
+{{- end }}
+{{- if .CRD.UpdateWaiter }}
+	updated = &resource{ko}
+	// Wait for the update of the resource to complete
+{{ GoCodeWaitUntil .CRD "update" "updated" 1 }}
+{{- if .CRD.ReconcileRequeueOnSuccessByState }}	return updated, requeueOnSuccessByState(updated)
+{{- else }}	return updated, nil
+{{- end }}
+{{- else if .CRD.ReconcileRequeueOnSuccessByState }}
+	updated = &resource{ko}
+	return updated, requeueOnSuccessByState(updated)
+{{- else }}
 	return &resource{ko}, nil
+{{- end }}
 }
+{{- if .CRD.HasDeferredUpdateFields }}
+
+// deferDependentUpdates returns a copy of the desired resource in which the
+// fields whose updates depend on other changed fields are reset to their
+// latest value, along with the paths of those deferred fields.
+func (rm *resourceManager) deferDependentUpdates(
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (*resource, []string) {
+	ko := desired.ko.DeepCopy()
+	deferred := []string{}
+{{ GoCodeDeferDependentUpdates .CRD "delta" "latest.ko" "ko" "deferred" 1 }}
+	return &resource{ko}, deferred
+}
+
+// pendingUpdatesAnnotation is the annotation recording the generation of the
+// resource and the paths of its fields whose deferred updates are pending,
+// e.g. "3:Spec.ScalingPolicy". It lets the resource manager wait for the
+// updates they depend on, across reconciles and controller restarts, instead
+// of requesting those updates again.
+const pendingUpdatesAnnotation = "{{ .APIGroup }}/pending-updates"
+
+// pendingUpdates returns the paths of the fields whose deferred updates were
+// recorded as pending for the current generation of the supplied resource
+func pendingUpdates(r *resource) []string {
+	value, found := r.ko.GetAnnotations()[pendingUpdatesAnnotation]
+	if !found {
+		return nil
+	}
+	generation, paths, found := strings.Cut(value, ":")
+	if !found || generation != fmt.Sprint(r.ko.GetGeneration()) {
+		return nil
+	}
+	return strings.Split(paths, ",")
+}
+
+// setPendingUpdates records the paths of the supplied deferred fields as
+// pending for the current generation of the supplied resource, or removes the
+// record when no field is deferred
+func setPendingUpdates(ko *svcapitypes.{{ .CRD.Names.Camel }}, deferred []string) {
+	annotations := ko.GetAnnotations()
+	if len(deferred) == 0 {
+		if _, found := annotations[pendingUpdatesAnnotation]; found {
+			delete(annotations, pendingUpdatesAnnotation)
+			ko.SetAnnotations(annotations)
+		}
+		return
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[pendingUpdatesAnnotation] = fmt.Sprintf(
+		"%d:%s", ko.GetGeneration(), strings.Join(deferred, ","),
+	)
+	ko.SetAnnotations(annotations)
+}
+{{- end }}
 
 // newUpdateRequestPayload returns an SDK-specific struct for the HTTP request
 // payload of the Update API call for the resource
@@ -0,0 +1,14 @@
+{{- define "sdk_update_observe_only" -}}
+func (rm *resourceManager) sdkUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (*resource, error) {
+	// {{ .CRD.Names.Camel }} is observe-only and is never updated in the
+	// backend AWS service API. Its Status reflects the latest observed state.
+	ko := desired.ko.DeepCopy()
+	ko.Status = latest.ko.DeepCopy().Status
+	return &resource{ko}, nil
+}
+{{- end -}}
@@ -0,0 +1,36 @@
+{{- define "sdk_update_operations" -}}
+func (rm *resourceManager) sdkUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.sdkUpdate")
+	defer func() {
+		exit(err)
+	}()
+{{- if .CRD.HasImmutableFieldChanges }}
+	if immutableFieldChanges := rm.getImmutableFieldChanges(delta); len(immutableFieldChanges) > 0 {
+		msg := fmt.Sprintf("Immutable Spec fields have been modified: %s", strings.Join(immutableFieldChanges, ","))
+		return nil, ackerr.NewTerminalError(fmt.Errorf(msg))
+	}
+{{- end }}
+{{- if $hookCode := Hook .CRD "sdk_update_pre_build_request" }}
+{{ $hookCode }}
+{{- end }}
+{{- with .CRD.GetTagSync }}
+	if delta.DifferentAt("{{ .FieldPath }}") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+{{- end }}
+	// {{ .CRD.Names.Camel }} has no Update operation, its fields are updated by
+	// the operations they are assigned to in `update_operations`
+	if err = rm.updateWithOperations(ctx, desired, delta); err != nil {
+		return nil, err
+	}
+	return &resource{desired.ko.DeepCopy()}, nil
+}
+{{- end -}}
@@ -21,11 +21,22 @@ func (rm *resourceManager) sdkUpdate(
 	if rm.requiredFieldsMissingFromSetAttributesInput(desired) {
 		panic("Required field in SetAttributes input shape missing!")
 	}
+{{- if .CRD.SetsChangedAttributesOnly }}
+
+	attrMap := rm.changedAttributes(desired, delta)
+	if len(attrMap) == 0 {
+		// None of the attributes to set changed
+		return desired, nil
+	}
+{{- end }}
 
 	input, err := rm.newSetAttributesRequestPayload(desired)
 	if err != nil {
 		return nil, err
 	}
+{{- if and .CRD.SetsChangedAttributesOnly (not .CRD.SetAttributesSingleAttribute) }}
+	input.SetAttributes(attrMap)
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_update_post_build_request" }}
 {{ $hookCode }} 
 {{- end }}
@@ -34,19 +45,21 @@ func (rm *resourceManager) sdkUpdate(
 	// contain any useful information. Instead, below, we'll be returning a
 	// DeepCopy of the supplied desired state, which should be fine because
 	// that desired state has been constructed from a call to GetAttributes...
-	_, respErr := rm.sdkapi.{{ .CRD.Ops.SetAttributes.ExportedName }}WithContext(ctx, input)
-{{- if $hookCode := Hook .CRD "sdk_update_post_request" }}
-{{ $hookCode }}
-{{- end }}
-	rm.metrics.RecordAPICall("SET_ATTRIBUTES", "{{ .CRD.Ops.SetAttributes.ExportedName }}", respErr)
-	if respErr != nil {
-		if awsErr, ok := ackerr.AWSError(respErr); ok && awsErr.Code() == "{{ ResourceExceptionCode .CRD 404 }}" {{ GoCodeSetExceptionMessageCheck .CRD 404 }}{
-			// Technically, this means someone deleted the backend resource in
-			// between the time we got a result back from sdkFind() and here...
-			return nil, ackerr.NotFound
+{{- if and .CRD.SetsChangedAttributesOnly .CRD.SetAttributesSingleAttribute }}
+	// The SetAttributes API call only sets a single attribute at a time, so
+	// it is called once for each changed attribute
+	for _, attrName := range []string{ {{- range $i, $attrName := .CRD.GetSettableAttributeNames }}{{ if $i }}, {{ end }}{{ printf "%q" $attrName }}{{ end -}} } {
+		attrValue, changed := attrMap[attrName]
+		if !changed {
+			continue
 		}
-		return nil, respErr
+		input.SetAttributeName(attrName)
+		input.SetAttributeValue(*attrValue)
+{{ template "sdk_update_set_attributes_call" . }}
 	}
+{{- else }}
+{{ template "sdk_update_set_attributes_call" . }}
+{{- end }}
 
 	// Merge in the information we read from the API call above to the copy of
 	// the original Kubernetes object we passed to the function
@@ -83,4 +96,37 @@ func (rm *resourceManager) newSetAttributesRequestPayload(
 {{ GoCodeSetAttributesSetInput .CRD "r.ko" "res" 1 }}
 	return res, nil
 }
+{{- if .CRD.SetsChangedAttributesOnly }}
+
+// changedAttributes returns the attributes to send in the SetAttributes API
+// calls for the resource: those whose fields differ in the supplied delta and
+// those that are always sent
+func (rm *resourceManager) changedAttributes(
+	r *resource,
+	delta *ackcompare.Delta,
+) map[string]*string {
+{{ GoCodeSetChangedAttributes .CRD "r.ko" "delta" "attrMap" 1 -}}
+	return attrMap
+}
+{{- end }}
+{{- end -}}
+
+{{- define "sdk_update_set_attributes_call" -}}
+{{- if .CRD.LogsSDKPayloads }}
+	logSDKPayload(ctx, "{{ .CRD.Ops.SetAttributes.ExportedName }}", "input", input)
+{{- end }}
+	var respErr error
+{{ GoCodeSDKCall .CRD .CRD.Ops.SetAttributes "_, respErr =" "input" 1 }}
+{{- if $hookCode := Hook .CRD "sdk_update_post_request" }}
+{{ $hookCode }}
+{{- end }}
+	rm.metrics.RecordAPICall("SET_ATTRIBUTES", "{{ .CRD.Ops.SetAttributes.ExportedName }}", respErr)
+	if respErr != nil {
+		if awsErr, ok := ackerr.AWSError(respErr); ok && awsErr.Code() == "{{ ResourceExceptionCode .CRD 404 }}" {{ GoCodeSetExceptionMessageCheck .CRD 404 }}{
+			// Technically, this means someone deleted the backend resource in
+			// between the time we got a result back from sdkFind() and here...
+			return nil, ackerr.NotFound
+		}
+		return nil, respErr
+	}
 {{- end -}}
@@ -0,0 +1,31 @@
+{{- define "sdk_update_tags" -}}
+func (rm *resourceManager) sdkUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.sdkUpdate")
+	defer func() {
+		exit(err)
+	}()
+{{- if .CRD.HasImmutableFieldChanges }}
+	if immutableFieldChanges := rm.getImmutableFieldChanges(delta); len(immutableFieldChanges) > 0 {
+		msg := fmt.Sprintf("Immutable Spec fields have been modified: %s", strings.Join(immutableFieldChanges, ","))
+		return nil, ackerr.NewTerminalError(fmt.Errorf(msg))
+	}
+{{- end }}
+	// {{ .CRD.Names.Camel }} has no Update operation, only its tags are updated
+	// by the tagging operations, see `tags.sync`
+	if delta.DifferentAt("{{ .CRD.GetTagSync.FieldPath }}") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentExcept("{{ .CRD.GetTagSync.FieldPath }}") {
+		return nil, ackerr.NewTerminalError(ackerr.NotImplemented)
+	}
+	return &resource{desired.ko.DeepCopy()}, nil
+}
+{{- end -}}
@@ -0,0 +1,95 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrtwebhook "github.com/aws-controllers-k8s/runtime/pkg/webhook"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ControllerName }}-controller/apis/{{ .APIVersion }}"
+)
+
+// +kubebuilder:webhook:path=/validate-{{ Replace .APIGroup "." "-" }}-{{ .APIVersion }}-{{ ToLower .CRD.Names.Camel }},mutating=false,failurePolicy=fail,sideEffects=None,groups={{ .APIGroup }},resources={{ ToLower .CRD.Plural }},verbs=create,versions={{ .APIVersion }},name=v{{ ToLower .CRD.Names.Camel }}.{{ .APIGroup }},admissionReviewVersions=v1
+
+// singletonValidator rejects the creation of a {{ .CRD.Names.Camel }} when
+// another {{ .CRD.Names.Camel }} already manages the settings of the same
+// namespace and region: {{ .CRD.Names.Camel }} is a singleton and two custom
+// resources would fight over the same AWS settings.
+//
+// The validator only runs when the webhook server is enabled and a
+// ValidatingWebhookConfiguration, generated from the marker above, is
+// installed. Listing the existing resources and admitting the new one is not
+// atomic, so two concurrent creations may both be admitted.
+type singletonValidator struct {
+	client ctrlrtclient.Client
+}
+
+// ValidateCreate implements admission.CustomValidator
+func (v *singletonValidator) ValidateCreate(
+	ctx context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	ko, ok := obj.(*svcapitypes.{{ .CRD.Names.Camel }})
+	if !ok {
+		return nil, fmt.Errorf("expected a {{ .CRD.Names.Camel }} but got %T", obj)
+	}
+	existing := &svcapitypes.{{ .CRD.Names.Camel }}List{}
+	if err := v.client.List(ctx, existing, ctrlrtclient.InNamespace(ko.Namespace)); err != nil {
+		return nil, err
+	}
+	region := ko.GetAnnotations()[ackv1alpha1.AnnotationRegion]
+	for _, other := range existing.Items {
+		if other.Name == ko.Name {
+			continue
+		}
+		if other.GetAnnotations()[ackv1alpha1.AnnotationRegion] == region {
+			return nil, fmt.Errorf(
+				"{{ .CRD.Names.Camel }} %s/%s already manages these settings: only one {{ .CRD.Names.Camel }} may exist per namespace and region",
+				other.Namespace, other.Name,
+			)
+		}
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements admission.CustomValidator
+func (v *singletonValidator) ValidateUpdate(
+	ctx context.Context,
+	oldObj runtime.Object,
+	newObj runtime.Object,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements admission.CustomValidator
+func (v *singletonValidator) ValidateDelete(
+	ctx context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func init() {
+	webhook := ackrtwebhook.New(
+		"{{ .APIVersion }}",
+		"{{ .CRD.Names.Camel }}",
+		"validation",
+		func(mgr ctrlrt.Manager) error {
+			return ctrlrt.NewWebhookManagedBy(mgr).
+				For(&svcapitypes.{{ .CRD.Names.Camel }}{}).
+				WithValidator(&singletonValidator{client: mgr.GetClient()}).
+				Complete()
+		},
+	)
+	if err := ackrtwebhook.RegisterWebhook(webhook); err != nil {
+		msg := fmt.Sprintf("cannot register webhook: %v", err)
+		panic(msg)
+	}
+}
@@ -3,7 +3,18 @@
 package {{ .CRD.Names.Snake }}
 
 import(
+{{- if .CRD.GetTagConversion }}
+    "sort"
+{{- end }}
+{{- if .CRD.GetIgnoredTagKeyPrefixes }}
+    "strings"
+{{- end }}
     acktags "github.com/aws-controllers-k8s/runtime/pkg/tags"
+{{- with .CRD.GetTagConversion }}
+{{- if eq "list" .SDKShape.Type }}
+    svcsdk "github.com/aws/aws-sdk-go/service/{{ $.ServicePackageName }}"
+{{- end }}
+{{- end }}
 
     svcapitypes "github.com/aws-controllers-k8s/{{ .ControllerName }}-controller/apis/{{ .APIVersion }}"
 )
@@ -86,4 +97,102 @@ func FromACKTags(tags acktags.Tags) {{ $tagFieldGoType }} {
     return result
 }
 {{ end }}
-{{ end }}
\ No newline at end of file
+{{ end }}
+{{- with .CRD.GetTagConversion }}
+{{- $fieldGoType := "map[string]*string" }}
+{{- if eq "list" .Field.ShapeRef.Shape.Type }}
+{{- $fieldGoType = (print "[]*svcapitypes." .Field.GoTypeElem) }}
+{{- end }}
+{{- $sdkGoType := "map[string]*string" }}
+{{- if eq "list" .SDKShape.Type }}
+{{- $sdkGoType = (print "[]*svcsdk." .SDKShape.MemberRef.Shape.ShapeName) }}
+{{- end }}
+{{- $keyMemberName := $.CRD.GetTagKeyMemberName }}
+{{- $valueMemberName := $.CRD.GetTagValueMemberName }}
+
+// toSDKTags converts the tags of the resource, a {{ $fieldGoType }}, into the
+// {{ $sdkGoType }} representation of the tags of the AWS API.
+func toSDKTags(tags {{ $fieldGoType }}) {{ $sdkGoType }} {
+    if tags == nil {
+        return nil
+    }
+{{- if eq "list" .SDKShape.Type }}
+    keys := make([]string, 0, len(tags))
+    for k := range tags {
+        keys = append(keys, k)
+    }
+    sort.Strings(keys)
+    result := {{ $sdkGoType }}{}
+    for _, k := range keys {
+        kCopy := k
+        result = append(result, &svcsdk.{{ .SDKShape.MemberRef.Shape.ShapeName }}{ {{ .SDKKeyMemberName }}: &kCopy, {{ .SDKValueMemberName }}: tags[k]})
+    }
+{{- else }}
+    result := {{ $sdkGoType }}{}
+    for _, t := range tags {
+        if t.{{ $keyMemberName }} != nil {
+            result[*t.{{ $keyMemberName }}] = t.{{ $valueMemberName }}
+        }
+    }
+{{- end }}
+    return result
+}
+
+// fromSDKTags converts the {{ $sdkGoType }} representation of the tags of the
+// AWS API into the tags of the resource, a {{ $fieldGoType }}.
+func fromSDKTags(tags {{ $sdkGoType }}) {{ $fieldGoType }} {
+    if tags == nil {
+        return nil
+    }
+{{- if eq "list" .SDKShape.Type }}
+    result := {{ $fieldGoType }}{}
+    for _, t := range tags {
+        if t.{{ .SDKKeyMemberName }} != nil {
+            result[*t.{{ .SDKKeyMemberName }}] = t.{{ .SDKValueMemberName }}
+        }
+    }
+{{- else }}
+    keys := make([]string, 0, len(tags))
+    for k := range tags {
+        keys = append(keys, k)
+    }
+    sort.Strings(keys)
+    result := {{ $fieldGoType }}{}
+    for _, k := range keys {
+        kCopy := k
+        result = append(result, &svcapitypes.{{ .Field.GoTypeElem }}{ {{ $keyMemberName }}: &kCopy, {{ $valueMemberName }}: tags[k]})
+    }
+{{- end }}
+    return result
+}
+{{- end }}
+{{- with .CRD.GetIgnoredTagKeyPrefixes }}
+
+// ignoredTagKeyPrefixes are the prefixes of the keys of the tags managed by
+// AWS, i.e. "aws:", or by other services, see `tags.ignore_key_prefixes`, that
+// are ignored when comparing and updating the tags of the resource.
+var ignoredTagKeyPrefixes = []string{
+{{- range $prefix := . }}
+    {{ printf "%q" $prefix }},
+{{- end }}
+}
+
+// withoutIgnoredTags returns the supplied tags without the tags whose keys
+// have one of the ignoredTagKeyPrefixes.
+func withoutIgnoredTags(tags acktags.Tags) acktags.Tags {
+    result := acktags.NewTags()
+    for k, v := range tags {
+        ignored := false
+        for _, prefix := range ignoredTagKeyPrefixes {
+            if strings.HasPrefix(k, prefix) {
+                ignored = true
+                break
+            }
+        }
+        if !ignored {
+            result[k] = v
+        }
+    }
+    return result
+}
+{{- end }}